@@ -6,6 +6,8 @@ import (
 	"log"
 	"sync"
 	"time"
+
+	"github.com/hashicorp/errwrap"
 )
 
 const defaultBatchSendIntervalSec = 10
@@ -81,6 +83,10 @@ type startedBatch struct {
 type batchingConfig struct {
 	sendAfter      time.Duration
 	enableBatching bool
+
+	// Polls of compute operations are only batched when opted into, since
+	// every batched poll waits for sendAfter.
+	enableComputeOperationBatching bool
 }
 
 // Initializes a new batcher.
@@ -154,7 +160,8 @@ func (b *RequestBatcher) SendRequestWithTimeout(batchKey string, request *BatchR
 	select {
 	case resp := <-respCh:
 		if resp.err != nil {
-			return nil, fmt.Errorf("Batch %q for request %q returned error: %v", batchKey, request.DebugId, resp.err)
+			// Wrap the error so callers can still check for specific API errors.
+			return nil, errwrap.Wrapf(fmt.Sprintf("Batch %q for request %q returned error: {{err}}", batchKey, request.DebugId), resp.err)
 		}
 		return resp.body, nil
 	case <-ctx.Done():
@@ -0,0 +1,41 @@
+package google
+
+import (
+	"fmt"
+)
+
+type CloudRunV2OperationWaiter struct {
+	Config *Config
+	CommonOperationWaiter
+}
+
+func (w *CloudRunV2OperationWaiter) QueryOp() (interface{}, error) {
+	if w == nil {
+		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
+	}
+	url := fmt.Sprintf("%s%s", w.Config.CloudRunV2BasePath, w.CommonOperationWaiter.Op.Name)
+	return sendRequest(w.Config, "GET", "", url, nil)
+}
+
+func createCloudRunV2Waiter(config *Config, op map[string]interface{}, activity string) (*CloudRunV2OperationWaiter, error) {
+	if val, ok := op["name"]; !ok || val == "" {
+		// This was a synchronous call - there is no operation to wait for.
+		return nil, nil
+	}
+	w := &CloudRunV2OperationWaiter{
+		Config: config,
+	}
+	if err := w.CommonOperationWaiter.SetOp(op); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func cloudRunV2OperationWaitTime(config *Config, op map[string]interface{}, activity string, timeoutMinutes int) error {
+	w, err := createCloudRunV2Waiter(config, op, activity)
+	if err != nil || w == nil {
+		// If w is nil, the op was synchronous.
+		return err
+	}
+	return OperationWait(w, activity, timeoutMinutes)
+}
@@ -7,7 +7,6 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v1"
-	"google.golang.org/api/googleapi"
 )
 
 type Waiter interface {
@@ -97,7 +96,7 @@ func CommonRefreshFunc(w Waiter) resource.StateRefreshFunc {
 		if err != nil {
 			// Importantly, this error is in the GET to the operation, and isn't an error
 			// with the resource CRUD request itself.
-			if gerr, ok := err.(*googleapi.Error); ok && gerr.Code == 404 {
+			if isGoogleApiErrorWithCode(err, 404) {
 				log.Printf("[DEBUG] Dismissed an operation GET as retryable based on error code being 404: %s", err)
 				return op, "done: false", nil
 			}
@@ -119,6 +118,12 @@ func CommonRefreshFunc(w Waiter) resource.StateRefreshFunc {
 }
 
 func OperationWait(w Waiter, activity string, timeoutMinutes int) error {
+	return OperationWaitTime(w, activity, time.Duration(timeoutMinutes)*time.Minute)
+}
+
+// OperationWaitTime waits for an operation for at most timeout, which is
+// usually derived from the resource's timeouts block.
+func OperationWaitTime(w Waiter, activity string, timeout time.Duration) error {
 	if OperationDone(w) {
 		if w.Error() != nil {
 			return w.Error()
@@ -130,7 +135,7 @@ func OperationWait(w Waiter, activity string, timeoutMinutes int) error {
 		Pending:    w.PendingStates(),
 		Target:     w.TargetStates(),
 		Refresh:    CommonRefreshFunc(w),
-		Timeout:    time.Duration(timeoutMinutes) * time.Minute,
+		Timeout:    timeout,
 		MinTimeout: 2 * time.Second,
 	}
 	opRaw, err := c.WaitForState()
@@ -2,6 +2,7 @@ package google
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -15,18 +16,15 @@ func instanceSchedulingNodeAffinitiesElemSchema() *schema.Resource {
 			"key": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"operator": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.StringInSlice([]string{"IN", "NOT"}, false),
+				ValidateFunc: validation.StringInSlice([]string{"IN", "NOT_IN"}, false),
 			},
 			"values": {
 				Type:     schema.TypeSet,
 				Required: true,
-				ForceNew: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
@@ -34,6 +32,43 @@ func instanceSchedulingNodeAffinitiesElemSchema() *schema.Resource {
 	}
 }
 
+func instanceSchedulingMaxRunDurationElemSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"seconds": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"nanos": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(0, 999999999),
+			},
+		},
+	}
+}
+
+// instanceSchedulingDurationElemSchema returns a duration whose seconds must
+// be between minSeconds and maxSeconds.
+func instanceSchedulingDurationElemSchema(minSeconds, maxSeconds int) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"seconds": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(minSeconds, maxSeconds),
+			},
+			"nanos": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 999999999),
+			},
+		},
+	}
+}
+
 func expandAliasIpRanges(ranges []interface{}) []*computeBeta.AliasIpRange {
 	ipRanges := make([]*computeBeta.AliasIpRange, 0, len(ranges))
 	for _, raw := range ranges {
@@ -100,7 +135,7 @@ func expandScheduling(v interface{}) (*computeBeta.Scheduling, error) {
 
 	if v, ok := original["node_affinities"]; ok && v != nil {
 		naSet := v.(*schema.Set).List()
-		scheduling.NodeAffinities = make([]*computeBeta.SchedulingNodeAffinity, len(ls))
+		scheduling.NodeAffinities = make([]*computeBeta.SchedulingNodeAffinity, 0, len(naSet))
 		scheduling.ForceSendFields = append(scheduling.ForceSendFields, "NodeAffinities")
 		for _, nodeAffRaw := range naSet {
 			if nodeAffRaw == nil {
@@ -142,6 +177,206 @@ func flattenScheduling(resp *computeBeta.Scheduling) []map[string]interface{} {
 	return []map[string]interface{}{schedulingMap}
 }
 
+// The vendored compute client predates Spot VMs and doesn't model the
+// provisioningModel, instanceTerminationAction, maxRunDuration,
+// localSsdRecoveryTimeout and gracefulShutdown scheduling fields. Requests that
+// set them are sent as raw JSON with the fields merged into the scheduling
+// object, and they're read back from the raw response.
+func expandSchedulingRawFields(v interface{}) (map[string]interface{}, error) {
+	ls, ok := v.([]interface{})
+	if !ok || len(ls) == 0 || ls[0] == nil {
+		return nil, nil
+	}
+
+	original := ls[0].(map[string]interface{})
+	fields := make(map[string]interface{})
+
+	provisioningModel, _ := original["provisioning_model"].(string)
+	if provisioningModel != "" {
+		fields["provisioningModel"] = provisioningModel
+	}
+	if provisioningModel == "SPOT" {
+		preemptible, _ := original["preemptible"].(bool)
+		automaticRestart, _ := original["automatic_restart"].(bool)
+		if !preemptible || automaticRestart {
+			return nil, fmt.Errorf("scheduling.provisioning_model SPOT requires preemptible to be true and automatic_restart to be false")
+		}
+	}
+
+	terminationAction, _ := original["instance_termination_action"].(string)
+	if terminationAction != "" {
+		fields["instanceTerminationAction"] = terminationAction
+	}
+
+	if duration := expandSchedulingDuration(original["max_run_duration"]); duration != nil {
+		if terminationAction == "" {
+			return nil, fmt.Errorf("scheduling.max_run_duration requires instance_termination_action to be set")
+		}
+		fields["maxRunDuration"] = duration
+	}
+
+	if duration := expandSchedulingDuration(original["local_ssd_recovery_timeout"]); duration != nil {
+		fields["localSsdRecoveryTimeout"] = duration
+	}
+
+	if gs, ok := original["graceful_shutdown"].([]interface{}); ok && len(gs) > 0 && gs[0] != nil {
+		gracefulShutdown := gs[0].(map[string]interface{})
+		transformed := map[string]interface{}{
+			"enabled": gracefulShutdown["enabled"].(bool),
+		}
+		if duration := expandSchedulingDuration(gracefulShutdown["max_duration"]); duration != nil {
+			transformed["maxDuration"] = duration
+		}
+		fields["gracefulShutdown"] = transformed
+	}
+
+	return fields, nil
+}
+
+func expandSchedulingDuration(v interface{}) map[string]interface{} {
+	ls, ok := v.([]interface{})
+	if !ok || len(ls) == 0 || ls[0] == nil {
+		return nil
+	}
+	duration := ls[0].(map[string]interface{})
+	return map[string]interface{}{
+		"seconds": strconv.Itoa(duration["seconds"].(int)),
+		"nanos":   duration["nanos"].(int),
+	}
+}
+
+// flattenSchedulingRawFields only sets the fields that are present in the
+// response, as not every resource using scheduling supports all of them.
+func flattenSchedulingRawFields(raw map[string]interface{}, scheduling []map[string]interface{}) {
+	if raw == nil || len(scheduling) == 0 {
+		return
+	}
+
+	schedulingMap := scheduling[0]
+	if v, ok := raw["provisioningModel"]; ok {
+		schedulingMap["provisioning_model"] = v
+	}
+	if v, ok := raw["instanceTerminationAction"]; ok {
+		schedulingMap["instance_termination_action"] = v
+	}
+	if v, ok := raw["maxRunDuration"]; ok {
+		schedulingMap["max_run_duration"] = flattenSchedulingDuration(v)
+	}
+	if v, ok := raw["localSsdRecoveryTimeout"]; ok {
+		schedulingMap["local_ssd_recovery_timeout"] = flattenSchedulingDuration(v)
+	}
+	if gracefulShutdown, ok := raw["gracefulShutdown"].(map[string]interface{}); ok {
+		schedulingMap["graceful_shutdown"] = []map[string]interface{}{
+			{
+				"enabled":      gracefulShutdown["enabled"],
+				"max_duration": flattenSchedulingDuration(gracefulShutdown["maxDuration"]),
+			},
+		}
+	}
+}
+
+func flattenSchedulingDuration(v interface{}) []map[string]interface{} {
+	duration, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	transformed := map[string]interface{}{}
+	// seconds is an int64, which the API returns as a string
+	if strVal, ok := duration["seconds"].(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			transformed["seconds"] = intVal
+		}
+	}
+	if floatVal, ok := duration["nanos"].(float64); ok {
+		transformed["nanos"] = int(floatVal)
+	}
+	return []map[string]interface{}{transformed}
+}
+
+// mergeSchedulingRawFields converts obj to its JSON form and adds the given
+// scheduling fields to the scheduling object found by following path.
+func mergeSchedulingRawFields(obj interface{}, fields map[string]interface{}, path ...string) (map[string]interface{}, error) {
+	m, err := ConvertToMap(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduling := m
+	for _, k := range path {
+		next, ok := scheduling[k].(map[string]interface{})
+		if !ok {
+			next = make(map[string]interface{})
+			scheduling[k] = next
+		}
+		scheduling = next
+	}
+	for k, v := range fields {
+		scheduling[k] = v
+	}
+
+	return m, nil
+}
+
+// rawSchedulingFromResponse returns the scheduling object found by following
+// path in a raw API response.
+func rawSchedulingFromResponse(res map[string]interface{}, path ...string) map[string]interface{} {
+	scheduling := res
+	for _, k := range path {
+		next, ok := scheduling[k].(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		scheduling = next
+	}
+	return scheduling
+}
+
+func expandReservationAffinity(d *schema.ResourceData) (*computeBeta.ReservationAffinity, error) {
+	_, ok := d.GetOk("reservation_affinity")
+	if !ok {
+		return nil, nil
+	}
+
+	prefix := "reservation_affinity.0"
+	reservationAffinityType := d.Get(prefix + ".type").(string)
+
+	affinity := computeBeta.ReservationAffinity{
+		ConsumeReservationType: reservationAffinityType,
+	}
+
+	_, hasSpecificReservation := d.GetOk(prefix + ".specific_reservation")
+	if (reservationAffinityType == "SPECIFIC_RESERVATION") != hasSpecificReservation {
+		return nil, fmt.Errorf("specific_reservation must be set when reservation_affinity is SPECIFIC_RESERVATION, and not set otherwise")
+	}
+
+	prefix = prefix + ".specific_reservation.0"
+	if hasSpecificReservation {
+		affinity.Key = d.Get(prefix + ".key").(string)
+		affinity.Values = convertStringArr(d.Get(prefix + ".values").([]interface{}))
+	}
+
+	return &affinity, nil
+}
+
+func flattenReservationAffinity(affinity *computeBeta.ReservationAffinity) []map[string]interface{} {
+	if affinity == nil {
+		return nil
+	}
+
+	flattened := map[string]interface{}{
+		"type": affinity.ConsumeReservationType,
+	}
+
+	if affinity.ConsumeReservationType == "SPECIFIC_RESERVATION" {
+		flattened["specific_reservation"] = []map[string]interface{}{{
+			"key":    affinity.Key,
+			"values": affinity.Values,
+		}}
+	}
+
+	return []map[string]interface{}{flattened}
+}
+
 func flattenAccessConfigs(accessConfigs []*computeBeta.AccessConfig) ([]map[string]interface{}, string) {
 	flattened := make([]map[string]interface{}, len(accessConfigs))
 	natIP := ""
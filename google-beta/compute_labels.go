@@ -0,0 +1,56 @@
+package google
+
+import (
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// computeSetLabelsMaxAttempts bounds how often a setLabels request is resent
+// after a fingerprint conflict, so labels that are rewritten continuously by
+// another client fail the apply instead of spinning until the timeout.
+const computeSetLabelsMaxAttempts = 5
+
+// sendComputeSetLabelsRequest posts obj to the setLabels URL of a compute
+// resource in project. Labels on a compute resource can be written by several
+// resources or tools, so the label fingerprint is re-read before every attempt
+// instead of trusting the one in state, and a 412 caused by a concurrent label
+// update is retried with the fresh fingerprint up to
+// computeSetLabelsMaxAttempts times.
+func sendComputeSetLabelsRequest(config *Config, project, setLabelsUrl string, obj map[string]interface{}, timeout time.Duration) (map[string]interface{}, error) {
+	resourceUrl := strings.TrimSuffix(setLabelsUrl, "/setLabels")
+
+	var res map[string]interface{}
+	attempts := 0
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		attempts++
+		current, err := sendRequestWithTimeout(config, "GET", project, resourceUrl, nil, timeout)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		obj["labelFingerprint"] = current["labelFingerprint"]
+
+		res, err = sendRequestWithRetryPredicate(config, "POST", project, setLabelsUrl, obj, timeout, isRetryableNonFingerprintError)
+		if err != nil {
+			if isGoogleApiErrorWithCode(err, 412) && attempts < computeSetLabelsMaxAttempts {
+				log.Printf("[DEBUG] Label fingerprint of %s changed concurrently, retrying: %s", resourceUrl, err)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	return res, err
+}
+
+// A fingerprint mismatch can't be fixed by resending the same request, so it
+// isn't retried by the transport.
+func isRetryableNonFingerprintError(err error) bool {
+	if isGoogleApiErrorWithCode(err, 412) && isFingerprintError(err) {
+		return false
+	}
+	return isRetryableError(err)
+}
@@ -0,0 +1,117 @@
+package google
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSendComputeSetLabelsRequest_refreshesFingerprint(t *testing.T) {
+	t.Parallel()
+
+	fingerprints := []string{"stale", "fresh"}
+	gets := 0
+	var sent []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == "GET" {
+			fingerprint := fingerprints[len(fingerprints)-1]
+			if gets < len(fingerprints) {
+				fingerprint = fingerprints[gets]
+			}
+			gets++
+			json.NewEncoder(w).Encode(map[string]interface{}{"labelFingerprint": fingerprint})
+			return
+		}
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unable to decode setLabels body: %s", err)
+		}
+		sent = append(sent, body["labelFingerprint"].(string))
+		if body["labelFingerprint"] != "fresh" {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			fmt.Fprintf(w, `{"error": {"code": 412, "message": "%s"}}`, FINGERPRINT_FAIL_ERRORS[0])
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"name": "operation-1"})
+	}))
+	defer server.Close()
+
+	config := &Config{client: server.Client()}
+	obj := map[string]interface{}{
+		"labels":           map[string]string{"foo": "bar"},
+		"labelFingerprint": "from-state",
+	}
+
+	res, err := sendComputeSetLabelsRequest(config, "p", server.URL+"/projects/p/global/images/i/setLabels", obj, time.Minute)
+	if err != nil {
+		t.Fatalf("expected the fingerprint conflict to be retried, got: %s", err)
+	}
+	if res["name"] != "operation-1" {
+		t.Errorf("unexpected response: %v", res)
+	}
+	if len(sent) != 2 || sent[0] != "stale" || sent[1] != "fresh" {
+		t.Errorf("expected fingerprints [stale fresh] to be sent, got %v", sent)
+	}
+}
+
+func TestSendComputeSetLabelsRequest_fingerprintConflictsBounded(t *testing.T) {
+	t.Parallel()
+
+	gets, posts := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == "GET" {
+			gets++
+			json.NewEncoder(w).Encode(map[string]interface{}{"labelFingerprint": fmt.Sprintf("fingerprint-%d", gets)})
+			return
+		}
+		// Another client always updates the labels first
+		posts++
+		w.WriteHeader(http.StatusPreconditionFailed)
+		fmt.Fprintf(w, `{"error": {"code": 412, "message": "%s"}}`, FINGERPRINT_FAIL_ERRORS[0])
+	}))
+	defer server.Close()
+
+	config := &Config{client: server.Client()}
+	obj := map[string]interface{}{"labels": map[string]string{"foo": "bar"}}
+
+	_, err := sendComputeSetLabelsRequest(config, "p", server.URL+"/projects/p/global/images/i/setLabels", obj, time.Minute)
+	if !isGoogleApiErrorWithCode(err, 412) {
+		t.Fatalf("expected the fingerprint conflict to be returned, got: %v", err)
+	}
+	if gets != computeSetLabelsMaxAttempts || posts != computeSetLabelsMaxAttempts {
+		t.Errorf("expected %d fingerprint reads and setLabels POSTs, got %d and %d", computeSetLabelsMaxAttempts, gets, posts)
+	}
+}
+
+func TestSendComputeSetLabelsRequest_otherErrorsNotRetried(t *testing.T) {
+	t.Parallel()
+
+	posts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == "GET" {
+			json.NewEncoder(w).Encode(map[string]interface{}{"labelFingerprint": "fresh"})
+			return
+		}
+		posts++
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error": {"code": 400, "message": "Invalid value for field 'labels'"}}`)
+	}))
+	defer server.Close()
+
+	config := &Config{client: server.Client()}
+	obj := map[string]interface{}{"labels": map[string]string{"Foo": "bar"}}
+
+	if _, err := sendComputeSetLabelsRequest(config, "p", server.URL+"/projects/p/global/images/i/setLabels", obj, time.Minute); err == nil {
+		t.Fatal("expected an error for an invalid setLabels request")
+	}
+	if posts != 1 {
+		t.Errorf("expected a single setLabels POST, got %d", posts)
+	}
+}
@@ -3,15 +3,38 @@ package google
 import (
 	"bytes"
 	"fmt"
+	"log"
+	"time"
 
+	"github.com/hashicorp/errwrap"
 	computeBeta "google.golang.org/api/compute/v0.beta"
 	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
+)
+
+const (
+	// defaultComputeOperationRetryBudget is the number of transient errors
+	// tolerated while polling a single compute operation.
+	defaultComputeOperationRetryBudget = 5
+
+	defaultComputeOperationRetryDelay = 2 * time.Second
 )
 
 type ComputeOperationWaiter struct {
 	Service *compute.Service
 	Op      *compute.Operation
 	Project string
+
+	// RetryBudget is the number of transient errors tolerated while polling
+	// the operation.
+	RetryBudget int
+
+	// Batcher, if set, batches polls of this operation with polls of other
+	// operations in the same project.
+	Batcher *RequestBatcher
+
+	retries    int
+	retryDelay time.Duration
 }
 
 func (w *ComputeOperationWaiter) State() string {
@@ -38,18 +61,62 @@ func (w *ComputeOperationWaiter) SetOp(op interface{}) error {
 	return nil
 }
 
+// QueryOp gets the current state of the operation. Transient errors are
+// retried until the waiter's retry budget is spent, any other error is
+// returned immediately. The budget is restored after every successful poll,
+// so it only bounds consecutive transient errors. Errors are wrapped so that
+// callers can still inspect the underlying googleapi.Error.
 func (w *ComputeOperationWaiter) QueryOp() (interface{}, error) {
 	if w == nil || w.Op == nil {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
+
+	for {
+		op, err := w.getOp()
+		if err == nil {
+			w.retries = 0
+			return op, nil
+		}
+
+		// Errors from batched polls are wrapped, so check the API error itself.
+		retryErr := err
+		if gerr, ok := errwrap.GetType(err, &googleapi.Error{}).(*googleapi.Error); ok && gerr != nil {
+			retryErr = gerr
+		}
+		if !isRetryableError(retryErr) {
+			return nil, errwrap.Wrapf(fmt.Sprintf("terminal error polling operation %s: {{err}}", w.Op.Name), err)
+		}
+		if w.retries >= w.RetryBudget {
+			return nil, errwrap.Wrapf(fmt.Sprintf("giving up polling operation %s after %d transient errors: {{err}}", w.Op.Name, w.retries), err)
+		}
+
+		w.retries++
+		delay := w.retryDelay
+		if delay == 0 {
+			delay = defaultComputeOperationRetryDelay
+		}
+		log.Printf("[DEBUG] Retrying transient error polling operation %s (%d/%d): %s", w.Op.Name, w.retries, w.RetryBudget, err)
+		time.Sleep(delay)
+	}
+}
+
+func (w *ComputeOperationWaiter) getOp() (*compute.Operation, error) {
+	if w.Batcher != nil && w.Batcher.enableBatching {
+		op, err := batchGetComputeOperation(w.Batcher, w.Service, "", w.Op.Name)
+		if err != nil || op != nil {
+			return op, err
+		}
+		log.Printf("[DEBUG] Operation %s wasn't listed in its project's operations, polling it individually", w.Op.Name)
+	}
+
 	if w.Op.Zone != "" {
 		zone := GetResourceNameFromSelfLink(w.Op.Zone)
-		return w.Service.ZoneOperations.Get(w.Project, zone, w.Op.Name).Do()
+		return w.Service.ZoneOperations.Get("", zone, w.Op.Name).Do()
 	} else if w.Op.Region != "" {
 		region := GetResourceNameFromSelfLink(w.Op.Region)
-		return w.Service.RegionOperations.Get(w.Project, region, w.Op.Name).Do()
+		return w.Service.RegionOperations.Get("", region, w.Op.Name).Do()
 	}
-	return w.Service.GlobalOperations.Get(w.Project, w.Op.Name).Do()
+	return w.Service.GlobalOperations.Get("", w.Op.Name).Do()
 }
 
 func (w *ComputeOperationWaiter) OpName() string {
@@ -68,31 +135,39 @@ func (w *ComputeOperationWaiter) TargetStates() []string {
 	return []string{"DONE"}
 }
 
-func computeOperationWait(client *compute.Service, op *compute.Operation, project, activity string) error {
-	return computeOperationWaitTime(client, op, project, activity, 4)
+func computeOperationWait(config *Config, op *compute.Operation, project, activity string) error {
+	return computeOperationWaitTime(config, op, project, activity, 4)
+}
+
+func computeOperationWaitTime(config *Config, op *compute.Operation, project, activity string, timeoutMinutes int) error {
+	return computeOperationWaitTimeout(config, op, project, activity, time.Duration(timeoutMinutes)*time.Minute)
 }
 
-func computeOperationWaitTime(client *compute.Service, op *compute.Operation, project, activity string, timeoutMinutes int) error {
+// computeOperationWaitTimeout waits for a compute operation for at most
+// timeout, which should come from the resource's timeouts block.
+func computeOperationWaitTimeout(config *Config, op *compute.Operation, project, activity string, timeout time.Duration) error {
 	w := &ComputeOperationWaiter{
-		Service: client,
-		Op:      op,
-		Project: project,
+		Service:     config.clientCompute,
+		Op:          op,
+		Project:     project,
+		RetryBudget: defaultComputeOperationRetryBudget,
+		Batcher:     config.requestBatcherComputeOperations,
 	}
 
 	if err := w.SetOp(op); err != nil {
 		return err
 	}
-	return OperationWait(w, activity, timeoutMinutes)
+	return OperationWaitTime(w, activity, timeout)
 }
 
-func computeBetaOperationWaitTime(client *compute.Service, op *computeBeta.Operation, project, activity string, timeoutMin int) error {
+func computeBetaOperationWaitTime(config *Config, op *computeBeta.Operation, project, activity string, timeoutMin int) error {
 	opV1 := &compute.Operation{}
 	err := Convert(op, opV1)
 	if err != nil {
 		return err
 	}
 
-	return computeOperationWaitTime(client, opV1, project, activity, timeoutMin)
+	return computeOperationWaitTime(config, opV1, project, activity, timeoutMin)
 }
 
 // ComputeOperationError wraps compute.OperationError and implements the
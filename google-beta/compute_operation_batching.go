@@ -0,0 +1,97 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/api/compute/v1"
+)
+
+const (
+	batchKeyTmplComputeOperations = "project/%s/aggregated/operations"
+
+	// The timeout for a single batched poll. Polls are retried by the waiter,
+	// so this only bounds how long one waits for a batch to be sent.
+	computeOperationBatchPollTimeout = 5 * time.Minute
+
+	// The most operation names ORed into the filter of a single aggregated
+	// list, which keeps the request URL and the filter within API limits.
+	maxComputeOperationBatchFilterSize = 20
+)
+
+// batchGetComputeOperation gets the current state of a compute operation,
+// batching concurrent polls for operations in the same project into a single
+// aggregated list of the project's operations. Returns nil if the operation
+// wasn't listed, in which case it should be polled individually.
+func batchGetComputeOperation(batcher *RequestBatcher, client *compute.Service, project, name string) (*compute.Operation, error) {
+	req := &BatchRequest{
+		ResourceName: project,
+		Body:         []string{name},
+		CombineF:     combineComputeOperationBatches,
+		SendF:        sendBatchFuncListComputeOperations(client),
+		DebugId:      fmt.Sprintf("Poll Compute Operation %s/%s", project, name),
+	}
+
+	respRaw, err := batcher.SendRequestWithTimeout(
+		fmt.Sprintf(batchKeyTmplComputeOperations, project),
+		req,
+		computeOperationBatchPollTimeout)
+	if err != nil {
+		return nil, err
+	}
+	ops, ok := respRaw.(map[string]*compute.Operation)
+	if !ok {
+		return nil, fmt.Errorf("Expected batch response type to be map[string]*compute.Operation, got %v. This is a provider error.", respRaw)
+	}
+	return ops[name], nil
+}
+
+func combineComputeOperationBatches(namesRaw interface{}, toAddRaw interface{}) (interface{}, error) {
+	names, ok := namesRaw.([]string)
+	if !ok {
+		return nil, fmt.Errorf("Expected batch body type to be []string, got %v. This is a provider error.", namesRaw)
+	}
+	toAdd, ok := toAddRaw.([]string)
+	if !ok {
+		return nil, fmt.Errorf("Expected new request body type to be []string, got %v. This is a provider error.", toAddRaw)
+	}
+
+	return append(names, toAdd...), nil
+}
+
+func sendBatchFuncListComputeOperations(client *compute.Service) batcherSendFunc {
+	return func(project string, namesRaw interface{}) (interface{}, error) {
+		names, ok := namesRaw.([]string)
+		if !ok {
+			return nil, fmt.Errorf("Expected batch body type to be []string, got %v. This is a provider error.", namesRaw)
+		}
+
+		ops := make(map[string]*compute.Operation, len(names))
+		for i := 0; i < len(names); i += maxComputeOperationBatchFilterSize {
+			j := i + maxComputeOperationBatchFilterSize
+			if j > len(names) {
+				j = len(names)
+			}
+
+			filters := make([]string, 0, j-i)
+			for _, n := range names[i:j] {
+				filters = append(filters, fmt.Sprintf("(name = %q)", n))
+			}
+
+			err := client.GlobalOperations.AggregatedList(project).Filter(strings.Join(filters, " OR ")).Pages(context.Background(), func(page *compute.OperationAggregatedList) error {
+				for _, scoped := range page.Items {
+					for _, op := range scoped.Operations {
+						ops[op.Name] = op
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+		return ops, nil
+	}
+}
@@ -0,0 +1,195 @@
+package google
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/api/compute/v1"
+)
+
+var testComputeOperationFilterName = regexp.MustCompile(`name = "([^"]+)"`)
+
+// testComputeOperationListServer serves operations that are done, both to
+// individual GETs and to aggregated lists filtered by name, and counts the
+// requests of each kind. Operations in unlisted aren't returned by lists.
+func testComputeOperationListServer(t *testing.T, unlisted ...string) (*compute.Service, func() (int, int)) {
+	unlistedNames := golangSetFromStringSlice(unlisted)
+	var mu sync.Mutex
+	gets, lists := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "/aggregated/operations") {
+			lists++
+			var ops []*compute.Operation
+			for _, m := range testComputeOperationFilterName.FindAllStringSubmatch(r.URL.Query().Get("filter"), -1) {
+				if _, ok := unlistedNames[m[1]]; !ok {
+					ops = append(ops, &compute.Operation{Name: m[1], Status: "DONE"})
+				}
+			}
+			json.NewEncoder(w).Encode(&compute.OperationAggregatedList{
+				Items: map[string]compute.OperationsScopedList{
+					"global": {Operations: ops},
+				},
+			})
+			return
+		}
+
+		gets++
+		name := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		json.NewEncoder(w).Encode(&compute.Operation{Name: name, Status: "DONE"})
+	}))
+	t.Cleanup(server.Close)
+
+	service, err := compute.New(server.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+	service.BasePath = server.URL + "/"
+
+	return service, func() (int, int) {
+		mu.Lock()
+		defer mu.Unlock()
+		return gets, lists
+	}
+}
+
+// testWaitComputeOperationsConcurrently waits for count operations at once.
+func testWaitComputeOperationsConcurrently(t *testing.T, service *compute.Service, batcher *RequestBatcher, count int) {
+	wg := sync.WaitGroup{}
+	wg.Add(count)
+	for i := 0; i < count; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			w := testComputeOperationWaiter(service, defaultComputeOperationRetryBudget)
+			w.Op.Name = fmt.Sprintf("operation-%d", idx)
+			w.Batcher = batcher
+			if err := OperationWaitTime(w, "Testing", time.Minute); err != nil {
+				t.Errorf("got unexpected error waiting for %s: %s", w.Op.Name, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func testComputeOperationBatcher(enableBatching bool) *RequestBatcher {
+	return NewRequestBatcher("Compute Operations", context.Background(), &batchingConfig{
+		sendAfter:      100 * time.Millisecond,
+		enableBatching: enableBatching,
+	})
+}
+
+func TestComputeOperationWaiter_batchedPollsReduceCalls(t *testing.T) {
+	t.Parallel()
+
+	count := 20
+
+	service, calls := testComputeOperationListServer(t)
+	testWaitComputeOperationsConcurrently(t, service, nil, count)
+	unbatchedGets, unbatchedLists := calls()
+	if unbatchedGets != count || unbatchedLists != 0 {
+		t.Errorf("expected %d operation GETs and no lists without batching, got %d GETs and %d lists", count, unbatchedGets, unbatchedLists)
+	}
+
+	service, calls = testComputeOperationListServer(t)
+	testWaitComputeOperationsConcurrently(t, service, testComputeOperationBatcher(true), count)
+	batchedGets, batchedLists := calls()
+	if batchedGets != 0 {
+		t.Errorf("expected no operation GETs with batching, got %d", batchedGets)
+	}
+	if batchedLists == 0 || batchedLists >= unbatchedGets {
+		t.Errorf("expected batching to poll %d operations in fewer than %d calls, got %d lists", count, unbatchedGets, batchedLists)
+	}
+}
+
+func TestSendBatchFuncListComputeOperations_chunksFilter(t *testing.T) {
+	t.Parallel()
+
+	count := 2*maxComputeOperationBatchFilterSize + 5
+	names := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		names = append(names, fmt.Sprintf("operation-%d", i))
+	}
+
+	service, calls := testComputeOperationListServer(t)
+	respRaw, err := sendBatchFuncListComputeOperations(service)("project", names)
+	if err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	}
+
+	if ops := respRaw.(map[string]*compute.Operation); len(ops) != count {
+		t.Errorf("expected %d operations to be listed, got %d", count, len(ops))
+	}
+	if _, lists := calls(); lists != 3 {
+		t.Errorf("expected %d operations to be listed in 3 lists, got %d lists", count, lists)
+	}
+}
+
+func TestComputeOperationWaiter_batchingDisabled(t *testing.T) {
+	t.Parallel()
+
+	service, calls := testComputeOperationListServer(t)
+	testWaitComputeOperationsConcurrently(t, service, testComputeOperationBatcher(false), 5)
+
+	if gets, lists := calls(); gets != 5 || lists != 0 {
+		t.Errorf("expected 5 operation GETs and no lists with batching disabled, got %d GETs and %d lists", gets, lists)
+	}
+}
+
+func TestComputeOperationWaiter_unlistedOperationPolledIndividually(t *testing.T) {
+	t.Parallel()
+
+	service, calls := testComputeOperationListServer(t, "operation-0")
+	testWaitComputeOperationsConcurrently(t, service, testComputeOperationBatcher(true), 1)
+
+	if gets, lists := calls(); gets != 1 || lists != 1 {
+		t.Errorf("expected 1 operation GET after 1 list, got %d GETs and %d lists", gets, lists)
+	}
+}
+
+func TestComputeOperationWaiter_batchedTransientErrorRetried(t *testing.T) {
+	t.Parallel()
+
+	lists := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lists++
+		w.Header().Set("Content-Type", "application/json")
+		if lists == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, `{"error": {"code": 503, "message": "simulated error"}}`)
+			return
+		}
+		json.NewEncoder(w).Encode(&compute.OperationAggregatedList{
+			Items: map[string]compute.OperationsScopedList{
+				"global": {Operations: []*compute.Operation{{Name: "operation-1", Status: "DONE"}}},
+			},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	service, err := compute.New(server.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+	service.BasePath = server.URL + "/"
+
+	w := testComputeOperationWaiter(service, defaultComputeOperationRetryBudget)
+	w.Batcher = testComputeOperationBatcher(true)
+	if err := OperationWaitTime(w, "Testing", time.Minute); err != nil {
+		t.Fatalf("expected the transient error from the batched poll to be retried, got: %s", err)
+	}
+	if lists != 2 {
+		t.Errorf("expected 2 aggregated lists, got %d", lists)
+	}
+}
@@ -0,0 +1,167 @@
+package google
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/api/compute/v1"
+)
+
+// testComputeOperationServer serves the given responses to operation GETs in
+// order. Responses with a non-200 status are sent as googleapi errors.
+func testComputeOperationServer(t *testing.T, statuses []int, done *compute.Operation) (*compute.Service, *int) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := http.StatusOK
+		if calls < len(statuses) {
+			status = statuses[calls]
+		}
+		calls++
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if status != http.StatusOK {
+			fmt.Fprintf(w, `{"error": {"code": %d, "message": "simulated error"}}`, status)
+			return
+		}
+		json.NewEncoder(w).Encode(done)
+	}))
+	t.Cleanup(server.Close)
+
+	service, err := compute.New(server.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+	service.BasePath = server.URL + "/"
+
+	return service, &calls
+}
+
+func testComputeOperationWaiter(service *compute.Service, budget int) *ComputeOperationWaiter {
+	return &ComputeOperationWaiter{
+		Service:     service,
+		Op:          &compute.Operation{Name: "operation-1", Status: "RUNNING"},
+		Project:     "my-project",
+		RetryBudget: budget,
+		retryDelay:  time.Millisecond,
+	}
+}
+
+func TestComputeOperationWaiter_transientErrorsRetried(t *testing.T) {
+	t.Parallel()
+
+	service, calls := testComputeOperationServer(t, []int{503, 429}, &compute.Operation{Name: "operation-1", Status: "DONE"})
+	w := testComputeOperationWaiter(service, defaultComputeOperationRetryBudget)
+
+	if err := OperationWaitTime(w, "Testing", time.Minute); err != nil {
+		t.Fatalf("expected transient errors to be retried, got: %s", err)
+	}
+	if *calls != 3 {
+		t.Errorf("expected 3 operation GETs, got %d", *calls)
+	}
+}
+
+func TestComputeOperationWaiter_terminalErrorNotRetried(t *testing.T) {
+	t.Parallel()
+
+	service, calls := testComputeOperationServer(t, []int{403}, &compute.Operation{Name: "operation-1", Status: "DONE"})
+	w := testComputeOperationWaiter(service, defaultComputeOperationRetryBudget)
+
+	err := OperationWaitTime(w, "Testing", time.Minute)
+	if err == nil || !strings.Contains(err.Error(), "terminal error polling operation operation-1") {
+		t.Fatalf("expected a terminal error, got: %v", err)
+	}
+	if *calls != 1 {
+		t.Errorf("expected a single operation GET, got %d", *calls)
+	}
+}
+
+func TestComputeOperationWaiter_retryBudgetExhausted(t *testing.T) {
+	t.Parallel()
+
+	service, calls := testComputeOperationServer(t, []int{503, 503, 503, 503}, &compute.Operation{Name: "operation-1", Status: "DONE"})
+	w := testComputeOperationWaiter(service, 2)
+
+	err := OperationWaitTime(w, "Testing", time.Minute)
+	if err == nil || !strings.Contains(err.Error(), "giving up polling operation operation-1 after 2 transient errors") {
+		t.Fatalf("expected the retry budget to be exhausted, got: %v", err)
+	}
+	if *calls != 3 {
+		t.Errorf("expected 3 operation GETs, got %d", *calls)
+	}
+}
+
+func TestComputeOperationWaiter_retryBudgetRestoredAfterSuccess(t *testing.T) {
+	t.Parallel()
+
+	service, calls := testComputeOperationServer(t, []int{503, 503, 200, 503, 503}, &compute.Operation{Name: "operation-1", Status: "RUNNING"})
+	w := testComputeOperationWaiter(service, 2)
+
+	// Each poll hits as many transient errors as the budget allows, which is
+	// only tolerated if a successful poll restores the budget.
+	for i := 0; i < 2; i++ {
+		if _, err := w.QueryOp(); err != nil {
+			t.Fatalf("poll %d: expected transient errors to be retried, got: %s", i, err)
+		}
+	}
+	if *calls != 6 {
+		t.Errorf("expected 6 operation GETs, got %d", *calls)
+	}
+}
+
+func TestComputeOperationWaiter_notFoundKeepsPolling(t *testing.T) {
+	t.Parallel()
+
+	service, calls := testComputeOperationServer(t, []int{404}, &compute.Operation{Name: "operation-1", Status: "DONE"})
+	w := testComputeOperationWaiter(service, defaultComputeOperationRetryBudget)
+
+	if err := OperationWaitTime(w, "Testing", time.Minute); err != nil {
+		t.Fatalf("expected a 404 polling the operation to keep waiting, got: %s", err)
+	}
+	if *calls != 2 {
+		t.Errorf("expected 2 operation GETs, got %d", *calls)
+	}
+}
+
+func TestComputeOperationWaiter_noRetryBudget(t *testing.T) {
+	t.Parallel()
+
+	service, calls := testComputeOperationServer(t, []int{503}, &compute.Operation{Name: "operation-1", Status: "DONE"})
+	w := testComputeOperationWaiter(service, 0)
+
+	if err := OperationWaitTime(w, "Testing", time.Minute); err == nil {
+		t.Fatal("expected an error without a retry budget")
+	}
+	if *calls != 1 {
+		t.Errorf("expected a single operation GET, got %d", *calls)
+	}
+}
+
+func TestComputeOperationWaiter_operationError(t *testing.T) {
+	t.Parallel()
+
+	failed := &compute.Operation{
+		Name:   "operation-1",
+		Status: "DONE",
+		Error: &compute.OperationError{
+			Errors: []*compute.OperationErrorErrors{
+				{Code: "QUOTA_EXCEEDED", Message: "Quota 'CPUS' exceeded."},
+			},
+		},
+	}
+	service, calls := testComputeOperationServer(t, nil, failed)
+	w := testComputeOperationWaiter(service, defaultComputeOperationRetryBudget)
+
+	err := OperationWaitTime(w, "Testing", time.Minute)
+	if err == nil || !strings.Contains(err.Error(), "Quota 'CPUS' exceeded.") {
+		t.Fatalf("expected the operation error, got: %v", err)
+	}
+	if *calls != 1 {
+		t.Errorf("expected a single operation GET, got %d", *calls)
+	}
+}
@@ -5,20 +5,20 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
-func computeSharedOperationWait(client *compute.Service, op interface{}, project string, activity string) error {
-	return computeSharedOperationWaitTime(client, op, project, 4, activity)
+func computeSharedOperationWait(config *Config, op interface{}, project string, activity string) error {
+	return computeSharedOperationWaitTime(config, op, project, 4, activity)
 }
 
-func computeSharedOperationWaitTime(client *compute.Service, op interface{}, project string, minutes int, activity string) error {
+func computeSharedOperationWaitTime(config *Config, op interface{}, project string, minutes int, activity string) error {
 	if op == nil {
 		panic("Attempted to wait on an Operation that was nil.")
 	}
 
 	switch op.(type) {
 	case *compute.Operation:
-		return computeOperationWaitTime(client, op.(*compute.Operation), project, activity, minutes)
+		return computeOperationWaitTime(config, op.(*compute.Operation), project, activity, minutes)
 	case *computeBeta.Operation:
-		return computeBetaOperationWaitTime(client, op.(*computeBeta.Operation), project, activity, minutes)
+		return computeBetaOperationWaitTime(config, op.(*computeBeta.Operation), project, activity, minutes)
 	default:
 		panic("Attempted to wait on an Operation of unknown type.")
 	}
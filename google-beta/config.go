@@ -6,6 +6,7 @@ import (
 	"log"
 	"net/http"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/logging"
@@ -64,6 +65,26 @@ type Config struct {
 	Zone           string
 	Scopes         []string
 	BatchingConfig *batchingConfig
+	DefaultLabels  map[string]string
+
+	BillingProject      string
+	UserProjectOverride bool
+
+	// RequestReason is sent with every request as the X-Goog-Request-Reason
+	// header, and UserAgentExtension is appended to the provider's User-Agent.
+	RequestReason      string
+	UserAgentExtension string
+
+	// ImpersonateServiceAccount is the email of a service account whose
+	// identity is used for every request, with tokens generated through the
+	// IAM Credentials API using the configured credentials. Each of the
+	// ImpersonateServiceAccountDelegates, if any, must be able to impersonate
+	// the next one, the last the target service account.
+	ImpersonateServiceAccount          string
+	ImpersonateServiceAccountDelegates []string
+
+	AddTerraformAttributionLabel              bool
+	TerraformAttributionLabelAdditionStrategy string
 
 	client    *http.Client
 	userAgent string
@@ -74,12 +95,39 @@ type Config struct {
 	SecurityScannerBasePath   string
 
 	AccessContextManagerBasePath string
+	BackupDRBasePath             string
+	BeyondcorpBasePath           string
+	BigqueryReservationBasePath  string
 	BinaryAuthorizationBasePath  string
+	Cloudbuildv2BasePath         string
+	ClouddeployBasePath          string
+	CloudRunBasePath             string
+	CloudRunV2BasePath           string
 	CloudSchedulerBasePath       string
+	ContainerAttachedBasePath    string
+	DataFusionBasePath           string
+	DataLossPreventionBasePath   string
+	DataplexBasePath             string
+	DocumentAIBasePath           string
+	FirebaserulesBasePath        string
 	FirestoreBasePath            string
+	GKEBackupBasePath            string
+	GKEHubBasePath               string
 	MonitoringBasePath           string
+	NetappBasePath               string
+	NetworkSecurityBasePath      string
+	NetworkServicesBasePath      string
+	PrivatecaBasePath            string
+	PublicCABasePath             string
+	PubsubLiteBasePath           string
+	RecaptchaEnterpriseBasePath  string
 	RedisBasePath                string
+	SecurityCenterBasePath       string
+	SecurityCenterV2BasePath     string
+	TagsBasePath                 string
+	TagsLocationBasePath         string
 	TpuBasePath                  string
+	VPCAccessBasePath            string
 
 	CloudBillingBasePath string
 	clientBilling        *cloudbilling.APIService
@@ -168,6 +216,9 @@ type Config struct {
 	ServiceUsageBasePath       string
 	clientServiceUsage         *serviceusage.Service
 	requestBatcherServiceUsage *RequestBatcher
+	requestBatcherIam          *RequestBatcher
+
+	requestBatcherComputeOperations *RequestBatcher
 
 	BigQueryBasePath string
 	clientBigQuery   *bigquery.Service
@@ -216,6 +267,11 @@ func (c *Config) LoadAndValidate() error {
 	c.tokenSource = tokenSource
 
 	client := oauth2.NewClient(context.Background(), tokenSource)
+	if c.RequestReason != "" {
+		client.Transport = newHeaderTransport(client.Transport, http.Header{
+			"X-Goog-Request-Reason": []string{c.RequestReason},
+		})
+	}
 	client.Transport = logging.NewTransport("Google", client.Transport)
 	// Each individual request should return within 30s - timeouts will be retried.
 	// This is a timeout for, e.g. a single GET request of an operation - not a
@@ -226,6 +282,9 @@ func (c *Config) LoadAndValidate() error {
 	providerVersion := fmt.Sprintf("terraform-provider-google-beta/%s", version.ProviderVersion)
 	terraformWebsite := "(+https://www.terraform.io)"
 	userAgent := fmt.Sprintf("%s %s %s", terraformVersion, terraformWebsite, providerVersion)
+	if ext := strings.TrimSpace(c.UserAgentExtension); ext != "" {
+		userAgent = fmt.Sprintf("%s %s", userAgent, ext)
+	}
 
 	c.client = client
 	c.userAgent = userAgent
@@ -417,6 +476,13 @@ func (c *Config) LoadAndValidate() error {
 	c.clientServiceUsage.UserAgent = userAgent
 	c.clientServiceUsage.BasePath = serviceUsageClientBasePath
 	c.requestBatcherServiceUsage = NewRequestBatcher("Service Usage", context, c.BatchingConfig)
+	c.requestBatcherIam = NewRequestBatcher("IAM", context, c.BatchingConfig)
+	computeOperationBatching := &batchingConfig{}
+	if c.BatchingConfig != nil {
+		computeOperationBatching.sendAfter = c.BatchingConfig.sendAfter
+		computeOperationBatching.enableBatching = c.BatchingConfig.enableBatching && c.BatchingConfig.enableComputeOperationBatching
+	}
+	c.requestBatcherComputeOperations = NewRequestBatcher("Compute Operations", context, computeOperationBatching)
 
 	cloudBillingClientBasePath := removeBasePathVersion(c.CloudBillingBasePath)
 	log.Printf("[INFO] Instantiating Google Cloud Billing client for path %s", cloudBillingClientBasePath)
@@ -600,10 +666,30 @@ func expandProviderBatchingConfig(v interface{}) (*batchingConfig, error) {
 		config.enableBatching = enable.(bool)
 	}
 
+	if enable, ok := cfgV["enable_compute_operation_batching"]; ok {
+		config.enableComputeOperationBatching = enable.(bool)
+	}
+
 	return config, nil
 }
 
 func (c *Config) getTokenSource(clientScopes []string) (oauth2.TokenSource, error) {
+	tokenSource, err := c.getCredentialsTokenSource(clientScopes)
+	if err != nil {
+		return nil, err
+	}
+	if c.ImpersonateServiceAccount == "" {
+		return tokenSource, nil
+	}
+
+	log.Printf("[INFO] Impersonating service account %q...", c.ImpersonateServiceAccount)
+	log.Printf("[INFO]   -- Delegates: %s", c.ImpersonateServiceAccountDelegates)
+	return c.impersonatedTokenSource(tokenSource, clientScopes)
+}
+
+// getCredentialsTokenSource returns a token source for the configured
+// credentials, falling back to Application Default Credentials.
+func (c *Config) getCredentialsTokenSource(clientScopes []string) (oauth2.TokenSource, error) {
 	if c.AccessToken != "" {
 		contents, _, err := pathorcontents.Read(c.AccessToken)
 		if err != nil {
@@ -2,14 +2,19 @@ package google
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"golang.org/x/oauth2/google"
+	"google.golang.org/api/iamcredentials/v1"
 )
 
 const testFakeCredentialsPath = "./test-fixtures/fake_account.json"
@@ -218,3 +223,191 @@ func TestConfigLoadAndValidate_customBatchingConfig(t *testing.T) {
 		t.Fatalf("expected enableBatching to be false")
 	}
 }
+
+func TestConfigLoadAndValidate_userAgentExtensionAndRequestReason(t *testing.T) {
+	var headers []http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+
+	config := &Config{
+		AccessToken:        "fake-token",
+		Project:            "my-gce-project",
+		Region:             "us-central1",
+		RequestReason:      "ticket-1234",
+		UserAgentExtension: "my-tooling/1.2.3",
+	}
+
+	ConfigureBasePaths(config)
+
+	if err := config.LoadAndValidate(); err != nil {
+		t.Fatalf("error: %v", err)
+	}
+
+	// Requests sent by sendRequest and by the typed clients.
+	if _, err := sendRequest(config, "GET", "", server.URL+"/resource", nil); err != nil {
+		t.Fatalf("error sending request: %v", err)
+	}
+	config.clientCompute.BasePath = server.URL + "/"
+	if _, err := config.clientCompute.Projects.Get("my-gce-project").Do(); err != nil {
+		t.Fatalf("error getting project: %v", err)
+	}
+
+	if len(headers) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(headers))
+	}
+	for _, h := range headers {
+		ua := h.Get("User-Agent")
+		if !strings.Contains(ua, "terraform-provider-google-beta/") {
+			t.Errorf("expected User-Agent %q to contain the provider's own User-Agent", ua)
+		}
+		if !strings.HasSuffix(ua, " my-tooling/1.2.3") {
+			t.Errorf("expected User-Agent %q to end with the extension", ua)
+		}
+		if got := h.Get("X-Goog-Request-Reason"); got != "ticket-1234" {
+			t.Errorf("expected X-Goog-Request-Reason to be %q, got %q", "ticket-1234", got)
+		}
+	}
+}
+
+func TestAccConfigLoadValidate_impersonation(t *testing.T) {
+	if os.Getenv(resource.TestEnvVar) == "" {
+		t.Skip(fmt.Sprintf("Network access not allowed; use %s=1 to enable", resource.TestEnvVar))
+	}
+	testAccPreCheck(t)
+
+	creds := getTestCredsFromEnv()
+	proj := getTestProjectFromEnv()
+	serviceAccount := getTestServiceAccountFromEnv(t)
+
+	// The email scope lets the token info show who the token belongs to.
+	config := &Config{
+		Credentials:               creds,
+		Project:                   proj,
+		Region:                    "us-central1",
+		Scopes:                    append(defaultClientScopes, "https://www.googleapis.com/auth/userinfo.email"),
+		ImpersonateServiceAccount: serviceAccount,
+	}
+
+	ConfigureBasePaths(config)
+
+	err := config.LoadAndValidate()
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+
+	_, err = config.clientCompute.Zones.Get(proj, "us-central1-a").Do()
+	if err != nil {
+		t.Fatalf("expected call with loaded config client to work, got error: %s", err)
+	}
+
+	res, err := sendRequest(config, "GET", "", "https://oauth2.googleapis.com/tokeninfo?access_token="+testConfigAccessToken(t, config), nil)
+	if err != nil {
+		t.Fatalf("error getting token info: %s", err)
+	}
+	if res["email"] != serviceAccount {
+		t.Fatalf("expected requests to be made as %q, got %q", serviceAccount, res["email"])
+	}
+}
+
+func testConfigAccessToken(t *testing.T, config *Config) string {
+	token, err := config.tokenSource.Token()
+	if err != nil {
+		t.Fatalf("error getting access token: %s", err)
+	}
+	return token.AccessToken
+}
+
+// testImpersonationServer serves tokens for the IAM Credentials API, returning
+// generateStatus to requests to generate one, and records the Authorization
+// header of any other request.
+func testImpersonationServer(t *testing.T, generateStatus int) (*httptest.Server, *[]string) {
+	var authorizations []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if !strings.HasSuffix(r.URL.Path, ":generateAccessToken") {
+			authorizations = append(authorizations, r.Header.Get("Authorization"))
+			fmt.Fprint(w, `{}`)
+			return
+		}
+
+		if got := r.Header.Get("Authorization"); got != "Bearer caller-token" {
+			t.Errorf("expected token generation to be authorized by the caller, got %q", got)
+		}
+		if want := "/v1/projects/-/serviceAccounts/target@my-gce-project.iam.gserviceaccount.com:generateAccessToken"; r.URL.Path != want {
+			t.Errorf("expected a token to be generated at %q, got %q", want, r.URL.Path)
+		}
+		var req iamcredentials.GenerateAccessTokenRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("error decoding request: %s", err)
+		}
+		if len(req.Delegates) != 1 || req.Delegates[0] != "projects/-/serviceAccounts/delegate@my-gce-project.iam.gserviceaccount.com" {
+			t.Errorf("expected the delegate to be sent, got %v", req.Delegates)
+		}
+
+		w.WriteHeader(generateStatus)
+		if generateStatus != http.StatusOK {
+			fmt.Fprintf(w, `{"error": {"code": %d, "message": "Permission 'iam.serviceAccounts.getAccessToken' denied"}}`, generateStatus)
+			return
+		}
+		fmt.Fprintf(w, `{"accessToken": "impersonated-token", "expireTime": %q}`, time.Now().Add(time.Hour).Format(time.RFC3339))
+	}))
+	t.Cleanup(server.Close)
+	return server, &authorizations
+}
+
+func testImpersonationConfig(server *httptest.Server) *Config {
+	config := &Config{
+		AccessToken:                        "caller-token",
+		Project:                            "my-gce-project",
+		Region:                             "us-central1",
+		ImpersonateServiceAccount:          "target@my-gce-project.iam.gserviceaccount.com",
+		ImpersonateServiceAccountDelegates: []string{"delegate@my-gce-project.iam.gserviceaccount.com"},
+	}
+	ConfigureBasePaths(config)
+	config.IamCredentialsBasePath = server.URL + "/v1/"
+	return config
+}
+
+func TestConfigLoadAndValidate_impersonation(t *testing.T) {
+	server, authorizations := testImpersonationServer(t, http.StatusOK)
+	config := testImpersonationConfig(server)
+
+	if err := config.LoadAndValidate(); err != nil {
+		t.Fatalf("error: %v", err)
+	}
+
+	// Requests sent by sendRequest and by the typed clients.
+	if _, err := sendRequest(config, "GET", "", server.URL+"/resource", nil); err != nil {
+		t.Fatalf("error sending request: %v", err)
+	}
+	config.clientCompute.BasePath = server.URL + "/"
+	if _, err := config.clientCompute.Projects.Get("my-gce-project").Do(); err != nil {
+		t.Fatalf("error getting project: %v", err)
+	}
+
+	if len(*authorizations) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(*authorizations))
+	}
+	for _, got := range *authorizations {
+		if got != "Bearer impersonated-token" {
+			t.Errorf("expected requests to be authorized as the impersonated service account, got %q", got)
+		}
+	}
+}
+
+func TestConfigLoadAndValidate_impersonationDenied(t *testing.T) {
+	server, _ := testImpersonationServer(t, http.StatusForbidden)
+	config := testImpersonationConfig(server)
+
+	err := config.LoadAndValidate()
+	if err == nil {
+		t.Fatalf("expected an error when the caller can't impersonate the service account")
+	}
+	if !strings.Contains(err.Error(), "roles/iam.serviceAccountTokenCreator") {
+		t.Errorf("expected the error to name the role needed to impersonate, got: %s", err)
+	}
+}
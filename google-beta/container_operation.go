@@ -55,7 +55,7 @@ func (w *ContainerOperationWaiter) QueryOp() (interface{}, error) {
 		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
 	}
 	name := fmt.Sprintf("projects/%s/locations/%s/operations/%s",
-		w.Project, w.Location, w.Op.Name)
+		"", w.Location, w.Op.Name)
 
 	var op *container.Operation
 	err := retryTimeDuration(func() (opErr error) {
@@ -0,0 +1,10 @@
+package google
+
+// Info type names are either one of the built-in detectors, e.g.
+// EMAIL_ADDRESS, or the name of a custom info type, and share the same
+// format.
+const dataLossPreventionInfoTypeNameRegex = `^[A-Za-z0-9$_-]{1,64}$`
+
+func validateDataLossPreventionInfoTypeName(v interface{}, k string) (ws []string, errors []error) {
+	return validateRegexp(dataLossPreventionInfoTypeNameRegex)(v, k)
+}
@@ -0,0 +1,80 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGoogleAppEngineDefaultServiceAccount() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleAppEngineDefaultServiceAccountRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"unique_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"member": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGoogleAppEngineDefaultServiceAccountRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	serviceAccountName, err := serviceAccountFQN(appEngineDefaultServiceAccountEmail(project), d, config)
+	if err != nil {
+		return err
+	}
+
+	sa, err := config.clientIAM.Projects.ServiceAccounts.Get(serviceAccountName).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Service Account %q", serviceAccountName))
+	}
+
+	d.SetId(sa.Name)
+	d.Set("email", sa.Email)
+	d.Set("unique_id", sa.UniqueId)
+	d.Set("project", sa.ProjectId)
+	d.Set("name", sa.Name)
+	d.Set("display_name", sa.DisplayName)
+	d.Set("member", "serviceAccount:"+sa.Email)
+
+	return nil
+}
+
+// appEngineDefaultServiceAccountEmail returns the email of the service account
+// App Engine creates with an application. The domain of a domain-scoped
+// project, e.g. "example.com:my-project", is moved after its id.
+func appEngineDefaultServiceAccountEmail(project string) string {
+	if parts := strings.SplitN(project, ":", 2); len(parts) == 2 {
+		project = parts[1] + "." + parts[0]
+	}
+	return project + "@appspot.gserviceaccount.com"
+}
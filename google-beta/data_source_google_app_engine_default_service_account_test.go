@@ -0,0 +1,50 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAppEngineDefaultServiceAccountEmail(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"my-project":             "my-project@appspot.gserviceaccount.com",
+		"example.com:my-project": "my-project.example.com@appspot.gserviceaccount.com",
+	}
+
+	for project, expected := range cases {
+		if email := appEngineDefaultServiceAccountEmail(project); email != expected {
+			t.Errorf("expected the email for project %q to be %q, got %q", project, expected, email)
+		}
+	}
+}
+
+func TestAccDataSourceGoogleAppEngineDefaultServiceAccount_basic(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "data.google_app_engine_default_service_account.default"
+	email := appEngineDefaultServiceAccountEmail(getTestProjectFromEnv())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckGoogleAppEngineDefaultServiceAccount_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "email", email),
+					resource.TestCheckResourceAttr(resourceName, "member", fmt.Sprintf("serviceAccount:%s", email)),
+					resource.TestCheckResourceAttrSet(resourceName, "unique_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckGoogleAppEngineDefaultServiceAccount_basic = `
+data "google_app_engine_default_service_account" "default" { }
+`
@@ -0,0 +1,27 @@
+package google
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGoogleComputeInterconnect() *schema.Resource {
+	// Generate datasource schema from resource
+	dsSchema := datasourceSchemaFromResourceSchema(resourceComputeInterconnect().Schema)
+
+	// Set 'Required' schema elements
+	addRequiredFieldsToSchema(dsSchema, "name")
+
+	// Set 'Optional' schema elements
+	addOptionalFieldsToSchema(dsSchema, "project")
+
+	return &schema.Resource{
+		Read:   datasourceComputeInterconnectRead,
+		Schema: dsSchema,
+	}
+}
+
+func datasourceComputeInterconnectRead(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("name").(string))
+
+	return resourceComputeInterconnectRead(d, meta)
+}
@@ -0,0 +1,42 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceGoogleComputeInterconnect(t *testing.T) {
+	t.Parallel()
+
+	// Interconnects are physical connections that can't be provisioned
+	// within a test run, so read one that already exists.
+	interconnect := getTestInterconnectFromEnv(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleComputeInterconnect(interconnect),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_compute_interconnect.default", "name", interconnect),
+					resource.TestCheckResourceAttrSet("data.google_compute_interconnect.default", "operational_status"),
+					resource.TestCheckResourceAttrSet("data.google_compute_interconnect.default", "interconnect_type"),
+					resource.TestCheckResourceAttrSet("data.google_compute_interconnect.default", "link_type"),
+					resource.TestCheckResourceAttrSet("data.google_compute_interconnect.default", "location"),
+					resource.TestCheckResourceAttrSet("data.google_compute_interconnect.default", "self_link"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleComputeInterconnect(name string) string {
+	return fmt.Sprintf(`
+data "google_compute_interconnect" "default" {
+  name = "%s"
+}
+`, name)
+}
@@ -2,10 +2,12 @@ package google
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform/helper/schema"
 	"io/ioutil"
 	"net/http"
 	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func dataSourceGoogleNetblockIpRanges() *schema.Resource {
@@ -13,6 +15,20 @@ func dataSourceGoogleNetblockIpRanges() *schema.Resource {
 		Read: dataSourceGoogleNetblockIpRangesRead,
 
 		Schema: map[string]*schema.Schema{
+			"range_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "cloud-netblocks",
+				ValidateFunc: validation.StringInSlice([]string{
+					"cloud-netblocks",
+					"google-netblocks",
+					"restricted-googleapis",
+					"private-googleapis",
+					"dns-forwarders",
+					"iap-forwarders",
+					"health-checkers",
+				}, false),
+			},
 			"cidr_blocks": {
 				Type:     schema.TypeList,
 				Elem:     &schema.Schema{Type: schema.TypeString},
@@ -33,15 +49,54 @@ func dataSourceGoogleNetblockIpRanges() *schema.Resource {
 }
 
 func dataSourceGoogleNetblockIpRangesRead(d *schema.ResourceData, meta interface{}) error {
-	d.SetId("netblock-ip-ranges")
-
-	// https://cloud.google.com/compute/docs/faq#where_can_i_find_product_name_short_ip_ranges
-	CidrBlocks, err := getCidrBlocks()
-
-	if err != nil {
-		return err
+	rt := d.Get("range_type").(string)
+	CidrBlocks := make(map[string][]string)
+
+	switch rt {
+	// Dynamic ranges, published as SPF records
+	case "cloud-netblocks":
+		// https://cloud.google.com/compute/docs/faq#where_can_i_find_product_name_short_ip_ranges
+		const CLOUD_NETBLOCK_DNS = "_cloud-netblocks.googleusercontent.com"
+		blocks, err := getCidrBlocks(CLOUD_NETBLOCK_DNS)
+		if err != nil {
+			return err
+		}
+		CidrBlocks = blocks
+	case "google-netblocks":
+		// https://support.google.com/a/answer/33786
+		const GOOGLE_NETBLOCK_DNS = "_spf.google.com"
+		blocks, err := getCidrBlocks(GOOGLE_NETBLOCK_DNS)
+		if err != nil {
+			return err
+		}
+		CidrBlocks = blocks
+	// Static ranges
+	case "restricted-googleapis":
+		// https://cloud.google.com/vpc/docs/configure-private-google-access-hybrid
+		CidrBlocks["cidr_blocks_ipv4"] = append(CidrBlocks["cidr_blocks_ipv4"], "199.36.153.4/30")
+		CidrBlocks["cidr_blocks"] = CidrBlocks["cidr_blocks_ipv4"]
+	case "private-googleapis":
+		// https://cloud.google.com/vpc/docs/configure-private-google-access-hybrid
+		CidrBlocks["cidr_blocks_ipv4"] = append(CidrBlocks["cidr_blocks_ipv4"], "199.36.153.8/30")
+		CidrBlocks["cidr_blocks"] = CidrBlocks["cidr_blocks_ipv4"]
+	case "dns-forwarders":
+		// https://cloud.google.com/dns/zones/#creating-forwarding-zones
+		CidrBlocks["cidr_blocks_ipv4"] = append(CidrBlocks["cidr_blocks_ipv4"], "35.199.192.0/19")
+		CidrBlocks["cidr_blocks"] = CidrBlocks["cidr_blocks_ipv4"]
+	case "iap-forwarders":
+		// https://cloud.google.com/iap/docs/using-tcp-forwarding
+		CidrBlocks["cidr_blocks_ipv4"] = append(CidrBlocks["cidr_blocks_ipv4"], "35.235.240.0/20")
+		CidrBlocks["cidr_blocks"] = CidrBlocks["cidr_blocks_ipv4"]
+	case "health-checkers":
+		// https://cloud.google.com/load-balancing/docs/health-checks#fw-ruleh
+		CidrBlocks["cidr_blocks_ipv4"] = append(CidrBlocks["cidr_blocks_ipv4"], "35.191.0.0/16", "130.211.0.0/22")
+		CidrBlocks["cidr_blocks"] = CidrBlocks["cidr_blocks_ipv4"]
+	default:
+		return fmt.Errorf("Unknown range_type: %s", rt)
 	}
 
+	d.SetId("netblock-ip-ranges-" + rt)
+
 	d.Set("cidr_blocks", CidrBlocks["cidr_blocks"])
 	d.Set("cidr_blocks_ipv4", CidrBlocks["cidr_blocks_ipv4"])
 	d.Set("cidr_blocks_ipv6", CidrBlocks["cidr_blocks_ipv6"])
@@ -53,7 +108,7 @@ func netblock_request(name string) (string, error) {
 	response, err := http.Get(fmt.Sprintf("https://dns.google.com/resolve?name=%s&type=TXT", name))
 
 	if err != nil {
-		return "", fmt.Errorf("Error from _cloud-netblocks: %s", err)
+		return "", fmt.Errorf("Error from %s: %s", name, err)
 	}
 
 	defer response.Body.Close()
@@ -66,12 +121,11 @@ func netblock_request(name string) (string, error) {
 	return string(body), nil
 }
 
-func getCidrBlocks() (map[string][]string, error) {
-	const INITIAL_NETBLOCK_DNS = "_cloud-netblocks.googleusercontent.com"
+func getCidrBlocks(initialNetblockDns string) (map[string][]string, error) {
 	var dnsNetblockList []string
 	cidrBlocks := make(map[string][]string)
 
-	response, err := netblock_request(INITIAL_NETBLOCK_DNS)
+	response, err := netblock_request(initialNetblockDns)
 
 	if err != nil {
 		return nil, err
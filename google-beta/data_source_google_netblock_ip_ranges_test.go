@@ -29,6 +29,26 @@ func TestAccDataSourceGoogleNetblockIpRanges_basic(t *testing.T) {
 						"cidr_blocks_ipv6.0", regexp.MustCompile("^(?:[0-9a-fA-F]{1,4}:){1,2}.*/[0-9]{1,3}$")),
 				),
 			},
+			{
+				Config: testAccNetblockIpRangesConfig_iap,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_netblock_ip_ranges.iap",
+						"cidr_blocks.#", "1"),
+					resource.TestCheckResourceAttr("data.google_netblock_ip_ranges.iap",
+						"cidr_blocks.0", "35.235.240.0/20"),
+					resource.TestCheckResourceAttr("data.google_netblock_ip_ranges.iap",
+						"cidr_blocks_ipv4.0", "35.235.240.0/20"),
+				),
+			},
+			{
+				Config: testAccNetblockIpRangesConfig_google,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.google_netblock_ip_ranges.google",
+						"cidr_blocks_ipv4.#", regexp.MustCompile(("^[1-9]+[0-9]*$"))),
+					resource.TestMatchResourceAttr("data.google_netblock_ip_ranges.google",
+						"cidr_blocks_ipv4.0", regexp.MustCompile("^(?:[0-9]{1,3}.){3}[0-9]{1,3}/[0-9]{1,2}$")),
+				),
+			},
 		},
 	})
 }
@@ -36,3 +56,15 @@ func TestAccDataSourceGoogleNetblockIpRanges_basic(t *testing.T) {
 const testAccNetblockIpRangesConfig = `
 data "google_netblock_ip_ranges" "some" {}
 `
+
+const testAccNetblockIpRangesConfig_iap = `
+data "google_netblock_ip_ranges" "iap" {
+  range_type = "iap-forwarders"
+}
+`
+
+const testAccNetblockIpRangesConfig_google = `
+data "google_netblock_ip_ranges" "google" {
+  range_type = "google-netblocks"
+}
+`
@@ -44,7 +44,7 @@ func datasourceGoogleProjectsRead(d *schema.ResourceData, meta interface{}) erro
 			return err
 		}
 
-		res, err := sendRequest(config, "GET", url, nil)
+		res, err := sendRequest(config, "GET", "", url, nil)
 		if err != nil {
 			return fmt.Errorf("Error retrieving projects: %s", err)
 		}
@@ -23,6 +23,10 @@ func dataSourceGoogleStorageProjectServiceAccount() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"member": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -48,6 +52,7 @@ func dataSourceGoogleStorageProjectServiceAccountRead(d *schema.ResourceData, me
 
 	d.Set("project", project)
 	d.Set("email_address", serviceAccount.EmailAddress)
+	d.Set("member", "serviceAccount:"+serviceAccount.EmailAddress)
 
 	d.SetId(serviceAccount.EmailAddress)
 
@@ -1,6 +1,7 @@
 package google
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -18,7 +19,8 @@ func TestAccDataSourceGoogleStorageProjectServiceAccount_basic(t *testing.T) {
 			{
 				Config: testAccCheckGoogleStorageProjectServiceAccount_basic,
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttrSet(resourceName, "email_address"),
+					resource.TestMatchResourceAttr(resourceName, "email_address", regexp.MustCompile(`^service-[0-9]+@gs-project-accounts\.iam\.gserviceaccount\.com$`)),
+					resource.TestMatchResourceAttr(resourceName, "member", regexp.MustCompile(`^serviceAccount:service-[0-9]+@gs-project-accounts\.iam\.gserviceaccount\.com$`)),
 				),
 			},
 		},
@@ -0,0 +1,57 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+package google
+
+import (
+	"fmt"
+	"strings"
+)
+
+// documentAIBasePath returns the base path of the regional Document AI
+// endpoint for a location.
+func documentAIBasePath(config *Config, location string) string {
+	return strings.Replace(config.DocumentAIBasePath, "{{location}}", location, 1)
+}
+
+type DocumentAIOperationWaiter struct {
+	Config   *Config
+	Project  string
+	Location string
+	CommonOperationWaiter
+}
+
+func (w *DocumentAIOperationWaiter) QueryOp() (interface{}, error) {
+	if w == nil {
+		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
+	}
+	// Returns the proper get.
+	url := fmt.Sprintf("%s%s", documentAIBasePath(w.Config, w.Location), w.CommonOperationWaiter.Op.Name)
+	return sendRequest(w.Config, "GET", w.Project, url, nil)
+}
+
+func documentAIOperationWaitTime(config *Config, op map[string]interface{}, project, location, activity string, timeoutMinutes int) error {
+	if val, ok := op["name"]; !ok || val == "" {
+		// This was a synchronous call - there is no operation to wait for.
+		return nil
+	}
+	w := &DocumentAIOperationWaiter{
+		Config:   config,
+		Project:  project,
+		Location: location,
+	}
+	if err := w.CommonOperationWaiter.SetOp(op); err != nil {
+		return err
+	}
+	return OperationWait(w, activity, timeoutMinutes)
+}
@@ -18,7 +18,8 @@ import (
 )
 
 type FilestoreOperationWaiter struct {
-	Config *Config
+	Config  *Config
+	Project string
 	CommonOperationWaiter
 }
 
@@ -28,7 +29,7 @@ func (w *FilestoreOperationWaiter) QueryOp() (interface{}, error) {
 	}
 	// Returns the proper get.
 	url := fmt.Sprintf("https://file.googleapis.com/v1/%s", w.CommonOperationWaiter.Op.Name)
-	return sendRequest(w.Config, "GET", url, nil)
+	return sendRequest(w.Config, "GET", w.Project, url, nil)
 }
 
 func filestoreOperationWaitTime(config *Config, op map[string]interface{}, project, activity string, timeoutMinutes int) error {
@@ -37,7 +38,8 @@ func filestoreOperationWaitTime(config *Config, op map[string]interface{}, proje
 		return nil
 	}
 	w := &FilestoreOperationWaiter{
-		Config: config,
+		Config:  config,
+		Project: project,
 	}
 	if err := w.CommonOperationWaiter.SetOp(op); err != nil {
 		return err
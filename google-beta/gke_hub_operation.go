@@ -0,0 +1,32 @@
+package google
+
+import (
+	"fmt"
+)
+
+type GKEHubOperationWaiter struct {
+	Config *Config
+	CommonOperationWaiter
+}
+
+func (w *GKEHubOperationWaiter) QueryOp() (interface{}, error) {
+	if w == nil {
+		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
+	}
+	url := fmt.Sprintf("%s%s", w.Config.GKEHubBasePath, w.CommonOperationWaiter.Op.Name)
+	return sendRequest(w.Config, "GET", "", url, nil)
+}
+
+func gkeHubOperationWaitTime(config *Config, op map[string]interface{}, activity string, timeoutMinutes int) error {
+	if val, ok := op["name"]; !ok || val == "" {
+		// This was a synchronous call - there is no operation to wait for.
+		return nil
+	}
+	w := &GKEHubOperationWaiter{
+		Config: config,
+	}
+	if err := w.CommonOperationWaiter.SetOp(op); err != nil {
+		return err
+	}
+	return OperationWait(w, activity, timeoutMinutes)
+}
@@ -0,0 +1,31 @@
+package google
+
+import (
+	"net/http"
+)
+
+// headerTransport sets a fixed set of headers on every request sent through
+// it, including those sent by the typed API clients.
+type headerTransport struct {
+	headers http.Header
+	base    http.RoundTripper
+}
+
+func newHeaderTransport(base http.RoundTripper, headers http.Header) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &headerTransport{
+		headers: headers,
+		base:    base,
+	}
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the request it's given.
+	req = req.Clone(req.Context())
+	for k, v := range t.headers {
+		req.Header[k] = v
+	}
+	return t.base.RoundTrip(req)
+}
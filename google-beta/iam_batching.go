@@ -0,0 +1,68 @@
+package google
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+const (
+	batchKeyTmplModifyIamPolicy = "%s modifyIamPolicy"
+
+	IamBatchingEnabled  = true
+	IamBatchingDisabled = false
+)
+
+// BatchRequestModifyIamPolicy can be used to batch changes to the IAM policy
+// of a single resource across resource nodes, i.e. to apply several
+// google_project_iam_member(s) resources with a single read-modify-write of
+// the project's policy.
+func BatchRequestModifyIamPolicy(updater ResourceIamUpdater, modify iamPolicyModifyFunc, config *Config, reqDesc string) error {
+	batchKey := fmt.Sprintf(batchKeyTmplModifyIamPolicy, updater.GetMutexKey())
+
+	request := &BatchRequest{
+		ResourceName: updater.GetResourceId(),
+		Body:         []iamPolicyModifyFunc{modify},
+		CombineF:     combineBatchIamPolicyModifiers,
+		SendF:        sendBatchModifyIamPolicy(updater),
+		DebugId:      reqDesc,
+	}
+
+	_, err := config.requestBatcherIam.SendRequestWithTimeout(batchKey, request, time.Minute*30)
+	return err
+}
+
+func combineBatchIamPolicyModifiers(currV interface{}, toAddV interface{}) (interface{}, error) {
+	currModifiers, ok := currV.([]iamPolicyModifyFunc)
+	if !ok {
+		return nil, fmt.Errorf("provider error in batch combiner: expected data to be type []iamPolicyModifyFunc, got %v with type %T", currV, currV)
+	}
+
+	newModifiers, ok := toAddV.([]iamPolicyModifyFunc)
+	if !ok {
+		return nil, fmt.Errorf("provider error in batch combiner: expected data to be type []iamPolicyModifyFunc, got %v with type %T", toAddV, toAddV)
+	}
+
+	return append(currModifiers, newModifiers...), nil
+}
+
+// sendBatchModifyIamPolicy applies every modifier in the batch within the same
+// read-modify-write cycle, which is serialized with other changes to the
+// policy by the updater's mutex.
+func sendBatchModifyIamPolicy(updater ResourceIamUpdater) batcherSendFunc {
+	return func(resourceName string, body interface{}) (interface{}, error) {
+		modifiers, ok := body.([]iamPolicyModifyFunc)
+		if !ok {
+			return nil, fmt.Errorf("provider error: expected data to be type []iamPolicyModifyFunc, got %v with type %T", body, body)
+		}
+		return nil, iamPolicyReadModifyWrite(updater, func(policy *cloudresourcemanager.Policy) error {
+			for _, modifyF := range modifiers {
+				if err := modifyF(policy); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+}
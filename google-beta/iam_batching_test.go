@@ -0,0 +1,143 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/googleapi"
+)
+
+type testIamUpdater struct {
+	mu       sync.Mutex
+	policy   *cloudresourcemanager.Policy
+	getErr   error
+	getCalls int
+	setCalls int
+}
+
+func (u *testIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.getCalls++
+	if u.getErr != nil {
+		return nil, u.getErr
+	}
+
+	p := &cloudresourcemanager.Policy{}
+	for _, b := range u.policy.Bindings {
+		p.Bindings = append(p.Bindings, &cloudresourcemanager.Binding{
+			Role:    b.Role,
+			Members: append([]string{}, b.Members...),
+		})
+	}
+	return p, nil
+}
+
+func (u *testIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.setCalls++
+
+	u.policy = policy
+	return nil
+}
+
+func (u *testIamUpdater) GetMutexKey() string {
+	return "iam-test-resource"
+}
+
+func (u *testIamUpdater) GetResourceId() string {
+	return "test-resource"
+}
+
+func (u *testIamUpdater) DescribeResource() string {
+	return "test resource"
+}
+
+func TestBatchRequestModifyIamPolicy(t *testing.T) {
+	t.Parallel()
+
+	config := &Config{
+		requestBatcherIam: NewRequestBatcher("IAM", context.Background(), &batchingConfig{
+			sendAfter:      time.Duration(1) * time.Second,
+			enableBatching: true,
+		}),
+	}
+	updater := &testIamUpdater{policy: &cloudresourcemanager.Policy{}}
+
+	numMembers := 10
+	wg := sync.WaitGroup{}
+	wg.Add(numMembers)
+	for i := 0; i < numMembers; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			member := &cloudresourcemanager.Binding{
+				Role:    "roles/viewer",
+				Members: []string{fmt.Sprintf("user:test-%d@example.com", idx)},
+			}
+			err := BatchRequestModifyIamPolicy(updater, func(p *cloudresourcemanager.Policy) error {
+				p.Bindings = mergeBindings(append(p.Bindings, member))
+				return nil
+			}, config, fmt.Sprintf("Create IAM Member #%d", idx))
+			if err != nil {
+				t.Errorf("got unexpected error: %s", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if updater.setCalls != 1 {
+		t.Errorf("expected a single call to set the policy, got %d", updater.setCalls)
+	}
+	if len(updater.policy.Bindings) != 1 {
+		t.Fatalf("expected a single binding, got %+v", updater.policy.Bindings)
+	}
+	if got := len(updater.policy.Bindings[0].Members); got != numMembers {
+		t.Errorf("expected %d members in binding, got %d", numMembers, got)
+	}
+}
+
+func TestBatchRequestModifyIamPolicy_deleteFromDeletedResource(t *testing.T) {
+	t.Parallel()
+
+	config := &Config{
+		requestBatcherIam: NewRequestBatcher("IAM", context.Background(), &batchingConfig{
+			sendAfter:      time.Millisecond,
+			enableBatching: true,
+		}),
+	}
+	updater := &testIamUpdater{
+		getErr: &googleapi.Error{Code: 404, Message: "The project was deleted"},
+	}
+	newUpdaterFunc := func(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+		return updater, nil
+	}
+
+	member := ResourceIamMember(map[string]*schema.Schema{}, newUpdaterFunc, IamBatchingEnabled)
+	d := member.TestResourceData()
+	d.SetId("test-resource/roles/viewer/user:test@example.com")
+	d.Set("role", "roles/viewer")
+	d.Set("member", "user:test@example.com")
+	if err := member.Delete(d, config); err != nil {
+		t.Errorf("expected deleting a member of a deleted resource to succeed, got: %s", err)
+	}
+
+	binding := ResourceIamBinding(map[string]*schema.Schema{}, newUpdaterFunc, IamBatchingEnabled)
+	d = binding.TestResourceData()
+	d.SetId("test-resource/roles/viewer")
+	d.Set("role", "roles/viewer")
+	d.Set("members", []string{"user:test@example.com"})
+	if err := binding.Delete(d, config); err != nil {
+		t.Errorf("expected deleting a binding of a deleted resource to succeed, got: %s", err)
+	}
+
+	if updater.getCalls != 2 {
+		t.Errorf("expected both deletions to read the policy, got %d reads", updater.getCalls)
+	}
+}
@@ -0,0 +1,98 @@
+package google
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+var IamStorageManagedFolderSchema = map[string]*schema.Schema{
+	"bucket": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"managed_folder": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validateRegexp(`^[^/].*/$`),
+	},
+}
+
+// Managed folder names are paths, so the bucket is everything up to the first
+// slash and the managed folder everything after it.
+var storageManagedFolderIdRegex = regexp.MustCompile(`^(?:b/)?([^/]+)/(?:managedFolders/)?(.+/)$`)
+
+func StorageManagedFolderIdParseFunc(d *schema.ResourceData, _ *Config) error {
+	parts := storageManagedFolderIdRegex.FindStringSubmatch(d.Id())
+	if parts == nil {
+		return fmt.Errorf("Invalid managed folder id %q, expected {{bucket}}/{{managed_folder}}", d.Id())
+	}
+	d.Set("bucket", parts[1])
+	d.Set("managed_folder", parts[2])
+	d.SetId(fmt.Sprintf("%s/%s", parts[1], parts[2]))
+	return nil
+}
+
+type StorageManagedFolderIamUpdater struct {
+	bucket        string
+	managedFolder string
+	Config        *Config
+}
+
+func NewStorageManagedFolderIamUpdater(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+	return &StorageManagedFolderIamUpdater{
+		bucket:        d.Get("bucket").(string),
+		managedFolder: d.Get("managed_folder").(string),
+		Config:        config,
+	}, nil
+}
+
+func (u *StorageManagedFolderIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
+	policy, err := sendRequest(u.Config, "GET", "", u.qualifyManagedFolderUrl(), nil)
+	if err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	out := &cloudresourcemanager.Policy{}
+	err = Convert(policy, out)
+	if err != nil {
+		return nil, errwrap.Wrapf("Cannot convert a storage policy to a v1 policy: {{err}}", err)
+	}
+
+	return out, nil
+}
+
+func (u *StorageManagedFolderIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error {
+	obj, err := ConvertToMap(policy)
+	if err != nil {
+		return errwrap.Wrapf(fmt.Sprintf("Invalid IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	_, err = sendRequest(u.Config, "PUT", "", u.qualifyManagedFolderUrl(), obj)
+	if err != nil {
+		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	return nil
+}
+
+func (u *StorageManagedFolderIamUpdater) qualifyManagedFolderUrl() string {
+	return storageManagedFolderUrl(u.Config, u.bucket, u.managedFolder) + "/iam"
+}
+
+func (u *StorageManagedFolderIamUpdater) GetResourceId() string {
+	return fmt.Sprintf("%s/%s", u.bucket, u.managedFolder)
+}
+
+func (u *StorageManagedFolderIamUpdater) GetMutexKey() string {
+	return fmt.Sprintf("iam-storage-managed-folder-%s", u.GetResourceId())
+}
+
+func (u *StorageManagedFolderIamUpdater) DescribeResource() string {
+	return fmt.Sprintf("Storage Managed Folder %q", u.GetResourceId())
+}
@@ -0,0 +1,85 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/iamcredentials/v1"
+	"google.golang.org/api/option"
+)
+
+const impersonatedTokenLifetime = "3600s"
+
+// impersonatedTokenSource generates access tokens for a service account
+// through the IAM Credentials API, authenticated as the caller.
+type impersonatedTokenSource struct {
+	service   *iamcredentials.Service
+	name      string
+	delegates []string
+	scopes    []string
+}
+
+func (ts *impersonatedTokenSource) Token() (*oauth2.Token, error) {
+	resp, err := ts.service.Projects.ServiceAccounts.GenerateAccessToken(ts.name, &iamcredentials.GenerateAccessTokenRequest{
+		Delegates: ts.delegates,
+		Scope:     ts.scopes,
+		Lifetime:  impersonatedTokenLifetime,
+	}).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	expiry, err := time.Parse(time.RFC3339, resp.ExpireTime)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing expiry of impersonated token %q: %s", resp.ExpireTime, err)
+	}
+	return &oauth2.Token{
+		AccessToken: resp.AccessToken,
+		Expiry:      expiry,
+	}, nil
+}
+
+// impersonatedTokenSource wraps the token source of the caller's credentials
+// into one generating tokens for the configured service account. A first
+// token is generated immediately so that a caller who can't impersonate the
+// service account fails when the provider is configured.
+func (c *Config) impersonatedTokenSource(tokenSource oauth2.TokenSource, clientScopes []string) (oauth2.TokenSource, error) {
+	basePath := c.IamCredentialsBasePath
+	if basePath == "" {
+		basePath = IamCredentialsDefaultBasePath
+	}
+
+	service, err := iamcredentials.NewService(context.Background(), option.WithTokenSource(tokenSource))
+	if err != nil {
+		return nil, err
+	}
+	service.BasePath = removeBasePathVersion(basePath)
+
+	delegates := make([]string, 0, len(c.ImpersonateServiceAccountDelegates))
+	for _, d := range c.ImpersonateServiceAccountDelegates {
+		delegates = append(delegates, serviceAccountResourceName(d))
+	}
+	ts := &impersonatedTokenSource{
+		service:   service,
+		name:      serviceAccountResourceName(c.ImpersonateServiceAccount),
+		delegates: delegates,
+		scopes:    clientScopes,
+	}
+
+	token, err := ts.Token()
+	if err != nil {
+		if isGoogleApiErrorWithCode(err, 403) {
+			return nil, fmt.Errorf("Error impersonating service account %q: the configured credentials need roles/iam.serviceAccountTokenCreator on it, or on each of impersonate_service_account_delegates: %s", c.ImpersonateServiceAccount, err)
+		}
+		return nil, fmt.Errorf("Error impersonating service account %q: %s", c.ImpersonateServiceAccount, err)
+	}
+	return oauth2.ReuseTokenSource(token, ts), nil
+}
+
+// serviceAccountResourceName returns the IAM Credentials resource name of a
+// service account given its email.
+func serviceAccountResourceName(email string) string {
+	return fmt.Sprintf("projects/-/serviceAccounts/%s", email)
+}
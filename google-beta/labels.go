@@ -0,0 +1,164 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Resources with labels expose three label fields:
+//
+//   * labels holds the labels configured on the resource itself.
+//   * terraform_labels holds the labels Terraform manages, the provider's
+//     default_labels merged with labels. This is what's sent to the API.
+//   * effective_labels holds every label present on the resource in GCP.
+//
+// Labels configured on the resource take precedence over default labels with
+// the same key.
+
+const (
+	// The label added to resources to attribute them to Terraform when the
+	// provider's add_terraform_attribution_label is set.
+	terraformAttributionLabelKey   = "goog-terraform-provisioned"
+	terraformAttributionLabelValue = "true"
+
+	// Strategies for terraform_attribution_label_addition_strategy: add the
+	// attribution label only to resources as they're created, or to every
+	// resource the next time it's planned.
+	terraformAttributionLabelCreationOnly = "CREATION_ONLY"
+	terraformAttributionLabelProactive    = "PROACTIVE"
+)
+
+// mergeDefaultLabels returns the provider's default labels overridden by the
+// labels configured on the resource.
+func mergeDefaultLabels(defaults map[string]string, labels map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(defaults)+len(labels))
+	for k, v := range defaults {
+		merged[k] = v
+	}
+	for k, v := range labels {
+		merged[k] = v
+	}
+	return merged
+}
+
+// setLabelsDiff is a CustomizeDiff func that computes terraform_labels and
+// effective_labels from the configured labels and the provider's default
+// labels.
+func setLabelsDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("labels") {
+		if err := diff.SetNewComputed("terraform_labels"); err != nil {
+			return fmt.Errorf("error setting terraform_labels in diff: %s", err)
+		}
+		if err := diff.SetNewComputed("effective_labels"); err != nil {
+			return fmt.Errorf("error setting effective_labels in diff: %s", err)
+		}
+		return nil
+	}
+
+	config := meta.(*Config)
+	labels, _ := diff.Get("labels").(map[string]interface{})
+	merged := mergeDefaultLabels(config.DefaultLabels, labels)
+
+	old, _ := diff.GetChange("effective_labels")
+	oldEffective := old.(map[string]interface{})
+	if _, ok := merged[terraformAttributionLabelKey]; !ok && config.AddTerraformAttributionLabel {
+		// With the CREATION_ONLY strategy existing resources only keep the
+		// attribution label if it was added when they were created.
+		_, hasAttribution := oldEffective[terraformAttributionLabelKey]
+		if diff.Id() == "" || hasAttribution || config.TerraformAttributionLabelAdditionStrategy == terraformAttributionLabelProactive {
+			merged[terraformAttributionLabelKey] = terraformAttributionLabelValue
+		}
+	}
+
+	if err := diff.SetNew("terraform_labels", merged); err != nil {
+		return fmt.Errorf("error setting terraform_labels in diff: %s", err)
+	}
+	// Labels are sent authoritatively, so once applied the resource will
+	// hold the labels Terraform manages alongside any added by services.
+	effective := make(map[string]interface{}, len(merged))
+	for k, v := range oldEffective {
+		if isServiceLabel(config, k) {
+			effective[k] = v
+		}
+	}
+	for k, v := range merged {
+		effective[k] = v
+	}
+	if err := diff.SetNew("effective_labels", effective); err != nil {
+		return fmt.Errorf("error setting effective_labels in diff: %s", err)
+	}
+	return nil
+}
+
+// forceNewIfTerraformLabelsChange is a CustomizeDiff func for resources whose
+// labels can't be updated in place. A change to the provider's default labels
+// replaces the resource just like a change to its own labels does.
+func forceNewIfTerraformLabelsChange(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && diff.HasChange("terraform_labels") {
+		return diff.ForceNew("terraform_labels")
+	}
+	return nil
+}
+
+// setLabelsFields sets labels, terraform_labels and effective_labels from the
+// labels returned by the API. Labels are sent authoritatively, so every label
+// on the resource other than those added by services is one Terraform
+// manages. Default labels the resource doesn't override are left out of
+// labels, so that removing one out of band shows up as drift in
+// terraform_labels rather than in labels. An overriding label has a different
+// value than the default, which keeps it in labels even when the configured
+// labels aren't known, as on import.
+func setLabelsFields(d *schema.ResourceData, config *Config, v interface{}) error {
+	configured, _ := d.Get("labels").(map[string]interface{})
+
+	effectiveLabels := flattenLabelsMap(v)
+	labels := make(map[string]interface{})
+	terraformLabels := make(map[string]interface{})
+	for k, val := range effectiveLabels {
+		_, isConfigured := configured[k]
+		defaultVal, hasDefault := config.DefaultLabels[k]
+		if k == terraformAttributionLabelKey && config.AddTerraformAttributionLabel {
+			defaultVal, hasDefault = terraformAttributionLabelValue, true
+		}
+		if isConfigured || !hasDefault || val != defaultVal {
+			labels[k] = val
+		}
+		if !isServiceLabel(config, k) {
+			terraformLabels[k] = val
+		}
+	}
+
+	if err := d.Set("labels", labels); err != nil {
+		return err
+	}
+	if err := d.Set("terraform_labels", terraformLabels); err != nil {
+		return err
+	}
+	return d.Set("effective_labels", effectiveLabels)
+}
+
+// Labels prefixed with goog- are reserved for labels Google services add to
+// the resources they create or manage, such as goog-dataproc-cluster-uuid.
+// The Terraform attribution label is managed by Terraform when it's enabled.
+func isServiceLabel(config *Config, k string) bool {
+	if k == terraformAttributionLabelKey && config.AddTerraformAttributionLabel {
+		return false
+	}
+	return strings.HasPrefix(k, "goog-")
+}
+
+func flattenLabelsMap(v interface{}) map[string]interface{} {
+	switch labels := v.(type) {
+	case map[string]interface{}:
+		return labels
+	case map[string]string:
+		transformed := make(map[string]interface{}, len(labels))
+		for k, val := range labels {
+			transformed[k] = val
+		}
+		return transformed
+	}
+	return map[string]interface{}{}
+}
@@ -0,0 +1,370 @@
+package google
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestMergeDefaultLabels(t *testing.T) {
+	t.Parallel()
+
+	defaults := map[string]string{
+		"cost-center": "cc-1",
+		"team":        "platform",
+	}
+	labels := map[string]interface{}{
+		"team": "data",
+		"env":  "prod",
+	}
+
+	merged := mergeDefaultLabels(defaults, labels)
+	expected := map[string]interface{}{
+		"cost-center": "cc-1",
+		"team":        "data",
+		"env":         "prod",
+	}
+	if !reflect.DeepEqual(merged, expected) {
+		t.Errorf("expected resource labels to override default labels: want %v, got %v", expected, merged)
+	}
+}
+
+func TestSetLabelsFields(t *testing.T) {
+	t.Parallel()
+
+	config := &Config{
+		DefaultLabels: map[string]string{
+			"cost-center": "cc-1",
+			"team":        "platform",
+		},
+	}
+
+	cases := map[string]struct {
+		Configured map[string]interface{}
+		Api        map[string]interface{}
+
+		Labels          map[string]interface{}
+		TerraformLabels map[string]interface{}
+		EffectiveLabels map[string]interface{}
+	}{
+		"default labels left out of labels": {
+			Configured: map[string]interface{}{"env": "prod"},
+			Api:        map[string]interface{}{"env": "prod", "cost-center": "cc-1", "team": "platform"},
+
+			Labels:          map[string]interface{}{"env": "prod"},
+			TerraformLabels: map[string]interface{}{"env": "prod", "cost-center": "cc-1", "team": "platform"},
+			EffectiveLabels: map[string]interface{}{"env": "prod", "cost-center": "cc-1", "team": "platform"},
+		},
+		"configured default key kept in labels": {
+			Configured: map[string]interface{}{"team": "platform"},
+			Api:        map[string]interface{}{"cost-center": "cc-1", "team": "platform"},
+
+			Labels:          map[string]interface{}{"team": "platform"},
+			TerraformLabels: map[string]interface{}{"cost-center": "cc-1", "team": "platform"},
+			EffectiveLabels: map[string]interface{}{"cost-center": "cc-1", "team": "platform"},
+		},
+		"overridden default kept in labels on import": {
+			Api: map[string]interface{}{"cost-center": "cc-1", "team": "data"},
+
+			Labels:          map[string]interface{}{"team": "data"},
+			TerraformLabels: map[string]interface{}{"cost-center": "cc-1", "team": "data"},
+			EffectiveLabels: map[string]interface{}{"cost-center": "cc-1", "team": "data"},
+		},
+		"default removed out of band": {
+			Configured: map[string]interface{}{"env": "prod"},
+			Api:        map[string]interface{}{"env": "prod", "team": "platform"},
+
+			Labels:          map[string]interface{}{"env": "prod"},
+			TerraformLabels: map[string]interface{}{"env": "prod", "team": "platform"},
+			EffectiveLabels: map[string]interface{}{"env": "prod", "team": "platform"},
+		},
+		"service labels only in effective_labels": {
+			Configured: map[string]interface{}{"env": "prod"},
+			Api:        map[string]interface{}{"env": "prod", "goog-dataproc-cluster-name": "cluster"},
+
+			Labels:          map[string]interface{}{"env": "prod", "goog-dataproc-cluster-name": "cluster"},
+			TerraformLabels: map[string]interface{}{"env": "prod"},
+			EffectiveLabels: map[string]interface{}{"env": "prod", "goog-dataproc-cluster-name": "cluster"},
+		},
+	}
+
+	for tn, tc := range cases {
+		d := schema.TestResourceDataRaw(t, resourcePubsubTopic().Schema, map[string]interface{}{
+			"name":   "topic",
+			"labels": tc.Configured,
+		})
+
+		if err := setLabelsFields(d, config, tc.Api); err != nil {
+			t.Fatalf("%s: unexpected error: %s", tn, err)
+		}
+		if got := d.Get("labels"); !reflect.DeepEqual(got, tc.Labels) {
+			t.Errorf("%s: bad labels: want %v, got %v", tn, tc.Labels, got)
+		}
+		if got := d.Get("terraform_labels"); !reflect.DeepEqual(got, tc.TerraformLabels) {
+			t.Errorf("%s: bad terraform_labels: want %v, got %v", tn, tc.TerraformLabels, got)
+		}
+		if got := d.Get("effective_labels"); !reflect.DeepEqual(got, tc.EffectiveLabels) {
+			t.Errorf("%s: bad effective_labels: want %v, got %v", tn, tc.EffectiveLabels, got)
+		}
+	}
+}
+
+func TestSetLabelsDiff(t *testing.T) {
+	t.Parallel()
+
+	meta := &Config{
+		DefaultLabels: map[string]string{
+			"cost-center": "cc-1",
+			"team":        "platform",
+		},
+	}
+	raw := map[string]interface{}{
+		"name": "topic",
+		"labels": map[string]interface{}{
+			"team": "data",
+		},
+	}
+	inSync := map[string]string{
+		"id":                             "projects/p/topics/topic",
+		"name":                           "topic",
+		"project":                        "p",
+		"labels.%":                       "1",
+		"labels.team":                    "data",
+		"terraform_labels.%":             "2",
+		"terraform_labels.cost-center":   "cc-1",
+		"terraform_labels.team":          "data",
+		"effective_labels.%":             "3",
+		"effective_labels.cost-center":   "cc-1",
+		"effective_labels.team":          "data",
+		"effective_labels.goog-internal": "x",
+	}
+
+	cases := map[string]struct {
+		State    map[string]string
+		Expected map[string]string
+	}{
+		"create merges default labels": {
+			Expected: map[string]string{
+				"terraform_labels.cost-center": "cc-1",
+				"terraform_labels.team":        "data",
+			},
+		},
+		"no diff when in sync": {
+			State: inSync,
+		},
+		"default removed out of band": {
+			State: map[string]string{
+				"id":                             "projects/p/topics/topic",
+				"name":                           "topic",
+				"project":                        "p",
+				"labels.%":                       "1",
+				"labels.team":                    "data",
+				"terraform_labels.%":             "1",
+				"terraform_labels.team":          "data",
+				"effective_labels.%":             "2",
+				"effective_labels.team":          "data",
+				"effective_labels.goog-internal": "x",
+			},
+			Expected: map[string]string{
+				"terraform_labels.cost-center": "cc-1",
+				"effective_labels.cost-center": "cc-1",
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		rc, err := config.NewRawConfig(raw)
+		if err != nil {
+			t.Fatalf("%s: bad config: %s", tn, err)
+		}
+
+		var state *terraform.InstanceState
+		if tc.State != nil {
+			state = &terraform.InstanceState{ID: tc.State["id"], Attributes: tc.State}
+		}
+
+		diff, err := resourcePubsubTopic().Diff(state, terraform.NewResourceConfig(rc), meta)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tn, err)
+		}
+
+		if len(tc.Expected) == 0 {
+			if diff != nil && !diff.Empty() {
+				t.Errorf("%s: expected no diff, got %#v", tn, diff.Attributes)
+			}
+			continue
+		}
+		if diff == nil {
+			t.Fatalf("%s: expected a diff", tn)
+		}
+		for k, v := range tc.Expected {
+			attr, ok := diff.Attributes[k]
+			if !ok || attr.New != v {
+				t.Errorf("%s: expected %s to be %q in the diff, got %#v", tn, k, v, attr)
+			}
+		}
+		if tc.State != nil {
+			if attr, ok := diff.Attributes["labels.team"]; ok {
+				t.Errorf("%s: expected no change to labels, got %#v", tn, attr)
+			}
+		}
+		if attr, ok := diff.Attributes["effective_labels.goog-internal"]; ok && attr.NewRemoved {
+			t.Errorf("%s: expected service labels to be kept in effective_labels", tn)
+		}
+	}
+}
+
+func TestSetLabelsDiff_terraformAttributionLabel(t *testing.T) {
+	t.Parallel()
+
+	raw := map[string]interface{}{
+		"name": "topic",
+		"labels": map[string]interface{}{
+			"team": "data",
+		},
+	}
+	withoutAttribution := map[string]string{
+		"id":                    "projects/p/topics/topic",
+		"name":                  "topic",
+		"project":               "p",
+		"labels.%":              "1",
+		"labels.team":           "data",
+		"terraform_labels.%":    "1",
+		"terraform_labels.team": "data",
+		"effective_labels.%":    "1",
+		"effective_labels.team": "data",
+	}
+	withAttribution := map[string]string{
+		"id":                    "projects/p/topics/topic",
+		"name":                  "topic",
+		"project":               "p",
+		"labels.%":              "1",
+		"labels.team":           "data",
+		"terraform_labels.%":    "2",
+		"terraform_labels.team": "data",
+		"terraform_labels.goog-terraform-provisioned": "true",
+		"effective_labels.%":                          "2",
+		"effective_labels.team":                       "data",
+		"effective_labels.goog-terraform-provisioned": "true",
+	}
+
+	cases := map[string]struct {
+		Strategy string
+		State    map[string]string
+		Added    bool
+	}{
+		"added on create": {
+			Strategy: terraformAttributionLabelCreationOnly,
+			Added:    true,
+		},
+		"no diff once added": {
+			Strategy: terraformAttributionLabelCreationOnly,
+			State:    withAttribution,
+		},
+		"not added to existing resources when creation only": {
+			Strategy: terraformAttributionLabelCreationOnly,
+			State:    withoutAttribution,
+		},
+		"added to existing resources when proactive": {
+			Strategy: terraformAttributionLabelProactive,
+			State:    withoutAttribution,
+			Added:    true,
+		},
+	}
+
+	for tn, tc := range cases {
+		meta := &Config{
+			AddTerraformAttributionLabel:              true,
+			TerraformAttributionLabelAdditionStrategy: tc.Strategy,
+		}
+		rc, err := config.NewRawConfig(raw)
+		if err != nil {
+			t.Fatalf("%s: bad config: %s", tn, err)
+		}
+
+		var state *terraform.InstanceState
+		if tc.State != nil {
+			state = &terraform.InstanceState{ID: tc.State["id"], Attributes: tc.State}
+		}
+
+		diff, err := resourcePubsubTopic().Diff(state, terraform.NewResourceConfig(rc), meta)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tn, err)
+		}
+
+		if !tc.Added {
+			if diff != nil && !diff.Empty() {
+				t.Errorf("%s: expected no diff, got %#v", tn, diff.Attributes)
+			}
+			continue
+		}
+		if diff == nil {
+			t.Fatalf("%s: expected a diff", tn)
+		}
+		attr, ok := diff.Attributes["terraform_labels.goog-terraform-provisioned"]
+		if !ok || attr.New != "true" {
+			t.Errorf("%s: expected the attribution label to be added to terraform_labels, got %#v", tn, attr)
+		}
+		if attr, ok := diff.Attributes["labels.goog-terraform-provisioned"]; ok {
+			t.Errorf("%s: expected the attribution label to be left out of labels, got %#v", tn, attr)
+		}
+	}
+}
+
+func TestSetLabelsFields_terraformAttributionLabel(t *testing.T) {
+	t.Parallel()
+
+	api := map[string]interface{}{"team": "data", "goog-terraform-provisioned": "true"}
+
+	cases := map[string]struct {
+		Enabled    bool
+		Configured map[string]interface{}
+
+		Labels          map[string]interface{}
+		TerraformLabels map[string]interface{}
+	}{
+		"enabled": {
+			Enabled:    true,
+			Configured: map[string]interface{}{"team": "data"},
+
+			Labels:          map[string]interface{}{"team": "data"},
+			TerraformLabels: map[string]interface{}{"team": "data", "goog-terraform-provisioned": "true"},
+		},
+		"enabled and configured": {
+			Enabled:    true,
+			Configured: map[string]interface{}{"team": "data", "goog-terraform-provisioned": "true"},
+
+			Labels:          map[string]interface{}{"team": "data", "goog-terraform-provisioned": "true"},
+			TerraformLabels: map[string]interface{}{"team": "data", "goog-terraform-provisioned": "true"},
+		},
+		"disabled": {
+			Configured: map[string]interface{}{"team": "data"},
+
+			Labels:          map[string]interface{}{"team": "data", "goog-terraform-provisioned": "true"},
+			TerraformLabels: map[string]interface{}{"team": "data"},
+		},
+	}
+
+	for tn, tc := range cases {
+		d := schema.TestResourceDataRaw(t, resourcePubsubTopic().Schema, map[string]interface{}{
+			"name":   "topic",
+			"labels": tc.Configured,
+		})
+
+		if err := setLabelsFields(d, &Config{AddTerraformAttributionLabel: tc.Enabled}, api); err != nil {
+			t.Fatalf("%s: unexpected error: %s", tn, err)
+		}
+		if got := d.Get("labels"); !reflect.DeepEqual(got, tc.Labels) {
+			t.Errorf("%s: bad labels: want %v, got %v", tn, tc.Labels, got)
+		}
+		if got := d.Get("terraform_labels"); !reflect.DeepEqual(got, tc.TerraformLabels) {
+			t.Errorf("%s: bad terraform_labels: want %v, got %v", tn, tc.TerraformLabels, got)
+		}
+		if got := d.Get("effective_labels"); !reflect.DeepEqual(got, api) {
+			t.Errorf("%s: bad effective_labels: want %v, got %v", tn, api, got)
+		}
+	}
+}
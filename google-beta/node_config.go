@@ -1,6 +1,7 @@
 package google
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -27,6 +28,12 @@ var schemaNodeConfig = &schema.Schema{
 	MaxItems: 1,
 	Elem: &schema.Resource{
 		Schema: map[string]*schema.Schema{
+			"boot_disk_kms_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
 			"disk_size_gb": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -43,6 +50,23 @@ var schemaNodeConfig = &schema.Schema{
 				ValidateFunc: validation.StringInSlice([]string{"pd-standard", "pd-ssd"}, false),
 			},
 
+			"ephemeral_storage_local_ssd_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"local_ssd_count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 24),
+						},
+					},
+				},
+			},
+
 			"guest_accelerator": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -91,6 +115,23 @@ var schemaNodeConfig = &schema.Schema{
 				ValidateFunc: validation.IntAtLeast(0),
 			},
 
+			"local_nvme_ssd_block_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"local_ssd_count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 24),
+						},
+					},
+				},
+			},
+
 			"machine_type": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -336,6 +377,35 @@ func expandNodeConfig(v interface{}) *containerBeta.NodeConfig {
 	return nc
 }
 
+// expandNodeConfigRawFields adds the node config fields that the vendored
+// container client doesn't know about to nc, the node config of a raw request.
+func expandNodeConfigRawFields(v interface{}, nc map[string]interface{}) {
+	nodeConfigs := v.([]interface{})
+	if len(nodeConfigs) == 0 || nodeConfigs[0] == nil {
+		return
+	}
+
+	nodeConfig := nodeConfigs[0].(map[string]interface{})
+
+	if v, ok := nodeConfig["boot_disk_kms_key"]; ok && v != "" {
+		nc["bootDiskKmsKey"] = v
+	}
+
+	if v, ok := nodeConfig["ephemeral_storage_local_ssd_config"]; ok && len(v.([]interface{})) > 0 {
+		conf := v.([]interface{})[0].(map[string]interface{})
+		nc["ephemeralStorageLocalSsdConfig"] = map[string]interface{}{
+			"localSsdCount": conf["local_ssd_count"],
+		}
+	}
+
+	if v, ok := nodeConfig["local_nvme_ssd_block_config"]; ok && len(v.([]interface{})) > 0 {
+		conf := v.([]interface{})[0].(map[string]interface{})
+		nc["localNvmeSsdBlockConfig"] = map[string]interface{}{
+			"localSsdCount": conf["local_ssd_count"],
+		}
+	}
+}
+
 func flattenNodeConfig(c *containerBeta.NodeConfig) []map[string]interface{} {
 	config := make([]map[string]interface{}, 0, 1)
 
@@ -368,6 +438,35 @@ func flattenNodeConfig(c *containerBeta.NodeConfig) []map[string]interface{} {
 	return config
 }
 
+// flattenNodeConfigRawFields adds the node config fields that the vendored
+// container client doesn't know about, read from the raw node config c.
+func flattenNodeConfigRawFields(config []map[string]interface{}, c interface{}) {
+	nc, ok := c.(map[string]interface{})
+	if !ok || len(config) == 0 {
+		return
+	}
+
+	config[0]["boot_disk_kms_key"] = nc["bootDiskKmsKey"]
+	config[0]["ephemeral_storage_local_ssd_config"] = flattenLocalSsdConfig(nc["ephemeralStorageLocalSsdConfig"])
+	config[0]["local_nvme_ssd_block_config"] = flattenLocalSsdConfig(nc["localNvmeSsdBlockConfig"])
+}
+
+func flattenLocalSsdConfig(c interface{}) []map[string]interface{} {
+	conf, ok := c.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	count := 0
+	if v, ok := conf["localSsdCount"].(float64); ok {
+		count = int(v)
+	}
+	return []map[string]interface{}{
+		{
+			"local_ssd_count": count,
+		},
+	}
+}
+
 func flattenContainerGuestAccelerators(c []*containerBeta.AcceleratorConfig) []map[string]interface{} {
 	result := []map[string]interface{}{}
 	for _, accel := range c {
@@ -427,3 +526,65 @@ func taintDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
 		}
 	}
 }
+
+// validLocalSsdCounts returns the numbers of local SSDs that can be attached to
+// the machine type, or nil if the limits of its machine family aren't known.
+// See https://cloud.google.com/compute/docs/disks#local_ssd_machine_type_restrictions
+func validLocalSsdCounts(machineType string) []int {
+	parts := strings.Split(machineType, "-")
+	switch parts[0] {
+	case "n1":
+		return []int{1, 2, 3, 4, 5, 6, 7, 8, 16, 24}
+	case "n2", "n2d":
+		if len(parts) < 3 {
+			return nil
+		}
+		cpus, err := strconv.Atoi(parts[2])
+		if err != nil {
+			return nil
+		}
+		switch {
+		case cpus <= 10:
+			return []int{1, 2, 4, 8, 16, 24}
+		case cpus <= 20:
+			return []int{2, 4, 8, 16, 24}
+		case cpus <= 40:
+			return []int{4, 8, 16, 24}
+		case cpus <= 80:
+			return []int{8, 16, 24}
+		default:
+			return []int{16, 24}
+		}
+	}
+	return nil
+}
+
+func resourceNodeConfigLocalSsdCountCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	machineType := diff.Get("node_config.0.machine_type").(string)
+	counts := validLocalSsdCounts(machineType)
+	if counts == nil {
+		return nil
+	}
+
+	for _, k := range []string{
+		"node_config.0.ephemeral_storage_local_ssd_config.0.local_ssd_count",
+		"node_config.0.local_nvme_ssd_block_config.0.local_ssd_count",
+	} {
+		count := diff.Get(k).(int)
+		if count == 0 {
+			continue
+		}
+		valid := false
+		for _, c := range counts {
+			if c == count {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return fmt.Errorf("%s = %d isn't supported on machine type %q, supported counts are %v", k, count, machineType, counts)
+		}
+	}
+
+	return nil
+}
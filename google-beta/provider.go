@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 
 	googleoauth "golang.org/x/oauth2/google"
@@ -71,11 +72,75 @@ func Provider() terraform.ResourceProvider {
 			},
 
 			"scopes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateOAuthScope,
+				},
+			},
+
+			"billing_project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					"GOOGLE_BILLING_PROJECT",
+				}, nil),
+			},
+
+			"user_project_override": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"impersonate_service_account": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					"GOOGLE_IMPERSONATE_SERVICE_ACCOUNT",
+				}, nil),
+			},
+
+			"impersonate_service_account_delegates": {
 				Type:     schema.TypeList,
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"request_reason": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					"CLOUDSDK_CORE_REQUEST_REASON",
+				}, nil),
+			},
+
+			"user_agent_extension": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					"GOOGLE_TERRAFORM_USERAGENT_EXTENSION",
+				}, nil),
+			},
+
+			"default_labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"add_terraform_attribution_label": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"terraform_attribution_label_addition_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      terraformAttributionLabelCreationOnly,
+				ValidateFunc: validation.StringInSlice([]string{terraformAttributionLabelCreationOnly, terraformAttributionLabelProactive}, false),
+			},
+
 			"batching": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -93,6 +158,11 @@ func Provider() terraform.ResourceProvider {
 							Optional: true,
 							Default:  true,
 						},
+						"enable_compute_operation_batching": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 					},
 				},
 			},
@@ -104,23 +174,45 @@ func Provider() terraform.ResourceProvider {
 			// end beta-only products
 			AccessContextManagerCustomEndpointEntryKey: AccessContextManagerCustomEndpointEntry,
 			AppEngineCustomEndpointEntryKey:            AppEngineCustomEndpointEntry,
+			BackupDRCustomEndpointEntryKey:             BackupDRCustomEndpointEntry,
+			BeyondcorpCustomEndpointEntryKey:           BeyondcorpCustomEndpointEntry,
+			BigqueryReservationCustomEndpointEntryKey:  BigqueryReservationCustomEndpointEntry,
 			BinaryAuthorizationCustomEndpointEntryKey:  BinaryAuthorizationCustomEndpointEntry,
 			ComputeCustomEndpointEntryKey:              ComputeCustomEndpointEntry,
 			CloudBuildCustomEndpointEntryKey:           CloudBuildCustomEndpointEntry,
+			Cloudbuildv2CustomEndpointEntryKey:         Cloudbuildv2CustomEndpointEntry,
+			ClouddeployCustomEndpointEntryKey:          ClouddeployCustomEndpointEntry,
 			CloudSchedulerCustomEndpointEntryKey:       CloudSchedulerCustomEndpointEntry,
+			ContainerAttachedCustomEndpointEntryKey:    ContainerAttachedCustomEndpointEntry,
+			DataFusionCustomEndpointEntryKey:           DataFusionCustomEndpointEntry,
+			DataplexCustomEndpointEntryKey:             DataplexCustomEndpointEntry,
 			DnsCustomEndpointEntryKey:                  DnsCustomEndpointEntry,
+			DataLossPreventionCustomEndpointEntryKey:   DataLossPreventionCustomEndpointEntry,
+			DocumentAICustomEndpointEntryKey:           DocumentAICustomEndpointEntry,
 			FilestoreCustomEndpointEntryKey:            FilestoreCustomEndpointEntry,
+			FirebaserulesCustomEndpointEntryKey:        FirebaserulesCustomEndpointEntry,
 			FirestoreCustomEndpointEntryKey:            FirestoreCustomEndpointEntry,
+			GKEBackupCustomEndpointEntryKey:            GKEBackupCustomEndpointEntry,
 			KmsCustomEndpointEntryKey:                  KmsCustomEndpointEntry,
 			MonitoringCustomEndpointEntryKey:           MonitoringCustomEndpointEntry,
+			NetappCustomEndpointEntryKey:               NetappCustomEndpointEntry,
+			NetworkSecurityCustomEndpointEntryKey:      NetworkSecurityCustomEndpointEntry,
+			NetworkServicesCustomEndpointEntryKey:      NetworkServicesCustomEndpointEntry,
+			PrivatecaCustomEndpointEntryKey:            PrivatecaCustomEndpointEntry,
+			PublicCACustomEndpointEntryKey:             PublicCACustomEndpointEntry,
 			PubsubCustomEndpointEntryKey:               PubsubCustomEndpointEntry,
+			RecaptchaEnterpriseCustomEndpointEntryKey:  RecaptchaEnterpriseCustomEndpointEntry,
 			RedisCustomEndpointEntryKey:                RedisCustomEndpointEntry,
 			ResourceManagerCustomEndpointEntryKey:      ResourceManagerCustomEndpointEntry,
+			SecurityCenterCustomEndpointEntryKey:       SecurityCenterCustomEndpointEntry,
+			SecurityCenterV2CustomEndpointEntryKey:     SecurityCenterV2CustomEndpointEntry,
 			SourceRepoCustomEndpointEntryKey:           SourceRepoCustomEndpointEntry,
 			SpannerCustomEndpointEntryKey:              SpannerCustomEndpointEntry,
 			SqlCustomEndpointEntryKey:                  SqlCustomEndpointEntry,
 			StorageCustomEndpointEntryKey:              StorageCustomEndpointEntry,
+			TagsCustomEndpointEntryKey:                 TagsCustomEndpointEntry,
 			TpuCustomEndpointEntryKey:                  TpuCustomEndpointEntry,
+			VPCAccessCustomEndpointEntryKey:            VPCAccessCustomEndpointEntry,
 
 			// Handwritten Products / Versioned / Atypical Entries
 			// start beta-only products
@@ -136,6 +228,7 @@ func Provider() terraform.ResourceProvider {
 			DataprocBetaCustomEndpointEntryKey:           DataprocBetaCustomEndpointEntry,
 			DataflowCustomEndpointEntryKey:               DataflowCustomEndpointEntry,
 			DnsBetaCustomEndpointEntryKey:                DnsBetaCustomEndpointEntry,
+			GKEHubCustomEndpointEntryKey:                 GKEHubCustomEndpointEntry,
 			IamCredentialsCustomEndpointEntryKey:         IamCredentialsCustomEndpointEntry,
 			LoggingCustomEndpointEntryKey:                LoggingCustomEndpointEntry,
 			ResourceManagerV2Beta1CustomEndpointEntryKey: ResourceManagerV2Beta1CustomEndpointEntry,
@@ -148,11 +241,16 @@ func Provider() terraform.ResourceProvider {
 			CloudFunctionsCustomEndpointEntryKey:         CloudFunctionsCustomEndpointEntry,
 			CloudIoTCustomEndpointEntryKey:               CloudIoTCustomEndpointEntry,
 			StorageTransferCustomEndpointEntryKey:        StorageTransferCustomEndpointEntry,
+			TagsLocationCustomEndpointEntryKey:           TagsLocationCustomEndpointEntry,
+			PubsubLiteCustomEndpointEntryKey:             PubsubLiteCustomEndpointEntry,
+			CloudRunCustomEndpointEntryKey:               CloudRunCustomEndpointEntry,
+			CloudRunV2CustomEndpointEntryKey:             CloudRunV2CustomEndpointEntry,
 			BigtableAdminCustomEndpointEntryKey:          BigtableAdminCustomEndpointEntry,
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
 			"google_active_folder":                            dataSourceGoogleActiveFolder(),
+			"google_app_engine_default_service_account":       dataSourceGoogleAppEngineDefaultServiceAccount(),
 			"google_billing_account":                          dataSourceGoogleBillingAccount(),
 			"google_dns_managed_zone":                         dataSourceDnsManagedZone(),
 			"google_client_config":                            dataSourceGoogleClientConfig(),
@@ -167,6 +265,7 @@ func Provider() terraform.ResourceProvider {
 			"google_compute_instance":                         dataSourceGoogleComputeInstance(),
 			"google_compute_global_address":                   dataSourceGoogleComputeGlobalAddress(),
 			"google_compute_instance_group":                   dataSourceGoogleComputeInstanceGroup(),
+			"google_compute_interconnect":                     dataSourceGoogleComputeInterconnect(),
 			"google_compute_lb_ip_ranges":                     dataSourceGoogleComputeLbIpRanges(),
 			"google_compute_network":                          dataSourceGoogleComputeNetwork(),
 			"google_compute_node_types":                       dataSourceGoogleComputeNodeTypes(),
@@ -224,166 +323,215 @@ func ResourceMapWithErrors() (map[string]*schema.Resource, error) {
 		// end beta-only products
 		GeneratedAccessContextManagerResourcesMap,
 		GeneratedAppEngineResourcesMap,
+		GeneratedBackupDRResourcesMap,
+		GeneratedBeyondcorpResourcesMap,
+		GeneratedBigqueryReservationResourcesMap,
 		GeneratedBinaryAuthorizationResourcesMap,
 		GeneratedComputeResourcesMap,
 		GeneratedCloudBuildResourcesMap,
+		GeneratedCloudbuildv2ResourcesMap,
+		GeneratedClouddeployResourcesMap,
 		GeneratedCloudSchedulerResourcesMap,
+		GeneratedContainerAttachedResourcesMap,
+		GeneratedDataFusionResourcesMap,
+		GeneratedDataplexResourcesMap,
 		GeneratedDnsResourcesMap,
+		GeneratedDataLossPreventionResourcesMap,
+		GeneratedDocumentAIResourcesMap,
 		GeneratedFilestoreResourcesMap,
+		GeneratedFirebaserulesResourcesMap,
 		GeneratedFirestoreResourcesMap,
+		GeneratedGKEBackupResourcesMap,
 		GeneratedKmsResourcesMap,
 		GeneratedPubsubResourcesMap,
+		GeneratedRecaptchaEnterpriseResourcesMap,
 		GeneratedRedisResourcesMap,
 		GeneratedResourceManagerResourcesMap,
+		GeneratedSecurityCenterResourcesMap,
+		GeneratedSecurityCenterV2ResourcesMap,
 		GeneratedSourceRepoResourcesMap,
 		GeneratedSpannerResourcesMap,
 		GeneratedSqlResourcesMap,
 		GeneratedStorageResourcesMap,
+		GeneratedTagsResourcesMap,
 		GeneratedTpuResourcesMap,
+		GeneratedVPCAccessResourcesMap,
 		GeneratedMonitoringResourcesMap,
+		GeneratedNetappResourcesMap,
+		GeneratedNetworkSecurityResourcesMap,
+		GeneratedNetworkServicesResourcesMap,
+		GeneratedPrivatecaResourcesMap,
+		GeneratedPublicCAResourcesMap,
 		map[string]*schema.Resource{
-			"google_app_engine_application":                resourceAppEngineApplication(),
-			"google_bigquery_dataset":                      resourceBigQueryDataset(),
-			"google_bigquery_table":                        resourceBigQueryTable(),
-			"google_bigtable_instance":                     resourceBigtableInstance(),
-			"google_bigtable_instance_iam_binding":         ResourceIamBindingWithImport(IamBigtableInstanceSchema, NewBigtableInstanceUpdater, BigtableInstanceIdParseFunc),
-			"google_bigtable_instance_iam_member":          ResourceIamMemberWithImport(IamBigtableInstanceSchema, NewBigtableInstanceUpdater, BigtableInstanceIdParseFunc),
-			"google_bigtable_instance_iam_policy":          ResourceIamPolicyWithImport(IamBigtableInstanceSchema, NewBigtableInstanceUpdater, BigtableInstanceIdParseFunc),
-			"google_bigtable_table":                        resourceBigtableTable(),
-			"google_billing_account_iam_binding":           ResourceIamBindingWithImport(IamBillingAccountSchema, NewBillingAccountIamUpdater, BillingAccountIdParseFunc),
-			"google_billing_account_iam_member":            ResourceIamMemberWithImport(IamBillingAccountSchema, NewBillingAccountIamUpdater, BillingAccountIdParseFunc),
-			"google_billing_account_iam_policy":            ResourceIamPolicyWithImport(IamBillingAccountSchema, NewBillingAccountIamUpdater, BillingAccountIdParseFunc),
-			"google_cloudfunctions_function":               resourceCloudFunctionsFunction(),
-			"google_cloudiot_registry":                     resourceCloudIoTRegistry(),
-			"google_composer_environment":                  resourceComposerEnvironment(),
-			"google_compute_attached_disk":                 resourceComputeAttachedDisk(),
-			"google_compute_instance":                      resourceComputeInstance(),
-			"google_compute_instance_from_template":        resourceComputeInstanceFromTemplate(),
-			"google_compute_instance_group":                resourceComputeInstanceGroup(),
-			"google_compute_instance_group_manager":        resourceComputeInstanceGroupManager(),
-			"google_compute_instance_iam_binding":          ResourceIamBindingWithImport(IamComputeInstanceSchema, NewComputeInstanceIamUpdater, ComputeInstanceIdParseFunc),
-			"google_compute_instance_iam_member":           ResourceIamMemberWithImport(IamComputeInstanceSchema, NewComputeInstanceIamUpdater, ComputeInstanceIdParseFunc),
-			"google_compute_instance_iam_policy":           ResourceIamPolicyWithImport(IamComputeInstanceSchema, NewComputeInstanceIamUpdater, ComputeInstanceIdParseFunc),
-			"google_compute_instance_template":             resourceComputeInstanceTemplate(),
-			"google_compute_network_peering":               resourceComputeNetworkPeering(),
-			"google_compute_project_default_network_tier":  resourceComputeProjectDefaultNetworkTier(),
-			"google_compute_project_metadata":              resourceComputeProjectMetadata(),
-			"google_compute_project_metadata_item":         resourceComputeProjectMetadataItem(),
-			"google_compute_region_instance_group_manager": resourceComputeRegionInstanceGroupManager(),
-			"google_compute_router_interface":              resourceComputeRouterInterface(),
-			"google_compute_router_nat":                    resourceComputeRouterNat(),
-			"google_compute_router_peer":                   resourceComputeRouterPeer(),
-			"google_compute_security_policy":               resourceComputeSecurityPolicy(),
-			"google_compute_shared_vpc_host_project":       resourceComputeSharedVpcHostProject(),
-			"google_compute_shared_vpc_service_project":    resourceComputeSharedVpcServiceProject(),
-			"google_compute_subnetwork_iam_binding":        ResourceIamBindingWithImport(IamComputeSubnetworkSchema, NewComputeSubnetworkIamUpdater, ComputeSubnetworkIdParseFunc),
-			"google_compute_subnetwork_iam_member":         ResourceIamMemberWithImport(IamComputeSubnetworkSchema, NewComputeSubnetworkIamUpdater, ComputeSubnetworkIdParseFunc),
-			"google_compute_subnetwork_iam_policy":         ResourceIamPolicyWithImport(IamComputeSubnetworkSchema, NewComputeSubnetworkIamUpdater, ComputeSubnetworkIdParseFunc),
-			"google_compute_target_pool":                   resourceComputeTargetPool(),
-			"google_container_cluster":                     resourceContainerCluster(),
-			"google_container_node_pool":                   resourceContainerNodePool(),
-			"google_dataflow_job":                          resourceDataflowJob(),
-			"google_dataproc_cluster":                      resourceDataprocCluster(),
-			"google_dataproc_cluster_iam_binding":          ResourceIamBindingWithImport(IamDataprocClusterSchema, NewDataprocClusterUpdater, DataprocClusterIdParseFunc),
-			"google_dataproc_cluster_iam_member":           ResourceIamMemberWithImport(IamDataprocClusterSchema, NewDataprocClusterUpdater, DataprocClusterIdParseFunc),
-			"google_dataproc_cluster_iam_policy":           ResourceIamPolicyWithImport(IamDataprocClusterSchema, NewDataprocClusterUpdater, DataprocClusterIdParseFunc),
-			"google_dataproc_job":                          resourceDataprocJob(),
-			"google_dataproc_job_iam_binding":              ResourceIamBindingWithImport(IamDataprocJobSchema, NewDataprocJobUpdater, DataprocJobIdParseFunc),
-			"google_dataproc_job_iam_member":               ResourceIamMemberWithImport(IamDataprocJobSchema, NewDataprocJobUpdater, DataprocJobIdParseFunc),
-			"google_dataproc_job_iam_policy":               ResourceIamPolicyWithImport(IamDataprocJobSchema, NewDataprocJobUpdater, DataprocJobIdParseFunc),
-			"google_dns_record_set":                        resourceDnsRecordSet(),
-			"google_endpoints_service":                     resourceEndpointsService(),
-			"google_folder":                                resourceGoogleFolder(),
-			"google_folder_iam_binding":                    ResourceIamBindingWithImport(IamFolderSchema, NewFolderIamUpdater, FolderIdParseFunc),
-			"google_folder_iam_member":                     ResourceIamMemberWithImport(IamFolderSchema, NewFolderIamUpdater, FolderIdParseFunc),
-			"google_folder_iam_policy":                     ResourceIamPolicyWithImport(IamFolderSchema, NewFolderIamUpdater, FolderIdParseFunc),
-			"google_folder_organization_policy":            resourceGoogleFolderOrganizationPolicy(),
-			"google_healthcare_dataset_iam_binding":        ResourceIamBindingWithImport(IamHealthcareDatasetSchema, NewHealthcareDatasetIamUpdater, DatasetIdParseFunc),
-			"google_healthcare_dataset_iam_member":         ResourceIamMemberWithImport(IamHealthcareDatasetSchema, NewHealthcareDatasetIamUpdater, DatasetIdParseFunc),
-			"google_healthcare_dataset_iam_policy":         ResourceIamPolicyWithImport(IamHealthcareDatasetSchema, NewHealthcareDatasetIamUpdater, DatasetIdParseFunc),
-			"google_healthcare_dicom_store_iam_binding":    ResourceIamBindingWithImport(IamHealthcareDicomStoreSchema, NewHealthcareDicomStoreIamUpdater, DicomStoreIdParseFunc),
-			"google_healthcare_dicom_store_iam_member":     ResourceIamMemberWithImport(IamHealthcareDicomStoreSchema, NewHealthcareDicomStoreIamUpdater, DicomStoreIdParseFunc),
-			"google_healthcare_dicom_store_iam_policy":     ResourceIamPolicyWithImport(IamHealthcareDicomStoreSchema, NewHealthcareDicomStoreIamUpdater, DicomStoreIdParseFunc),
-			"google_healthcare_fhir_store_iam_binding":     ResourceIamBindingWithImport(IamHealthcareFhirStoreSchema, NewHealthcareFhirStoreIamUpdater, FhirStoreIdParseFunc),
-			"google_healthcare_fhir_store_iam_member":      ResourceIamMemberWithImport(IamHealthcareFhirStoreSchema, NewHealthcareFhirStoreIamUpdater, FhirStoreIdParseFunc),
-			"google_healthcare_fhir_store_iam_policy":      ResourceIamPolicyWithImport(IamHealthcareFhirStoreSchema, NewHealthcareFhirStoreIamUpdater, FhirStoreIdParseFunc),
-			"google_healthcare_hl7_v2_store_iam_binding":   ResourceIamBindingWithImport(IamHealthcareHl7V2StoreSchema, NewHealthcareHl7V2StoreIamUpdater, Hl7V2StoreIdParseFunc),
-			"google_healthcare_hl7_v2_store_iam_member":    ResourceIamMemberWithImport(IamHealthcareHl7V2StoreSchema, NewHealthcareHl7V2StoreIamUpdater, Hl7V2StoreIdParseFunc),
-			"google_healthcare_hl7_v2_store_iam_policy":    ResourceIamPolicyWithImport(IamHealthcareHl7V2StoreSchema, NewHealthcareHl7V2StoreIamUpdater, Hl7V2StoreIdParseFunc),
-			"google_iap_tunnel_instance_iam_binding":       ResourceIamBindingWithImport(IamIapTunnelInstanceSchema, NewIapTunnelInstanceIamUpdater, IapTunnelInstanceIdParseFunc),
-			"google_iap_tunnel_instance_iam_member":        ResourceIamMemberWithImport(IamIapTunnelInstanceSchema, NewIapTunnelInstanceIamUpdater, IapTunnelInstanceIdParseFunc),
-			"google_iap_tunnel_instance_iam_policy":        ResourceIamPolicyWithImport(IamIapTunnelInstanceSchema, NewIapTunnelInstanceIamUpdater, IapTunnelInstanceIdParseFunc),
-			"google_logging_billing_account_sink":          resourceLoggingBillingAccountSink(),
-			"google_logging_billing_account_exclusion":     ResourceLoggingExclusion(BillingAccountLoggingExclusionSchema, NewBillingAccountLoggingExclusionUpdater, billingAccountLoggingExclusionIdParseFunc),
-			"google_logging_metric":                        resourceLoggingMetric(),
-			"google_logging_organization_sink":             resourceLoggingOrganizationSink(),
-			"google_logging_organization_exclusion":        ResourceLoggingExclusion(OrganizationLoggingExclusionSchema, NewOrganizationLoggingExclusionUpdater, organizationLoggingExclusionIdParseFunc),
-			"google_logging_folder_sink":                   resourceLoggingFolderSink(),
-			"google_logging_folder_exclusion":              ResourceLoggingExclusion(FolderLoggingExclusionSchema, NewFolderLoggingExclusionUpdater, folderLoggingExclusionIdParseFunc),
-			"google_logging_project_sink":                  resourceLoggingProjectSink(),
-			"google_logging_project_exclusion":             ResourceLoggingExclusion(ProjectLoggingExclusionSchema, NewProjectLoggingExclusionUpdater, projectLoggingExclusionIdParseFunc),
-			"google_kms_key_ring_iam_binding":              ResourceIamBindingWithImport(IamKmsKeyRingSchema, NewKmsKeyRingIamUpdater, KeyRingIdParseFunc),
-			"google_kms_key_ring_iam_member":               ResourceIamMemberWithImport(IamKmsKeyRingSchema, NewKmsKeyRingIamUpdater, KeyRingIdParseFunc),
-			"google_kms_key_ring_iam_policy":               ResourceIamPolicyWithImport(IamKmsKeyRingSchema, NewKmsKeyRingIamUpdater, KeyRingIdParseFunc),
-			"google_kms_crypto_key_iam_binding":            ResourceIamBindingWithImport(IamKmsCryptoKeySchema, NewKmsCryptoKeyIamUpdater, CryptoIdParseFunc),
-			"google_kms_crypto_key_iam_member":             ResourceIamMemberWithImport(IamKmsCryptoKeySchema, NewKmsCryptoKeyIamUpdater, CryptoIdParseFunc),
-			"google_service_networking_connection":         resourceServiceNetworkingConnection(),
-			"google_spanner_instance_iam_binding":          ResourceIamBindingWithImport(IamSpannerInstanceSchema, NewSpannerInstanceIamUpdater, SpannerInstanceIdParseFunc),
-			"google_spanner_instance_iam_member":           ResourceIamMemberWithImport(IamSpannerInstanceSchema, NewSpannerInstanceIamUpdater, SpannerInstanceIdParseFunc),
-			"google_spanner_instance_iam_policy":           ResourceIamPolicyWithImport(IamSpannerInstanceSchema, NewSpannerInstanceIamUpdater, SpannerInstanceIdParseFunc),
-			"google_spanner_database_iam_binding":          ResourceIamBindingWithImport(IamSpannerDatabaseSchema, NewSpannerDatabaseIamUpdater, SpannerDatabaseIdParseFunc),
-			"google_spanner_database_iam_member":           ResourceIamMemberWithImport(IamSpannerDatabaseSchema, NewSpannerDatabaseIamUpdater, SpannerDatabaseIdParseFunc),
-			"google_spanner_database_iam_policy":           ResourceIamPolicyWithImport(IamSpannerDatabaseSchema, NewSpannerDatabaseIamUpdater, SpannerDatabaseIdParseFunc),
-			"google_sql_database_instance":                 resourceSqlDatabaseInstance(),
-			"google_sql_ssl_cert":                          resourceSqlSslCert(),
-			"google_sql_user":                              resourceSqlUser(),
-			"google_organization_iam_binding":              ResourceIamBindingWithImport(IamOrganizationSchema, NewOrganizationIamUpdater, OrgIdParseFunc),
-			"google_organization_iam_custom_role":          resourceGoogleOrganizationIamCustomRole(),
-			"google_organization_iam_member":               ResourceIamMemberWithImport(IamOrganizationSchema, NewOrganizationIamUpdater, OrgIdParseFunc),
-			"google_organization_iam_policy":               ResourceIamPolicyWithImport(IamOrganizationSchema, NewOrganizationIamUpdater, OrgIdParseFunc),
-			"google_organization_policy":                   resourceGoogleOrganizationPolicy(),
-			"google_project":                               resourceGoogleProject(),
-			"google_project_iam_policy":                    resourceGoogleProjectIamPolicy(),
-			"google_project_iam_binding":                   ResourceIamBindingWithImport(IamProjectSchema, NewProjectIamUpdater, ProjectIdParseFunc),
-			"google_project_iam_member":                    ResourceIamMemberWithImport(IamProjectSchema, NewProjectIamUpdater, ProjectIdParseFunc),
-			"google_project_iam_audit_config":              ResourceIamAuditConfigWithImport(IamProjectSchema, NewProjectIamUpdater, ProjectIdParseFunc),
-			"google_project_service":                       resourceGoogleProjectService(),
-			"google_project_iam_custom_role":               resourceGoogleProjectIamCustomRole(),
-			"google_project_organization_policy":           resourceGoogleProjectOrganizationPolicy(),
-			"google_project_usage_export_bucket":           resourceProjectUsageBucket(),
-			"google_project_services":                      resourceGoogleProjectServices(),
-			"google_pubsub_subscription_iam_binding":       ResourceIamBindingWithImport(IamPubsubSubscriptionSchema, NewPubsubSubscriptionIamUpdater, PubsubSubscriptionIdParseFunc),
-			"google_pubsub_subscription_iam_member":        ResourceIamMemberWithImport(IamPubsubSubscriptionSchema, NewPubsubSubscriptionIamUpdater, PubsubSubscriptionIdParseFunc),
-			"google_pubsub_subscription_iam_policy":        ResourceIamPolicyWithImport(IamPubsubSubscriptionSchema, NewPubsubSubscriptionIamUpdater, PubsubSubscriptionIdParseFunc),
-			"google_runtimeconfig_config":                  resourceRuntimeconfigConfig(),
-			"google_runtimeconfig_variable":                resourceRuntimeconfigVariable(),
-			"google_service_account":                       resourceGoogleServiceAccount(),
-			"google_service_account_iam_binding":           ResourceIamBindingWithImport(IamServiceAccountSchema, NewServiceAccountIamUpdater, ServiceAccountIdParseFunc),
-			"google_service_account_iam_member":            ResourceIamMemberWithImport(IamServiceAccountSchema, NewServiceAccountIamUpdater, ServiceAccountIdParseFunc),
-			"google_service_account_iam_policy":            ResourceIamPolicyWithImport(IamServiceAccountSchema, NewServiceAccountIamUpdater, ServiceAccountIdParseFunc),
-			"google_service_account_key":                   resourceGoogleServiceAccountKey(),
-			"google_storage_bucket":                        resourceStorageBucket(),
-			"google_storage_bucket_acl":                    resourceStorageBucketAcl(),
+			"google_app_engine_application":                             resourceAppEngineApplication(),
+			"google_app_engine_flexible_app_version":                    resourceAppEngineFlexibleAppVersion(),
+			"google_app_engine_service_split":                           resourceAppEngineServiceSplit(),
+			"google_bigquery_dataset":                                   resourceBigQueryDataset(),
+			"google_bigquery_table":                                     resourceBigQueryTable(),
+			"google_bigtable_instance":                                  resourceBigtableInstance(),
+			"google_bigtable_instance_iam_binding":                      ResourceIamBindingWithImport(IamBigtableInstanceSchema, NewBigtableInstanceUpdater, BigtableInstanceIdParseFunc, IamBatchingDisabled),
+			"google_bigtable_instance_iam_member":                       ResourceIamMemberWithImport(IamBigtableInstanceSchema, NewBigtableInstanceUpdater, BigtableInstanceIdParseFunc, IamBatchingDisabled),
+			"google_bigtable_instance_iam_policy":                       ResourceIamPolicyWithImport(IamBigtableInstanceSchema, NewBigtableInstanceUpdater, BigtableInstanceIdParseFunc),
+			"google_bigtable_table":                                     resourceBigtableTable(),
+			"google_billing_account_iam_binding":                        ResourceIamBindingWithImport(IamBillingAccountSchema, NewBillingAccountIamUpdater, BillingAccountIdParseFunc, IamBatchingDisabled),
+			"google_billing_account_iam_member":                         ResourceIamMemberWithImport(IamBillingAccountSchema, NewBillingAccountIamUpdater, BillingAccountIdParseFunc, IamBatchingDisabled),
+			"google_billing_account_iam_policy":                         ResourceIamPolicyWithImport(IamBillingAccountSchema, NewBillingAccountIamUpdater, BillingAccountIdParseFunc),
+			"google_cloudfunctions_function":                            resourceCloudFunctionsFunction(),
+			"google_cloudiot_registry":                                  resourceCloudIoTRegistry(),
+			"google_cloud_run_domain_mapping":                           resourceCloudRunDomainMapping(),
+			"google_cloud_run_v2_job":                                   resourceCloudRunV2Job(),
+			"google_cloud_run_v2_service":                               resourceCloudRunV2Service(),
+			"google_composer_environment":                               resourceComposerEnvironment(),
+			"google_compute_attached_disk":                              resourceComputeAttachedDisk(),
+			"google_compute_instance":                                   resourceComputeInstance(),
+			"google_compute_instance_bulk":                              resourceComputeInstanceBulk(),
+			"google_compute_instance_from_template":                     resourceComputeInstanceFromTemplate(),
+			"google_compute_instance_group":                             resourceComputeInstanceGroup(),
+			"google_compute_instance_group_manager":                     resourceComputeInstanceGroupManager(),
+			"google_compute_instance_iam_binding":                       ResourceIamBindingWithImport(IamComputeInstanceSchema, NewComputeInstanceIamUpdater, ComputeInstanceIdParseFunc, IamBatchingDisabled),
+			"google_compute_instance_iam_member":                        ResourceIamMemberWithImport(IamComputeInstanceSchema, NewComputeInstanceIamUpdater, ComputeInstanceIdParseFunc, IamBatchingDisabled),
+			"google_compute_instance_iam_policy":                        ResourceIamPolicyWithImport(IamComputeInstanceSchema, NewComputeInstanceIamUpdater, ComputeInstanceIdParseFunc),
+			"google_compute_instance_template":                          resourceComputeInstanceTemplate(),
+			"google_compute_network_peering":                            resourceComputeNetworkPeering(),
+			"google_compute_project_default_network_tier":               resourceComputeProjectDefaultNetworkTier(),
+			"google_compute_project_metadata":                           resourceComputeProjectMetadata(),
+			"google_compute_project_metadata_item":                      resourceComputeProjectMetadataItem(),
+			"google_compute_region_instance_group_manager":              resourceComputeRegionInstanceGroupManager(),
+			"google_compute_region_network_firewall_policy_association": resourceComputeRegionNetworkFirewallPolicyAssociation(),
+			"google_compute_region_network_firewall_policy_rule":        resourceComputeRegionNetworkFirewallPolicyRule(),
+			"google_compute_router_interface":                           resourceComputeRouterInterface(),
+			"google_compute_router_nat":                                 resourceComputeRouterNat(),
+			"google_compute_router_peer":                                resourceComputeRouterPeer(),
+			"google_compute_security_policy":                            resourceComputeSecurityPolicy(),
+			"google_compute_shared_vpc_host_project":                    resourceComputeSharedVpcHostProject(),
+			"google_compute_shared_vpc_service_project":                 resourceComputeSharedVpcServiceProject(),
+			"google_compute_subnetwork_iam_binding":                     ResourceIamBindingWithImport(IamComputeSubnetworkSchema, NewComputeSubnetworkIamUpdater, ComputeSubnetworkIdParseFunc, IamBatchingDisabled),
+			"google_compute_subnetwork_iam_member":                      ResourceIamMemberWithImport(IamComputeSubnetworkSchema, NewComputeSubnetworkIamUpdater, ComputeSubnetworkIdParseFunc, IamBatchingDisabled),
+			"google_compute_subnetwork_iam_policy":                      ResourceIamPolicyWithImport(IamComputeSubnetworkSchema, NewComputeSubnetworkIamUpdater, ComputeSubnetworkIdParseFunc),
+			"google_compute_target_pool":                                resourceComputeTargetPool(),
+			"google_container_cluster":                                  resourceContainerCluster(),
+			"google_container_node_pool":                                resourceContainerNodePool(),
+			"google_dataflow_job":                                       resourceDataflowJob(),
+			"google_dataproc_cluster":                                   resourceDataprocCluster(),
+			"google_dataproc_cluster_iam_binding":                       ResourceIamBindingWithImport(IamDataprocClusterSchema, NewDataprocClusterUpdater, DataprocClusterIdParseFunc, IamBatchingDisabled),
+			"google_dataproc_cluster_iam_member":                        ResourceIamMemberWithImport(IamDataprocClusterSchema, NewDataprocClusterUpdater, DataprocClusterIdParseFunc, IamBatchingDisabled),
+			"google_dataproc_cluster_iam_policy":                        ResourceIamPolicyWithImport(IamDataprocClusterSchema, NewDataprocClusterUpdater, DataprocClusterIdParseFunc),
+			"google_dataproc_job":                                       resourceDataprocJob(),
+			"google_dataproc_job_iam_binding":                           ResourceIamBindingWithImport(IamDataprocJobSchema, NewDataprocJobUpdater, DataprocJobIdParseFunc, IamBatchingDisabled),
+			"google_dataproc_job_iam_member":                            ResourceIamMemberWithImport(IamDataprocJobSchema, NewDataprocJobUpdater, DataprocJobIdParseFunc, IamBatchingDisabled),
+			"google_dataproc_job_iam_policy":                            ResourceIamPolicyWithImport(IamDataprocJobSchema, NewDataprocJobUpdater, DataprocJobIdParseFunc),
+			"google_dns_record_set":                                     resourceDnsRecordSet(),
+			"google_endpoints_service":                                  resourceEndpointsService(),
+			"google_folder":                                             resourceGoogleFolder(),
+			"google_folder_iam_binding":                                 ResourceIamBindingWithImport(IamFolderSchema, NewFolderIamUpdater, FolderIdParseFunc, IamBatchingDisabled),
+			"google_folder_iam_member":                                  ResourceIamMemberWithImport(IamFolderSchema, NewFolderIamUpdater, FolderIdParseFunc, IamBatchingDisabled),
+			"google_folder_iam_policy":                                  ResourceIamPolicyWithImport(IamFolderSchema, NewFolderIamUpdater, FolderIdParseFunc),
+			"google_folder_organization_policy":                         resourceGoogleFolderOrganizationPolicy(),
+			"google_gke_hub_feature_membership":                         resourceGKEHubFeatureMembership(),
+			"google_healthcare_dataset_iam_binding":                     ResourceIamBindingWithImport(IamHealthcareDatasetSchema, NewHealthcareDatasetIamUpdater, DatasetIdParseFunc, IamBatchingDisabled),
+			"google_healthcare_dataset_iam_member":                      ResourceIamMemberWithImport(IamHealthcareDatasetSchema, NewHealthcareDatasetIamUpdater, DatasetIdParseFunc, IamBatchingDisabled),
+			"google_healthcare_dataset_iam_policy":                      ResourceIamPolicyWithImport(IamHealthcareDatasetSchema, NewHealthcareDatasetIamUpdater, DatasetIdParseFunc),
+			"google_healthcare_dicom_store_iam_binding":                 ResourceIamBindingWithImport(IamHealthcareDicomStoreSchema, NewHealthcareDicomStoreIamUpdater, DicomStoreIdParseFunc, IamBatchingDisabled),
+			"google_healthcare_dicom_store_iam_member":                  ResourceIamMemberWithImport(IamHealthcareDicomStoreSchema, NewHealthcareDicomStoreIamUpdater, DicomStoreIdParseFunc, IamBatchingDisabled),
+			"google_healthcare_dicom_store_iam_policy":                  ResourceIamPolicyWithImport(IamHealthcareDicomStoreSchema, NewHealthcareDicomStoreIamUpdater, DicomStoreIdParseFunc),
+			"google_healthcare_fhir_store_iam_binding":                  ResourceIamBindingWithImport(IamHealthcareFhirStoreSchema, NewHealthcareFhirStoreIamUpdater, FhirStoreIdParseFunc, IamBatchingDisabled),
+			"google_healthcare_fhir_store_iam_member":                   ResourceIamMemberWithImport(IamHealthcareFhirStoreSchema, NewHealthcareFhirStoreIamUpdater, FhirStoreIdParseFunc, IamBatchingDisabled),
+			"google_healthcare_fhir_store_iam_policy":                   ResourceIamPolicyWithImport(IamHealthcareFhirStoreSchema, NewHealthcareFhirStoreIamUpdater, FhirStoreIdParseFunc),
+			"google_healthcare_hl7_v2_store_iam_binding":                ResourceIamBindingWithImport(IamHealthcareHl7V2StoreSchema, NewHealthcareHl7V2StoreIamUpdater, Hl7V2StoreIdParseFunc, IamBatchingDisabled),
+			"google_healthcare_hl7_v2_store_iam_member":                 ResourceIamMemberWithImport(IamHealthcareHl7V2StoreSchema, NewHealthcareHl7V2StoreIamUpdater, Hl7V2StoreIdParseFunc, IamBatchingDisabled),
+			"google_healthcare_hl7_v2_store_iam_policy":                 ResourceIamPolicyWithImport(IamHealthcareHl7V2StoreSchema, NewHealthcareHl7V2StoreIamUpdater, Hl7V2StoreIdParseFunc),
+			"google_iap_tunnel_instance_iam_binding":                    ResourceIamBindingWithImport(IamIapTunnelInstanceSchema, NewIapTunnelInstanceIamUpdater, IapTunnelInstanceIdParseFunc, IamBatchingDisabled),
+			"google_iap_tunnel_instance_iam_member":                     ResourceIamMemberWithImport(IamIapTunnelInstanceSchema, NewIapTunnelInstanceIamUpdater, IapTunnelInstanceIdParseFunc, IamBatchingDisabled),
+			"google_iap_tunnel_instance_iam_policy":                     ResourceIamPolicyWithImport(IamIapTunnelInstanceSchema, NewIapTunnelInstanceIamUpdater, IapTunnelInstanceIdParseFunc),
+			"google_logging_billing_account_sink":                       resourceLoggingBillingAccountSink(),
+			"google_logging_billing_account_exclusion":                  ResourceLoggingExclusion(BillingAccountLoggingExclusionSchema, NewBillingAccountLoggingExclusionUpdater, billingAccountLoggingExclusionIdParseFunc),
+			"google_logging_metric":                                     resourceLoggingMetric(),
+			"google_logging_organization_sink":                          resourceLoggingOrganizationSink(),
+			"google_logging_organization_exclusion":                     ResourceLoggingExclusion(OrganizationLoggingExclusionSchema, NewOrganizationLoggingExclusionUpdater, organizationLoggingExclusionIdParseFunc),
+			"google_logging_folder_sink":                                resourceLoggingFolderSink(),
+			"google_logging_folder_exclusion":                           ResourceLoggingExclusion(FolderLoggingExclusionSchema, NewFolderLoggingExclusionUpdater, folderLoggingExclusionIdParseFunc),
+			"google_logging_project_sink":                               resourceLoggingProjectSink(),
+			"google_logging_project_exclusion":                          ResourceLoggingExclusion(ProjectLoggingExclusionSchema, NewProjectLoggingExclusionUpdater, projectLoggingExclusionIdParseFunc),
+			"google_kms_key_ring_iam_binding":                           ResourceIamBindingWithImport(IamKmsKeyRingSchema, NewKmsKeyRingIamUpdater, KeyRingIdParseFunc, IamBatchingDisabled),
+			"google_kms_key_ring_iam_member":                            ResourceIamMemberWithImport(IamKmsKeyRingSchema, NewKmsKeyRingIamUpdater, KeyRingIdParseFunc, IamBatchingDisabled),
+			"google_kms_key_ring_iam_policy":                            ResourceIamPolicyWithImport(IamKmsKeyRingSchema, NewKmsKeyRingIamUpdater, KeyRingIdParseFunc),
+			"google_kms_crypto_key_iam_binding":                         ResourceIamBindingWithImport(IamKmsCryptoKeySchema, NewKmsCryptoKeyIamUpdater, CryptoIdParseFunc, IamBatchingDisabled),
+			"google_kms_crypto_key_iam_member":                          ResourceIamMemberWithImport(IamKmsCryptoKeySchema, NewKmsCryptoKeyIamUpdater, CryptoIdParseFunc, IamBatchingDisabled),
+			"google_service_networking_connection":                      resourceServiceNetworkingConnection(),
+			"google_spanner_instance_iam_binding":                       ResourceIamBindingWithImport(IamSpannerInstanceSchema, NewSpannerInstanceIamUpdater, SpannerInstanceIdParseFunc, IamBatchingDisabled),
+			"google_spanner_instance_iam_member":                        ResourceIamMemberWithImport(IamSpannerInstanceSchema, NewSpannerInstanceIamUpdater, SpannerInstanceIdParseFunc, IamBatchingDisabled),
+			"google_spanner_instance_iam_policy":                        ResourceIamPolicyWithImport(IamSpannerInstanceSchema, NewSpannerInstanceIamUpdater, SpannerInstanceIdParseFunc),
+			"google_spanner_database_iam_binding":                       ResourceIamBindingWithImport(IamSpannerDatabaseSchema, NewSpannerDatabaseIamUpdater, SpannerDatabaseIdParseFunc, IamBatchingDisabled),
+			"google_spanner_database_iam_member":                        ResourceIamMemberWithImport(IamSpannerDatabaseSchema, NewSpannerDatabaseIamUpdater, SpannerDatabaseIdParseFunc, IamBatchingDisabled),
+			"google_spanner_database_iam_policy":                        ResourceIamPolicyWithImport(IamSpannerDatabaseSchema, NewSpannerDatabaseIamUpdater, SpannerDatabaseIdParseFunc),
+			"google_sql_database_instance":                              resourceSqlDatabaseInstance(),
+			"google_sql_ssl_cert":                                       resourceSqlSslCert(),
+			"google_sql_user":                                           resourceSqlUser(),
+			"google_organization_iam_binding":                           ResourceIamBindingWithImport(IamOrganizationSchema, NewOrganizationIamUpdater, OrgIdParseFunc, IamBatchingDisabled),
+			"google_organization_iam_custom_role":                       resourceGoogleOrganizationIamCustomRole(),
+			"google_organization_iam_member":                            ResourceIamMemberWithImport(IamOrganizationSchema, NewOrganizationIamUpdater, OrgIdParseFunc, IamBatchingDisabled),
+			"google_organization_iam_policy":                            ResourceIamPolicyWithImport(IamOrganizationSchema, NewOrganizationIamUpdater, OrgIdParseFunc),
+			"google_organization_policy":                                resourceGoogleOrganizationPolicy(),
+			"google_project":                                            resourceGoogleProject(),
+			"google_project_iam_policy":                                 resourceGoogleProjectIamPolicy(),
+			"google_project_iam_binding":                                ResourceIamBindingWithImport(IamProjectSchema, NewProjectIamUpdater, ProjectIdParseFunc, IamBatchingEnabled),
+			"google_project_iam_member":                                 ResourceIamMemberWithImport(IamProjectSchema, NewProjectIamUpdater, ProjectIdParseFunc, IamBatchingEnabled),
+			"google_project_iam_audit_config":                           ResourceIamAuditConfigWithImport(IamProjectSchema, NewProjectIamUpdater, ProjectIdParseFunc),
+			"google_project_service":                                    resourceGoogleProjectService(),
+			"google_project_iam_custom_role":                            resourceGoogleProjectIamCustomRole(),
+			"google_project_organization_policy":                        resourceGoogleProjectOrganizationPolicy(),
+			"google_project_usage_export_bucket":                        resourceProjectUsageBucket(),
+			"google_project_services":                                   resourceGoogleProjectServices(),
+			"google_pubsub_subscription_iam_binding":                    ResourceIamBindingWithImport(IamPubsubSubscriptionSchema, NewPubsubSubscriptionIamUpdater, PubsubSubscriptionIdParseFunc, IamBatchingDisabled),
+			"google_pubsub_subscription_iam_member":                     ResourceIamMemberWithImport(IamPubsubSubscriptionSchema, NewPubsubSubscriptionIamUpdater, PubsubSubscriptionIdParseFunc, IamBatchingDisabled),
+			"google_pubsub_subscription_iam_policy":                     ResourceIamPolicyWithImport(IamPubsubSubscriptionSchema, NewPubsubSubscriptionIamUpdater, PubsubSubscriptionIdParseFunc),
+			"google_pubsub_lite_reservation":                            resourcePubsubLiteReservation(),
+			"google_pubsub_lite_subscription":                           resourcePubsubLiteSubscription(),
+			"google_pubsub_lite_topic":                                  resourcePubsubLiteTopic(),
+			"google_runtimeconfig_config":                               resourceRuntimeconfigConfig(),
+			"google_runtimeconfig_variable":                             resourceRuntimeconfigVariable(),
+			"google_service_account":                                    resourceGoogleServiceAccount(),
+			"google_service_account_iam_binding":                        ResourceIamBindingWithImport(IamServiceAccountSchema, NewServiceAccountIamUpdater, ServiceAccountIdParseFunc, IamBatchingDisabled),
+			"google_service_account_iam_member":                         ResourceIamMemberWithImport(IamServiceAccountSchema, NewServiceAccountIamUpdater, ServiceAccountIdParseFunc, IamBatchingDisabled),
+			"google_service_account_iam_policy":                         ResourceIamPolicyWithImport(IamServiceAccountSchema, NewServiceAccountIamUpdater, ServiceAccountIdParseFunc),
+			"google_service_account_key":                                resourceGoogleServiceAccountKey(),
+			"google_storage_bucket":                                     resourceStorageBucket(),
+			"google_storage_bucket_acl":                                 resourceStorageBucketAcl(),
 			// Legacy roles such as roles/storage.legacyBucketReader are automatically added
 			// when creating a bucket. For this reason, it is better not to add the authoritative
 			// google_storage_bucket_iam_policy resource.
-			"google_storage_bucket_iam_binding": ResourceIamBindingWithImport(IamStorageBucketSchema, NewStorageBucketIamUpdater, StorageBucketIdParseFunc),
-			"google_storage_bucket_iam_member":  ResourceIamMemberWithImport(IamStorageBucketSchema, NewStorageBucketIamUpdater, StorageBucketIdParseFunc),
-			"google_storage_bucket_iam_policy":  ResourceIamPolicyWithImport(IamStorageBucketSchema, NewStorageBucketIamUpdater, StorageBucketIdParseFunc),
-			"google_storage_bucket_object":      resourceStorageBucketObject(),
-			"google_storage_object_acl":         resourceStorageObjectAcl(),
-			"google_storage_default_object_acl": resourceStorageDefaultObjectAcl(),
-			"google_storage_notification":       resourceStorageNotification(),
-			"google_storage_transfer_job":       resourceStorageTransferJob(),
+			"google_storage_bucket_iam_binding":         ResourceIamBindingWithImport(IamStorageBucketSchema, NewStorageBucketIamUpdater, StorageBucketIdParseFunc, IamBatchingDisabled),
+			"google_storage_bucket_iam_member":          ResourceIamMemberWithImport(IamStorageBucketSchema, NewStorageBucketIamUpdater, StorageBucketIdParseFunc, IamBatchingDisabled),
+			"google_storage_bucket_iam_policy":          ResourceIamPolicyWithImport(IamStorageBucketSchema, NewStorageBucketIamUpdater, StorageBucketIdParseFunc),
+			"google_storage_bucket_object":              resourceStorageBucketObject(),
+			"google_storage_managed_folder":             resourceStorageManagedFolder(),
+			"google_storage_managed_folder_iam_binding": ResourceIamBindingWithImport(IamStorageManagedFolderSchema, NewStorageManagedFolderIamUpdater, StorageManagedFolderIdParseFunc, IamBatchingDisabled),
+			"google_storage_managed_folder_iam_member":  ResourceIamMemberWithImport(IamStorageManagedFolderSchema, NewStorageManagedFolderIamUpdater, StorageManagedFolderIdParseFunc, IamBatchingDisabled),
+			"google_storage_managed_folder_iam_policy":  ResourceIamPolicyWithImport(IamStorageManagedFolderSchema, NewStorageManagedFolderIamUpdater, StorageManagedFolderIdParseFunc),
+			"google_storage_object_acl":                 resourceStorageObjectAcl(),
+			"google_storage_default_object_acl":         resourceStorageDefaultObjectAcl(),
+			"google_storage_notification":               resourceStorageNotification(),
+			"google_storage_transfer_job":               resourceStorageTransferJob(),
+			"google_tags_location_tag_binding":          resourceTagsLocationTagBinding(),
 		},
 	)
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	config := Config{
-		Project: d.Get("project").(string),
-		Region:  d.Get("region").(string),
-		Zone:    d.Get("zone").(string),
+		Project:             d.Get("project").(string),
+		Region:              d.Get("region").(string),
+		Zone:                d.Get("zone").(string),
+		BillingProject:      d.Get("billing_project").(string),
+		UserProjectOverride: d.Get("user_project_override").(bool),
+		RequestReason:       d.Get("request_reason").(string),
+		UserAgentExtension:  d.Get("user_agent_extension").(string),
+
+		ImpersonateServiceAccount: d.Get("impersonate_service_account").(string),
+	}
+
+	if v, ok := d.GetOk("impersonate_service_account_delegates"); ok {
+		config.ImpersonateServiceAccountDelegates = convertStringArr(v.([]interface{}))
 	}
 
 	// Add credential source
@@ -401,6 +549,14 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		config.Scopes[i] = scope.(string)
 	}
 
+	config.DefaultLabels = make(map[string]string)
+	for k, v := range d.Get("default_labels").(map[string]interface{}) {
+		config.DefaultLabels[k] = v.(string)
+	}
+
+	config.AddTerraformAttributionLabel = d.Get("add_terraform_attribution_label").(bool)
+	config.TerraformAttributionLabelAdditionStrategy = d.Get("terraform_attribution_label_addition_strategy").(string)
+
 	batchCfg, err := expandProviderBatchingConfig(d.Get("batching"))
 	if err != nil {
 		return nil, err
@@ -413,23 +569,45 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	config.AccessContextManagerBasePath = d.Get(AccessContextManagerCustomEndpointEntryKey).(string)
 	config.CloudSchedulerBasePath = d.Get(CloudSchedulerCustomEndpointEntryKey).(string)
 	config.FirestoreBasePath = d.Get(FirestoreCustomEndpointEntryKey).(string)
+	config.GKEBackupBasePath = d.Get(GKEBackupCustomEndpointEntryKey).(string)
 
 	config.AppEngineBasePath = d.Get(AppEngineCustomEndpointEntryKey).(string)
+	config.BackupDRBasePath = d.Get(BackupDRCustomEndpointEntryKey).(string)
+	config.BeyondcorpBasePath = d.Get(BeyondcorpCustomEndpointEntryKey).(string)
+	config.BigqueryReservationBasePath = d.Get(BigqueryReservationCustomEndpointEntryKey).(string)
 	config.BinaryAuthorizationBasePath = d.Get(BinaryAuthorizationCustomEndpointEntryKey).(string)
 	config.ComputeBasePath = d.Get(ComputeCustomEndpointEntryKey).(string)
 	config.CloudBuildBasePath = d.Get(CloudBuildCustomEndpointEntryKey).(string)
+	config.Cloudbuildv2BasePath = d.Get(Cloudbuildv2CustomEndpointEntryKey).(string)
+	config.ClouddeployBasePath = d.Get(ClouddeployCustomEndpointEntryKey).(string)
+	config.ContainerAttachedBasePath = d.Get(ContainerAttachedCustomEndpointEntryKey).(string)
+	config.DataFusionBasePath = d.Get(DataFusionCustomEndpointEntryKey).(string)
+	config.DataplexBasePath = d.Get(DataplexCustomEndpointEntryKey).(string)
 	config.DnsBasePath = d.Get(DnsCustomEndpointEntryKey).(string)
+	config.DataLossPreventionBasePath = d.Get(DataLossPreventionCustomEndpointEntryKey).(string)
+	config.DocumentAIBasePath = d.Get(DocumentAICustomEndpointEntryKey).(string)
 	config.FilestoreBasePath = d.Get(FilestoreCustomEndpointEntryKey).(string)
+	config.FirebaserulesBasePath = d.Get(FirebaserulesCustomEndpointEntryKey).(string)
 	config.KmsBasePath = d.Get(KmsCustomEndpointEntryKey).(string)
 	config.MonitoringBasePath = d.Get(MonitoringCustomEndpointEntryKey).(string)
+	config.NetappBasePath = d.Get(NetappCustomEndpointEntryKey).(string)
+	config.NetworkSecurityBasePath = d.Get(NetworkSecurityCustomEndpointEntryKey).(string)
+	config.NetworkServicesBasePath = d.Get(NetworkServicesCustomEndpointEntryKey).(string)
+	config.PrivatecaBasePath = d.Get(PrivatecaCustomEndpointEntryKey).(string)
+	config.PublicCABasePath = d.Get(PublicCACustomEndpointEntryKey).(string)
 	config.PubsubBasePath = d.Get(PubsubCustomEndpointEntryKey).(string)
+	config.RecaptchaEnterpriseBasePath = d.Get(RecaptchaEnterpriseCustomEndpointEntryKey).(string)
 	config.RedisBasePath = d.Get(RedisCustomEndpointEntryKey).(string)
 	config.ResourceManagerBasePath = d.Get(ResourceManagerCustomEndpointEntryKey).(string)
+	config.SecurityCenterBasePath = d.Get(SecurityCenterCustomEndpointEntryKey).(string)
+	config.SecurityCenterV2BasePath = d.Get(SecurityCenterV2CustomEndpointEntryKey).(string)
 	config.SourceRepoBasePath = d.Get(SourceRepoCustomEndpointEntryKey).(string)
 	config.SpannerBasePath = d.Get(SpannerCustomEndpointEntryKey).(string)
 	config.SqlBasePath = d.Get(SqlCustomEndpointEntryKey).(string)
 	config.StorageBasePath = d.Get(StorageCustomEndpointEntryKey).(string)
+	config.TagsBasePath = d.Get(TagsCustomEndpointEntryKey).(string)
 	config.TpuBasePath = d.Get(TpuCustomEndpointEntryKey).(string)
+	config.VPCAccessBasePath = d.Get(VPCAccessCustomEndpointEntryKey).(string)
 
 	config.HealthcareBasePath = d.Get(HealthcareCustomEndpointEntryKey).(string)
 	config.IAPBasePath = d.Get(IAPCustomEndpointEntryKey).(string)
@@ -442,6 +620,7 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	config.DataprocBetaBasePath = d.Get(DataprocBetaCustomEndpointEntryKey).(string)
 	config.DataflowBasePath = d.Get(DataflowCustomEndpointEntryKey).(string)
 	config.DnsBetaBasePath = d.Get(DnsBetaCustomEndpointEntryKey).(string)
+	config.GKEHubBasePath = d.Get(GKEHubCustomEndpointEntryKey).(string)
 	config.IamCredentialsBasePath = d.Get(IamCredentialsCustomEndpointEntryKey).(string)
 	config.LoggingBasePath = d.Get(LoggingCustomEndpointEntryKey).(string)
 	config.ResourceManagerV2Beta1BasePath = d.Get(ResourceManagerV2Beta1CustomEndpointEntryKey).(string)
@@ -454,6 +633,10 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	config.CloudFunctionsBasePath = d.Get(CloudFunctionsCustomEndpointEntryKey).(string)
 	config.CloudIoTBasePath = d.Get(CloudIoTCustomEndpointEntryKey).(string)
 	config.StorageTransferBasePath = d.Get(StorageTransferCustomEndpointEntryKey).(string)
+	config.TagsLocationBasePath = d.Get(TagsLocationCustomEndpointEntryKey).(string)
+	config.PubsubLiteBasePath = d.Get(PubsubLiteCustomEndpointEntryKey).(string)
+	config.CloudRunBasePath = d.Get(CloudRunCustomEndpointEntryKey).(string)
+	config.CloudRunV2BasePath = d.Get(CloudRunV2CustomEndpointEntryKey).(string)
 	config.BigtableAdminBasePath = d.Get(BigtableAdminCustomEndpointEntryKey).(string)
 
 	if err := config.LoadAndValidate(); err != nil {
@@ -474,23 +657,45 @@ func ConfigureBasePaths(c *Config) {
 	// end beta-only products
 	c.AccessContextManagerBasePath = AccessContextManagerDefaultBasePath
 	c.AppEngineBasePath = AppEngineDefaultBasePath
+	c.BackupDRBasePath = BackupDRDefaultBasePath
+	c.BeyondcorpBasePath = BeyondcorpDefaultBasePath
+	c.BigqueryReservationBasePath = BigqueryReservationDefaultBasePath
 	c.BinaryAuthorizationBasePath = BinaryAuthorizationDefaultBasePath
 	c.ComputeBasePath = ComputeDefaultBasePath
 	c.CloudBuildBasePath = CloudBuildDefaultBasePath
+	c.Cloudbuildv2BasePath = Cloudbuildv2DefaultBasePath
+	c.ClouddeployBasePath = ClouddeployDefaultBasePath
 	c.CloudSchedulerBasePath = CloudSchedulerDefaultBasePath
+	c.ContainerAttachedBasePath = ContainerAttachedDefaultBasePath
+	c.DataFusionBasePath = DataFusionDefaultBasePath
+	c.DataplexBasePath = DataplexDefaultBasePath
 	c.DnsBasePath = DnsDefaultBasePath
+	c.DataLossPreventionBasePath = DataLossPreventionDefaultBasePath
+	c.DocumentAIBasePath = DocumentAIDefaultBasePath
 	c.FilestoreBasePath = FilestoreDefaultBasePath
+	c.FirebaserulesBasePath = FirebaserulesDefaultBasePath
 	c.FirestoreBasePath = FirestoreDefaultBasePath
+	c.GKEBackupBasePath = GKEBackupDefaultBasePath
 	c.KmsBasePath = KmsDefaultBasePath
 	c.MonitoringBasePath = MonitoringDefaultBasePath
+	c.NetappBasePath = NetappDefaultBasePath
+	c.NetworkSecurityBasePath = NetworkSecurityDefaultBasePath
+	c.NetworkServicesBasePath = NetworkServicesDefaultBasePath
+	c.PrivatecaBasePath = PrivatecaDefaultBasePath
+	c.PublicCABasePath = PublicCADefaultBasePath
 	c.PubsubBasePath = PubsubDefaultBasePath
+	c.RecaptchaEnterpriseBasePath = RecaptchaEnterpriseDefaultBasePath
 	c.RedisBasePath = RedisDefaultBasePath
 	c.ResourceManagerBasePath = ResourceManagerDefaultBasePath
+	c.SecurityCenterBasePath = SecurityCenterDefaultBasePath
+	c.SecurityCenterV2BasePath = SecurityCenterV2DefaultBasePath
 	c.SourceRepoBasePath = SourceRepoDefaultBasePath
 	c.SpannerBasePath = SpannerDefaultBasePath
 	c.SqlBasePath = SqlDefaultBasePath
 	c.StorageBasePath = StorageDefaultBasePath
+	c.TagsBasePath = TagsDefaultBasePath
 	c.TpuBasePath = TpuDefaultBasePath
+	c.VPCAccessBasePath = VPCAccessDefaultBasePath
 
 	// Handwritten Products / Versioned / Atypical Entries
 	// start beta-only products
@@ -504,6 +709,7 @@ func ConfigureBasePaths(c *Config) {
 	c.DataprocBasePath = DataprocDefaultBasePath
 	c.DataflowBasePath = DataflowDefaultBasePath
 	c.DnsBetaBasePath = DnsBetaDefaultBasePath
+	c.GKEHubBasePath = GKEHubDefaultBasePath
 	c.IamCredentialsBasePath = IamCredentialsDefaultBasePath
 	c.LoggingBasePath = LoggingDefaultBasePath
 	c.ResourceManagerV2Beta1BasePath = ResourceManagerV2Beta1DefaultBasePath
@@ -516,6 +722,10 @@ func ConfigureBasePaths(c *Config) {
 	c.CloudFunctionsBasePath = CloudFunctionsDefaultBasePath
 	c.CloudIoTBasePath = CloudIoTDefaultBasePath
 	c.StorageTransferBasePath = StorageTransferDefaultBasePath
+	c.TagsLocationBasePath = TagsLocationDefaultBasePath
+	c.PubsubLiteBasePath = PubsubLiteDefaultBasePath
+	c.CloudRunBasePath = CloudRunDefaultBasePath
+	c.CloudRunV2BasePath = CloudRunV2DefaultBasePath
 	c.BigtableAdminBasePath = BigtableAdminDefaultBasePath
 }
 
@@ -0,0 +1,35 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+// If the base path has changed as a result of your PR, make sure to update
+// the provider_reference page!
+var BackupDRDefaultBasePath = "https://backupdr.googleapis.com/v1/"
+var BackupDRCustomEndpointEntryKey = "backup_dr_custom_endpoint"
+var BackupDRCustomEndpointEntry = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	ValidateFunc: validateCustomEndpoint,
+	DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+		"GOOGLE_BACKUP_DR_CUSTOM_ENDPOINT",
+	}, BackupDRDefaultBasePath),
+}
+
+var GeneratedBackupDRResourcesMap = map[string]*schema.Resource{
+	"google_backup_dr_backup_vault":      resourceBackupDRBackupVault(),
+	"google_backup_dr_management_server": resourceBackupDRManagementServer(),
+}
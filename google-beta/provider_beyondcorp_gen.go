@@ -0,0 +1,36 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+// If the base path has changed as a result of your PR, make sure to update
+// the provider_reference page!
+var BeyondcorpDefaultBasePath = "https://beyondcorp.googleapis.com/v1/"
+var BeyondcorpCustomEndpointEntryKey = "beyondcorp_custom_endpoint"
+var BeyondcorpCustomEndpointEntry = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	ValidateFunc: validateCustomEndpoint,
+	DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+		"GOOGLE_BEYONDCORP_CUSTOM_ENDPOINT",
+	}, BeyondcorpDefaultBasePath),
+}
+
+var GeneratedBeyondcorpResourcesMap = map[string]*schema.Resource{
+	"google_beyondcorp_app_connection": resourceBeyondcorpAppConnection(),
+	"google_beyondcorp_app_connector":  resourceBeyondcorpAppConnector(),
+	"google_beyondcorp_app_gateway":    resourceBeyondcorpAppGateway(),
+}
@@ -0,0 +1,36 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+// If the base path has changed as a result of your PR, make sure to update
+// the provider_reference page!
+var BigqueryReservationDefaultBasePath = "https://bigqueryreservation.googleapis.com/v1/"
+var BigqueryReservationCustomEndpointEntryKey = "bigquery_reservation_custom_endpoint"
+var BigqueryReservationCustomEndpointEntry = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	ValidateFunc: validateCustomEndpoint,
+	DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+		"GOOGLE_BIGQUERY_RESERVATION_CUSTOM_ENDPOINT",
+	}, BigqueryReservationDefaultBasePath),
+}
+
+var GeneratedBigqueryReservationResourcesMap = map[string]*schema.Resource{
+	"google_bigquery_reservation":            resourceBigqueryReservationReservation(),
+	"google_bigquery_reservation_assignment": resourceBigqueryReservationAssignment(),
+	"google_bigquery_capacity_commitment":    resourceBigqueryReservationCapacityCommitment(),
+}
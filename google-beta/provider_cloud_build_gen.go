@@ -30,5 +30,6 @@ var CloudBuildCustomEndpointEntry = &schema.Schema{
 }
 
 var GeneratedCloudBuildResourcesMap = map[string]*schema.Resource{
-	"google_cloudbuild_trigger": resourceCloudBuildTrigger(),
+	"google_cloudbuild_trigger":     resourceCloudBuildTrigger(),
+	"google_cloudbuild_worker_pool": resourceCloudBuildWorkerPool(),
 }
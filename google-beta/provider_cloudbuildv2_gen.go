@@ -0,0 +1,35 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+// If the base path has changed as a result of your PR, make sure to update
+// the provider_reference page!
+var Cloudbuildv2DefaultBasePath = "https://cloudbuild.googleapis.com/v2/"
+var Cloudbuildv2CustomEndpointEntryKey = "cloudbuildv2_custom_endpoint"
+var Cloudbuildv2CustomEndpointEntry = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	ValidateFunc: validateCustomEndpoint,
+	DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+		"GOOGLE_CLOUDBUILDV2_CUSTOM_ENDPOINT",
+	}, Cloudbuildv2DefaultBasePath),
+}
+
+var GeneratedCloudbuildv2ResourcesMap = map[string]*schema.Resource{
+	"google_cloudbuildv2_connection": resourceCloudbuildv2Connection(),
+	"google_cloudbuildv2_repository": resourceCloudbuildv2Repository(),
+}
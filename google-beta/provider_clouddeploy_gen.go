@@ -0,0 +1,36 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+// If the base path has changed as a result of your PR, make sure to update
+// the provider_reference page!
+var ClouddeployDefaultBasePath = "https://clouddeploy.googleapis.com/v1/"
+var ClouddeployCustomEndpointEntryKey = "clouddeploy_custom_endpoint"
+var ClouddeployCustomEndpointEntry = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	ValidateFunc: validateCustomEndpoint,
+	DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+		"GOOGLE_CLOUDDEPLOY_CUSTOM_ENDPOINT",
+	}, ClouddeployDefaultBasePath),
+}
+
+var GeneratedClouddeployResourcesMap = map[string]*schema.Resource{
+	"google_clouddeploy_automation":        resourceClouddeployAutomation(),
+	"google_clouddeploy_delivery_pipeline": resourceClouddeployDeliveryPipeline(),
+	"google_clouddeploy_target":            resourceClouddeployTarget(),
+}
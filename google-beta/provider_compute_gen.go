@@ -46,14 +46,22 @@ var GeneratedComputeResourcesMap = map[string]*schema.Resource{
 	"google_compute_https_health_check":             resourceComputeHttpsHealthCheck(),
 	"google_compute_health_check":                   resourceComputeHealthCheck(),
 	"google_compute_image":                          resourceComputeImage(),
+	"google_compute_interconnect":                   resourceComputeInterconnect(),
 	"google_compute_interconnect_attachment":        resourceComputeInterconnectAttachment(),
 	"google_compute_network":                        resourceComputeNetwork(),
+	"google_compute_network_edge_security_service":  resourceComputeNetworkEdgeSecurityService(),
 	"google_compute_network_endpoint":               resourceComputeNetworkEndpoint(),
 	"google_compute_network_endpoint_group":         resourceComputeNetworkEndpointGroup(),
 	"google_compute_node_group":                     resourceComputeNodeGroup(),
 	"google_compute_node_template":                  resourceComputeNodeTemplate(),
 	"google_compute_region_autoscaler":              resourceComputeRegionAutoscaler(),
+	"google_compute_region_commitment":              resourceComputeRegionCommitment(),
 	"google_compute_region_disk":                    resourceComputeRegionDisk(),
+	"google_compute_region_network_firewall_policy": resourceComputeRegionNetworkFirewallPolicy(),
+	"google_compute_region_security_policy":         resourceComputeRegionSecurityPolicy(),
+	"google_compute_region_target_http_proxy":       resourceComputeRegionTargetHttpProxy(),
+	"google_compute_region_url_map":                 resourceComputeRegionUrlMap(),
+	"google_compute_reservation":                    resourceComputeReservation(),
 	"google_compute_resource_policy":                resourceComputeResourcePolicy(),
 	"google_compute_route":                          resourceComputeRoute(),
 	"google_compute_router":                         resourceComputeRouter(),
@@ -62,6 +70,7 @@ var GeneratedComputeResourcesMap = map[string]*schema.Resource{
 	"google_compute_managed_ssl_certificate":        resourceComputeManagedSslCertificate(),
 	"google_compute_ssl_policy":                     resourceComputeSslPolicy(),
 	"google_compute_subnetwork":                     resourceComputeSubnetwork(),
+	"google_compute_storage_pool":                   resourceComputeStoragePool(),
 	"google_compute_target_http_proxy":              resourceComputeTargetHttpProxy(),
 	"google_compute_target_https_proxy":             resourceComputeTargetHttpsProxy(),
 	"google_compute_target_instance":                resourceComputeTargetInstance(),
@@ -30,5 +30,6 @@ var ContainerAnalysisCustomEndpointEntry = &schema.Schema{
 }
 
 var GeneratedContainerAnalysisResourcesMap = map[string]*schema.Resource{
-	"google_container_analysis_note": resourceContainerAnalysisNote(),
+	"google_container_analysis_note":       resourceContainerAnalysisNote(),
+	"google_container_analysis_occurrence": resourceContainerAnalysisOccurrence(),
 }
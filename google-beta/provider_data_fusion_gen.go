@@ -0,0 +1,34 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+// If the base path has changed as a result of your PR, make sure to update
+// the provider_reference page!
+var DataFusionDefaultBasePath = "https://datafusion.googleapis.com/v1/"
+var DataFusionCustomEndpointEntryKey = "data_fusion_custom_endpoint"
+var DataFusionCustomEndpointEntry = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	ValidateFunc: validateCustomEndpoint,
+	DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+		"GOOGLE_DATA_FUSION_CUSTOM_ENDPOINT",
+	}, DataFusionDefaultBasePath),
+}
+
+var GeneratedDataFusionResourcesMap = map[string]*schema.Resource{
+	"google_data_fusion_instance": resourceDataFusionInstance(),
+}
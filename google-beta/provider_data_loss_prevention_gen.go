@@ -0,0 +1,36 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+// If the base path has changed as a result of your PR, make sure to update
+// the provider_reference page!
+var DataLossPreventionDefaultBasePath = "https://dlp.googleapis.com/v2/"
+var DataLossPreventionCustomEndpointEntryKey = "data_loss_prevention_custom_endpoint"
+var DataLossPreventionCustomEndpointEntry = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	ValidateFunc: validateCustomEndpoint,
+	DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+		"GOOGLE_DATA_LOSS_PREVENTION_CUSTOM_ENDPOINT",
+	}, DataLossPreventionDefaultBasePath),
+}
+
+var GeneratedDataLossPreventionResourcesMap = map[string]*schema.Resource{
+	"google_data_loss_prevention_inspect_template":    resourceDataLossPreventionInspectTemplate(),
+	"google_data_loss_prevention_job_trigger":         resourceDataLossPreventionJobTrigger(),
+	"google_data_loss_prevention_deidentify_template": resourceDataLossPreventionDeidentifyTemplate(),
+}
@@ -0,0 +1,36 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+// If the base path has changed as a result of your PR, make sure to update
+// the provider_reference page!
+var DataplexDefaultBasePath = "https://dataplex.googleapis.com/v1/"
+var DataplexCustomEndpointEntryKey = "dataplex_custom_endpoint"
+var DataplexCustomEndpointEntry = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	ValidateFunc: validateCustomEndpoint,
+	DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+		"GOOGLE_DATAPLEX_CUSTOM_ENDPOINT",
+	}, DataplexDefaultBasePath),
+}
+
+var GeneratedDataplexResourcesMap = map[string]*schema.Resource{
+	"google_dataplex_asset": resourceDataplexAsset(),
+	"google_dataplex_lake":  resourceDataplexLake(),
+	"google_dataplex_zone":  resourceDataplexZone(),
+}
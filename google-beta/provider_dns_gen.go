@@ -30,6 +30,8 @@ var DnsCustomEndpointEntry = &schema.Schema{
 }
 
 var GeneratedDnsResourcesMap = map[string]*schema.Resource{
-	"google_dns_managed_zone": resourceDnsManagedZone(),
-	"google_dns_policy":       resourceDnsPolicy(),
+	"google_dns_managed_zone":         resourceDnsManagedZone(),
+	"google_dns_policy":               resourceDnsPolicy(),
+	"google_dns_response_policy":      resourceDnsResponsePolicy(),
+	"google_dns_response_policy_rule": resourceDnsResponsePolicyRule(),
 }
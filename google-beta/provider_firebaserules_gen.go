@@ -0,0 +1,35 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+// If the base path has changed as a result of your PR, make sure to update
+// the provider_reference page!
+var FirebaserulesDefaultBasePath = "https://firebaserules.googleapis.com/v1/"
+var FirebaserulesCustomEndpointEntryKey = "firebaserules_custom_endpoint"
+var FirebaserulesCustomEndpointEntry = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	ValidateFunc: validateCustomEndpoint,
+	DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+		"GOOGLE_FIREBASERULES_CUSTOM_ENDPOINT",
+	}, FirebaserulesDefaultBasePath),
+}
+
+var GeneratedFirebaserulesResourcesMap = map[string]*schema.Resource{
+	"google_firebaserules_release": resourceFirebaserulesRelease(),
+	"google_firebaserules_ruleset": resourceFirebaserulesRuleset(),
+}
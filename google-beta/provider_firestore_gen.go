@@ -30,5 +30,7 @@ var FirestoreCustomEndpointEntry = &schema.Schema{
 }
 
 var GeneratedFirestoreResourcesMap = map[string]*schema.Resource{
-	"google_firestore_index": resourceFirestoreIndex(),
+	"google_firestore_database": resourceFirestoreDatabase(),
+	"google_firestore_field":    resourceFirestoreField(),
+	"google_firestore_index":    resourceFirestoreIndex(),
 }
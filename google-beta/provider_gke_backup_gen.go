@@ -0,0 +1,35 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+// If the base path has changed as a result of your PR, make sure to update
+// the provider_reference page!
+var GKEBackupDefaultBasePath = "https://gkebackup.googleapis.com/v1/"
+var GKEBackupCustomEndpointEntryKey = "gke_backup_custom_endpoint"
+var GKEBackupCustomEndpointEntry = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	ValidateFunc: validateCustomEndpoint,
+	DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+		"GOOGLE_GKE_BACKUP_CUSTOM_ENDPOINT",
+	}, GKEBackupDefaultBasePath),
+}
+
+var GeneratedGKEBackupResourcesMap = map[string]*schema.Resource{
+	"google_gke_backup_backup_plan":  resourceGKEBackupBackupPlan(),
+	"google_gke_backup_restore_plan": resourceGKEBackupRestorePlan(),
+}
@@ -139,6 +139,17 @@ var DnsBetaCustomEndpointEntry = &schema.Schema{
 	}, DnsBetaDefaultBasePath),
 }
 
+var GKEHubDefaultBasePath = "https://gkehub.googleapis.com/v1beta/"
+var GKEHubCustomEndpointEntryKey = "gke_hub_custom_endpoint"
+var GKEHubCustomEndpointEntry = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	ValidateFunc: validateCustomEndpoint,
+	DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+		"GOOGLE_GKE_HUB_CUSTOM_ENDPOINT",
+	}, GKEHubDefaultBasePath),
+}
+
 var IAMDefaultBasePath = "https://iam.googleapis.com/v1/"
 var IAMCustomEndpointEntryKey = "iam_custom_endpoint"
 var IAMCustomEndpointEntry = &schema.Schema{
@@ -238,6 +249,56 @@ var StorageTransferCustomEndpointEntry = &schema.Schema{
 	}, StorageTransferDefaultBasePath),
 }
 
+// Regional tags endpoints are only known once the location of a resource is,
+// so the base path holds a {{location}} placeholder.
+var TagsLocationDefaultBasePath = "https://{{location}}-cloudresourcemanager.googleapis.com/v3/"
+var TagsLocationCustomEndpointEntryKey = "tags_location_custom_endpoint"
+var TagsLocationCustomEndpointEntry = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	ValidateFunc: validateCustomEndpoint,
+	DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+		"GOOGLE_TAGS_LOCATION_CUSTOM_ENDPOINT",
+	}, TagsLocationDefaultBasePath),
+}
+
+// Cloud Run domain mappings are only served by the regional v1 endpoints, so
+// the base path holds a {{location}} placeholder.
+var CloudRunDefaultBasePath = "https://{{location}}-run.googleapis.com/"
+var CloudRunCustomEndpointEntryKey = "cloud_run_custom_endpoint"
+var CloudRunCustomEndpointEntry = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	ValidateFunc: validateCustomEndpoint,
+	DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+		"GOOGLE_CLOUD_RUN_CUSTOM_ENDPOINT",
+	}, CloudRunDefaultBasePath),
+}
+
+var CloudRunV2DefaultBasePath = "https://run.googleapis.com/v2/"
+var CloudRunV2CustomEndpointEntryKey = "cloud_run_v2_custom_endpoint"
+var CloudRunV2CustomEndpointEntry = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	ValidateFunc: validateCustomEndpoint,
+	DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+		"GOOGLE_CLOUD_RUN_V2_CUSTOM_ENDPOINT",
+	}, CloudRunV2DefaultBasePath),
+}
+
+// Pub/Sub Lite is served by regional endpoints, so the base path holds a
+// {{region}} placeholder.
+var PubsubLiteDefaultBasePath = "https://{{region}}-pubsublite.googleapis.com/v1/admin/"
+var PubsubLiteCustomEndpointEntryKey = "pubsub_lite_custom_endpoint"
+var PubsubLiteCustomEndpointEntry = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	ValidateFunc: validateCustomEndpoint,
+	DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+		"GOOGLE_PUBSUB_LITE_CUSTOM_ENDPOINT",
+	}, PubsubLiteDefaultBasePath),
+}
+
 var BigtableAdminDefaultBasePath = "https://bigtableadmin.googleapis.com/v2/"
 var BigtableAdminCustomEndpointEntryKey = "bigtable_custom_endpoint"
 var BigtableAdminCustomEndpointEntry = &schema.Schema{
@@ -0,0 +1,35 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+// If the base path has changed as a result of your PR, make sure to update
+// the provider_reference page!
+var NetappDefaultBasePath = "https://netapp.googleapis.com/v1/"
+var NetappCustomEndpointEntryKey = "netapp_custom_endpoint"
+var NetappCustomEndpointEntry = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	ValidateFunc: validateCustomEndpoint,
+	DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+		"GOOGLE_NETAPP_CUSTOM_ENDPOINT",
+	}, NetappDefaultBasePath),
+}
+
+var GeneratedNetappResourcesMap = map[string]*schema.Resource{
+	"google_netapp_storage_pool": resourceNetappStoragePool(),
+	"google_netapp_volume":       resourceNetappVolume(),
+}
@@ -0,0 +1,36 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+// If the base path has changed as a result of your PR, make sure to update
+// the provider_reference page!
+var NetworkSecurityDefaultBasePath = "https://networksecurity.googleapis.com/v1/"
+var NetworkSecurityCustomEndpointEntryKey = "network_security_custom_endpoint"
+var NetworkSecurityCustomEndpointEntry = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	ValidateFunc: validateCustomEndpoint,
+	DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+		"GOOGLE_NETWORK_SECURITY_CUSTOM_ENDPOINT",
+	}, NetworkSecurityDefaultBasePath),
+}
+
+var GeneratedNetworkSecurityResourcesMap = map[string]*schema.Resource{
+	"google_network_security_gateway_security_policy":      resourceNetworkSecurityGatewaySecurityPolicy(),
+	"google_network_security_gateway_security_policy_rule": resourceNetworkSecurityGatewaySecurityPolicyRule(),
+	"google_network_security_tls_inspection_policy":        resourceNetworkSecurityTlsInspectionPolicy(),
+}
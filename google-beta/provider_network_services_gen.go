@@ -0,0 +1,37 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+// If the base path has changed as a result of your PR, make sure to update
+// the provider_reference page!
+var NetworkServicesDefaultBasePath = "https://networkservices.googleapis.com/v1/"
+var NetworkServicesCustomEndpointEntryKey = "network_services_custom_endpoint"
+var NetworkServicesCustomEndpointEntry = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	ValidateFunc: validateCustomEndpoint,
+	DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+		"GOOGLE_NETWORK_SERVICES_CUSTOM_ENDPOINT",
+	}, NetworkServicesDefaultBasePath),
+}
+
+var GeneratedNetworkServicesResourcesMap = map[string]*schema.Resource{
+	"google_network_services_edge_cache_keyset":  resourceNetworkServicesEdgeCacheKeyset(),
+	"google_network_services_edge_cache_origin":  resourceNetworkServicesEdgeCacheOrigin(),
+	"google_network_services_edge_cache_service": resourceNetworkServicesEdgeCacheService(),
+	"google_network_services_gateway":            resourceNetworkServicesGateway(),
+}
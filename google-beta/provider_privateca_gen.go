@@ -0,0 +1,36 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+// If the base path has changed as a result of your PR, make sure to update
+// the provider_reference page!
+var PrivatecaDefaultBasePath = "https://privateca.googleapis.com/v1/"
+var PrivatecaCustomEndpointEntryKey = "privateca_custom_endpoint"
+var PrivatecaCustomEndpointEntry = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	ValidateFunc: validateCustomEndpoint,
+	DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+		"GOOGLE_PRIVATECA_CUSTOM_ENDPOINT",
+	}, PrivatecaDefaultBasePath),
+}
+
+var GeneratedPrivatecaResourcesMap = map[string]*schema.Resource{
+	"google_privateca_ca_pool":               resourcePrivatecaCaPool(),
+	"google_privateca_certificate":           resourcePrivatecaCertificate(),
+	"google_privateca_certificate_authority": resourcePrivatecaCertificateAuthority(),
+}
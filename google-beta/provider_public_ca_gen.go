@@ -0,0 +1,34 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+// If the base path has changed as a result of your PR, make sure to update
+// the provider_reference page!
+var PublicCADefaultBasePath = "https://publicca.googleapis.com/v1/"
+var PublicCACustomEndpointEntryKey = "public_ca_custom_endpoint"
+var PublicCACustomEndpointEntry = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	ValidateFunc: validateCustomEndpoint,
+	DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+		"GOOGLE_PUBLIC_CA_CUSTOM_ENDPOINT",
+	}, PublicCADefaultBasePath),
+}
+
+var GeneratedPublicCAResourcesMap = map[string]*schema.Resource{
+	"google_public_ca_external_account_key": resourcePublicCAExternalAccountKey(),
+}
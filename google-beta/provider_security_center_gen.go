@@ -0,0 +1,36 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+// If the base path has changed as a result of your PR, make sure to update
+// the provider_reference page!
+var SecurityCenterDefaultBasePath = "https://securitycenter.googleapis.com/v1/"
+var SecurityCenterCustomEndpointEntryKey = "security_center_custom_endpoint"
+var SecurityCenterCustomEndpointEntry = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	ValidateFunc: validateCustomEndpoint,
+	DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+		"GOOGLE_SECURITY_CENTER_CUSTOM_ENDPOINT",
+	}, SecurityCenterDefaultBasePath),
+}
+
+var GeneratedSecurityCenterResourcesMap = map[string]*schema.Resource{
+	"google_scc_mute_config":         resourceSecurityCenterMuteConfig(),
+	"google_scc_notification_config": resourceSecurityCenterNotificationConfig(),
+	"google_scc_source":              resourceSecurityCenterSource(),
+}
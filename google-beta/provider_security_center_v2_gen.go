@@ -0,0 +1,36 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+// If the base path has changed as a result of your PR, make sure to update
+// the provider_reference page!
+var SecurityCenterV2DefaultBasePath = "https://securitycenter.googleapis.com/v2/"
+var SecurityCenterV2CustomEndpointEntryKey = "security_center_v2_custom_endpoint"
+var SecurityCenterV2CustomEndpointEntry = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	ValidateFunc: validateCustomEndpoint,
+	DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+		"GOOGLE_SECURITY_CENTER_V2_CUSTOM_ENDPOINT",
+	}, SecurityCenterV2DefaultBasePath),
+}
+
+var GeneratedSecurityCenterV2ResourcesMap = map[string]*schema.Resource{
+	"google_scc_v2_organization_mute_config":         resourceSecurityCenterV2OrganizationMuteConfig(),
+	"google_scc_v2_organization_notification_config": resourceSecurityCenterV2OrganizationNotificationConfig(),
+	"google_scc_v2_organization_source":              resourceSecurityCenterV2OrganizationSource(),
+}
@@ -0,0 +1,36 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+// If the base path has changed as a result of your PR, make sure to update
+// the provider_reference page!
+var TagsDefaultBasePath = "https://cloudresourcemanager.googleapis.com/v3/"
+var TagsCustomEndpointEntryKey = "tags_custom_endpoint"
+var TagsCustomEndpointEntry = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	ValidateFunc: validateCustomEndpoint,
+	DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+		"GOOGLE_TAGS_CUSTOM_ENDPOINT",
+	}, TagsDefaultBasePath),
+}
+
+var GeneratedTagsResourcesMap = map[string]*schema.Resource{
+	"google_tags_tag_binding": resourceTagsTagBinding(),
+	"google_tags_tag_key":     resourceTagsTagKey(),
+	"google_tags_tag_value":   resourceTagsTagValue(),
+}
@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -65,6 +67,26 @@ var billingAccountEnvVars = []string{
 	"GOOGLE_BILLING_ACCOUNT",
 }
 
+var attachedClusterIssuerUrlEnvVars = []string{
+	"GOOGLE_ATTACHED_CLUSTER_ISSUER_URL",
+}
+
+var interconnectEnvVars = []string{
+	"GOOGLE_INTERCONNECT_NAME",
+}
+
+var githubAppInstallationIdEnvVars = []string{
+	"GOOGLE_GITHUB_APP_INSTALLATION_ID",
+}
+
+var githubOAuthTokenSecretVersionEnvVars = []string{
+	"GOOGLE_GITHUB_OAUTH_TOKEN_SECRET_VERSION",
+}
+
+var githubRepositoryUriEnvVars = []string{
+	"GOOGLE_GITHUB_REPOSITORY_URI",
+}
+
 func init() {
 	testAccProvider = Provider().(*schema.Provider)
 	testAccRandomProvider = random.Provider().(*schema.Provider)
@@ -157,6 +179,99 @@ func TestProvider_loadCredentialsFromJSON(t *testing.T) {
 	}
 }
 
+func TestProvider_accessTokenConflictsWithCredentials(t *testing.T) {
+	raw := map[string]interface{}{
+		"access_token": "static-token",
+		"credentials":  testFakeCredentialsPath,
+	}
+	rc, err := config.NewRawConfig(raw)
+	if err != nil {
+		t.Fatalf("bad: %s", err)
+	}
+
+	_, es := Provider().Validate(terraform.NewResourceConfig(rc))
+	if len(es) == 0 {
+		t.Fatalf("expected an error configuring both access_token and credentials")
+	}
+}
+
+func TestProvider_configureWithAccessToken(t *testing.T) {
+	// A token configured on the provider takes precedence over credentials
+	// found in the environment.
+	os.Setenv("GOOGLE_CREDENTIALS", testFakeCredentialsPath)
+	defer os.Unsetenv("GOOGLE_CREDENTIALS")
+
+	raw := map[string]interface{}{
+		"access_token": "static-token",
+		"project":      "my-gce-project",
+	}
+	rc, err := config.NewRawConfig(raw)
+	if err != nil {
+		t.Fatalf("bad: %s", err)
+	}
+
+	p := Provider().(*schema.Provider)
+	if err := p.Configure(terraform.NewResourceConfig(rc)); err != nil {
+		t.Fatalf("error configuring provider: %s", err)
+	}
+
+	c := p.Meta().(*Config)
+	if c.Credentials != "" {
+		t.Errorf("expected credentials to be ignored, got %q", c.Credentials)
+	}
+	token, err := c.tokenSource.Token()
+	if err != nil {
+		t.Fatalf("error getting token: %s", err)
+	}
+	if token.AccessToken != "static-token" {
+		t.Errorf("expected requests to use the static token, got %q", token.AccessToken)
+	}
+}
+
+func TestProvider_configureWithScopes(t *testing.T) {
+	raw := map[string]interface{}{
+		"credentials": testFakeCredentialsPath,
+		"project":     "my-gce-project",
+		"scopes": []interface{}{
+			"https://www.googleapis.com/auth/compute.readonly",
+			"https://www.googleapis.com/auth/devstorage.read_only",
+		},
+	}
+	rc, err := config.NewRawConfig(raw)
+	if err != nil {
+		t.Fatalf("bad: %s", err)
+	}
+
+	p := Provider().(*schema.Provider)
+	if err := p.Configure(terraform.NewResourceConfig(rc)); err != nil {
+		t.Fatalf("error configuring provider: %s", err)
+	}
+
+	c := p.Meta().(*Config)
+	expected := []string{
+		"https://www.googleapis.com/auth/compute.readonly",
+		"https://www.googleapis.com/auth/devstorage.read_only",
+	}
+	if !reflect.DeepEqual(c.Scopes, expected) {
+		t.Errorf("expected scopes %v, got %v", expected, c.Scopes)
+	}
+}
+
+func TestProvider_invalidScopes(t *testing.T) {
+	raw := map[string]interface{}{
+		"scopes": []interface{}{"cloud-platform"},
+	}
+	rc, err := config.NewRawConfig(raw)
+	if err != nil {
+		t.Fatalf("bad: %s", err)
+	}
+
+	_, es := Provider().Validate(terraform.NewResourceConfig(rc))
+	if len(es) == 0 {
+		t.Fatalf("expected an error configuring a scope that isn't a URL")
+	}
+}
+
 func TestAccProviderBasePath_setBasePath(t *testing.T) {
 	t.Parallel()
 
@@ -272,6 +387,38 @@ func getTestServiceAccountFromEnv(t *testing.T) string {
 	return multiEnvSearch(serviceAccountEnvVars)
 }
 
+// Attaching a cluster requires a real EKS or AKS cluster, which is identified
+// by the OIDC issuer URL of its API server.
+func getTestAttachedClusterIssuerUrlFromEnv(t *testing.T) string {
+	skipIfEnvNotSet(t, attachedClusterIssuerUrlEnvVars...)
+	return multiEnvSearch(attachedClusterIssuerUrlEnvVars)
+}
+
+// Interconnects are physical cross connects, so tests that read one need an
+// existing interconnect in the test project.
+func getTestInterconnectFromEnv(t *testing.T) string {
+	skipIfEnvNotSet(t, interconnectEnvVars...)
+	return multiEnvSearch(interconnectEnvVars)
+}
+
+// Cloud Build GitHub connections need the Cloud Build GitHub App installed on
+// a GitHub account, and a Secret Manager secret version holding an OAuth token
+// of that account.
+func getTestGithubAppInstallationIdFromEnv(t *testing.T) string {
+	skipIfEnvNotSet(t, githubAppInstallationIdEnvVars...)
+	return multiEnvSearch(githubAppInstallationIdEnvVars)
+}
+
+func getTestGithubOAuthTokenSecretVersionFromEnv(t *testing.T) string {
+	skipIfEnvNotSet(t, githubOAuthTokenSecretVersionEnvVars...)
+	return multiEnvSearch(githubOAuthTokenSecretVersionEnvVars)
+}
+
+func getTestGithubRepositoryUriFromEnv(t *testing.T) string {
+	skipIfEnvNotSet(t, githubRepositoryUriEnvVars...)
+	return multiEnvSearch(githubRepositoryUriEnvVars)
+}
+
 func multiEnvSearch(ks []string) string {
 	for _, k := range ks {
 		if v := os.Getenv(k); v != "" {
@@ -0,0 +1,34 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+// If the base path has changed as a result of your PR, make sure to update
+// the provider_reference page!
+var VPCAccessDefaultBasePath = "https://vpcaccess.googleapis.com/v1/"
+var VPCAccessCustomEndpointEntryKey = "vpc_access_custom_endpoint"
+var VPCAccessCustomEndpointEntry = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	ValidateFunc: validateCustomEndpoint,
+	DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+		"GOOGLE_VPC_ACCESS_CUSTOM_ENDPOINT",
+	}, VPCAccessDefaultBasePath),
+}
+
+var GeneratedVPCAccessResourcesMap = map[string]*schema.Resource{
+	"google_vpc_access_connector": resourceVPCAccessConnector(),
+}
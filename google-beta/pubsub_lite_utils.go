@@ -0,0 +1,71 @@
+package google
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// pubsubLiteBasePath returns the base path of the regional Pub/Sub Lite admin
+// endpoint for a region.
+func pubsubLiteBasePath(config *Config, region string) string {
+	return strings.Replace(config.PubsubLiteBasePath, "{{region}}", region, 1)
+}
+
+var pubsubLiteZoneRegex = regexp.MustCompile(`^[a-z]+-[a-z]+[0-9]+-[a-z]$`)
+
+// pubsubLiteRegion returns the region serving a Pub/Sub Lite location, which
+// is either a zone or a region.
+func pubsubLiteRegion(location string) string {
+	if pubsubLiteZoneRegex.MatchString(location) {
+		return getRegionFromZone(location)
+	}
+	return location
+}
+
+// Each partition retains between 30 GiB and 10 TiB of messages.
+const (
+	pubsubLiteMinPerPartitionBytes = 30 * 1024 * 1024 * 1024
+	pubsubLiteMaxPerPartitionBytes = 10 * 1024 * 1024 * 1024 * 1024
+)
+
+func validatePubsubLitePerPartitionBytes(v interface{}, k string) (ws []string, errors []error) {
+	value, err := strconv.ParseInt(v.(string), 10, 64)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a number of bytes, got: %q", k, v))
+		return
+	}
+	if value < pubsubLiteMinPerPartitionBytes || value > pubsubLiteMaxPerPartitionBytes {
+		errors = append(errors, fmt.Errorf("%q must be between %d (30 GiB) and %d (10 TiB), got: %d", k, int64(pubsubLiteMinPerPartitionBytes), int64(pubsubLiteMaxPerPartitionBytes), value))
+	}
+	return
+}
+
+// pubsubLiteResourcePath expands a reference to a Pub/Sub Lite resource to its
+// relative resource name, unless it's already one.
+func pubsubLiteResourcePath(project, location, collection, v string) string {
+	if v == "" || strings.HasPrefix(v, "projects/") {
+		return v
+	}
+	return fmt.Sprintf("projects/%s/locations/%s/%s/%s", project, location, collection, v)
+}
+
+// flattenPubsubLiteInt reads an integer field of the API. int64 fields are
+// represented as strings in JSON, and int32 fields as numbers.
+func flattenPubsubLiteInt(v interface{}) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+
+	// number values are represented as float64
+	if floatVal, ok := v.(float64); ok {
+		intVal := int(floatVal)
+		return intVal
+	}
+
+	return v
+}
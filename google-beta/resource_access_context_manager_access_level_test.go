@@ -72,7 +72,7 @@ func testAccCheckAccessContextManagerAccessLevelDestroy(s *terraform.State) erro
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("AccessLevel still exists at %s", url)
 		}
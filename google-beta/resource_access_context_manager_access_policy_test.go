@@ -72,7 +72,7 @@ func testAccCheckAccessContextManagerAccessPolicyDestroy(s *terraform.State) err
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("AccessPolicy still exists at %s", url)
 		}
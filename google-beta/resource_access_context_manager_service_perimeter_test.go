@@ -71,7 +71,7 @@ func testAccCheckAccessContextManagerServicePerimeterDestroy(s *terraform.State)
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("ServicePerimeter still exists at %s", url)
 		}
@@ -87,7 +87,7 @@ func testAccCheckAppEngineFirewallRuleDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("AppEngineFirewallRule still exists at %s", url)
 		}
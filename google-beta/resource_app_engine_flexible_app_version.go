@@ -0,0 +1,640 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	appengine "google.golang.org/api/appengine/v1"
+)
+
+func resourceAppEngineFlexibleAppVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAppEngineFlexibleAppVersionCreate,
+		Read:   resourceAppEngineFlexibleAppVersionRead,
+		Update: resourceAppEngineFlexibleAppVersionUpdate,
+		Delete: resourceAppEngineFlexibleAppVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAppEngineFlexibleAppVersionImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: appEngineFlexibleAppVersionScalingCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"service": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `AppEngine service resource. Can contain numbers, letters, and hyphens.`,
+			},
+			"version_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Relative name of the version within the service. For example, v1. Version names can contain only lowercase letters, numbers, or hyphens. Reserved names,"default", "latest", and any name with the prefix "ah-".`,
+			},
+			"runtime": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Desired runtime. Example python27.`,
+			},
+			"deployment": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: `Code and application artifacts that make up this version.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MaxItems:    1,
+							Description: `The Docker image for the container that runs the version.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"image": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `URI to the hosted container image in Google Container Registry. The URI must be fully qualified and include a tag or digest. Examples: "gcr.io/my-project/image:tag" or "gcr.io/my-project/image@digest"`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"env_variables": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Environment variables available to the application.`,
+			},
+			"automatic_scaling": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"manual_scaling"},
+				Description:   `Automatic scaling is based on request rate, response latencies, and other application metrics.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cpu_utilization": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MaxItems:    1,
+							Description: `Target scaling by CPU usage.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"target_utilization": {
+										Type:         schema.TypeFloat,
+										Required:     true,
+										ValidateFunc: validation.FloatBetween(0, 1),
+										Description:  `Target CPU utilization ratio to maintain when scaling. Must be between 0 and 1.`,
+									},
+									"aggregation_window_length": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Computed:    true,
+										Description: `Period of time over which CPU utilization is calculated. A duration in seconds with up to nine fractional digits, terminated by 's'. Example: "3.5s".`,
+									},
+								},
+							},
+						},
+						"cool_down_period": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "120s",
+							Description: `The time period that the Autoscaler should wait before it starts collecting information from a new instance. This prevents the autoscaler from collecting information when the instance is initializing, during which the collected usage would not be reliable. Default: 120s`,
+						},
+						"min_total_instances": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      2,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  `Minimum number of running instances that should be maintained for this version. Default: 2`,
+						},
+						"max_total_instances": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      20,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  `Maximum number of instances that should be started to handle requests for this version. Default: 20`,
+						},
+					},
+				},
+			},
+			"manual_scaling": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"automatic_scaling"},
+				Description:   `A service with manual scaling runs continuously, allowing you to perform complex initialization and rely on the state of its memory over time.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instances": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  `Number of instances to assign to the service at the start.`,
+						},
+					},
+				},
+			},
+			"liveness_check": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: `Health checking configuration for VM instances. Unhealthy instances are killed and replaced with new instances.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `The request path.`,
+						},
+						"host": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Host header to send when performing a HTTP Readiness check. Example: "myapp.appspot.com"`,
+						},
+						"failure_threshold": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: `Number of consecutive failed checks required before considering the VM unhealthy. Default: 4.`,
+						},
+						"success_threshold": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: `Number of consecutive successful checks required before considering the VM healthy. Default: 2.`,
+						},
+						"check_interval": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: `Interval between health checks. Default: "30s".`,
+						},
+						"timeout": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: `Time before the check is considered failed. Default: "4s"`,
+						},
+						"initial_delay": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: `The initial delay before starting to execute the checks. Default: "300s"`,
+						},
+					},
+				},
+			},
+			"readiness_check": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: `Configures readiness health checking for instances. Unhealthy instances are not put into the backend traffic rotation.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `The request path.`,
+						},
+						"host": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Host header to send when performing a HTTP Readiness check. Example: "myapp.appspot.com"`,
+						},
+						"failure_threshold": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: `Number of consecutive failed checks required before removing traffic. Default: 2.`,
+						},
+						"success_threshold": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: `Number of consecutive successful checks required before receiving traffic. Default: 2.`,
+						},
+						"check_interval": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: `Interval between health checks. Default: "5s".`,
+						},
+						"timeout": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: `Time before the check is considered failed. Default: "4s"`,
+						},
+						"app_start_timeout": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: `A maximum time limit on application initialization, measured from moment the application successfully replies to a healthcheck until it is ready to serve traffic. Default: "300s"`,
+						},
+					},
+				},
+			},
+			"noop_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: `If set to true, the application version will not be deleted upon running Terraform destroy.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Full path to the Version resource in the API. Example, "v1".`,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func appEngineFlexibleAppVersionScalingCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	// separate func to allow unit testing
+	return appEngineFlexibleAppVersionScalingCustomizeDiffFunc(diff)
+}
+
+// A flexible version scales either automatically or manually, and the API
+// doesn't default to either of them.
+func appEngineFlexibleAppVersionScalingCustomizeDiffFunc(diff TerraformResourceDiff) error {
+	_, automatic := diff.GetChange("automatic_scaling.#")
+	_, manual := diff.GetChange("manual_scaling.#")
+	automaticCount, _ := automatic.(int)
+	manualCount, _ := manual.(int)
+	if automaticCount+manualCount != 1 {
+		return fmt.Errorf("exactly one of automatic_scaling or manual_scaling must be set")
+	}
+	return nil
+}
+
+func resourceAppEngineFlexibleAppVersionCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "{{AppEngineBasePath}}apps/{{project}}/services/{{service}}/versions")
+	if err != nil {
+		return err
+	}
+
+	obj := expandAppEngineFlexibleAppVersion(d)
+	log.Printf("[DEBUG] Creating new FlexibleAppVersion: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating FlexibleAppVersion: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "apps/{{project}}/services/{{service}}/versions/{{version_id}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	op := &appengine.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
+	waitErr := appEngineOperationWaitTime(config.clientAppEngine, op, project, "Creating FlexibleAppVersion", int(d.Timeout(schema.TimeoutCreate).Minutes()))
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create FlexibleAppVersion: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating FlexibleAppVersion %q: %#v", d.Id(), res)
+
+	return resourceAppEngineFlexibleAppVersionRead(d, meta)
+}
+
+func resourceAppEngineFlexibleAppVersionRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{AppEngineBasePath}}apps/{{project}}/services/{{service}}/versions/{{version_id}}?view=FULL")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("AppEngineFlexibleAppVersion %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading FlexibleAppVersion: %s", err)
+	}
+
+	if err := d.Set("name", res["name"]); err != nil {
+		return fmt.Errorf("Error reading FlexibleAppVersion: %s", err)
+	}
+	if err := d.Set("version_id", res["id"]); err != nil {
+		return fmt.Errorf("Error reading FlexibleAppVersion: %s", err)
+	}
+	if err := d.Set("runtime", res["runtime"]); err != nil {
+		return fmt.Errorf("Error reading FlexibleAppVersion: %s", err)
+	}
+	if err := d.Set("env_variables", res["envVariables"]); err != nil {
+		return fmt.Errorf("Error reading FlexibleAppVersion: %s", err)
+	}
+	if err := d.Set("automatic_scaling", flattenAppEngineFlexibleAppVersionAutomaticScaling(res["automaticScaling"])); err != nil {
+		return fmt.Errorf("Error reading FlexibleAppVersion: %s", err)
+	}
+	if err := d.Set("manual_scaling", flattenAppEngineFlexibleAppVersionManualScaling(res["manualScaling"])); err != nil {
+		return fmt.Errorf("Error reading FlexibleAppVersion: %s", err)
+	}
+	if err := d.Set("liveness_check", flattenAppEngineFlexibleAppVersionHealthCheck(res["livenessCheck"], "initialDelay", "initial_delay")); err != nil {
+		return fmt.Errorf("Error reading FlexibleAppVersion: %s", err)
+	}
+	if err := d.Set("readiness_check", flattenAppEngineFlexibleAppVersionHealthCheck(res["readinessCheck"], "appStartTimeout", "app_start_timeout")); err != nil {
+		return fmt.Errorf("Error reading FlexibleAppVersion: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAppEngineFlexibleAppVersionUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	// Only the scaling settings of a version can be patched, so changes are
+	// applied by deploying the version again under the same id.
+	url, err := replaceVars(d, config, "{{AppEngineBasePath}}apps/{{project}}/services/{{service}}/versions")
+	if err != nil {
+		return err
+	}
+
+	obj := expandAppEngineFlexibleAppVersion(d)
+	log.Printf("[DEBUG] Updating FlexibleAppVersion %q: %#v", d.Id(), obj)
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("Error updating FlexibleAppVersion %q: %s", d.Id(), err)
+	}
+
+	op := &appengine.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
+	err = appEngineOperationWaitTime(config.clientAppEngine, op, project, "Updating FlexibleAppVersion", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+	if err != nil {
+		return err
+	}
+
+	return resourceAppEngineFlexibleAppVersionRead(d, meta)
+}
+
+func resourceAppEngineFlexibleAppVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if d.Get("noop_on_destroy").(bool) {
+		log.Printf("[DEBUG] Keeping the FlexibleAppVersion %q", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "{{AppEngineBasePath}}apps/{{project}}/services/{{service}}/versions/{{version_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting FlexibleAppVersion %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, nil, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "FlexibleAppVersion")
+	}
+
+	op := &appengine.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
+	err = appEngineOperationWaitTime(config.clientAppEngine, op, project, "Deleting FlexibleAppVersion", int(d.Timeout(schema.TimeoutDelete).Minutes()))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting FlexibleAppVersion %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceAppEngineFlexibleAppVersionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"apps/(?P<project>[^/]+)/services/(?P<service>[^/]+)/versions/(?P<version_id>[^/]+)",
+		"(?P<project>[^/]+)/(?P<service>[^/]+)/(?P<version_id>[^/]+)",
+		"(?P<service>[^/]+)/(?P<version_id>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "apps/{{project}}/services/{{service}}/versions/{{version_id}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	// Explicitly set virtual fields to default values on import
+	if err := d.Set("noop_on_destroy", false); err != nil {
+		return nil, fmt.Errorf("Error setting noop_on_destroy: %s", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandAppEngineFlexibleAppVersion(d *schema.ResourceData) map[string]interface{} {
+	obj := map[string]interface{}{
+		"id":         d.Get("version_id"),
+		"env":        "flex",
+		"runtime":    d.Get("runtime"),
+		"deployment": expandAppEngineFlexibleAppVersionDeployment(d.Get("deployment").([]interface{})),
+	}
+	if v, ok := d.GetOk("env_variables"); ok {
+		obj["envVariables"] = v
+	}
+	if v, ok := d.GetOk("automatic_scaling"); ok {
+		obj["automaticScaling"] = expandAppEngineFlexibleAppVersionAutomaticScaling(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("manual_scaling"); ok {
+		raw := v.([]interface{})[0].(map[string]interface{})
+		obj["manualScaling"] = map[string]interface{}{
+			"instances": raw["instances"],
+		}
+	}
+	if v, ok := d.GetOk("liveness_check"); ok {
+		obj["livenessCheck"] = expandAppEngineFlexibleAppVersionHealthCheck(v.([]interface{}), "initial_delay", "initialDelay")
+	}
+	if v, ok := d.GetOk("readiness_check"); ok {
+		obj["readinessCheck"] = expandAppEngineFlexibleAppVersionHealthCheck(v.([]interface{}), "app_start_timeout", "appStartTimeout")
+	}
+	return obj
+}
+
+func expandAppEngineFlexibleAppVersionDeployment(l []interface{}) map[string]interface{} {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	raw := l[0].(map[string]interface{})
+	containers := raw["container"].([]interface{})
+	if len(containers) == 0 || containers[0] == nil {
+		return nil
+	}
+	container := containers[0].(map[string]interface{})
+	return map[string]interface{}{
+		"container": map[string]interface{}{
+			"image": container["image"],
+		},
+	}
+}
+
+func expandAppEngineFlexibleAppVersionAutomaticScaling(l []interface{}) map[string]interface{} {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	raw := l[0].(map[string]interface{})
+
+	transformed := map[string]interface{}{
+		"coolDownPeriod":    raw["cool_down_period"],
+		"minTotalInstances": raw["min_total_instances"],
+		"maxTotalInstances": raw["max_total_instances"],
+	}
+	if cpu, ok := raw["cpu_utilization"].([]interface{}); ok && len(cpu) > 0 && cpu[0] != nil {
+		rawCpu := cpu[0].(map[string]interface{})
+		cpuUtilization := map[string]interface{}{
+			"targetUtilization": rawCpu["target_utilization"],
+		}
+		if v := rawCpu["aggregation_window_length"].(string); v != "" {
+			cpuUtilization["aggregationWindowLength"] = v
+		}
+		transformed["cpuUtilization"] = cpuUtilization
+	}
+	return transformed
+}
+
+// expandAppEngineFlexibleAppVersionHealthCheck expands a liveness or readiness
+// check. They only differ by their startup delay field.
+func expandAppEngineFlexibleAppVersionHealthCheck(l []interface{}, delayKey, delayField string) map[string]interface{} {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	raw := l[0].(map[string]interface{})
+
+	transformed := map[string]interface{}{
+		"path": raw["path"],
+	}
+	for key, field := range map[string]string{
+		"host":           "host",
+		"check_interval": "checkInterval",
+		"timeout":        "timeout",
+		delayKey:         delayField,
+	} {
+		if v := raw[key].(string); v != "" {
+			transformed[field] = v
+		}
+	}
+	if v := raw["failure_threshold"].(int); v != 0 {
+		transformed["failureThreshold"] = v
+	}
+	if v := raw["success_threshold"].(int); v != 0 {
+		transformed["successThreshold"] = v
+	}
+	return transformed
+}
+
+func flattenAppEngineFlexibleAppVersionAutomaticScaling(v interface{}) interface{} {
+	original, ok := v.(map[string]interface{})
+	if !ok || len(original) == 0 {
+		return nil
+	}
+
+	transformed := map[string]interface{}{
+		"cool_down_period":    original["coolDownPeriod"],
+		"min_total_instances": flattenAppEngineFlexibleAppVersionInt(original["minTotalInstances"]),
+		"max_total_instances": flattenAppEngineFlexibleAppVersionInt(original["maxTotalInstances"]),
+	}
+	if cpu, ok := original["cpuUtilization"].(map[string]interface{}); ok {
+		transformed["cpu_utilization"] = []interface{}{
+			map[string]interface{}{
+				"target_utilization":        cpu["targetUtilization"],
+				"aggregation_window_length": cpu["aggregationWindowLength"],
+			},
+		}
+	}
+	return []interface{}{transformed}
+}
+
+func flattenAppEngineFlexibleAppVersionManualScaling(v interface{}) interface{} {
+	original, ok := v.(map[string]interface{})
+	if !ok || len(original) == 0 {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"instances": flattenAppEngineFlexibleAppVersionInt(original["instances"]),
+		},
+	}
+}
+
+func flattenAppEngineFlexibleAppVersionHealthCheck(v interface{}, delayField, delayKey string) interface{} {
+	original, ok := v.(map[string]interface{})
+	if !ok || len(original) == 0 {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"path":              original["path"],
+			"host":              original["host"],
+			"failure_threshold": flattenAppEngineFlexibleAppVersionInt(original["failureThreshold"]),
+			"success_threshold": flattenAppEngineFlexibleAppVersionInt(original["successThreshold"]),
+			"check_interval":    original["checkInterval"],
+			"timeout":           original["timeout"],
+			delayKey:            original[delayField],
+		},
+	}
+}
+
+func flattenAppEngineFlexibleAppVersionInt(v interface{}) interface{} {
+	// number values are represented as float64
+	if floatVal, ok := v.(float64); ok {
+		intVal := int(floatVal)
+		return intVal
+	}
+	return v
+}
@@ -0,0 +1,170 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAppEngineFlexibleAppVersionScalingCustomizeDiff(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Automatic, Manual int
+		ExpectError       bool
+	}{
+		"automatic": {
+			Automatic: 1,
+		},
+		"manual": {
+			Manual: 1,
+		},
+		"none": {
+			ExpectError: true,
+		},
+		"both": {
+			Automatic:   1,
+			Manual:      1,
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &ResourceDiffMock{
+			After: map[string]interface{}{
+				"automatic_scaling.#": tc.Automatic,
+				"manual_scaling.#":    tc.Manual,
+			},
+		}
+		err := appEngineFlexibleAppVersionScalingCustomizeDiffFunc(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
+
+func TestAccAppEngineFlexibleAppVersion_automaticScaling(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"org_id":          getTestOrgFromEnv(t),
+		"billing_account": getTestBillingAccountFromEnv(t),
+		"random_suffix":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAppEngineFlexibleAppVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppEngineFlexibleAppVersion_automaticScaling(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_app_engine_flexible_app_version.foo", "automatic_scaling.0.min_total_instances", "2"),
+					resource.TestCheckResourceAttr("google_app_engine_flexible_app_version.foo", "automatic_scaling.0.cpu_utilization.0.target_utilization", "0.5"),
+					resource.TestCheckResourceAttr("google_app_engine_flexible_app_version.foo", "liveness_check.0.path", "/"),
+				),
+			},
+			{
+				ResourceName:            "google_app_engine_flexible_app_version.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deployment", "env_variables", "noop_on_destroy"},
+			},
+		},
+	})
+}
+
+func testAccAppEngineFlexibleAppVersion_automaticScaling(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_project" "my_project" {
+  name            = "tf-test-appeng-flex"
+  project_id      = "tf-test-%{random_suffix}"
+  org_id          = "%{org_id}"
+  billing_account = "%{billing_account}"
+}
+
+resource "google_project_service" "flex" {
+  project = google_project.my_project.project_id
+  service = "appengineflex.googleapis.com"
+
+  disable_dependent_services = false
+}
+
+resource "google_app_engine_application" "app" {
+  project     = google_project.my_project.project_id
+  location_id = "us-central"
+}
+
+resource "google_app_engine_flexible_app_version" "foo" {
+  project    = google_app_engine_application.app.project
+  service    = "default"
+  version_id = "v1"
+  runtime    = "custom"
+
+  deployment {
+    container {
+      image = "gcr.io/google-samples/hello-app:1.0"
+    }
+  }
+
+  env_variables = {
+    port = "8080"
+  }
+
+  liveness_check {
+    path = "/"
+  }
+
+  readiness_check {
+    path = "/"
+  }
+
+  automatic_scaling {
+    cool_down_period = "120s"
+
+    cpu_utilization {
+      target_utilization = 0.5
+    }
+  }
+
+  noop_on_destroy = true
+
+  depends_on = [google_project_service.flex]
+}
+`, context)
+}
+
+func testAccCheckAppEngineFlexibleAppVersionDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_app_engine_flexible_app_version" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		// Versions with noop_on_destroy are kept, and only go away with their
+		// project.
+		if rs.Primary.Attributes["noop_on_destroy"] == "true" {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url := fmt.Sprintf("%s%s", config.AppEngineBasePath, rs.Primary.ID)
+		_, err := sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("AppEngineFlexibleAppVersion still exists at %s", url)
+		}
+	}
+
+	return nil
+}
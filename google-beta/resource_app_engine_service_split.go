@@ -0,0 +1,259 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	appengine "google.golang.org/api/appengine/v1"
+)
+
+func resourceAppEngineServiceSplit() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAppEngineServiceSplitCreate,
+		Read:   resourceAppEngineServiceSplitRead,
+		Update: resourceAppEngineServiceSplitUpdate,
+		Delete: resourceAppEngineServiceSplitDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAppEngineServiceSplitImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Update: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The name of the service these settings apply to.`,
+			},
+			"split": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: `Mapping that defines fractional HTTP traffic diversion to different versions within the service.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allocations": {
+							Type:         schema.TypeMap,
+							Required:     true,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+							ValidateFunc: validateAppEngineServiceSplitAllocations,
+							Description:  `Mapping from version IDs within the service to fractional (0.000, 1] allocations of traffic for that version. Each version can be specified only once, but some versions in the service may not have any traffic allocation. Services that have traffic allocated cannot be deleted until either the service is deleted or their traffic allocation is removed. Allocations must sum to 1. Up to two decimal place precision is supported for IP-based splits and up to three decimal places is supported for cookie-based splits.`,
+						},
+						"shard_by": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"UNSPECIFIED", "COOKIE", "IP", "RANDOM", ""}, false),
+							Description:  `Mechanism used to determine which version a request is sent to. The traffic selection algorithm will be stable for either type until allocations are changed.`,
+						},
+					},
+				},
+			},
+			"migrate_traffic": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: `If set to true traffic will be migrated to this version.`,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+// The allocations of a split are fractions of the traffic of the service, so
+// they must add up to exactly 1.
+func validateAppEngineServiceSplitAllocations(v interface{}, k string) (ws []string, errors []error) {
+	sum := 0.0
+	for version, raw := range v.(map[string]interface{}) {
+		allocation, err := strconv.ParseFloat(raw.(string), 64)
+		if err != nil {
+			errors = append(errors, fmt.Errorf("%q: the allocation of version %q must be a number, got: %q", k, version, raw))
+			continue
+		}
+		if allocation <= 0 || allocation > 1 {
+			errors = append(errors, fmt.Errorf("%q: the allocation of version %q must be in (0, 1], got: %v", k, version, allocation))
+		}
+		sum += allocation
+	}
+	if len(errors) == 0 && math.Abs(sum-1) > 1e-9 {
+		errors = append(errors, fmt.Errorf("%q: the allocations must sum to 1, got: %v", k, sum))
+	}
+	return
+}
+
+func resourceAppEngineServiceSplitCreate(d *schema.ResourceData, meta interface{}) error {
+	// Every service has a split, so creating one means updating the service.
+	id, err := replaceVars(d, meta.(*Config), "apps/{{project}}/services/{{service}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	if err := resourceAppEngineServiceSplitPatch(d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		d.SetId("")
+		return fmt.Errorf("Error creating ServiceSplit: %s", err)
+	}
+
+	return resourceAppEngineServiceSplitRead(d, meta)
+}
+
+func resourceAppEngineServiceSplitRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{AppEngineBasePath}}apps/{{project}}/services/{{service}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("AppEngineServiceSplit %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading ServiceSplit: %s", err)
+	}
+	if err := d.Set("split", flattenAppEngineServiceSplitSplit(res["split"])); err != nil {
+		return fmt.Errorf("Error reading ServiceSplit: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAppEngineServiceSplitUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceAppEngineServiceSplitPatch(d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("Error updating ServiceSplit %q: %s", d.Id(), err)
+	}
+
+	return resourceAppEngineServiceSplitRead(d, meta)
+}
+
+func resourceAppEngineServiceSplitDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARNING] AppEngine ServiceSplit resources"+
+		" cannot be deleted from GCP. The resource %s will be removed from Terraform"+
+		" state, but will still be present on the server.", d.Id())
+	d.SetId("")
+
+	return nil
+}
+
+func resourceAppEngineServiceSplitImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"apps/(?P<project>[^/]+)/services/(?P<service>[^/]+)",
+		"(?P<project>[^/]+)/(?P<service>[^/]+)",
+		"(?P<service>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "apps/{{project}}/services/{{service}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAppEngineServiceSplitPatch(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	obj := map[string]interface{}{
+		"split": expandAppEngineServiceSplitSplit(d.Get("split").([]interface{})),
+	}
+
+	url, err := replaceVars(d, config, "{{AppEngineBasePath}}apps/{{project}}/services/{{service}}")
+	if err != nil {
+		return err
+	}
+	url, err = addQueryParams(url, map[string]string{
+		"updateMask":     "split",
+		"migrateTraffic": strconv.FormatBool(d.Get("migrate_traffic").(bool)),
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating ServiceSplit %q: %#v", d.Id(), obj)
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, timeout)
+	if err != nil {
+		return err
+	}
+
+	op := &appengine.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
+	return appEngineOperationWaitTime(config.clientAppEngine, op, project, "Updating ServiceSplit", int(timeout.Minutes()))
+}
+
+func expandAppEngineServiceSplitSplit(l []interface{}) map[string]interface{} {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	raw := l[0].(map[string]interface{})
+
+	allocations := make(map[string]interface{})
+	for version, v := range raw["allocations"].(map[string]interface{}) {
+		// The values were checked by validateAppEngineServiceSplitAllocations
+		allocation, _ := strconv.ParseFloat(v.(string), 64)
+		allocations[version] = allocation
+	}
+
+	transformed := map[string]interface{}{
+		"allocations": allocations,
+	}
+	if v := raw["shard_by"].(string); v != "" {
+		transformed["shardBy"] = v
+	}
+	return transformed
+}
+
+func flattenAppEngineServiceSplitSplit(v interface{}) interface{} {
+	original, ok := v.(map[string]interface{})
+	if !ok || len(original) == 0 {
+		return nil
+	}
+
+	allocations := make(map[string]interface{})
+	if m, ok := original["allocations"].(map[string]interface{}); ok {
+		for version, raw := range m {
+			if allocation, ok := raw.(float64); ok {
+				allocations[version] = strconv.FormatFloat(allocation, 'f', -1, 64)
+			}
+		}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"allocations": allocations,
+			"shard_by":    original["shardBy"],
+		},
+	}
+}
@@ -0,0 +1,160 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestValidateAppEngineServiceSplitAllocations(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Allocations map[string]interface{}
+		ExpectError bool
+	}{
+		"single version": {
+			Allocations: map[string]interface{}{"v1": "1"},
+		},
+		"80/20": {
+			Allocations: map[string]interface{}{"v1": "0.8", "v2": "0.2"},
+		},
+		"three versions": {
+			Allocations: map[string]interface{}{"v1": "0.333", "v2": "0.333", "v3": "0.334"},
+		},
+		"sum below 1": {
+			Allocations: map[string]interface{}{"v1": "0.5", "v2": "0.2"},
+			ExpectError: true,
+		},
+		"sum above 1": {
+			Allocations: map[string]interface{}{"v1": "0.9", "v2": "0.2"},
+			ExpectError: true,
+		},
+		"zero allocation": {
+			Allocations: map[string]interface{}{"v1": "1", "v2": "0"},
+			ExpectError: true,
+		},
+		"not a number": {
+			Allocations: map[string]interface{}{"v1": "all"},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		_, errs := validateAppEngineServiceSplitAllocations(tc.Allocations, "split.0.allocations")
+		if tc.ExpectError && len(errs) == 0 {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.ExpectError && len(errs) > 0 {
+			t.Errorf("%s: unexpected errors: %v", tn, errs)
+		}
+	}
+}
+
+func TestAccAppEngineServiceSplit_split(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"org_id":          getTestOrgFromEnv(t),
+		"billing_account": getTestBillingAccountFromEnv(t),
+		"random_suffix":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppEngineServiceSplit_split(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_app_engine_service_split.split", "split.0.allocations.v1", "0.8"),
+					resource.TestCheckResourceAttr("google_app_engine_service_split.split", "split.0.allocations.v2", "0.2"),
+				),
+			},
+			{
+				ResourceName:            "google_app_engine_service_split.split",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"migrate_traffic"},
+			},
+		},
+	})
+}
+
+func testAccAppEngineServiceSplit_split(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_project" "my_project" {
+  name            = "tf-test-appeng-split"
+  project_id      = "tf-test-%{random_suffix}"
+  org_id          = "%{org_id}"
+  billing_account = "%{billing_account}"
+}
+
+resource "google_project_service" "flex" {
+  project = google_project.my_project.project_id
+  service = "appengineflex.googleapis.com"
+
+  disable_dependent_services = false
+}
+
+resource "google_app_engine_application" "app" {
+  project     = google_project.my_project.project_id
+  location_id = "us-central"
+}
+
+resource "google_app_engine_flexible_app_version" "v1" {
+  project    = google_app_engine_application.app.project
+  service    = "default"
+  version_id = "v1"
+  runtime    = "custom"
+
+  deployment {
+    container {
+      image = "gcr.io/google-samples/hello-app:1.0"
+    }
+  }
+
+  manual_scaling {
+    instances = 1
+  }
+
+  noop_on_destroy = true
+
+  depends_on = [google_project_service.flex]
+}
+
+resource "google_app_engine_flexible_app_version" "v2" {
+  project    = google_app_engine_flexible_app_version.v1.project
+  service    = "default"
+  version_id = "v2"
+  runtime    = "custom"
+
+  deployment {
+    container {
+      image = "gcr.io/google-samples/hello-app:2.0"
+    }
+  }
+
+  manual_scaling {
+    instances = 1
+  }
+
+  noop_on_destroy = true
+}
+
+resource "google_app_engine_service_split" "split" {
+  project = google_app_engine_flexible_app_version.v2.project
+  service = "default"
+
+  split {
+    shard_by = "IP"
+
+    allocations = {
+      (google_app_engine_flexible_app_version.v1.version_id) = 0.8
+      (google_app_engine_flexible_app_version.v2.version_id) = 0.2
+    }
+  }
+}
+`, context)
+}
@@ -0,0 +1,429 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceBackupDRBackupVault() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBackupDRBackupVaultCreate,
+		Read:   resourceBackupDRBackupVaultRead,
+		Update: resourceBackupDRBackupVaultUpdate,
+		Delete: resourceBackupDRBackupVaultDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceBackupDRBackupVaultImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backup_minimum_enforced_retention_duration": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateSecondsDurationBetween(24*time.Hour, 3122064000*time.Second),
+			},
+			"backup_vault_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"access_restriction": {
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"WITHIN_PROJECT", "WITHIN_ORGANIZATION", "UNRESTRICTED", "WITHIN_ORG_BUT_UNRESTRICTED_FOR_BA", ""}, false),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"backup_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deletable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_account": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"total_stored_bytes": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"uid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceBackupDRBackupVaultCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandBackupDRBackupVaultDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	backupMinimumEnforcedRetentionDurationProp, err := expandBackupDRBackupVaultBackupMinimumEnforcedRetentionDuration(d.Get("backup_minimum_enforced_retention_duration"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("backup_minimum_enforced_retention_duration"); !isEmptyValue(reflect.ValueOf(backupMinimumEnforcedRetentionDurationProp)) && (ok || !reflect.DeepEqual(v, backupMinimumEnforcedRetentionDurationProp)) {
+		obj["backupMinimumEnforcedRetentionDuration"] = backupMinimumEnforcedRetentionDurationProp
+	}
+	accessRestrictionProp, err := expandBackupDRBackupVaultAccessRestriction(d.Get("access_restriction"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("access_restriction"); !isEmptyValue(reflect.ValueOf(accessRestrictionProp)) && (ok || !reflect.DeepEqual(v, accessRestrictionProp)) {
+		obj["accessRestriction"] = accessRestrictionProp
+	}
+
+	url, err := replaceVars(d, config, "{{BackupDRBasePath}}projects/{{project}}/locations/{{location}}/backupVaults?backupVaultId={{backup_vault_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new BackupVault: %#v", obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating BackupVault: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/backupVaults/{{backup_vault_id}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	waitErr := backupDROperationWaitTime(
+		config, res, project, "Creating BackupVault",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create BackupVault: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating BackupVault %q: %#v", d.Id(), res)
+
+	return resourceBackupDRBackupVaultRead(d, meta)
+}
+
+func resourceBackupDRBackupVaultRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{BackupDRBasePath}}projects/{{project}}/locations/{{location}}/backupVaults/{{backup_vault_id}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("BackupDRBackupVault %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading BackupVault: %s", err)
+	}
+
+	if err := d.Set("name", flattenBackupDRBackupVaultName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading BackupVault: %s", err)
+	}
+	if err := d.Set("description", flattenBackupDRBackupVaultDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading BackupVault: %s", err)
+	}
+	if err := d.Set("create_time", flattenBackupDRBackupVaultCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading BackupVault: %s", err)
+	}
+	if err := d.Set("update_time", flattenBackupDRBackupVaultUpdateTime(res["updateTime"], d)); err != nil {
+		return fmt.Errorf("Error reading BackupVault: %s", err)
+	}
+	if err := d.Set("backup_minimum_enforced_retention_duration", flattenBackupDRBackupVaultBackupMinimumEnforcedRetentionDuration(res["backupMinimumEnforcedRetentionDuration"], d)); err != nil {
+		return fmt.Errorf("Error reading BackupVault: %s", err)
+	}
+	if err := d.Set("deletable", flattenBackupDRBackupVaultDeletable(res["deletable"], d)); err != nil {
+		return fmt.Errorf("Error reading BackupVault: %s", err)
+	}
+	if err := d.Set("etag", flattenBackupDRBackupVaultEtag(res["etag"], d)); err != nil {
+		return fmt.Errorf("Error reading BackupVault: %s", err)
+	}
+	if err := d.Set("state", flattenBackupDRBackupVaultState(res["state"], d)); err != nil {
+		return fmt.Errorf("Error reading BackupVault: %s", err)
+	}
+	if err := d.Set("backup_count", flattenBackupDRBackupVaultBackupCount(res["backupCount"], d)); err != nil {
+		return fmt.Errorf("Error reading BackupVault: %s", err)
+	}
+	if err := d.Set("service_account", flattenBackupDRBackupVaultServiceAccount(res["serviceAccount"], d)); err != nil {
+		return fmt.Errorf("Error reading BackupVault: %s", err)
+	}
+	if err := d.Set("total_stored_bytes", flattenBackupDRBackupVaultTotalStoredBytes(res["totalStoredBytes"], d)); err != nil {
+		return fmt.Errorf("Error reading BackupVault: %s", err)
+	}
+	if err := d.Set("uid", flattenBackupDRBackupVaultUid(res["uid"], d)); err != nil {
+		return fmt.Errorf("Error reading BackupVault: %s", err)
+	}
+	if err := d.Set("access_restriction", flattenBackupDRBackupVaultAccessRestriction(res["accessRestriction"], d)); err != nil {
+		return fmt.Errorf("Error reading BackupVault: %s", err)
+	}
+
+	return nil
+}
+
+func resourceBackupDRBackupVaultUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandBackupDRBackupVaultDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	backupMinimumEnforcedRetentionDurationProp, err := expandBackupDRBackupVaultBackupMinimumEnforcedRetentionDuration(d.Get("backup_minimum_enforced_retention_duration"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("backup_minimum_enforced_retention_duration"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, backupMinimumEnforcedRetentionDurationProp)) {
+		obj["backupMinimumEnforcedRetentionDuration"] = backupMinimumEnforcedRetentionDurationProp
+	}
+
+	url, err := replaceVars(d, config, "{{BackupDRBasePath}}projects/{{project}}/locations/{{location}}/backupVaults/{{backup_vault_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating BackupVault %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("description") {
+		updateMask = append(updateMask, "description")
+	}
+
+	if d.HasChange("backup_minimum_enforced_retention_duration") {
+		updateMask = append(updateMask, "backupMinimumEnforcedRetentionDuration")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating BackupVault %q: %s", d.Id(), err)
+	}
+
+	err = backupDROperationWaitTime(
+		config, res, project, "Updating BackupVault",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceBackupDRBackupVaultRead(d, meta)
+}
+
+func resourceBackupDRBackupVaultDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{BackupDRBasePath}}projects/{{project}}/locations/{{location}}/backupVaults/{{backup_vault_id}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting BackupVault %q", d.Id())
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "BackupVault")
+	}
+
+	err = backupDROperationWaitTime(
+		config, res, project, "Deleting BackupVault",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting BackupVault %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceBackupDRBackupVaultImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/backupVaults/(?P<backup_vault_id>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<backup_vault_id>[^/]+)",
+		"(?P<location>[^/]+)/(?P<backup_vault_id>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/backupVaults/{{backup_vault_id}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenBackupDRBackupVaultName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBackupDRBackupVaultDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBackupDRBackupVaultCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBackupDRBackupVaultUpdateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBackupDRBackupVaultBackupMinimumEnforcedRetentionDuration(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBackupDRBackupVaultDeletable(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBackupDRBackupVaultEtag(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBackupDRBackupVaultState(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBackupDRBackupVaultBackupCount(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenBackupDRBackupVaultServiceAccount(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBackupDRBackupVaultTotalStoredBytes(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBackupDRBackupVaultUid(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBackupDRBackupVaultAccessRestriction(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandBackupDRBackupVaultDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBackupDRBackupVaultBackupMinimumEnforcedRetentionDuration(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBackupDRBackupVaultAccessRestriction(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,90 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccBackupDRBackupVault_minimumRetention(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+		"retention":     "100000s",
+		"description":   "Backup vault created by Terraform",
+	}
+	updated := map[string]interface{}{
+		"random_suffix": context["random_suffix"],
+		"retention":     "200000s",
+		"description":   "Updated backup vault",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBackupDRBackupVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBackupDRBackupVault_minimumRetention(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_backup_dr_backup_vault.vault", "backup_minimum_enforced_retention_duration", "100000s"),
+					resource.TestCheckResourceAttrSet("google_backup_dr_backup_vault.vault", "service_account"),
+				),
+			},
+			{
+				ResourceName:      "google_backup_dr_backup_vault.vault",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBackupDRBackupVault_minimumRetention(updated),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_backup_dr_backup_vault.vault", "backup_minimum_enforced_retention_duration", "200000s"),
+				),
+			},
+			{
+				ResourceName:      "google_backup_dr_backup_vault.vault",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccBackupDRBackupVault_minimumRetention(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_backup_dr_backup_vault" "vault" {
+  location                                   = "us-central1"
+  backup_vault_id                            = "tf-test-vault-%{random_suffix}"
+  description                                = "%{description}"
+  backup_minimum_enforced_retention_duration = "%{retention}"
+  access_restriction                         = "WITHIN_ORGANIZATION"
+}
+`, context)
+}
+
+func testAccCheckBackupDRBackupVaultDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_backup_dr_backup_vault" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url := fmt.Sprintf("%s%s", config.BackupDRBasePath, rs.Primary.ID)
+		_, err := sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("BackupDRBackupVault still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,391 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceBackupDRManagementServer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBackupDRManagementServerCreate,
+		Read:   resourceBackupDRManagementServerRead,
+		Delete: resourceBackupDRManagementServerDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceBackupDRManagementServerImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(120 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"networks": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"network": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: compareSelfLinkOrResourceName,
+						},
+						"peering_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"PRIVATE_SERVICE_ACCESS", ""}, false),
+							Default:      "PRIVATE_SERVICE_ACCESS",
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"BACKUP_RESTORE", ""}, false),
+				Default:      "BACKUP_RESTORE",
+			},
+			"management_uri": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"web_ui": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"oauth2_client_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceBackupDRManagementServerCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandBackupDRManagementServerDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	typeProp, err := expandBackupDRManagementServerType(d.Get("type"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("type"); !isEmptyValue(reflect.ValueOf(typeProp)) && (ok || !reflect.DeepEqual(v, typeProp)) {
+		obj["type"] = typeProp
+	}
+	networksProp, err := expandBackupDRManagementServerNetworks(d.Get("networks"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("networks"); !isEmptyValue(reflect.ValueOf(networksProp)) && (ok || !reflect.DeepEqual(v, networksProp)) {
+		obj["networks"] = networksProp
+	}
+
+	url, err := replaceVars(d, config, "{{BackupDRBasePath}}projects/{{project}}/locations/{{location}}/managementServers?managementServerId={{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new ManagementServer: %#v", obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating ManagementServer: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/managementServers/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	waitErr := backupDROperationWaitTime(
+		config, res, project, "Creating ManagementServer",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create ManagementServer: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating ManagementServer %q: %#v", d.Id(), res)
+
+	return resourceBackupDRManagementServerRead(d, meta)
+}
+
+func resourceBackupDRManagementServerRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{BackupDRBasePath}}projects/{{project}}/locations/{{location}}/managementServers/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("BackupDRManagementServer %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading ManagementServer: %s", err)
+	}
+
+	if err := d.Set("description", flattenBackupDRManagementServerDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading ManagementServer: %s", err)
+	}
+	if err := d.Set("type", flattenBackupDRManagementServerType(res["type"], d)); err != nil {
+		return fmt.Errorf("Error reading ManagementServer: %s", err)
+	}
+	if err := d.Set("management_uri", flattenBackupDRManagementServerManagementUri(res["managementUri"], d)); err != nil {
+		return fmt.Errorf("Error reading ManagementServer: %s", err)
+	}
+	if err := d.Set("networks", flattenBackupDRManagementServerNetworks(res["networks"], d)); err != nil {
+		return fmt.Errorf("Error reading ManagementServer: %s", err)
+	}
+	if err := d.Set("oauth2_client_id", flattenBackupDRManagementServerOauth2ClientId(res["oauth2ClientId"], d)); err != nil {
+		return fmt.Errorf("Error reading ManagementServer: %s", err)
+	}
+	if err := d.Set("state", flattenBackupDRManagementServerState(res["state"], d)); err != nil {
+		return fmt.Errorf("Error reading ManagementServer: %s", err)
+	}
+
+	return nil
+}
+
+func resourceBackupDRManagementServerDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{BackupDRBasePath}}projects/{{project}}/locations/{{location}}/managementServers/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting ManagementServer %q", d.Id())
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "ManagementServer")
+	}
+
+	err = backupDROperationWaitTime(
+		config, res, project, "Deleting ManagementServer",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting ManagementServer %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceBackupDRManagementServerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/managementServers/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/managementServers/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenBackupDRManagementServerDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBackupDRManagementServerType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBackupDRManagementServerManagementUri(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["web_ui"] =
+		flattenBackupDRManagementServerManagementUriWebUi(original["webUi"], d)
+	transformed["api"] =
+		flattenBackupDRManagementServerManagementUriApi(original["api"], d)
+	return []interface{}{transformed}
+}
+func flattenBackupDRManagementServerManagementUriWebUi(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBackupDRManagementServerManagementUriApi(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBackupDRManagementServerNetworks(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"network":      flattenBackupDRManagementServerNetworksNetwork(original["network"], d),
+			"peering_mode": flattenBackupDRManagementServerNetworksPeeringMode(original["peeringMode"], d),
+		})
+	}
+	return transformed
+}
+func flattenBackupDRManagementServerNetworksNetwork(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBackupDRManagementServerNetworksPeeringMode(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBackupDRManagementServerOauth2ClientId(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBackupDRManagementServerState(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandBackupDRManagementServerDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBackupDRManagementServerType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBackupDRManagementServerNetworks(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedNetwork, err := expandBackupDRManagementServerNetworksNetwork(original["network"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedNetwork); val.IsValid() && !isEmptyValue(val) {
+			transformed["network"] = transformedNetwork
+		}
+
+		transformedPeeringMode, err := expandBackupDRManagementServerNetworksPeeringMode(original["peering_mode"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedPeeringMode); val.IsValid() && !isEmptyValue(val) {
+			transformed["peeringMode"] = transformedPeeringMode
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandBackupDRManagementServerNetworksNetwork(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	f, err := parseGlobalFieldValue("networks", v.(string), "project", d, config, true)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid value for network: %s", err)
+	}
+	return f.RelativeLink(), nil
+}
+
+func expandBackupDRManagementServerNetworksPeeringMode(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
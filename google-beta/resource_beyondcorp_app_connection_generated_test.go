@@ -0,0 +1,117 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccBeyondcorpAppConnection_beyondcorpAppConnectionBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBeyondcorpAppConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBeyondcorpAppConnection_beyondcorpAppConnectionBasicExample(context),
+			},
+			{
+				ResourceName:      "google_beyondcorp_app_connection.app_connection",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccBeyondcorpAppConnection_beyondcorpAppConnectionBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_service_account" "service_account" {
+  account_id   = "tf-test-my-account%{random_suffix}"
+  display_name = "Test Service Account"
+}
+
+resource "google_beyondcorp_app_connector" "app_connector" {
+  name   = "tf-test-my-app-connector%{random_suffix}"
+  region = "us-central1"
+
+  principal_info {
+    service_account {
+      email = "${google_service_account.service_account.email}"
+    }
+  }
+}
+
+resource "google_beyondcorp_app_gateway" "app_gateway" {
+  name      = "tf-test-my-app-gateway%{random_suffix}"
+  type      = "TCP_PROXY"
+  region    = "us-central1"
+  host_type = "GCP_REGIONAL_MIG"
+}
+
+resource "google_beyondcorp_app_connection" "app_connection" {
+  name       = "tf-test-my-app-connection%{random_suffix}"
+  type       = "TCP_PROXY"
+  region     = "us-central1"
+  connectors = ["${google_beyondcorp_app_connector.app_connector.id}"]
+
+  application_endpoint {
+    host = "foo-host"
+    port = 8080
+  }
+
+  gateway {
+    app_gateway = "${google_beyondcorp_app_gateway.app_gateway.id}"
+  }
+}
+`, context)
+}
+
+func testAccCheckBeyondcorpAppConnectionDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_beyondcorp_app_connection" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(config, rs, "{{BeyondcorpBasePath}}projects/{{project}}/locations/{{region}}/appConnections/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("BeyondcorpAppConnection still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package google
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccBeyondcorpAppConnector_withAppGateway(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBeyondcorpAppConnectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBeyondcorpAppConnector_withAppGateway(suffix, "Connector"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("google_beyondcorp_app_gateway.app_gateway", "uri"),
+				),
+			},
+			{
+				ResourceName:      "google_beyondcorp_app_connector.app_connector",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBeyondcorpAppConnector_withAppGateway(suffix, "Updated connector"),
+			},
+			{
+				ResourceName:      "google_beyondcorp_app_connector.app_connector",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBeyondcorpAppConnector_invalidPrincipal(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBeyondcorpAppConnector_principal("user@example.com"),
+				ExpectError: regexp.MustCompile("principal_info.0.service_account.0.email"),
+			},
+		},
+	})
+}
+
+func testAccBeyondcorpAppConnector_withAppGateway(suffix, displayName string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "service_account" {
+  account_id   = "tf-test-%s"
+  display_name = "Test Service Account"
+}
+
+resource "google_beyondcorp_app_gateway" "app_gateway" {
+  name      = "tf-test-gateway-%s"
+  region    = "us-central1"
+  type      = "TCP_PROXY"
+  host_type = "GCP_REGIONAL_MIG"
+}
+
+resource "google_beyondcorp_app_connector" "app_connector" {
+  name         = "tf-test-connector-%s"
+  region       = "us-central1"
+  display_name = "%s"
+
+  principal_info {
+    service_account {
+      email = "${google_service_account.service_account.email}"
+    }
+  }
+}
+`, suffix, suffix, suffix, displayName)
+}
+
+func testAccBeyondcorpAppConnector_principal(email string) string {
+	return fmt.Sprintf(`
+resource "google_beyondcorp_app_connector" "app_connector" {
+  name   = "tf-test-connector"
+  region = "us-central1"
+
+  principal_info {
+    service_account {
+      email = "%s"
+    }
+  }
+}
+`, email)
+}
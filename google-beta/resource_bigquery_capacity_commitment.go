@@ -0,0 +1,375 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceBigqueryReservationCapacityCommitment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigqueryReservationCapacityCommitmentCreate,
+		Read:   resourceBigqueryReservationCapacityCommitmentRead,
+		Update: resourceBigqueryReservationCapacityCommitmentUpdate,
+		Delete: resourceBigqueryReservationCapacityCommitmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceBigqueryReservationCapacityCommitmentImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Update: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"plan": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"FLEX", "MONTHLY", "ANNUAL"}, false),
+			},
+			"slot_count": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"capacity_commitment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Optional: true,
+				ForceNew: true,
+			},
+			"edition": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"STANDARD", "ENTERPRISE", "ENTERPRISE_PLUS", ""}, false),
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "US",
+			},
+			"renewal_plan": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"FLEX", "MONTHLY", "ANNUAL", ""}, false),
+			},
+			"commitment_end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"commitment_start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceBigqueryReservationCapacityCommitmentCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	slotCountProp, err := expandBigqueryReservationCapacityCommitmentSlotCount(d.Get("slot_count"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("slot_count"); !isEmptyValue(reflect.ValueOf(slotCountProp)) && (ok || !reflect.DeepEqual(v, slotCountProp)) {
+		obj["slotCount"] = slotCountProp
+	}
+	planProp, err := expandBigqueryReservationCapacityCommitmentPlan(d.Get("plan"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("plan"); !isEmptyValue(reflect.ValueOf(planProp)) && (ok || !reflect.DeepEqual(v, planProp)) {
+		obj["plan"] = planProp
+	}
+	editionProp, err := expandBigqueryReservationCapacityCommitmentEdition(d.Get("edition"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("edition"); !isEmptyValue(reflect.ValueOf(editionProp)) && (ok || !reflect.DeepEqual(v, editionProp)) {
+		obj["edition"] = editionProp
+	}
+	renewalPlanProp, err := expandBigqueryReservationCapacityCommitmentRenewalPlan(d.Get("renewal_plan"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("renewal_plan"); !isEmptyValue(reflect.ValueOf(renewalPlanProp)) && (ok || !reflect.DeepEqual(v, renewalPlanProp)) {
+		obj["renewalPlan"] = renewalPlanProp
+	}
+
+	url, err := replaceVars(d, config, "{{BigqueryReservationBasePath}}projects/{{project}}/locations/{{location}}/capacityCommitments?capacityCommitmentId={{capacity_commitment_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new CapacityCommitment: %#v", obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating CapacityCommitment: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/capacityCommitments/{{capacity_commitment_id}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating CapacityCommitment %q: %#v", d.Id(), res)
+
+	// `name` is autogenerated from the api so needs to be set post-create
+	name, ok := res["name"]
+	if !ok {
+		return fmt.Errorf("Create response didn't contain critical fields. Create may not have succeeded.")
+	}
+	d.Set("capacity_commitment_id", GetResourceNameFromSelfLink(name.(string)))
+	// Store the ID now that we have the capacity commitment id
+	id, err = replaceVars(d, config, "projects/{{project}}/locations/{{location}}/capacityCommitments/{{capacity_commitment_id}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return resourceBigqueryReservationCapacityCommitmentRead(d, meta)
+}
+
+func resourceBigqueryReservationCapacityCommitmentRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{BigqueryReservationBasePath}}projects/{{project}}/locations/{{location}}/capacityCommitments/{{capacity_commitment_id}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("BigqueryReservationCapacityCommitment %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading CapacityCommitment: %s", err)
+	}
+
+	if err := d.Set("name", flattenBigqueryReservationCapacityCommitmentName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading CapacityCommitment: %s", err)
+	}
+	if err := d.Set("slot_count", flattenBigqueryReservationCapacityCommitmentSlotCount(res["slotCount"], d)); err != nil {
+		return fmt.Errorf("Error reading CapacityCommitment: %s", err)
+	}
+	if err := d.Set("plan", flattenBigqueryReservationCapacityCommitmentPlan(res["plan"], d)); err != nil {
+		return fmt.Errorf("Error reading CapacityCommitment: %s", err)
+	}
+	if err := d.Set("edition", flattenBigqueryReservationCapacityCommitmentEdition(res["edition"], d)); err != nil {
+		return fmt.Errorf("Error reading CapacityCommitment: %s", err)
+	}
+	if err := d.Set("renewal_plan", flattenBigqueryReservationCapacityCommitmentRenewalPlan(res["renewalPlan"], d)); err != nil {
+		return fmt.Errorf("Error reading CapacityCommitment: %s", err)
+	}
+	if err := d.Set("state", flattenBigqueryReservationCapacityCommitmentState(res["state"], d)); err != nil {
+		return fmt.Errorf("Error reading CapacityCommitment: %s", err)
+	}
+	if err := d.Set("commitment_start_time", flattenBigqueryReservationCapacityCommitmentCommitmentStartTime(res["commitmentStartTime"], d)); err != nil {
+		return fmt.Errorf("Error reading CapacityCommitment: %s", err)
+	}
+	if err := d.Set("commitment_end_time", flattenBigqueryReservationCapacityCommitmentCommitmentEndTime(res["commitmentEndTime"], d)); err != nil {
+		return fmt.Errorf("Error reading CapacityCommitment: %s", err)
+	}
+
+	return nil
+}
+
+func resourceBigqueryReservationCapacityCommitmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	renewalPlanProp, err := expandBigqueryReservationCapacityCommitmentRenewalPlan(d.Get("renewal_plan"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("renewal_plan"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, renewalPlanProp)) {
+		obj["renewalPlan"] = renewalPlanProp
+	}
+
+	url, err := replaceVars(d, config, "{{BigqueryReservationBasePath}}projects/{{project}}/locations/{{location}}/capacityCommitments/{{capacity_commitment_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating CapacityCommitment %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("renewal_plan") {
+		updateMask = append(updateMask, "renewalPlan")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating CapacityCommitment %q: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Finished updating CapacityCommitment %q: %#v", d.Id(), res)
+
+	return resourceBigqueryReservationCapacityCommitmentRead(d, meta)
+}
+
+func resourceBigqueryReservationCapacityCommitmentDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{BigqueryReservationBasePath}}projects/{{project}}/locations/{{location}}/capacityCommitments/{{capacity_commitment_id}}")
+	if err != nil {
+		return err
+	}
+
+	// Only FLEX commitments can be deleted before the end of their term, the
+	// API error for other plans doesn't say when deletion becomes possible.
+	if plan := d.Get("plan").(string); plan != "FLEX" {
+		if end, err := time.Parse(time.RFC3339, d.Get("commitment_end_time").(string)); err == nil && time.Now().Before(end) {
+			return fmt.Errorf("CapacityCommitment %q with plan %s can't be deleted before the end of its commitment at %s", d.Id(), plan, d.Get("commitment_end_time").(string))
+		}
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting CapacityCommitment %q", d.Id())
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "CapacityCommitment")
+	}
+
+	log.Printf("[DEBUG] Finished deleting CapacityCommitment %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceBigqueryReservationCapacityCommitmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/capacityCommitments/(?P<capacity_commitment_id>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<capacity_commitment_id>[^/]+)",
+		"(?P<location>[^/]+)/(?P<capacity_commitment_id>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/capacityCommitments/{{capacity_commitment_id}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenBigqueryReservationCapacityCommitmentName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBigqueryReservationCapacityCommitmentSlotCount(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenBigqueryReservationCapacityCommitmentPlan(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBigqueryReservationCapacityCommitmentEdition(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBigqueryReservationCapacityCommitmentRenewalPlan(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBigqueryReservationCapacityCommitmentState(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBigqueryReservationCapacityCommitmentCommitmentStartTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBigqueryReservationCapacityCommitmentCommitmentEndTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandBigqueryReservationCapacityCommitmentSlotCount(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBigqueryReservationCapacityCommitmentPlan(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBigqueryReservationCapacityCommitmentEdition(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBigqueryReservationCapacityCommitmentRenewalPlan(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
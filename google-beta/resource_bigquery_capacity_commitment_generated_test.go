@@ -0,0 +1,88 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccBigqueryReservationCapacityCommitment_bigqueryReservationCapacityCommitmentFlexExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBigqueryReservationCapacityCommitmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigqueryReservationCapacityCommitment_bigqueryReservationCapacityCommitmentFlexExample(context),
+			},
+			{
+				ResourceName:            "google_bigquery_capacity_commitment.commitment",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"location"},
+			},
+		},
+	})
+}
+
+func testAccBigqueryReservationCapacityCommitment_bigqueryReservationCapacityCommitmentFlexExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_bigquery_capacity_commitment" "commitment" {
+  capacity_commitment_id = "tf-test-capacity-commitment-%{random_suffix}"
+
+  location   = "us-west2"
+  slot_count = 100
+  plan       = "FLEX"
+  edition    = "ENTERPRISE"
+}
+`, context)
+}
+
+func testAccCheckBigqueryReservationCapacityCommitmentDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_bigquery_capacity_commitment" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(config, rs, "{{BigqueryReservationBasePath}}projects/{{project}}/locations/{{location}}/capacityCommitments/{{capacity_commitment_id}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("BigqueryReservationCapacityCommitment still exists at %s", url)
+		}
+	}
+
+	return nil
+}
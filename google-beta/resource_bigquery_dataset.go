@@ -20,6 +20,8 @@ func resourceBigQueryDataset() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setLabelsDiff,
+
 		Schema: map[string]*schema.Schema{
 			// DatasetId: [Required] A unique ID for this dataset, without the
 			// project name. The ID must contain only letters (a-z, A-Z), numbers
@@ -125,6 +127,18 @@ func resourceBigQueryDataset() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"effective_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			// Access: [Optional] An array of objects that define dataset access
 			// for one or more entities. You can set this property when inserting
 			// or updating a dataset in order to control who is allowed to access
@@ -257,7 +271,7 @@ func resourceDataset(d *schema.ResourceData, meta interface{}) (*bigquery.Datase
 		dataset.DefaultTableExpirationMs = int64(v.(int))
 	}
 
-	if v, ok := d.GetOk("labels"); ok {
+	if v, ok := d.GetOk("terraform_labels"); ok {
 		labels := map[string]string{}
 
 		for k, v := range v.(map[string]interface{}) {
@@ -357,7 +371,9 @@ func resourceBigQueryDatasetRead(d *schema.ResourceData, meta interface{}) error
 
 	d.Set("project", id.Project)
 	d.Set("etag", res.Etag)
-	d.Set("labels", res.Labels)
+	if err := setLabelsFields(d, config, res.Labels); err != nil {
+		return err
+	}
 	if err := d.Set("access", flattenAccess(res.Access)); err != nil {
 		return err
 	}
@@ -0,0 +1,214 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceBigqueryReservationAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigqueryReservationAssignmentCreate,
+		Read:   resourceBigqueryReservationAssignmentRead,
+		Delete: resourceBigqueryReservationAssignmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceBigqueryReservationAssignmentImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"assignee": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"job_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"PIPELINE", "QUERY", "ML_EXTERNAL", "BACKGROUND"}, false),
+			},
+
+			"reservation": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+
+			"location": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// bigqueryReservationAssignmentParent returns the reservation an assignment
+// belongs to, `reservation` may either be a short name or a full reservation
+// name.
+func bigqueryReservationAssignmentParent(d *schema.ResourceData, config *Config) (string, error) {
+	reservation := d.Get("reservation").(string)
+	if strings.HasPrefix(reservation, "projects/") {
+		return reservation, nil
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return "", err
+	}
+	location := d.Get("location").(string)
+	if location == "" {
+		location = "US"
+	}
+	return fmt.Sprintf("projects/%s/locations/%s/reservations/%s", project, location, reservation), nil
+}
+
+func resourceBigqueryReservationAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	parent, err := bigqueryReservationAssignmentParent(d, config)
+	if err != nil {
+		return err
+	}
+
+	obj := map[string]interface{}{
+		"assignee": d.Get("assignee").(string),
+		"jobType":  d.Get("job_type").(string),
+	}
+
+	log.Printf("[DEBUG] Creating new ReservationAssignment: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", "", config.BigqueryReservationBasePath+parent+"/assignments", obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating ReservationAssignment: %s", err)
+	}
+
+	// `name` is autogenerated from the api so needs to be set post-create
+	name, ok := res["name"]
+	if !ok {
+		return fmt.Errorf("Create response didn't contain critical fields. Create may not have succeeded.")
+	}
+	d.SetId(name.(string))
+
+	log.Printf("[DEBUG] Finished creating ReservationAssignment %q: %#v", d.Id(), res)
+
+	return resourceBigqueryReservationAssignmentRead(d, meta)
+}
+
+func resourceBigqueryReservationAssignmentRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	// There's no get method for assignments, so the assignment is looked up in
+	// the list of its reservation's assignments.
+	parent := d.Id()[:strings.LastIndex(d.Id(), "/assignments/")]
+	url := config.BigqueryReservationBasePath + parent + "/assignments"
+
+	var assignment map[string]interface{}
+	pageToken := ""
+	for assignment == nil {
+		u := url
+		if pageToken != "" {
+			var err error
+			u, err = addQueryParams(url, map[string]string{"pageToken": pageToken})
+			if err != nil {
+				return err
+			}
+		}
+		res, err := sendRequest(config, "GET", "", u, nil)
+		if err != nil {
+			return handleNotFoundError(err, d, fmt.Sprintf("BigqueryReservationAssignment %q", d.Id()))
+		}
+
+		assignments, _ := res["assignments"].([]interface{})
+		for _, raw := range assignments {
+			a := raw.(map[string]interface{})
+			if a["name"] == d.Id() {
+				assignment = a
+				break
+			}
+		}
+
+		pageToken, _ = res["nextPageToken"].(string)
+		if pageToken == "" {
+			break
+		}
+	}
+	if assignment == nil {
+		log.Printf("[WARN] Removing BigqueryReservationAssignment %q because it's gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	parts := strings.Split(d.Id(), "/")
+	d.Set("project", parts[1])
+	d.Set("location", parts[3])
+	d.Set("reservation", parent)
+	d.Set("name", parts[7])
+	d.Set("assignee", assignment["assignee"])
+	d.Set("job_type", assignment["jobType"])
+	d.Set("state", assignment["state"])
+
+	return nil
+}
+
+func resourceBigqueryReservationAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] Deleting ReservationAssignment %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", "", config.BigqueryReservationBasePath+d.Id(), nil, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "ReservationAssignment")
+	}
+
+	log.Printf("[DEBUG] Finished deleting ReservationAssignment %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceBigqueryReservationAssignmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/reservations/(?P<reservation>[^/]+)/assignments/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<reservation>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<reservation>[^/]+)/(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/reservations/{{reservation}}/assignments/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
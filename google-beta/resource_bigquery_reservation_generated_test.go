@@ -0,0 +1,129 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccBigqueryReservationReservation_bigqueryReservationBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBigqueryReservationReservationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigqueryReservationReservation_bigqueryReservationBasicExample(context),
+			},
+			{
+				ResourceName:      "google_bigquery_reservation.reservation",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccBigqueryReservationReservation_bigqueryReservationBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_bigquery_reservation" "reservation" {
+  name     = "tf-test-my-reservation-%{random_suffix}"
+  location = "asia-northeast1"
+  // Set to 0 for testing purposes
+  // In reality this would be larger than zero
+  slot_capacity     = 0
+  ignore_idle_slots = false
+  concurrency       = 0
+}
+`, context)
+}
+
+func TestAccBigqueryReservationReservation_bigqueryReservationEditionExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBigqueryReservationReservationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigqueryReservationReservation_bigqueryReservationEditionExample(context),
+			},
+			{
+				ResourceName:      "google_bigquery_reservation.reservation",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccBigqueryReservationReservation_bigqueryReservationEditionExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_bigquery_reservation" "reservation" {
+  name              = "tf-test-my-reservation-%{random_suffix}"
+  location          = "us-west2"
+  slot_capacity     = 0
+  edition           = "STANDARD"
+  ignore_idle_slots = true
+  concurrency       = 0
+
+  autoscale {
+    max_slots = 100
+  }
+}
+`, context)
+}
+
+func testAccCheckBigqueryReservationReservationDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_bigquery_reservation" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(config, rs, "{{BigqueryReservationBasePath}}projects/{{project}}/locations/{{location}}/reservations/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("BigqueryReservationReservation still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package google
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccBigqueryReservation_withAssignment(t *testing.T) {
+	t.Parallel()
+
+	project := getTestProjectFromEnv()
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBigqueryReservationReservationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigqueryReservation_withAssignment(name, project),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("google_bigquery_reservation_assignment.assignment", "name"),
+				),
+			},
+			{
+				ResourceName:      "google_bigquery_reservation_assignment.assignment",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBigqueryReservation_legacySlotCapacity(t *testing.T) {
+	t.Parallel()
+
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBigqueryReservation_slotCapacity(name, 150),
+				ExpectError: regexp.MustCompile("slot_capacity must be a multiple of 100"),
+			},
+		},
+	})
+}
+
+func testAccBigqueryReservation_withAssignment(name, project string) string {
+	return fmt.Sprintf(`
+resource "google_bigquery_reservation" "reservation" {
+  name          = "%s"
+  location      = "us-central1"
+  slot_capacity = 0
+}
+
+resource "google_bigquery_reservation_assignment" "assignment" {
+  assignee    = "projects/%s"
+  job_type    = "PIPELINE"
+  reservation = "${google_bigquery_reservation.reservation.id}"
+}
+`, name, project)
+}
+
+func testAccBigqueryReservation_slotCapacity(name string, slots int) string {
+	return fmt.Sprintf(`
+resource "google_bigquery_reservation" "reservation" {
+  name          = "%s"
+  location      = "us-central1"
+  slot_capacity = %d
+}
+`, name, slots)
+}
@@ -22,6 +22,8 @@ func resourceBigQueryTable() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setLabelsDiff,
+
 		Schema: map[string]*schema.Schema{
 			// TableId: [Required] The ID of the table. The ID must contain only
 			// letters (a-z, A-Z), numbers (0-9), or underscores (_). The maximum
@@ -196,6 +198,14 @@ func resourceBigQueryTable() *schema.Resource {
 				},
 			},
 
+			// DeletionProtection: [Optional] Whether Terraform refuses to delete
+			// this table. This isn't part of the table, and is only kept in state.
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			// FriendlyName: [Optional] A descriptive name for this table.
 			"friendly_name": {
 				Type:     schema.TypeString,
@@ -215,6 +225,18 @@ func resourceBigQueryTable() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"effective_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			// Schema: [Optional] Describes the schema of this table.
 			// Schema is required for external tables in CSV and JSON formats
 			// and disallowed for Google Cloud Bigtable, Cloud Datastore backups,
@@ -408,7 +430,7 @@ func resourceTable(d *schema.ResourceData, meta interface{}) (*bigquery.Table, e
 		table.FriendlyName = v.(string)
 	}
 
-	if v, ok := d.GetOk("labels"); ok {
+	if v, ok := d.GetOk("terraform_labels"); ok {
 		labels := map[string]string{}
 
 		for k, v := range v.(map[string]interface{}) {
@@ -479,10 +501,15 @@ func resourceBigQueryTableRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("project", id.Project)
+	if _, ok := d.GetOkExists("deletion_protection"); !ok {
+		d.Set("deletion_protection", false)
+	}
 	d.Set("description", res.Description)
 	d.Set("expiration_time", res.ExpirationTime)
 	d.Set("friendly_name", res.FriendlyName)
-	d.Set("labels", res.Labels)
+	if err := setLabelsFields(d, config, res.Labels); err != nil {
+		return err
+	}
 	d.Set("creation_time", res.CreationTime)
 	d.Set("etag", res.Etag)
 	d.Set("last_modified_time", res.LastModifiedTime)
@@ -552,6 +579,10 @@ func resourceBigQueryTableUpdate(d *schema.ResourceData, meta interface{}) error
 func resourceBigQueryTableDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("Cannot delete BigQuery table %s: deletion_protection is enabled. Set deletion_protection to false for this resource and run \"terraform apply\" before attempting to delete it.", d.Id())
+	}
+
 	log.Printf("[INFO] Deleting BigQuery table: %s", d.Id())
 
 	id, err := parseBigQueryTableId(d.Id())
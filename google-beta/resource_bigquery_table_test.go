@@ -2,6 +2,7 @@ package google
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -40,6 +41,38 @@ func TestAccBigQueryTable_Basic(t *testing.T) {
 	})
 }
 
+func TestAccBigQueryTable_deletionProtection(t *testing.T) {
+	t.Parallel()
+
+	datasetID := fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+	tableID := fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBigQueryTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigQueryTableDeletionProtection(datasetID, tableID, true),
+			},
+			{
+				Config:      testAccBigQueryTableDeletionProtection(datasetID, tableID, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("deletion_protection"),
+			},
+			// Update deletion_protection to false, otherwise the test harness can't delete the table
+			{
+				Config: testAccBigQueryTableDeletionProtection(datasetID, tableID, false),
+			},
+			{
+				ResourceName:      "google_bigquery_table.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccBigQueryTable_View(t *testing.T) {
 	t.Parallel()
 
@@ -211,6 +244,20 @@ EOH
 }`, datasetID, tableID)
 }
 
+func testAccBigQueryTableDeletionProtection(datasetID, tableID string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "google_bigquery_dataset" "test" {
+  dataset_id = "%s"
+}
+
+resource "google_bigquery_table" "test" {
+  table_id            = "%s"
+  dataset_id          = "${google_bigquery_dataset.test.dataset_id}"
+  deletion_protection = %t
+}
+`, datasetID, tableID, deletionProtection)
+}
+
 func testAccBigQueryTableWithView(datasetID, tableID string) string {
 	return fmt.Sprintf(`
 resource "google_bigquery_dataset" "test" {
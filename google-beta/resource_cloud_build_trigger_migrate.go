@@ -0,0 +1,38 @@
+package google
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func resourceCloudBuildTriggerMigrateState(
+	v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	if is.Empty() {
+		log.Println("[DEBUG] Empty InstanceState; nothing to migrate.")
+		return is, nil
+	}
+
+	switch v {
+	case 0, 1:
+		log.Printf("[INFO] Found Cloud Build Trigger State v%d; migrating to v2", v)
+		return migrateCloudBuildTriggerStateV1toV2(is)
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+}
+
+// Triggers created before location was added are global, and were identified
+// by {{project}}/{{trigger_id}}.
+func migrateCloudBuildTriggerStateV1toV2(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+	log.Printf("[DEBUG] Attributes before migration: %#v", is.Attributes)
+
+	if is.Attributes["location"] == "" {
+		is.Attributes["location"] = "global"
+	}
+	is.ID = fmt.Sprintf("projects/%s/locations/%s/triggers/%s", is.Attributes["project"], is.Attributes["location"], is.Attributes["trigger_id"])
+
+	log.Printf("[DEBUG] Attributes after migration: %#v", is.Attributes)
+	return is, nil
+}
@@ -0,0 +1,81 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestCloudBuildTriggerMigrateState(t *testing.T) {
+	cases := map[string]struct {
+		StateVersion int
+		ID           string
+		Attributes   map[string]string
+		ExpectedID   string
+		Expected     map[string]string
+		Meta         interface{}
+	}{
+		"add global location": {
+			StateVersion: 1,
+			ID:           "my-project/0f2b8a1c",
+			Attributes: map[string]string{
+				"project":    "my-project",
+				"trigger_id": "0f2b8a1c",
+			},
+			ExpectedID: "projects/my-project/locations/global/triggers/0f2b8a1c",
+			Expected: map[string]string{
+				"location":   "global",
+				"trigger_id": "0f2b8a1c",
+			},
+			Meta: &Config{},
+		},
+	}
+
+	for tn, tc := range cases {
+		is := &terraform.InstanceState{
+			ID:         tc.ID,
+			Attributes: tc.Attributes,
+		}
+		is, err := resourceCloudBuildTriggerMigrateState(
+			tc.StateVersion, is, tc.Meta)
+
+		if err != nil {
+			t.Fatalf("bad: %s, err: %#v", tn, err)
+		}
+
+		if is.ID != tc.ExpectedID {
+			t.Fatalf("bad: %s\n\n expected ID: %s\n got: %s", tn, tc.ExpectedID, is.ID)
+		}
+
+		for k, v := range tc.Expected {
+			if is.Attributes[k] != v {
+				t.Fatalf(
+					"bad: %s\n\n expected: %#v -> %#v\n got: %#v -> %#v\n in: %#v",
+					tn, k, v, k, is.Attributes[k], is.Attributes)
+			}
+		}
+	}
+}
+
+func TestCloudBuildTriggerMigrateState_empty(t *testing.T) {
+	var is *terraform.InstanceState
+	var meta *Config
+
+	// should handle nil
+	is, err := resourceCloudBuildTriggerMigrateState(1, is, meta)
+
+	if err != nil {
+		t.Fatalf("err: %#v", err)
+	}
+	if is != nil {
+		t.Fatalf("expected nil instancestate, got: %#v", is)
+	}
+
+	// should handle non-nil but empty
+	is = &terraform.InstanceState{}
+	_, err = resourceCloudBuildTriggerMigrateState(1, is, meta)
+
+	if err != nil {
+		t.Fatalf("err: %#v", err)
+	}
+}
@@ -0,0 +1,389 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// cloudRunBasePath returns the base path of the regional Cloud Run endpoint
+// for a location.
+func cloudRunBasePath(config *Config, location string) string {
+	return strings.Replace(config.CloudRunBasePath, "{{location}}", location, 1)
+}
+
+func resourceCloudRunDomainMapping() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudRunDomainMappingCreate,
+		Read:   resourceCloudRunDomainMappingRead,
+		Delete: resourceCloudRunDomainMappingDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudRunDomainMappingImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(6 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The custom domain to map, e.g. "www.example.com".`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The region the domain mapping is served from. Domain mappings are only served by the regional Cloud Run endpoints, and must be in the region of the mapped service.`,
+			},
+			"spec": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: `The spec for this DomainMapping.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"route_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: `The name of the Cloud Run Service that this DomainMapping applies to.`,
+						},
+						"certificate_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"NONE", "AUTOMATIC"}, false),
+							Default:      "AUTOMATIC",
+							Description:  `The mode of the certificate.`,
+						},
+						"force_override": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `If set, the mapping will override any mapping set before this spec was set. It is recommended that the user leaves this empty to receive an error warning about a potential conflict and only set it once the respective UI has given such a warning.`,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `The current status of the DomainMapping.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_records": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `The resource records required to configure this domain mapping. These records must be added to the domain's DNS configuration in order to serve the application via this domain mapping.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Relative name of the object affected by this record. Only applicable for CNAME records. Example: 'www'.`,
+									},
+									"rrdata": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Data for this record. Values vary by record type, as defined in RFC 1035 (section 5) and RFC 1034 (section 3.6.1).`,
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Resource record type. Example: 'AAAA'.`,
+									},
+								},
+							},
+						},
+						"conditions": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `Array of observed DomainMappingConditions, indicating the current state of the DomainMapping.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Type of domain mapping condition.`,
+									},
+									"status": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Status of the condition, one of True, False, Unknown.`,
+									},
+									"reason": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `One-word CamelCase reason for the condition's current status.`,
+									},
+									"message": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Human readable message indicating details about the current status.`,
+									},
+								},
+							},
+						},
+						"mapped_route_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the route that the mapping currently points to.`,
+						},
+						"url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The URL the domain mapping serves the mapped service at.`,
+						},
+						"observed_generation": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `ObservedGeneration is the 'Generation' of the DomainMapping that was last processed by the controller.`,
+						},
+					},
+				},
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceCloudRunDomainMappingCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	obj := map[string]interface{}{
+		"apiVersion": "domains.cloudrun.com/v1",
+		"kind":       "DomainMapping",
+		"metadata": map[string]interface{}{
+			"name":      d.Get("name").(string),
+			"namespace": project,
+		},
+		"spec": expandCloudRunDomainMappingSpec(d.Get("spec")),
+	}
+
+	url, err := replaceVars(d, config, cloudRunBasePath(config, d.Get("location").(string))+"apis/domains.cloudrun.com/v1/namespaces/{{project}}/domainmappings")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new DomainMapping: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating DomainMapping: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "locations/{{location}}/namespaces/{{project}}/domainmappings/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating DomainMapping %q: %#v", d.Id(), res)
+
+	// The resource records are only known once the controller has processed
+	// the mapping. Readiness isn't waited for, as it depends on the records
+	// being added to the domain's DNS configuration.
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		res, err := sendRequest(config, "GET", project, cloudRunDomainMappingUrl(config, d), nil)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		status, _ := res["status"].(map[string]interface{})
+		if err := cloudRunDomainMappingFailure(status); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if records, _ := status["resourceRecords"].([]interface{}); len(records) == 0 {
+			return resource.RetryableError(fmt.Errorf("DomainMapping %q has no resource records yet", d.Id()))
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error waiting to create DomainMapping: %s", err)
+	}
+
+	return resourceCloudRunDomainMappingRead(d, meta)
+}
+
+func resourceCloudRunDomainMappingRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, cloudRunDomainMappingUrl(config, d), nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("CloudRunDomainMapping %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading DomainMapping: %s", err)
+	}
+	if err := d.Set("spec", flattenCloudRunDomainMappingSpec(res["spec"], d)); err != nil {
+		return fmt.Errorf("Error reading DomainMapping: %s", err)
+	}
+	if err := d.Set("status", flattenCloudRunDomainMappingStatus(res["status"])); err != nil {
+		return fmt.Errorf("Error reading DomainMapping: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCloudRunDomainMappingDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] Deleting DomainMapping %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", "", cloudRunDomainMappingUrl(config, d), nil, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "DomainMapping")
+	}
+
+	log.Printf("[DEBUG] Finished deleting DomainMapping %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceCloudRunDomainMappingImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"locations/(?P<location>[^/]+)/namespaces/(?P<project>[^/]+)/domainmappings/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<project>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "locations/{{location}}/namespaces/{{project}}/domainmappings/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func cloudRunDomainMappingUrl(config *Config, d *schema.ResourceData) string {
+	parts := strings.Split(d.Id(), "/")
+	return fmt.Sprintf("%sapis/domains.cloudrun.com/v1/namespaces/%s/domainmappings/%s", cloudRunBasePath(config, parts[1]), parts[3], parts[5])
+}
+
+// cloudRunDomainMappingFailure returns an error for the first condition of a
+// domain mapping status that reports a failure, other than the certificate
+// still waiting for its DNS records.
+func cloudRunDomainMappingFailure(status map[string]interface{}) error {
+	conditions, _ := status["conditions"].([]interface{})
+	for _, raw := range conditions {
+		condition, _ := raw.(map[string]interface{})
+		if condition["status"] != "False" || condition["reason"] == "CertificatePending" {
+			continue
+		}
+		return fmt.Errorf("condition %v failed: %v: %v", condition["type"], condition["reason"], condition["message"])
+	}
+	return nil
+}
+
+func expandCloudRunDomainMappingSpec(v interface{}) map[string]interface{} {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	raw := l[0].(map[string]interface{})
+
+	transformed := map[string]interface{}{
+		"routeName":       raw["route_name"],
+		"certificateMode": raw["certificate_mode"],
+	}
+	if raw["force_override"].(bool) {
+		transformed["forceOverride"] = true
+	}
+	return transformed
+}
+
+func flattenCloudRunDomainMappingSpec(v interface{}, d *schema.ResourceData) interface{} {
+	original, ok := v.(map[string]interface{})
+	if !ok || len(original) == 0 {
+		return nil
+	}
+	certificateMode := original["certificateMode"]
+	if certificateMode == nil {
+		certificateMode = "AUTOMATIC"
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"route_name":       original["routeName"],
+			"certificate_mode": certificateMode,
+			// forceOverride isn't returned by the API
+			"force_override": d.Get("spec.0.force_override"),
+		},
+	}
+}
+
+func flattenCloudRunDomainMappingStatus(v interface{}) interface{} {
+	original, ok := v.(map[string]interface{})
+	if !ok || len(original) == 0 {
+		return nil
+	}
+
+	records := make([]interface{}, 0)
+	if l, ok := original["resourceRecords"].([]interface{}); ok {
+		for _, raw := range l {
+			record, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			records = append(records, map[string]interface{}{
+				"name":   record["name"],
+				"rrdata": record["rrdata"],
+				"type":   record["type"],
+			})
+		}
+	}
+
+	conditions := make([]interface{}, 0)
+	if l, ok := original["conditions"].([]interface{}); ok {
+		for _, raw := range l {
+			condition, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			conditions = append(conditions, map[string]interface{}{
+				"type":    condition["type"],
+				"status":  condition["status"],
+				"reason":  condition["reason"],
+				"message": condition["message"],
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"resource_records":    records,
+			"conditions":          conditions,
+			"mapped_route_name":   original["mappedRouteName"],
+			"url":                 original["url"],
+			"observed_generation": flattenCloudRunV2Int(original["observedGeneration"]),
+		},
+	}
+}
@@ -0,0 +1,118 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestCloudRunDomainMappingFailure(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Conditions  []interface{}
+		ExpectError bool
+	}{
+		"no conditions": {},
+		"pending certificate": {
+			Conditions: []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "Unknown"},
+				map[string]interface{}{"type": "CertificateProvisioned", "status": "False", "reason": "CertificatePending"},
+			},
+		},
+		"ready": {
+			Conditions: []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "True"},
+			},
+		},
+		"failed": {
+			Conditions: []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "False", "reason": "PermissionDenied", "message": "Caller is not authorized to administer the domain."},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		err := cloudRunDomainMappingFailure(map[string]interface{}{"conditions": tc.Conditions})
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
+
+func TestAccCloudRunDomainMapping_basic(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudRunDomainMappingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudRunDomainMapping_basic(suffix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("google_cloud_run_domain_mapping.mapping", "status.0.resource_records.0.rrdata"),
+					resource.TestCheckResourceAttrSet("google_cloud_run_domain_mapping.mapping", "status.0.resource_records.0.type"),
+					resource.TestCheckResourceAttrSet("google_cloud_run_domain_mapping.mapping", "status.0.conditions.#"),
+				),
+			},
+			{
+				ResourceName:            "google_cloud_run_domain_mapping.mapping",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"status"},
+			},
+		},
+	})
+}
+
+func testAccCloudRunDomainMapping_basic(suffix string) string {
+	return fmt.Sprintf(`
+resource "google_cloud_run_v2_service" "service" {
+  name     = "tf-test-service-%s"
+  location = "us-central1"
+
+  template {
+    containers {
+      image = "us-docker.pkg.dev/cloudrun/container/hello"
+    }
+  }
+}
+
+resource "google_cloud_run_domain_mapping" "mapping" {
+  name     = "tf-test-domain%s.gcp.tfacc.hashicorptest.com"
+  location = google_cloud_run_v2_service.service.location
+
+  spec {
+    route_name = google_cloud_run_v2_service.service.name
+  }
+}
+`, suffix, suffix)
+}
+
+func testAccCheckCloudRunDomainMappingDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_cloud_run_domain_mapping" {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url := fmt.Sprintf("%sapis/domains.cloudrun.com/v1/namespaces/%s/domainmappings/%s", cloudRunBasePath(config, rs.Primary.Attributes["location"]), rs.Primary.Attributes["project"], rs.Primary.Attributes["name"])
+		_, err := sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("CloudRunDomainMapping still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,434 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceCloudRunV2Job() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudRunV2JobCreate,
+		Read:   resourceCloudRunV2JobRead,
+		Update: resourceCloudRunV2JobUpdate,
+		Delete: resourceCloudRunV2JobDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudRunV2JobImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+				Description:      `Name of the Job.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The location of the cloud run job.`,
+			},
+			"template": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: `The template used to create executions for this Job.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"task_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  `Specifies the desired number of tasks the execution should run. Setting to 1 means that parallelism is limited to 1 and the success of that task signals the success of the execution. Defaults to 1.`,
+						},
+						"parallelism": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  `Specifies the maximum desired number of tasks the execution should run at given time. Must be <= taskCount. When the job is run, if this field is 0 or unset, the maximum possible value will be used for that execution.`,
+						},
+						"template": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MaxItems:    1,
+							Description: `Describes the task(s) that will be created when executing an execution.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"containers": cloudRunV2ContainersSchema(),
+									"max_retries": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      3,
+										ValidateFunc: validation.IntBetween(0, 10),
+										Description:  `Number of retries allowed per Task, before marking this Task failed. Defaults to 3.`,
+									},
+									"timeout": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validateRegexp(`^[0-9]+(\.[0-9]{1,9})?s$`),
+										Description:  `Max allowed time duration the Task may be active before the system will actively try to mark it failed and kill associated containers. A duration in seconds with up to nine fractional digits, ending with 's'. Example: "3.5s".`,
+									},
+									"service_account": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Computed:    true,
+										Description: `Email address of the IAM service account associated with the Task of a Job. If not provided, the task will use the project's default service account.`,
+									},
+									"vpc_access": cloudRunV2VpcAccessSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"launch_stage": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(cloudRunV2LaunchStages, false),
+				Description:  `The launch stage as defined by Google Cloud Platform Launch Stages. Cloud Run supports ALPHA, BETA, and GA. If no value is specified, GA is assumed.`,
+			},
+			"execution": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: `Settings for executions Terraform triggers on the Job.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"run_on_create": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: `Whether to start an execution of the Job once it's created. Terraform doesn't wait for the execution to complete.`,
+						},
+					},
+				},
+			},
+			"execution_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `Number of executions created for this job.`,
+			},
+			"latest_created_execution": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `Name of the last created execution.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Name of the execution.`,
+						},
+						"create_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Creation timestamp of the execution.`,
+						},
+						"completion_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Completion timestamp of the execution.`,
+						},
+					},
+				},
+			},
+			"terminal_condition": cloudRunV2ConditionsSchema(`The Condition of this Job, containing its readiness status, and detailed error information in case it did not reach the desired state.`),
+			"conditions":         cloudRunV2ConditionsSchema(`The Conditions of all other associated sub-resources. They contain additional diagnostics information in case the Job does not reach its desired state.`),
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceCloudRunV2JobCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := expandCloudRunV2Job(d)
+
+	url, err := replaceVars(d, config, "{{CloudRunV2BasePath}}projects/{{project}}/locations/{{location}}/jobs?jobId={{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Job: %#v", obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Job: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/jobs/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	err = cloudRunV2OperationWaitTime(
+		config, res, "Creating Job",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+	if err != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create Job: %s", err)
+	}
+
+	log.Printf("[DEBUG] Finished creating Job %q: %#v", d.Id(), res)
+
+	if d.Get("execution.0.run_on_create").(bool) {
+		runUrl, err := replaceVars(d, config, "{{CloudRunV2BasePath}}projects/{{project}}/locations/{{location}}/jobs/{{name}}:run")
+		if err != nil {
+			return err
+		}
+		// The operation of a run completes with the execution, which may run
+		// for much longer than Terraform should block on it.
+		project, err := getProject(d, config)
+		if err != nil {
+			return err
+		}
+
+		op, err := sendRequestWithTimeout(config, "POST", project, runUrl, map[string]interface{}{}, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return fmt.Errorf("Error running Job %q: %s", d.Id(), err)
+		}
+		log.Printf("[DEBUG] Started an execution of Job %q: %#v", d.Id(), op)
+	}
+
+	return resourceCloudRunV2JobRead(d, meta)
+}
+
+func resourceCloudRunV2JobRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{CloudRunV2BasePath}}projects/{{project}}/locations/{{location}}/jobs/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("CloudRunV2Job %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Job: %s", err)
+	}
+	if err := d.Set("launch_stage", res["launchStage"]); err != nil {
+		return fmt.Errorf("Error reading Job: %s", err)
+	}
+	if err := d.Set("template", flattenCloudRunV2JobTemplate(res["template"])); err != nil {
+		return fmt.Errorf("Error reading Job: %s", err)
+	}
+	if err := d.Set("execution_count", flattenCloudRunV2Int(res["executionCount"])); err != nil {
+		return fmt.Errorf("Error reading Job: %s", err)
+	}
+	if err := d.Set("latest_created_execution", flattenCloudRunV2JobLatestCreatedExecution(res["latestCreatedExecution"])); err != nil {
+		return fmt.Errorf("Error reading Job: %s", err)
+	}
+	if err := d.Set("terminal_condition", flattenCloudRunV2Conditions([]interface{}{res["terminalCondition"]})); err != nil {
+		return fmt.Errorf("Error reading Job: %s", err)
+	}
+	if err := d.Set("conditions", flattenCloudRunV2Conditions(res["conditions"])); err != nil {
+		return fmt.Errorf("Error reading Job: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCloudRunV2JobUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	// execution only affects the creation of the Job.
+	if !d.HasChange("template") && !d.HasChange("launch_stage") {
+		return resourceCloudRunV2JobRead(d, meta)
+	}
+
+	obj := expandCloudRunV2Job(d)
+
+	url, err := replaceVars(d, config, "{{CloudRunV2BasePath}}projects/{{project}}/locations/{{location}}/jobs/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Job %q: %#v", d.Id(), obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("Error updating Job %q: %s", d.Id(), err)
+	}
+
+	err = cloudRunV2OperationWaitTime(
+		config, res, "Updating Job",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+	if err != nil {
+		return err
+	}
+
+	return resourceCloudRunV2JobRead(d, meta)
+}
+
+func resourceCloudRunV2JobDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{CloudRunV2BasePath}}projects/{{project}}/locations/{{location}}/jobs/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Job %q", d.Id())
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, nil, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Job")
+	}
+
+	err = cloudRunV2OperationWaitTime(
+		config, res, "Deleting Job",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting Job %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceCloudRunV2JobImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/jobs/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/jobs/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandCloudRunV2Job(d *schema.ResourceData) map[string]interface{} {
+	obj := map[string]interface{}{
+		"template": expandCloudRunV2JobTemplate(d.Get("template")),
+	}
+	if v, ok := d.GetOk("launch_stage"); ok {
+		obj["launchStage"] = v
+	}
+	return obj
+}
+
+func expandCloudRunV2JobTemplate(v interface{}) map[string]interface{} {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	original := l[0].(map[string]interface{})
+
+	transformed := map[string]interface{}{}
+	if taskCount := original["task_count"].(int); taskCount != 0 {
+		transformed["taskCount"] = taskCount
+	}
+	if parallelism := original["parallelism"].(int); parallelism != 0 {
+		transformed["parallelism"] = parallelism
+	}
+
+	if tl := original["template"].([]interface{}); len(tl) > 0 && tl[0] != nil {
+		task := tl[0].(map[string]interface{})
+		taskTemplate := map[string]interface{}{
+			"containers": expandCloudRunV2Containers(task["containers"]),
+			// 0 disables retries, so it's always sent.
+			"maxRetries": task["max_retries"],
+		}
+		if timeout := task["timeout"].(string); timeout != "" {
+			taskTemplate["timeout"] = timeout
+		}
+		if serviceAccount := task["service_account"].(string); serviceAccount != "" {
+			taskTemplate["serviceAccount"] = serviceAccount
+		}
+		if vpcAccess := expandCloudRunV2VpcAccess(task["vpc_access"]); vpcAccess != nil {
+			taskTemplate["vpcAccess"] = vpcAccess
+		}
+		transformed["template"] = taskTemplate
+	}
+	return transformed
+}
+
+func flattenCloudRunV2JobTemplate(v interface{}) interface{} {
+	original, ok := v.(map[string]interface{})
+	if !ok || len(original) == 0 {
+		return nil
+	}
+	transformed := map[string]interface{}{
+		"task_count":  flattenCloudRunV2Int(original["taskCount"]),
+		"parallelism": flattenCloudRunV2Int(original["parallelism"]),
+	}
+	if task, ok := original["template"].(map[string]interface{}); ok {
+		maxRetries := flattenCloudRunV2Int(task["maxRetries"])
+		if maxRetries == nil {
+			// The API omits maxRetries when retries are disabled.
+			maxRetries = 0
+		}
+		transformed["template"] = []interface{}{map[string]interface{}{
+			"containers":      flattenCloudRunV2Containers(task["containers"]),
+			"max_retries":     maxRetries,
+			"timeout":         task["timeout"],
+			"service_account": task["serviceAccount"],
+			"vpc_access":      flattenCloudRunV2VpcAccess(task["vpcAccess"]),
+		}}
+	}
+	return []interface{}{transformed}
+}
+
+func flattenCloudRunV2JobLatestCreatedExecution(v interface{}) interface{} {
+	original, ok := v.(map[string]interface{})
+	if !ok || len(original) == 0 {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"name":            original["name"],
+		"create_time":     original["createTime"],
+		"completion_time": original["completionTime"],
+	}}
+}
@@ -0,0 +1,103 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccCloudRunV2Job_update(t *testing.T) {
+	t.Parallel()
+
+	name := fmt.Sprintf("tf-test-job-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudRunV2JobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudRunV2Job_basic(name, 2, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_cloud_run_v2_job.job", "template.0.task_count", "2"),
+					resource.TestCheckResourceAttr("google_cloud_run_v2_job.job", "template.0.parallelism", "1"),
+					resource.TestCheckResourceAttr("google_cloud_run_v2_job.job", "template.0.template.0.max_retries", "0"),
+					resource.TestCheckResourceAttr("google_cloud_run_v2_job.job", "template.0.template.0.timeout", "600s"),
+					resource.TestCheckResourceAttr("google_cloud_run_v2_job.job", "template.0.template.0.containers.0.env.0.value", "bar"),
+				),
+			},
+			{
+				ResourceName:      "google_cloud_run_v2_job.job",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCloudRunV2Job_basic(name, 3, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_cloud_run_v2_job.job", "template.0.task_count", "3"),
+					resource.TestCheckResourceAttr("google_cloud_run_v2_job.job", "template.0.template.0.max_retries", "2"),
+				),
+			},
+			{
+				ResourceName:      "google_cloud_run_v2_job.job",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudRunV2Job_basic(name string, taskCount, maxRetries int) string {
+	return fmt.Sprintf(`
+resource "google_cloud_run_v2_job" "job" {
+  name     = "%s"
+  location = "us-central1"
+
+  template {
+    task_count  = %d
+    parallelism = 1
+
+    template {
+      max_retries = %d
+      timeout     = "600s"
+
+      containers {
+        image   = "us-docker.pkg.dev/cloudrun/container/job"
+        command = ["/bin/sh"]
+        args    = ["-c", "echo $FOO"]
+
+        env {
+          name  = "FOO"
+          value = "bar"
+        }
+      }
+    }
+  }
+}
+`, name, taskCount, maxRetries)
+}
+
+func testAccCheckCloudRunV2JobDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_cloud_run_v2_job" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url := config.CloudRunV2BasePath + rs.Primary.ID
+		_, err := sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("CloudRunV2Job still exists at %s", url)
+		}
+	}
+
+	return nil
+}
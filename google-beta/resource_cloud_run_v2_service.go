@@ -0,0 +1,790 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+var cloudRunV2LaunchStages = []string{"UNIMPLEMENTED", "PRELAUNCH", "EARLY_ACCESS", "ALPHA", "BETA", "GA", "DEPRECATED"}
+
+func resourceCloudRunV2Service() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudRunV2ServiceCreate,
+		Read:   resourceCloudRunV2ServiceRead,
+		Update: resourceCloudRunV2ServiceUpdate,
+		Delete: resourceCloudRunV2ServiceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudRunV2ServiceImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+				Description:      `Name of the Service.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The location of the cloud run service.`,
+			},
+			"template": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: `The template used to create revisions for this Service.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"containers": cloudRunV2ContainersSchema(),
+						"revision": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `The unique name for the revision. If this field is omitted, it will be automatically generated based on the Service name.`,
+						},
+						"scaling": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Computed:    true,
+							MaxItems:    1,
+							Description: `Scaling settings for this Revision.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"min_instance_count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+										Description:  `Minimum number of serving instances that this resource should have.`,
+									},
+									"max_instance_count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+										Description:  `Maximum number of serving instances that this resource should have.`,
+									},
+								},
+							},
+						},
+						"service_account": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: `Email address of the IAM service account associated with the revision of the service. If not provided, the revision will use the project's default service account.`,
+						},
+						"vpc_access": cloudRunV2VpcAccessSchema(),
+					},
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `User-provided description of the Service.`,
+			},
+			"ingress": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"INGRESS_TRAFFIC_ALL", "INGRESS_TRAFFIC_INTERNAL_ONLY", "INGRESS_TRAFFIC_INTERNAL_LOAD_BALANCER"}, false),
+				Description:  `Provides the ingress settings for this Service. On output, returns the currently observed ingress settings, or INGRESS_TRAFFIC_UNSPECIFIED if no revision is active.`,
+			},
+			"launch_stage": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(cloudRunV2LaunchStages, false),
+				Description:  `The launch stage as defined by Google Cloud Platform Launch Stages. Cloud Run supports ALPHA, BETA, and GA. If no value is specified, GA is assumed.`,
+			},
+			"traffic": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: `Specifies how to distribute traffic over a collection of Revisions belonging to the Service. If traffic is empty or not provided, defaults to 100% traffic to the latest Ready Revision.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"TRAFFIC_TARGET_ALLOCATION_TYPE_LATEST", "TRAFFIC_TARGET_ALLOCATION_TYPE_REVISION"}, false),
+							Description:  `The allocation type for this traffic target.`,
+						},
+						"revision": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Revision to which to send this portion of traffic, if traffic allocation is by revision.`,
+						},
+						"percent": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+							Description:  `Specifies percent of the traffic to this Revision. This defaults to zero if unspecified.`,
+						},
+						"tag": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Indicates a string to be part of the URI to exclusively reference this target.`,
+						},
+					},
+				},
+			},
+			"uri": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The main URI in which this Service is serving traffic.`,
+			},
+			"latest_ready_revision": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Name of the latest revision that is serving traffic.`,
+			},
+			"latest_created_revision": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Name of the last created revision.`,
+			},
+			"terminal_condition": cloudRunV2ConditionsSchema(`The Condition of this Service, containing its readiness status, and detailed error information in case it did not reach a serving state.`),
+			"conditions":         cloudRunV2ConditionsSchema(`The Conditions of all other associated sub-resources. They contain additional diagnostics information in case the Service does not reach its Serving state.`),
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func cloudRunV2ContainersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Required:    true,
+		MinItems:    1,
+		Description: `Holds the containers that define the unit of execution for this Revision.`,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"image": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: `URL of the Container image in Google Container Registry or Google Artifact Registry.`,
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Computed:    true,
+					Description: `Name of the container specified as a DNS_LABEL.`,
+				},
+				"command": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: `Entrypoint array. Not executed within a shell. The docker image's ENTRYPOINT is used if this is not provided.`,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"args": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: `Arguments to the entrypoint. The docker image's CMD is used if this is not provided.`,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"env": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: `List of environment variables to set in the container.`,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: `Name of the environment variable.`,
+							},
+							"value": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: `Value of the environment variable.`,
+							},
+						},
+					},
+				},
+				"ports": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Computed:    true,
+					MaxItems:    1,
+					Description: `List of ports to expose from the container. Only a single port can be specified.`,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.StringInSlice([]string{"http1", "h2c"}, false),
+								Description:  `If specified, used to specify which protocol to use. Allowed values are "http1" and "h2c".`,
+							},
+							"container_port": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(1, 65535),
+								Description:  `Port number the container listens on.`,
+							},
+						},
+					},
+				},
+				"resources": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Computed:    true,
+					MaxItems:    1,
+					Description: `Compute Resource requirements by this container.`,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"limits": {
+								Type:        schema.TypeMap,
+								Optional:    true,
+								Computed:    true,
+								Description: `Only memory and CPU are supported. The values of the map are string form of the quantity, e.g. "1000m" or "512Mi".`,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func cloudRunV2VpcAccessSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: `VPC Access configuration to use for this Revision.`,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"connector": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: `VPC Access connector name. Format: projects/{project}/locations/{location}/connectors/{connector}.`,
+				},
+				"egress": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice([]string{"ALL_TRAFFIC", "PRIVATE_RANGES_ONLY"}, false),
+					Description:  `Traffic VPC egress settings.`,
+				},
+			},
+		},
+	}
+}
+
+func cloudRunV2ConditionsSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: `type is used to communicate the status of the reconciliation process.`,
+				},
+				"state": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: `State of the condition.`,
+				},
+				"message": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: `Human readable message indicating details about the current status.`,
+				},
+				"last_transition_time": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: `Last time the condition transitioned from one status to another.`,
+				},
+				"severity": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: `How to interpret failures of this condition, one of Error, Warning, Info.`,
+				},
+				"reason": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: `A common (service-level) reason for this condition.`,
+				},
+			},
+		},
+	}
+}
+
+func resourceCloudRunV2ServiceCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := expandCloudRunV2Service(d)
+
+	url, err := replaceVars(d, config, "{{CloudRunV2BasePath}}projects/{{project}}/locations/{{location}}/services?serviceId={{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Service: %#v", obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Service: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/services/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	err = cloudRunV2OperationWaitTime(
+		config, res, "Creating Service",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+	if err != nil {
+		// The service didn't become ready, but it may still exist and is
+		// read into state so that it can be fixed or destroyed.
+		log.Printf("[WARN] Error waiting to create Service: %s", err)
+		if readErr := resourceCloudRunV2ServiceRead(d, meta); readErr != nil {
+			return readErr
+		}
+		return fmt.Errorf("Error waiting to create Service: %s", err)
+	}
+
+	log.Printf("[DEBUG] Finished creating Service %q: %#v", d.Id(), res)
+
+	return resourceCloudRunV2ServiceRead(d, meta)
+}
+
+func resourceCloudRunV2ServiceRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{CloudRunV2BasePath}}projects/{{project}}/locations/{{location}}/services/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("CloudRunV2Service %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Service: %s", err)
+	}
+	if err := d.Set("description", res["description"]); err != nil {
+		return fmt.Errorf("Error reading Service: %s", err)
+	}
+	if err := d.Set("ingress", res["ingress"]); err != nil {
+		return fmt.Errorf("Error reading Service: %s", err)
+	}
+	if err := d.Set("launch_stage", res["launchStage"]); err != nil {
+		return fmt.Errorf("Error reading Service: %s", err)
+	}
+	if err := d.Set("template", flattenCloudRunV2ServiceTemplate(res["template"])); err != nil {
+		return fmt.Errorf("Error reading Service: %s", err)
+	}
+	if err := d.Set("traffic", flattenCloudRunV2ServiceTraffic(res["traffic"])); err != nil {
+		return fmt.Errorf("Error reading Service: %s", err)
+	}
+	if err := d.Set("uri", res["uri"]); err != nil {
+		return fmt.Errorf("Error reading Service: %s", err)
+	}
+	if err := d.Set("latest_ready_revision", res["latestReadyRevision"]); err != nil {
+		return fmt.Errorf("Error reading Service: %s", err)
+	}
+	if err := d.Set("latest_created_revision", res["latestCreatedRevision"]); err != nil {
+		return fmt.Errorf("Error reading Service: %s", err)
+	}
+	if err := d.Set("terminal_condition", flattenCloudRunV2Conditions([]interface{}{res["terminalCondition"]})); err != nil {
+		return fmt.Errorf("Error reading Service: %s", err)
+	}
+	if err := d.Set("conditions", flattenCloudRunV2Conditions(res["conditions"])); err != nil {
+		return fmt.Errorf("Error reading Service: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCloudRunV2ServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := expandCloudRunV2Service(d)
+
+	url, err := replaceVars(d, config, "{{CloudRunV2BasePath}}projects/{{project}}/locations/{{location}}/services/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Service %q: %#v", d.Id(), obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("Error updating Service %q: %s", d.Id(), err)
+	}
+
+	err = cloudRunV2OperationWaitTime(
+		config, res, "Updating Service",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+	if err != nil {
+		return err
+	}
+
+	return resourceCloudRunV2ServiceRead(d, meta)
+}
+
+func resourceCloudRunV2ServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{CloudRunV2BasePath}}projects/{{project}}/locations/{{location}}/services/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Service %q", d.Id())
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, nil, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Service")
+	}
+
+	err = cloudRunV2OperationWaitTime(
+		config, res, "Deleting Service",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting Service %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceCloudRunV2ServiceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/services/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/services/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandCloudRunV2Service(d *schema.ResourceData) map[string]interface{} {
+	obj := map[string]interface{}{
+		"template": expandCloudRunV2ServiceTemplate(d.Get("template")),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		obj["description"] = v
+	}
+	if v, ok := d.GetOk("ingress"); ok {
+		obj["ingress"] = v
+	}
+	if v, ok := d.GetOk("launch_stage"); ok {
+		obj["launchStage"] = v
+	}
+	if v, ok := d.GetOk("traffic"); ok {
+		obj["traffic"] = expandCloudRunV2ServiceTraffic(v)
+	}
+	return obj
+}
+
+func expandCloudRunV2ServiceTemplate(v interface{}) map[string]interface{} {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	original := l[0].(map[string]interface{})
+
+	transformed := map[string]interface{}{
+		"containers": expandCloudRunV2Containers(original["containers"]),
+	}
+	if revision := original["revision"].(string); revision != "" {
+		transformed["revision"] = revision
+	}
+	if serviceAccount := original["service_account"].(string); serviceAccount != "" {
+		transformed["serviceAccount"] = serviceAccount
+	}
+	if scaling := original["scaling"].([]interface{}); len(scaling) > 0 && scaling[0] != nil {
+		s := scaling[0].(map[string]interface{})
+		transformed["scaling"] = map[string]interface{}{
+			"minInstanceCount": s["min_instance_count"],
+			"maxInstanceCount": s["max_instance_count"],
+		}
+	}
+	if vpcAccess := expandCloudRunV2VpcAccess(original["vpc_access"]); vpcAccess != nil {
+		transformed["vpcAccess"] = vpcAccess
+	}
+	return transformed
+}
+
+func expandCloudRunV2Containers(v interface{}) []interface{} {
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		container := map[string]interface{}{
+			"image": original["image"],
+		}
+		if name := original["name"].(string); name != "" {
+			container["name"] = name
+		}
+		if command := original["command"].([]interface{}); len(command) > 0 {
+			container["command"] = command
+		}
+		if args := original["args"].([]interface{}); len(args) > 0 {
+			container["args"] = args
+		}
+		if env := original["env"].([]interface{}); len(env) > 0 {
+			vars := make([]interface{}, 0, len(env))
+			for _, e := range env {
+				e := e.(map[string]interface{})
+				vars = append(vars, map[string]interface{}{
+					"name":  e["name"],
+					"value": e["value"],
+				})
+			}
+			container["env"] = vars
+		}
+		if ports := original["ports"].([]interface{}); len(ports) > 0 && ports[0] != nil {
+			p := ports[0].(map[string]interface{})
+			port := map[string]interface{}{}
+			if name := p["name"].(string); name != "" {
+				port["name"] = name
+			}
+			if containerPort := p["container_port"].(int); containerPort != 0 {
+				port["containerPort"] = containerPort
+			}
+			container["ports"] = []interface{}{port}
+		}
+		if resources := original["resources"].([]interface{}); len(resources) > 0 && resources[0] != nil {
+			r := resources[0].(map[string]interface{})
+			container["resources"] = map[string]interface{}{
+				"limits": r["limits"],
+			}
+		}
+		transformed = append(transformed, container)
+	}
+	return transformed
+}
+
+func expandCloudRunV2VpcAccess(v interface{}) map[string]interface{} {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	original := l[0].(map[string]interface{})
+	transformed := map[string]interface{}{}
+	if connector := original["connector"].(string); connector != "" {
+		transformed["connector"] = connector
+	}
+	if egress := original["egress"].(string); egress != "" {
+		transformed["egress"] = egress
+	}
+	return transformed
+}
+
+func expandCloudRunV2ServiceTraffic(v interface{}) []interface{} {
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		target := map[string]interface{}{
+			"percent": original["percent"],
+		}
+		if allocationType := original["type"].(string); allocationType != "" {
+			target["type"] = allocationType
+		}
+		if revision := original["revision"].(string); revision != "" {
+			target["revision"] = revision
+		}
+		if tag := original["tag"].(string); tag != "" {
+			target["tag"] = tag
+		}
+		transformed = append(transformed, target)
+	}
+	return transformed
+}
+
+func flattenCloudRunV2ServiceTemplate(v interface{}) interface{} {
+	original, ok := v.(map[string]interface{})
+	if !ok || len(original) == 0 {
+		return nil
+	}
+	transformed := map[string]interface{}{
+		"containers":      flattenCloudRunV2Containers(original["containers"]),
+		"revision":        original["revision"],
+		"service_account": original["serviceAccount"],
+		"vpc_access":      flattenCloudRunV2VpcAccess(original["vpcAccess"]),
+	}
+	if scaling, ok := original["scaling"].(map[string]interface{}); ok {
+		transformed["scaling"] = []interface{}{map[string]interface{}{
+			"min_instance_count": flattenCloudRunV2Int(scaling["minInstanceCount"]),
+			"max_instance_count": flattenCloudRunV2Int(scaling["maxInstanceCount"]),
+		}}
+	}
+	return []interface{}{transformed}
+}
+
+func flattenCloudRunV2Containers(v interface{}) interface{} {
+	l, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		container := map[string]interface{}{
+			"image":   original["image"],
+			"name":    original["name"],
+			"command": original["command"],
+			"args":    original["args"],
+		}
+		if env, ok := original["env"].([]interface{}); ok {
+			vars := make([]interface{}, 0, len(env))
+			for _, e := range env {
+				e, ok := e.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				vars = append(vars, map[string]interface{}{
+					"name":  e["name"],
+					"value": e["value"],
+				})
+			}
+			container["env"] = vars
+		}
+		if ports, ok := original["ports"].([]interface{}); ok && len(ports) > 0 {
+			if p, ok := ports[0].(map[string]interface{}); ok {
+				container["ports"] = []interface{}{map[string]interface{}{
+					"name":           p["name"],
+					"container_port": flattenCloudRunV2Int(p["containerPort"]),
+				}}
+			}
+		}
+		if resources, ok := original["resources"].(map[string]interface{}); ok {
+			container["resources"] = []interface{}{map[string]interface{}{
+				"limits": resources["limits"],
+			}}
+		}
+		transformed = append(transformed, container)
+	}
+	return transformed
+}
+
+func flattenCloudRunV2VpcAccess(v interface{}) interface{} {
+	original, ok := v.(map[string]interface{})
+	if !ok || len(original) == 0 {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"connector": original["connector"],
+		"egress":    original["egress"],
+	}}
+}
+
+func flattenCloudRunV2ServiceTraffic(v interface{}) interface{} {
+	l, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"type":     original["type"],
+			"revision": original["revision"],
+			"percent":  flattenCloudRunV2Int(original["percent"]),
+			"tag":      original["tag"],
+		})
+	}
+	return transformed
+}
+
+func flattenCloudRunV2Conditions(v interface{}) interface{} {
+	l, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original, ok := raw.(map[string]interface{})
+		if !ok || len(original) == 0 {
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"type":                 original["type"],
+			"state":                original["state"],
+			"message":              original["message"],
+			"last_transition_time": original["lastTransitionTime"],
+			"severity":             original["severity"],
+			"reason":               original["reason"],
+		})
+	}
+	return transformed
+}
+
+func flattenCloudRunV2Int(v interface{}) interface{} {
+	// number values are represented as float64
+	if floatVal, ok := v.(float64); ok {
+		intVal := int(floatVal)
+		return intVal
+	}
+	return v
+}
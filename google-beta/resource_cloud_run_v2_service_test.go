@@ -0,0 +1,136 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccCloudRunV2Service_trafficSplit(t *testing.T) {
+	t.Parallel()
+
+	name := fmt.Sprintf("tf-test-service-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudRunV2ServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudRunV2Service_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("google_cloud_run_v2_service.service", "uri"),
+					resource.TestCheckResourceAttr("google_cloud_run_v2_service.service", "terminal_condition.0.state", "CONDITION_SUCCEEDED"),
+				),
+			},
+			{
+				ResourceName:      "google_cloud_run_v2_service.service",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCloudRunV2Service_trafficSplit(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_cloud_run_v2_service.service", "traffic.#", "2"),
+					resource.TestCheckResourceAttr("google_cloud_run_v2_service.service", "latest_ready_revision", name+"-green"),
+				),
+			},
+			{
+				ResourceName:      "google_cloud_run_v2_service.service",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudRunV2Service_basic(name string) string {
+	return fmt.Sprintf(`
+resource "google_cloud_run_v2_service" "service" {
+  name     = "%s"
+  location = "us-central1"
+  ingress  = "INGRESS_TRAFFIC_ALL"
+
+  template {
+    revision = "%s-blue"
+
+    containers {
+      image = "us-docker.pkg.dev/cloudrun/container/hello"
+
+      env {
+        name  = "COLOR"
+        value = "blue"
+      }
+    }
+
+    scaling {
+      max_instance_count = 2
+    }
+  }
+}
+`, name, name)
+}
+
+func testAccCloudRunV2Service_trafficSplit(name string) string {
+	return fmt.Sprintf(`
+resource "google_cloud_run_v2_service" "service" {
+  name     = "%s"
+  location = "us-central1"
+  ingress  = "INGRESS_TRAFFIC_ALL"
+
+  template {
+    revision = "%s-green"
+
+    containers {
+      image = "us-docker.pkg.dev/cloudrun/container/hello"
+
+      env {
+        name  = "COLOR"
+        value = "green"
+      }
+    }
+
+    scaling {
+      max_instance_count = 2
+    }
+  }
+
+  traffic {
+    type     = "TRAFFIC_TARGET_ALLOCATION_TYPE_REVISION"
+    revision = "%s-blue"
+    percent  = 50
+  }
+
+  traffic {
+    type    = "TRAFFIC_TARGET_ALLOCATION_TYPE_LATEST"
+    percent = 50
+    tag     = "green"
+  }
+}
+`, name, name, name)
+}
+
+func testAccCheckCloudRunV2ServiceDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_cloud_run_v2_service" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url := config.CloudRunV2BasePath + rs.Primary.ID
+		_, err := sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("CloudRunV2Service still exists at %s", url)
+		}
+	}
+
+	return nil
+}
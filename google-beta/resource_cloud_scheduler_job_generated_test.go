@@ -173,7 +173,7 @@ func testAccCheckCloudSchedulerJobDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("CloudSchedulerJob still exists at %s", url)
 		}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
@@ -83,6 +84,36 @@ func TestAccCloudBuildTrigger_fullStep(t *testing.T) {
 	})
 }
 
+func TestAccCloudBuildTrigger_repositoryEventConfigApproval(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"installation_id": getTestGithubAppInstallationIdFromEnv(t),
+		"secret_version":  getTestGithubOAuthTokenSecretVersionFromEnv(t),
+		"remote_uri":      getTestGithubRepositoryUriFromEnv(t),
+		"random_suffix":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudBuildTriggerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudBuildTrigger_repositoryEventConfigApproval(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_cloudbuild_trigger.build_trigger", "approval_config.0.approval_required", "true"),
+				),
+			},
+			{
+				ResourceName:      "google_cloudbuild_trigger.build_trigger",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCloudBuildTrigger_basic() string {
 	return fmt.Sprintf(`
 resource "google_cloudbuild_trigger" "build_trigger" {
@@ -199,3 +230,44 @@ resource "google_cloudbuild_trigger" "build_trigger" {
 }
   `)
 }
+
+func testAccCloudBuildTrigger_repositoryEventConfigApproval(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_cloudbuildv2_connection" "github" {
+  name     = "tf-test-github-%{random_suffix}"
+  location = "us-central1"
+
+  github_config {
+    app_installation_id = %{installation_id}
+
+    authorizer_credential {
+      oauth_token_secret_version = "%{secret_version}"
+    }
+  }
+}
+
+resource "google_cloudbuildv2_repository" "repo" {
+  name              = "tf-test-repo-%{random_suffix}"
+  location          = google_cloudbuildv2_connection.github.location
+  parent_connection = google_cloudbuildv2_connection.github.name
+  remote_uri        = "%{remote_uri}"
+}
+
+resource "google_cloudbuild_trigger" "build_trigger" {
+  location    = "us-central1"
+  description = "acceptance test gen2 repository trigger"
+  filename    = "cloudbuild.yaml"
+
+  repository_event_config {
+    repository = google_cloudbuildv2_repository.repo.id
+    push {
+      branch = "^main$"
+    }
+  }
+
+  approval_config {
+    approval_required = true
+  }
+}
+`, context)
+}
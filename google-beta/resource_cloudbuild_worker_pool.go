@@ -0,0 +1,529 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceCloudBuildWorkerPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudBuildWorkerPoolCreate,
+		Read:   resourceCloudBuildWorkerPoolRead,
+		Update: resourceCloudBuildWorkerPoolUpdate,
+		Delete: resourceCloudBuildWorkerPoolDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudBuildWorkerPoolImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"network_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"peered_network": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateFunc:     validateRegexp(cloudBuildWorkerPoolPeeredNetworkRegex),
+							DiffSuppressFunc: compareSelfLinkOrResourceName,
+						},
+						"peered_network_ip_range": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateRegexp(`^(?:[0-9]{1,3}(?:\.[0-9]{1,3}){3})?/[0-9]{1,2}$`),
+						},
+					},
+				},
+			},
+			"worker_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"disk_size_gb": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"machine_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"no_external_ip": {
+							Type:     schema.TypeBool,
+							Computed: true,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delete_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"uid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+// peered_network can be a network name or self link, or the network's
+// relative resource name with a project ID or number.
+const cloudBuildWorkerPoolPeeredNetworkRegex = `^(?:(?:https://www\.googleapis\.com/compute/[^/]+/)?projects/[^/]+/global/networks/)?[a-z](?:[-a-z0-9]{0,61}[a-z0-9])?$`
+
+func resourceCloudBuildWorkerPoolCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	displayNameProp, err := expandCloudBuildWorkerPoolDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(displayNameProp)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+	annotationsProp, err := expandCloudBuildWorkerPoolAnnotations(d.Get("annotations"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("annotations"); !isEmptyValue(reflect.ValueOf(annotationsProp)) && (ok || !reflect.DeepEqual(v, annotationsProp)) {
+		obj["annotations"] = annotationsProp
+	}
+	privatePoolV1ConfigProp, err := expandCloudBuildWorkerPoolPrivatePoolV1Config(d, config)
+	if err != nil {
+		return err
+	} else if !isEmptyValue(reflect.ValueOf(privatePoolV1ConfigProp)) {
+		obj["privatePoolV1Config"] = privatePoolV1ConfigProp
+	}
+
+	url, err := replaceVars(d, config, "{{CloudBuildBasePath}}projects/{{project}}/locations/{{location}}/workerPools?workerPoolId={{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new WorkerPool: %#v", obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating WorkerPool: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/workerPools/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	waitErr := cloudBuildOperationWaitTime(
+		config, res, project, "Creating WorkerPool",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create WorkerPool: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating WorkerPool %q: %#v", d.Id(), res)
+
+	return resourceCloudBuildWorkerPoolRead(d, meta)
+}
+
+func resourceCloudBuildWorkerPoolRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{CloudBuildBasePath}}projects/{{project}}/locations/{{location}}/workerPools/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("CloudBuildWorkerPool %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading WorkerPool: %s", err)
+	}
+
+	if err := d.Set("display_name", flattenCloudBuildWorkerPoolDisplayName(res["displayName"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkerPool: %s", err)
+	}
+	if err := d.Set("annotations", flattenCloudBuildWorkerPoolAnnotations(res["annotations"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkerPool: %s", err)
+	}
+	if err := d.Set("uid", flattenCloudBuildWorkerPoolUid(res["uid"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkerPool: %s", err)
+	}
+	if err := d.Set("state", flattenCloudBuildWorkerPoolState(res["state"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkerPool: %s", err)
+	}
+	if err := d.Set("create_time", flattenCloudBuildWorkerPoolCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkerPool: %s", err)
+	}
+	if err := d.Set("update_time", flattenCloudBuildWorkerPoolUpdateTime(res["updateTime"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkerPool: %s", err)
+	}
+	if err := d.Set("delete_time", flattenCloudBuildWorkerPoolDeleteTime(res["deleteTime"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkerPool: %s", err)
+	}
+	if err := d.Set("etag", flattenCloudBuildWorkerPoolEtag(res["etag"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkerPool: %s", err)
+	}
+	if err := d.Set("worker_config", flattenCloudBuildWorkerPoolWorkerConfig(res["privatePoolV1Config"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkerPool: %s", err)
+	}
+	if err := d.Set("network_config", flattenCloudBuildWorkerPoolNetworkConfig(res["privatePoolV1Config"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkerPool: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCloudBuildWorkerPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	displayNameProp, err := expandCloudBuildWorkerPoolDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+	annotationsProp, err := expandCloudBuildWorkerPoolAnnotations(d.Get("annotations"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("annotations"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, annotationsProp)) {
+		obj["annotations"] = annotationsProp
+	}
+	privatePoolV1ConfigProp, err := expandCloudBuildWorkerPoolPrivatePoolV1Config(d, config)
+	if err != nil {
+		return err
+	} else if !isEmptyValue(reflect.ValueOf(privatePoolV1ConfigProp)) {
+		obj["privatePoolV1Config"] = privatePoolV1ConfigProp
+	}
+
+	url, err := replaceVars(d, config, "{{CloudBuildBasePath}}projects/{{project}}/locations/{{location}}/workerPools/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating WorkerPool %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("display_name") {
+		updateMask = append(updateMask, "displayName")
+	}
+
+	if d.HasChange("annotations") {
+		updateMask = append(updateMask, "annotations")
+	}
+
+	if d.HasChange("worker_config") {
+		updateMask = append(updateMask, "privatePoolV1Config.workerConfig", "privatePoolV1Config.networkConfig.egressOption")
+	}
+
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating WorkerPool %q: %s", d.Id(), err)
+	}
+
+	err = cloudBuildOperationWaitTime(
+		config, res, project, "Updating WorkerPool",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceCloudBuildWorkerPoolRead(d, meta)
+}
+
+func resourceCloudBuildWorkerPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{CloudBuildBasePath}}projects/{{project}}/locations/{{location}}/workerPools/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting WorkerPool %q", d.Id())
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "WorkerPool")
+	}
+
+	err = cloudBuildOperationWaitTime(
+		config, res, project, "Deleting WorkerPool",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting WorkerPool %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceCloudBuildWorkerPoolImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/workerPools/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/workerPools/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenCloudBuildWorkerPoolDisplayName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudBuildWorkerPoolAnnotations(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudBuildWorkerPoolUid(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudBuildWorkerPoolState(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudBuildWorkerPoolCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudBuildWorkerPoolUpdateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudBuildWorkerPoolDeleteTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudBuildWorkerPoolEtag(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+// worker_config and network_config are both read from privatePoolV1Config;
+// the egress option of the network config is surfaced as no_external_ip.
+func flattenCloudBuildWorkerPoolWorkerConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	if workerConfig, ok := original["workerConfig"].(map[string]interface{}); ok {
+		transformed["machine_type"] = workerConfig["machineType"]
+		transformed["disk_size_gb"] = flattenCloudBuildWorkerPoolWorkerConfigDiskSizeGb(workerConfig["diskSizeGb"], d)
+	}
+	transformed["no_external_ip"] = false
+	if networkConfig, ok := original["networkConfig"].(map[string]interface{}); ok {
+		transformed["no_external_ip"] = networkConfig["egressOption"] == "NO_PUBLIC_EGRESS"
+	}
+	return []interface{}{transformed}
+}
+
+func flattenCloudBuildWorkerPoolWorkerConfigDiskSizeGb(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+
+	// number values are represented as float64
+	if floatVal, ok := v.(float64); ok {
+		intVal := int(floatVal)
+		return intVal
+	}
+
+	return v // let terraform core handle it otherwise
+}
+
+func flattenCloudBuildWorkerPoolNetworkConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	networkConfig, ok := original["networkConfig"].(map[string]interface{})
+	if !ok || networkConfig["peeredNetwork"] == nil {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["peered_network"] = networkConfig["peeredNetwork"]
+	transformed["peered_network_ip_range"] = networkConfig["peeredNetworkIpRange"]
+	return []interface{}{transformed}
+}
+
+func expandCloudBuildWorkerPoolDisplayName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudBuildWorkerPoolAnnotations(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandCloudBuildWorkerPoolPrivatePoolV1Config(d TerraformResourceData, config *Config) (interface{}, error) {
+	transformed := make(map[string]interface{})
+	networkConfig := make(map[string]interface{})
+
+	if l := d.Get("worker_config").([]interface{}); len(l) > 0 && l[0] != nil {
+		original := l[0].(map[string]interface{})
+		workerConfig := make(map[string]interface{})
+		if v, ok := original["machine_type"]; ok && v.(string) != "" {
+			workerConfig["machineType"] = v
+		}
+		if v, ok := original["disk_size_gb"]; ok && v.(int) != 0 {
+			workerConfig["diskSizeGb"] = v
+		}
+		if len(workerConfig) > 0 {
+			transformed["workerConfig"] = workerConfig
+		}
+		if original["no_external_ip"].(bool) {
+			networkConfig["egressOption"] = "NO_PUBLIC_EGRESS"
+		} else {
+			networkConfig["egressOption"] = "PUBLIC_EGRESS"
+		}
+	}
+
+	if l := d.Get("network_config").([]interface{}); len(l) > 0 && l[0] != nil {
+		original := l[0].(map[string]interface{})
+		f, err := parseGlobalFieldValue("networks", original["peered_network"].(string), "project", d, config, true)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for peered_network: %s", err)
+		}
+		networkConfig["peeredNetwork"] = f.RelativeLink()
+		if v, ok := original["peered_network_ip_range"]; ok && v.(string) != "" {
+			networkConfig["peeredNetworkIpRange"] = v
+		}
+	}
+
+	if len(networkConfig) > 0 {
+		transformed["networkConfig"] = networkConfig
+	}
+	return transformed, nil
+}
@@ -0,0 +1,145 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestCloudBuildWorkerPoolPeeredNetwork(t *testing.T) {
+	cases := map[string]struct {
+		Value      string
+		ExpectFail bool
+	}{
+		"name":                 {Value: "my-network"},
+		"relative link":        {Value: "projects/my-project/global/networks/my-network"},
+		"project number":       {Value: "projects/123456789/global/networks/my-network"},
+		"self link":            {Value: "https://www.googleapis.com/compute/v1/projects/my-project/global/networks/my-network"},
+		"subnetwork":           {Value: "projects/my-project/regions/us-central1/subnetworks/my-subnet", ExpectFail: true},
+		"uppercase name":       {Value: "My-Network", ExpectFail: true},
+		"missing network name": {Value: "projects/my-project/global/networks/", ExpectFail: true},
+	}
+
+	for tn, tc := range cases {
+		_, errs := validateRegexp(cloudBuildWorkerPoolPeeredNetworkRegex)(tc.Value, "peered_network")
+		if tc.ExpectFail != (len(errs) > 0) {
+			t.Errorf("%s: expected failure %t for %q, got errors: %v", tn, tc.ExpectFail, tc.Value, errs)
+		}
+	}
+}
+
+func TestAccCloudBuildWorkerPool_private(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+		"project":       getTestProjectFromEnv(),
+		"machine_type":  "e2-standard-4",
+		"disk_size_gb":  100,
+	}
+	updated := map[string]interface{}{
+		"random_suffix": context["random_suffix"],
+		"project":       context["project"],
+		"machine_type":  "e2-standard-8",
+		"disk_size_gb":  200,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudBuildWorkerPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudBuildWorkerPool_private(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_cloudbuild_worker_pool.pool", "state", "RUNNING"),
+					resource.TestCheckResourceAttr("google_cloudbuild_worker_pool.pool", "worker_config.0.no_external_ip", "true"),
+				),
+			},
+			{
+				ResourceName:      "google_cloudbuild_worker_pool.pool",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCloudBuildWorkerPool_private(updated),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_cloudbuild_worker_pool.pool", "worker_config.0.machine_type", "e2-standard-8"),
+					resource.TestCheckResourceAttr("google_cloudbuild_worker_pool.pool", "worker_config.0.disk_size_gb", "200"),
+				),
+			},
+			{
+				ResourceName:      "google_cloudbuild_worker_pool.pool",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudBuildWorkerPool_private(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_network" "network" {
+  name                    = "tf-test-pool-%{random_suffix}"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_global_address" "worker_range" {
+  name          = "tf-test-pool-%{random_suffix}"
+  purpose       = "VPC_PEERING"
+  address_type  = "INTERNAL"
+  prefix_length = 16
+  network       = google_compute_network.network.self_link
+}
+
+resource "google_service_networking_connection" "worker_pool_conn" {
+  network                 = google_compute_network.network.self_link
+  service                 = "servicenetworking.googleapis.com"
+  reserved_peering_ranges = [google_compute_global_address.worker_range.name]
+}
+
+resource "google_cloudbuild_worker_pool" "pool" {
+  name         = "tf-test-pool-%{random_suffix}"
+  location     = "europe-west1"
+  display_name = "tf-test-pool-%{random_suffix}"
+
+  worker_config {
+    machine_type   = "%{machine_type}"
+    disk_size_gb   = %{disk_size_gb}
+    no_external_ip = true
+  }
+
+  network_config {
+    peered_network          = "projects/%{project}/global/networks/${google_compute_network.network.name}"
+    peered_network_ip_range = "/29"
+  }
+
+  depends_on = [google_service_networking_connection.worker_pool_conn]
+}
+`, context)
+}
+
+func testAccCheckCloudBuildWorkerPoolDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_cloudbuild_worker_pool" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url := fmt.Sprintf("%s%s", config.CloudBuildBasePath, rs.Primary.ID)
+		_, err := sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("WorkerPool still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,784 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceCloudbuildv2Connection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudbuildv2ConnectionCreate,
+		Read:   resourceCloudbuildv2ConnectionRead,
+		Update: resourceCloudbuildv2ConnectionUpdate,
+		Delete: resourceCloudbuildv2ConnectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudbuildv2ConnectionImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"github_config": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"gitlab_config"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"app_installation_id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"authorizer_credential": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"oauth_token_secret_version": {
+										Type:      schema.TypeString,
+										Optional:  true,
+										Sensitive: true,
+									},
+									"username": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"gitlab_config": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"github_config"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authorizer_credential": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"user_token_secret_version": {
+										Type:      schema.TypeString,
+										Required:  true,
+										Sensitive: true,
+									},
+									"username": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"read_authorizer_credential": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"user_token_secret_version": {
+										Type:      schema.TypeString,
+										Required:  true,
+										Sensitive: true,
+									},
+									"username": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"webhook_secret_secret_version": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+						"host_uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Optional: true,
+						},
+						"ssl_ca": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"server_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"installation_state": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stage": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"reconciling": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceCloudbuildv2ConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	disabledProp, err := expandCloudbuildv2ConnectionDisabled(d.Get("disabled"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("disabled"); !isEmptyValue(reflect.ValueOf(disabledProp)) && (ok || !reflect.DeepEqual(v, disabledProp)) {
+		obj["disabled"] = disabledProp
+	}
+	githubConfigProp, err := expandCloudbuildv2ConnectionGithubConfig(d.Get("github_config"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("github_config"); !isEmptyValue(reflect.ValueOf(githubConfigProp)) && (ok || !reflect.DeepEqual(v, githubConfigProp)) {
+		obj["githubConfig"] = githubConfigProp
+	}
+	gitlabConfigProp, err := expandCloudbuildv2ConnectionGitlabConfig(d.Get("gitlab_config"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("gitlab_config"); !isEmptyValue(reflect.ValueOf(gitlabConfigProp)) && (ok || !reflect.DeepEqual(v, gitlabConfigProp)) {
+		obj["gitlabConfig"] = gitlabConfigProp
+	}
+
+	url, err := replaceVars(d, config, "{{Cloudbuildv2BasePath}}projects/{{project}}/locations/{{location}}/connections?connectionId={{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Connection: %#v", obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Connection: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/connections/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	waitErr := cloudbuildv2OperationWaitTime(
+		config, res, project, "Creating Connection",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create Connection: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating Connection %q: %#v", d.Id(), res)
+
+	return resourceCloudbuildv2ConnectionRead(d, meta)
+}
+
+func resourceCloudbuildv2ConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{Cloudbuildv2BasePath}}projects/{{project}}/locations/{{location}}/connections/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Cloudbuildv2Connection %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Connection: %s", err)
+	}
+
+	if err := d.Set("create_time", flattenCloudbuildv2ConnectionCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Connection: %s", err)
+	}
+	if err := d.Set("update_time", flattenCloudbuildv2ConnectionUpdateTime(res["updateTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Connection: %s", err)
+	}
+	if err := d.Set("github_config", flattenCloudbuildv2ConnectionGithubConfig(res["githubConfig"], d)); err != nil {
+		return fmt.Errorf("Error reading Connection: %s", err)
+	}
+	if err := d.Set("gitlab_config", flattenCloudbuildv2ConnectionGitlabConfig(res["gitlabConfig"], d)); err != nil {
+		return fmt.Errorf("Error reading Connection: %s", err)
+	}
+	if err := d.Set("installation_state", flattenCloudbuildv2ConnectionInstallationState(res["installationState"], d)); err != nil {
+		return fmt.Errorf("Error reading Connection: %s", err)
+	}
+	if err := d.Set("disabled", flattenCloudbuildv2ConnectionDisabled(res["disabled"], d)); err != nil {
+		return fmt.Errorf("Error reading Connection: %s", err)
+	}
+	if err := d.Set("reconciling", flattenCloudbuildv2ConnectionReconciling(res["reconciling"], d)); err != nil {
+		return fmt.Errorf("Error reading Connection: %s", err)
+	}
+	if err := d.Set("etag", flattenCloudbuildv2ConnectionEtag(res["etag"], d)); err != nil {
+		return fmt.Errorf("Error reading Connection: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCloudbuildv2ConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	disabledProp, err := expandCloudbuildv2ConnectionDisabled(d.Get("disabled"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("disabled"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, disabledProp)) {
+		obj["disabled"] = disabledProp
+	}
+	githubConfigProp, err := expandCloudbuildv2ConnectionGithubConfig(d.Get("github_config"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("github_config"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, githubConfigProp)) {
+		obj["githubConfig"] = githubConfigProp
+	}
+	gitlabConfigProp, err := expandCloudbuildv2ConnectionGitlabConfig(d.Get("gitlab_config"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("gitlab_config"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, gitlabConfigProp)) {
+		obj["gitlabConfig"] = gitlabConfigProp
+	}
+
+	url, err := replaceVars(d, config, "{{Cloudbuildv2BasePath}}projects/{{project}}/locations/{{location}}/connections/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Connection %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("disabled") {
+		updateMask = append(updateMask, "disabled")
+	}
+
+	if d.HasChange("github_config") {
+		updateMask = append(updateMask, "githubConfig")
+	}
+
+	if d.HasChange("gitlab_config") {
+		updateMask = append(updateMask, "gitlabConfig")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Connection %q: %s", d.Id(), err)
+	}
+
+	err = cloudbuildv2OperationWaitTime(
+		config, res, project, "Updating Connection",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceCloudbuildv2ConnectionRead(d, meta)
+}
+
+func resourceCloudbuildv2ConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{Cloudbuildv2BasePath}}projects/{{project}}/locations/{{location}}/connections/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Connection %q", d.Id())
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Connection")
+	}
+
+	err = cloudbuildv2OperationWaitTime(
+		config, res, project, "Deleting Connection",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting Connection %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceCloudbuildv2ConnectionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/connections/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/connections/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenCloudbuildv2ConnectionCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudbuildv2ConnectionUpdateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudbuildv2ConnectionGithubConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["app_installation_id"] =
+		flattenCloudbuildv2ConnectionGithubConfigAppInstallationId(original["appInstallationId"], d)
+	transformed["authorizer_credential"] =
+		flattenCloudbuildv2ConnectionGithubConfigAuthorizerCredential(original["authorizerCredential"], d)
+	return []interface{}{transformed}
+}
+func flattenCloudbuildv2ConnectionGithubConfigAppInstallationId(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+
+	// number values are represented as float64
+	if floatVal, ok := v.(float64); ok {
+		intVal := int(floatVal)
+		return intVal
+	}
+
+	return v // let terraform core handle it otherwise
+}
+
+func flattenCloudbuildv2ConnectionGithubConfigAuthorizerCredential(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["oauth_token_secret_version"] =
+		flattenCloudbuildv2ConnectionGithubConfigAuthorizerCredentialOauthTokenSecretVersion(original["oauthTokenSecretVersion"], d)
+	transformed["username"] =
+		flattenCloudbuildv2ConnectionGithubConfigAuthorizerCredentialUsername(original["username"], d)
+	return []interface{}{transformed}
+}
+func flattenCloudbuildv2ConnectionGithubConfigAuthorizerCredentialOauthTokenSecretVersion(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudbuildv2ConnectionGithubConfigAuthorizerCredentialUsername(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudbuildv2ConnectionGitlabConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["host_uri"] =
+		flattenCloudbuildv2ConnectionGitlabConfigHostUri(original["hostUri"], d)
+	transformed["webhook_secret_secret_version"] =
+		flattenCloudbuildv2ConnectionGitlabConfigWebhookSecretSecretVersion(original["webhookSecretSecretVersion"], d)
+	transformed["read_authorizer_credential"] =
+		flattenCloudbuildv2ConnectionGitlabConfigReadAuthorizerCredential(original["readAuthorizerCredential"], d)
+	transformed["authorizer_credential"] =
+		flattenCloudbuildv2ConnectionGitlabConfigAuthorizerCredential(original["authorizerCredential"], d)
+	transformed["ssl_ca"] =
+		flattenCloudbuildv2ConnectionGitlabConfigSslCa(original["sslCa"], d)
+	transformed["server_version"] =
+		flattenCloudbuildv2ConnectionGitlabConfigServerVersion(original["serverVersion"], d)
+	return []interface{}{transformed}
+}
+func flattenCloudbuildv2ConnectionGitlabConfigHostUri(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudbuildv2ConnectionGitlabConfigWebhookSecretSecretVersion(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudbuildv2ConnectionGitlabConfigReadAuthorizerCredential(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["user_token_secret_version"] =
+		flattenCloudbuildv2ConnectionGitlabConfigReadAuthorizerCredentialUserTokenSecretVersion(original["userTokenSecretVersion"], d)
+	transformed["username"] =
+		flattenCloudbuildv2ConnectionGitlabConfigReadAuthorizerCredentialUsername(original["username"], d)
+	return []interface{}{transformed}
+}
+func flattenCloudbuildv2ConnectionGitlabConfigReadAuthorizerCredentialUserTokenSecretVersion(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudbuildv2ConnectionGitlabConfigReadAuthorizerCredentialUsername(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudbuildv2ConnectionGitlabConfigAuthorizerCredential(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["user_token_secret_version"] =
+		flattenCloudbuildv2ConnectionGitlabConfigAuthorizerCredentialUserTokenSecretVersion(original["userTokenSecretVersion"], d)
+	transformed["username"] =
+		flattenCloudbuildv2ConnectionGitlabConfigAuthorizerCredentialUsername(original["username"], d)
+	return []interface{}{transformed}
+}
+func flattenCloudbuildv2ConnectionGitlabConfigAuthorizerCredentialUserTokenSecretVersion(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudbuildv2ConnectionGitlabConfigAuthorizerCredentialUsername(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudbuildv2ConnectionGitlabConfigSslCa(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudbuildv2ConnectionGitlabConfigServerVersion(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudbuildv2ConnectionInstallationState(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["stage"] =
+		flattenCloudbuildv2ConnectionInstallationStateStage(original["stage"], d)
+	transformed["message"] =
+		flattenCloudbuildv2ConnectionInstallationStateMessage(original["message"], d)
+	transformed["action_uri"] =
+		flattenCloudbuildv2ConnectionInstallationStateActionUri(original["actionUri"], d)
+	return []interface{}{transformed}
+}
+func flattenCloudbuildv2ConnectionInstallationStateStage(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudbuildv2ConnectionInstallationStateMessage(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudbuildv2ConnectionInstallationStateActionUri(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudbuildv2ConnectionDisabled(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudbuildv2ConnectionReconciling(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudbuildv2ConnectionEtag(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandCloudbuildv2ConnectionDisabled(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudbuildv2ConnectionGithubConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedAppInstallationId, err := expandCloudbuildv2ConnectionGithubConfigAppInstallationId(original["app_installation_id"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedAppInstallationId); val.IsValid() && !isEmptyValue(val) {
+		transformed["appInstallationId"] = transformedAppInstallationId
+	}
+
+	transformedAuthorizerCredential, err := expandCloudbuildv2ConnectionGithubConfigAuthorizerCredential(original["authorizer_credential"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedAuthorizerCredential); val.IsValid() && !isEmptyValue(val) {
+		transformed["authorizerCredential"] = transformedAuthorizerCredential
+	}
+
+	return transformed, nil
+}
+
+func expandCloudbuildv2ConnectionGithubConfigAppInstallationId(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudbuildv2ConnectionGithubConfigAuthorizerCredential(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedOauthTokenSecretVersion, err := expandCloudbuildv2ConnectionGithubConfigAuthorizerCredentialOauthTokenSecretVersion(original["oauth_token_secret_version"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedOauthTokenSecretVersion); val.IsValid() && !isEmptyValue(val) {
+		transformed["oauthTokenSecretVersion"] = transformedOauthTokenSecretVersion
+	}
+
+	return transformed, nil
+}
+
+func expandCloudbuildv2ConnectionGithubConfigAuthorizerCredentialOauthTokenSecretVersion(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudbuildv2ConnectionGitlabConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedHostUri, err := expandCloudbuildv2ConnectionGitlabConfigHostUri(original["host_uri"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedHostUri); val.IsValid() && !isEmptyValue(val) {
+		transformed["hostUri"] = transformedHostUri
+	}
+
+	transformedWebhookSecretSecretVersion, err := expandCloudbuildv2ConnectionGitlabConfigWebhookSecretSecretVersion(original["webhook_secret_secret_version"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedWebhookSecretSecretVersion); val.IsValid() && !isEmptyValue(val) {
+		transformed["webhookSecretSecretVersion"] = transformedWebhookSecretSecretVersion
+	}
+
+	transformedReadAuthorizerCredential, err := expandCloudbuildv2ConnectionGitlabConfigReadAuthorizerCredential(original["read_authorizer_credential"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedReadAuthorizerCredential); val.IsValid() && !isEmptyValue(val) {
+		transformed["readAuthorizerCredential"] = transformedReadAuthorizerCredential
+	}
+
+	transformedAuthorizerCredential, err := expandCloudbuildv2ConnectionGitlabConfigAuthorizerCredential(original["authorizer_credential"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedAuthorizerCredential); val.IsValid() && !isEmptyValue(val) {
+		transformed["authorizerCredential"] = transformedAuthorizerCredential
+	}
+
+	transformedSslCa, err := expandCloudbuildv2ConnectionGitlabConfigSslCa(original["ssl_ca"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSslCa); val.IsValid() && !isEmptyValue(val) {
+		transformed["sslCa"] = transformedSslCa
+	}
+
+	return transformed, nil
+}
+
+func expandCloudbuildv2ConnectionGitlabConfigHostUri(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudbuildv2ConnectionGitlabConfigWebhookSecretSecretVersion(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudbuildv2ConnectionGitlabConfigReadAuthorizerCredential(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedUserTokenSecretVersion, err := expandCloudbuildv2ConnectionGitlabConfigReadAuthorizerCredentialUserTokenSecretVersion(original["user_token_secret_version"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedUserTokenSecretVersion); val.IsValid() && !isEmptyValue(val) {
+		transformed["userTokenSecretVersion"] = transformedUserTokenSecretVersion
+	}
+
+	return transformed, nil
+}
+
+func expandCloudbuildv2ConnectionGitlabConfigReadAuthorizerCredentialUserTokenSecretVersion(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudbuildv2ConnectionGitlabConfigAuthorizerCredential(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedUserTokenSecretVersion, err := expandCloudbuildv2ConnectionGitlabConfigAuthorizerCredentialUserTokenSecretVersion(original["user_token_secret_version"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedUserTokenSecretVersion); val.IsValid() && !isEmptyValue(val) {
+		transformed["userTokenSecretVersion"] = transformedUserTokenSecretVersion
+	}
+
+	return transformed, nil
+}
+
+func expandCloudbuildv2ConnectionGitlabConfigAuthorizerCredentialUserTokenSecretVersion(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudbuildv2ConnectionGitlabConfigSslCa(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,91 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccCloudbuildv2Repository_github(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"installation_id": getTestGithubAppInstallationIdFromEnv(t),
+		"secret_version":  getTestGithubOAuthTokenSecretVersionFromEnv(t),
+		"remote_uri":      getTestGithubRepositoryUriFromEnv(t),
+		"random_suffix":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudbuildv2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudbuildv2Repository_github(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_cloudbuildv2_connection.github", "installation_state.0.stage", "COMPLETE"),
+				),
+			},
+			{
+				ResourceName:      "google_cloudbuildv2_connection.github",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "google_cloudbuildv2_repository.repo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudbuildv2Repository_github(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_cloudbuildv2_connection" "github" {
+  name     = "tf-test-github-%{random_suffix}"
+  location = "us-central1"
+
+  github_config {
+    app_installation_id = %{installation_id}
+
+    authorizer_credential {
+      oauth_token_secret_version = "%{secret_version}"
+    }
+  }
+}
+
+resource "google_cloudbuildv2_repository" "repo" {
+  name              = "tf-test-repo-%{random_suffix}"
+  location          = google_cloudbuildv2_connection.github.location
+  parent_connection = google_cloudbuildv2_connection.github.name
+  remote_uri        = "%{remote_uri}"
+}
+`, context)
+}
+
+func testAccCheckCloudbuildv2Destroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_cloudbuildv2_connection" && rs.Type != "google_cloudbuildv2_repository" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url := fmt.Sprintf("%s%s", config.Cloudbuildv2BasePath, rs.Primary.ID)
+		_, err := sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("%s still exists at %s", rs.Type, url)
+		}
+	}
+
+	return nil
+}
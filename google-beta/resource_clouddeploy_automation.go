@@ -0,0 +1,941 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceClouddeployAutomation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceClouddeployAutomationCreate,
+		Read:   resourceClouddeployAutomationRead,
+		Update: resourceClouddeployAutomationUpdate,
+		Delete: resourceClouddeployAutomationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceClouddeployAutomationImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: clouddeployAutomationRulesCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"delivery_pipeline": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"advance_rollout_rule": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"source_phases": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"wait": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"promote_release_rule": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"destination_phase": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"destination_target_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"wait": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"repair_rollout_rule": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"jobs": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"phases": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"selector": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"targets": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"labels": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"service_account": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"suspended": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"uid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func clouddeployAutomationRulesCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	// separate func to allow unit testing
+	return clouddeployAutomationRulesCustomizeDiffFunc(diff)
+}
+
+// Each rule is a oneof in the API, so a rules block has to pick exactly one
+// kind of rule.
+func clouddeployAutomationRulesCustomizeDiffFunc(diff TerraformResourceDiff) error {
+	_, n := diff.GetChange("rules")
+	rules, _ := n.([]interface{})
+	for i, raw := range rules {
+		rule, _ := raw.(map[string]interface{})
+		count := 0
+		for _, key := range []string{"promote_release_rule", "advance_rollout_rule", "repair_rollout_rule"} {
+			if l, ok := rule[key].([]interface{}); ok {
+				count += len(l)
+			}
+		}
+		if count != 1 {
+			return fmt.Errorf("rules.%d: exactly one of promote_release_rule, advance_rollout_rule or repair_rollout_rule must be set", i)
+		}
+	}
+	return nil
+}
+
+func resourceClouddeployAutomationCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandClouddeployAutomationDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	suspendedProp, err := expandClouddeployAutomationSuspended(d.Get("suspended"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("suspended"); !isEmptyValue(reflect.ValueOf(suspendedProp)) && (ok || !reflect.DeepEqual(v, suspendedProp)) {
+		obj["suspended"] = suspendedProp
+	}
+	serviceAccountProp, err := expandClouddeployAutomationServiceAccount(d.Get("service_account"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("service_account"); !isEmptyValue(reflect.ValueOf(serviceAccountProp)) && (ok || !reflect.DeepEqual(v, serviceAccountProp)) {
+		obj["serviceAccount"] = serviceAccountProp
+	}
+	selectorProp, err := expandClouddeployAutomationSelector(d.Get("selector"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("selector"); !isEmptyValue(reflect.ValueOf(selectorProp)) && (ok || !reflect.DeepEqual(v, selectorProp)) {
+		obj["selector"] = selectorProp
+	}
+	rulesProp, err := expandClouddeployAutomationRules(d.Get("rules"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("rules"); !isEmptyValue(reflect.ValueOf(rulesProp)) && (ok || !reflect.DeepEqual(v, rulesProp)) {
+		obj["rules"] = rulesProp
+	}
+
+	url, err := replaceVars(d, config, "{{ClouddeployBasePath}}projects/{{project}}/locations/{{location}}/deliveryPipelines/{{delivery_pipeline}}/automations?automationId={{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Automation: %#v", obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Automation: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/deliveryPipelines/{{delivery_pipeline}}/automations/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	waitErr := clouddeployOperationWaitTime(
+		config, res, project, "Creating Automation",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create Automation: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating Automation %q: %#v", d.Id(), res)
+
+	return resourceClouddeployAutomationRead(d, meta)
+}
+
+func resourceClouddeployAutomationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{ClouddeployBasePath}}projects/{{project}}/locations/{{location}}/deliveryPipelines/{{delivery_pipeline}}/automations/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ClouddeployAutomation %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Automation: %s", err)
+	}
+
+	if err := d.Set("uid", flattenClouddeployAutomationUid(res["uid"], d)); err != nil {
+		return fmt.Errorf("Error reading Automation: %s", err)
+	}
+	if err := d.Set("description", flattenClouddeployAutomationDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading Automation: %s", err)
+	}
+	if err := d.Set("suspended", flattenClouddeployAutomationSuspended(res["suspended"], d)); err != nil {
+		return fmt.Errorf("Error reading Automation: %s", err)
+	}
+	if err := d.Set("service_account", flattenClouddeployAutomationServiceAccount(res["serviceAccount"], d)); err != nil {
+		return fmt.Errorf("Error reading Automation: %s", err)
+	}
+	if err := d.Set("selector", flattenClouddeployAutomationSelector(res["selector"], d)); err != nil {
+		return fmt.Errorf("Error reading Automation: %s", err)
+	}
+	if err := d.Set("rules", flattenClouddeployAutomationRules(res["rules"], d)); err != nil {
+		return fmt.Errorf("Error reading Automation: %s", err)
+	}
+	if err := d.Set("create_time", flattenClouddeployAutomationCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Automation: %s", err)
+	}
+	if err := d.Set("update_time", flattenClouddeployAutomationUpdateTime(res["updateTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Automation: %s", err)
+	}
+	if err := d.Set("etag", flattenClouddeployAutomationEtag(res["etag"], d)); err != nil {
+		return fmt.Errorf("Error reading Automation: %s", err)
+	}
+
+	return nil
+}
+
+func resourceClouddeployAutomationUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandClouddeployAutomationDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	suspendedProp, err := expandClouddeployAutomationSuspended(d.Get("suspended"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("suspended"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, suspendedProp)) {
+		obj["suspended"] = suspendedProp
+	}
+	serviceAccountProp, err := expandClouddeployAutomationServiceAccount(d.Get("service_account"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("service_account"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, serviceAccountProp)) {
+		obj["serviceAccount"] = serviceAccountProp
+	}
+	selectorProp, err := expandClouddeployAutomationSelector(d.Get("selector"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("selector"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, selectorProp)) {
+		obj["selector"] = selectorProp
+	}
+	rulesProp, err := expandClouddeployAutomationRules(d.Get("rules"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("rules"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, rulesProp)) {
+		obj["rules"] = rulesProp
+	}
+
+	url, err := replaceVars(d, config, "{{ClouddeployBasePath}}projects/{{project}}/locations/{{location}}/deliveryPipelines/{{delivery_pipeline}}/automations/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Automation %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("description") {
+		updateMask = append(updateMask, "description")
+	}
+
+	if d.HasChange("suspended") {
+		updateMask = append(updateMask, "suspended")
+	}
+
+	if d.HasChange("service_account") {
+		updateMask = append(updateMask, "serviceAccount")
+	}
+
+	if d.HasChange("selector") {
+		updateMask = append(updateMask, "selector")
+	}
+
+	if d.HasChange("rules") {
+		updateMask = append(updateMask, "rules")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Automation %q: %s", d.Id(), err)
+	}
+
+	err = clouddeployOperationWaitTime(
+		config, res, project, "Updating Automation",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceClouddeployAutomationRead(d, meta)
+}
+
+func resourceClouddeployAutomationDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{ClouddeployBasePath}}projects/{{project}}/locations/{{location}}/deliveryPipelines/{{delivery_pipeline}}/automations/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Automation %q", d.Id())
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Automation")
+	}
+
+	err = clouddeployOperationWaitTime(
+		config, res, project, "Deleting Automation",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting Automation %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceClouddeployAutomationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/deliveryPipelines/(?P<delivery_pipeline>[^/]+)/automations/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<delivery_pipeline>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<delivery_pipeline>[^/]+)/(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/deliveryPipelines/{{delivery_pipeline}}/automations/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenClouddeployAutomationUid(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployAutomationDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployAutomationSuspended(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployAutomationServiceAccount(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployAutomationSelector(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["targets"] =
+		flattenClouddeployAutomationSelectorTargets(original["targets"], d)
+	return []interface{}{transformed}
+}
+func flattenClouddeployAutomationSelectorTargets(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"id":     flattenClouddeployAutomationSelectorTargetsId(original["id"], d),
+			"labels": flattenClouddeployAutomationSelectorTargetsLabels(original["labels"], d),
+		})
+	}
+	return transformed
+}
+func flattenClouddeployAutomationSelectorTargetsId(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployAutomationSelectorTargetsLabels(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployAutomationRules(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"promote_release_rule": flattenClouddeployAutomationRulesPromoteReleaseRule(original["promoteReleaseRule"], d),
+			"advance_rollout_rule": flattenClouddeployAutomationRulesAdvanceRolloutRule(original["advanceRolloutRule"], d),
+			"repair_rollout_rule":  flattenClouddeployAutomationRulesRepairRolloutRule(original["repairRolloutRule"], d),
+		})
+	}
+	return transformed
+}
+func flattenClouddeployAutomationRulesPromoteReleaseRule(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["id"] =
+		flattenClouddeployAutomationRulesPromoteReleaseRuleId(original["id"], d)
+	transformed["wait"] =
+		flattenClouddeployAutomationRulesPromoteReleaseRuleWait(original["wait"], d)
+	transformed["destination_target_id"] =
+		flattenClouddeployAutomationRulesPromoteReleaseRuleDestinationTargetId(original["destinationTargetId"], d)
+	transformed["destination_phase"] =
+		flattenClouddeployAutomationRulesPromoteReleaseRuleDestinationPhase(original["destinationPhase"], d)
+	return []interface{}{transformed}
+}
+func flattenClouddeployAutomationRulesPromoteReleaseRuleId(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployAutomationRulesPromoteReleaseRuleWait(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployAutomationRulesPromoteReleaseRuleDestinationTargetId(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployAutomationRulesPromoteReleaseRuleDestinationPhase(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployAutomationRulesAdvanceRolloutRule(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["id"] =
+		flattenClouddeployAutomationRulesAdvanceRolloutRuleId(original["id"], d)
+	transformed["source_phases"] =
+		flattenClouddeployAutomationRulesAdvanceRolloutRuleSourcePhases(original["sourcePhases"], d)
+	transformed["wait"] =
+		flattenClouddeployAutomationRulesAdvanceRolloutRuleWait(original["wait"], d)
+	return []interface{}{transformed}
+}
+func flattenClouddeployAutomationRulesAdvanceRolloutRuleId(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployAutomationRulesAdvanceRolloutRuleSourcePhases(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployAutomationRulesAdvanceRolloutRuleWait(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployAutomationRulesRepairRolloutRule(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["id"] =
+		flattenClouddeployAutomationRulesRepairRolloutRuleId(original["id"], d)
+	transformed["phases"] =
+		flattenClouddeployAutomationRulesRepairRolloutRulePhases(original["phases"], d)
+	transformed["jobs"] =
+		flattenClouddeployAutomationRulesRepairRolloutRuleJobs(original["jobs"], d)
+	return []interface{}{transformed}
+}
+func flattenClouddeployAutomationRulesRepairRolloutRuleId(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployAutomationRulesRepairRolloutRulePhases(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployAutomationRulesRepairRolloutRuleJobs(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployAutomationCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployAutomationUpdateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployAutomationEtag(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandClouddeployAutomationDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandClouddeployAutomationSuspended(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandClouddeployAutomationServiceAccount(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandClouddeployAutomationSelector(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedTargets, err := expandClouddeployAutomationSelectorTargets(original["targets"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedTargets); val.IsValid() && !isEmptyValue(val) {
+		transformed["targets"] = transformedTargets
+	}
+
+	return transformed, nil
+}
+
+func expandClouddeployAutomationSelectorTargets(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedId, err := expandClouddeployAutomationSelectorTargetsId(original["id"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedId); val.IsValid() && !isEmptyValue(val) {
+			transformed["id"] = transformedId
+		}
+
+		transformedLabels, err := expandClouddeployAutomationSelectorTargetsLabels(original["labels"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedLabels); val.IsValid() && !isEmptyValue(val) {
+			transformed["labels"] = transformedLabels
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandClouddeployAutomationSelectorTargetsId(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandClouddeployAutomationSelectorTargetsLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandClouddeployAutomationRules(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedPromoteReleaseRule, err := expandClouddeployAutomationRulesPromoteReleaseRule(original["promote_release_rule"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedPromoteReleaseRule); val.IsValid() && !isEmptyValue(val) {
+			transformed["promoteReleaseRule"] = transformedPromoteReleaseRule
+		}
+
+		transformedAdvanceRolloutRule, err := expandClouddeployAutomationRulesAdvanceRolloutRule(original["advance_rollout_rule"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedAdvanceRolloutRule); val.IsValid() && !isEmptyValue(val) {
+			transformed["advanceRolloutRule"] = transformedAdvanceRolloutRule
+		}
+
+		transformedRepairRolloutRule, err := expandClouddeployAutomationRulesRepairRolloutRule(original["repair_rollout_rule"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedRepairRolloutRule); val.IsValid() && !isEmptyValue(val) {
+			transformed["repairRolloutRule"] = transformedRepairRolloutRule
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandClouddeployAutomationRulesPromoteReleaseRule(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedId, err := expandClouddeployAutomationRulesPromoteReleaseRuleId(original["id"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedId); val.IsValid() && !isEmptyValue(val) {
+		transformed["id"] = transformedId
+	}
+
+	transformedWait, err := expandClouddeployAutomationRulesPromoteReleaseRuleWait(original["wait"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedWait); val.IsValid() && !isEmptyValue(val) {
+		transformed["wait"] = transformedWait
+	}
+
+	transformedDestinationTargetId, err := expandClouddeployAutomationRulesPromoteReleaseRuleDestinationTargetId(original["destination_target_id"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDestinationTargetId); val.IsValid() && !isEmptyValue(val) {
+		transformed["destinationTargetId"] = transformedDestinationTargetId
+	}
+
+	transformedDestinationPhase, err := expandClouddeployAutomationRulesPromoteReleaseRuleDestinationPhase(original["destination_phase"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDestinationPhase); val.IsValid() && !isEmptyValue(val) {
+		transformed["destinationPhase"] = transformedDestinationPhase
+	}
+
+	return transformed, nil
+}
+
+func expandClouddeployAutomationRulesPromoteReleaseRuleId(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandClouddeployAutomationRulesPromoteReleaseRuleWait(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandClouddeployAutomationRulesPromoteReleaseRuleDestinationTargetId(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandClouddeployAutomationRulesPromoteReleaseRuleDestinationPhase(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandClouddeployAutomationRulesAdvanceRolloutRule(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedId, err := expandClouddeployAutomationRulesAdvanceRolloutRuleId(original["id"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedId); val.IsValid() && !isEmptyValue(val) {
+		transformed["id"] = transformedId
+	}
+
+	transformedSourcePhases, err := expandClouddeployAutomationRulesAdvanceRolloutRuleSourcePhases(original["source_phases"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSourcePhases); val.IsValid() && !isEmptyValue(val) {
+		transformed["sourcePhases"] = transformedSourcePhases
+	}
+
+	transformedWait, err := expandClouddeployAutomationRulesAdvanceRolloutRuleWait(original["wait"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedWait); val.IsValid() && !isEmptyValue(val) {
+		transformed["wait"] = transformedWait
+	}
+
+	return transformed, nil
+}
+
+func expandClouddeployAutomationRulesAdvanceRolloutRuleId(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandClouddeployAutomationRulesAdvanceRolloutRuleSourcePhases(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandClouddeployAutomationRulesAdvanceRolloutRuleWait(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandClouddeployAutomationRulesRepairRolloutRule(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedId, err := expandClouddeployAutomationRulesRepairRolloutRuleId(original["id"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedId); val.IsValid() && !isEmptyValue(val) {
+		transformed["id"] = transformedId
+	}
+
+	transformedPhases, err := expandClouddeployAutomationRulesRepairRolloutRulePhases(original["phases"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedPhases); val.IsValid() && !isEmptyValue(val) {
+		transformed["phases"] = transformedPhases
+	}
+
+	transformedJobs, err := expandClouddeployAutomationRulesRepairRolloutRuleJobs(original["jobs"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedJobs); val.IsValid() && !isEmptyValue(val) {
+		transformed["jobs"] = transformedJobs
+	}
+
+	return transformed, nil
+}
+
+func expandClouddeployAutomationRulesRepairRolloutRuleId(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandClouddeployAutomationRulesRepairRolloutRulePhases(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandClouddeployAutomationRulesRepairRolloutRuleJobs(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,151 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestClouddeployAutomationRulesCustomizeDiff(t *testing.T) {
+	t.Parallel()
+
+	rule := []interface{}{map[string]interface{}{"id": "rule"}}
+	cases := map[string]struct {
+		Rules       []interface{}
+		ExpectError bool
+	}{
+		"promote": {
+			Rules: []interface{}{
+				map[string]interface{}{"promote_release_rule": rule},
+			},
+		},
+		"promote and repair rules": {
+			Rules: []interface{}{
+				map[string]interface{}{"promote_release_rule": rule},
+				map[string]interface{}{"repair_rollout_rule": rule},
+			},
+		},
+		"empty rule": {
+			Rules: []interface{}{
+				map[string]interface{}{},
+			},
+			ExpectError: true,
+		},
+		"two kinds in one rule": {
+			Rules: []interface{}{
+				map[string]interface{}{
+					"promote_release_rule": rule,
+					"advance_rollout_rule": rule,
+				},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &ResourceDiffMock{
+			After: map[string]interface{}{
+				"rules": tc.Rules,
+			},
+		}
+		err := clouddeployAutomationRulesCustomizeDiffFunc(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
+
+func TestAccClouddeployAutomation_promoteDevToProd(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"project":       getTestProjectFromEnv(),
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckClouddeployDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClouddeployAutomation_promoteDevToProd(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_clouddeploy_automation.promote", "rules.0.promote_release_rule.0.wait", "600s"),
+				),
+			},
+			{
+				ResourceName:      "google_clouddeploy_automation.promote",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccClouddeployAutomation_promoteDevToProd(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_service_account" "automation" {
+  account_id   = "tf-test-%{random_suffix}"
+  display_name = "Cloud Deploy automation"
+}
+
+resource "google_clouddeploy_target" "dev" {
+  name     = "tf-test-dev-%{random_suffix}"
+  location = "us-central1"
+
+  gke {
+    cluster = "projects/%{project}/locations/us-central1/clusters/dev"
+  }
+}
+
+resource "google_clouddeploy_target" "prod" {
+  name     = "tf-test-prod-%{random_suffix}"
+  location = "us-central1"
+
+  gke {
+    cluster = "projects/%{project}/locations/us-central1/clusters/prod"
+  }
+}
+
+resource "google_clouddeploy_delivery_pipeline" "pipeline" {
+  name     = "tf-test-pipeline-%{random_suffix}"
+  location = "us-central1"
+
+  serial_pipeline {
+    stages {
+      target_id = google_clouddeploy_target.dev.name
+    }
+
+    stages {
+      target_id = google_clouddeploy_target.prod.name
+    }
+  }
+}
+
+resource "google_clouddeploy_automation" "promote" {
+  name              = "tf-test-promote-%{random_suffix}"
+  location          = google_clouddeploy_delivery_pipeline.pipeline.location
+  delivery_pipeline = google_clouddeploy_delivery_pipeline.pipeline.name
+  service_account   = google_service_account.automation.email
+
+  selector {
+    targets {
+      id = google_clouddeploy_target.dev.name
+    }
+  }
+
+  rules {
+    promote_release_rule {
+      id                    = "promote-to-prod"
+      wait                  = "600s"
+      destination_target_id = google_clouddeploy_target.prod.name
+    }
+  }
+}
+`, context)
+}
@@ -0,0 +1,542 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceClouddeployDeliveryPipeline() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceClouddeployDeliveryPipelineCreate,
+		Read:   resourceClouddeployDeliveryPipelineRead,
+		Update: resourceClouddeployDeliveryPipelineUpdate,
+		Delete: resourceClouddeployDeliveryPipelineDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceClouddeployDeliveryPipelineImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: clouddeployDeliveryPipelineStagesCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"serial_pipeline": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"stages": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"target_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"profiles": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"suspended": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"uid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func clouddeployDeliveryPipelineStagesCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	// separate func to allow unit testing
+	return clouddeployDeliveryPipelineStagesCustomizeDiffFunc(diff)
+}
+
+// A target can only be promoted to once in a serial pipeline.
+func clouddeployDeliveryPipelineStagesCustomizeDiffFunc(diff TerraformResourceDiff) error {
+	_, n := diff.GetChange("serial_pipeline")
+	seen := make(map[string]int)
+	for i, targetId := range clouddeployDeliveryPipelineStageTargets(n) {
+		// unknown values show up as empty until apply
+		if targetId == "" {
+			continue
+		}
+		if j, ok := seen[targetId]; ok {
+			return fmt.Errorf("serial_pipeline.0.stages.%d: target %q is already used by stage %d", i, targetId, j)
+		}
+		seen[targetId] = i
+	}
+	return nil
+}
+
+// clouddeployDeliveryPipelineStageTargets returns the target_id of each stage
+// of a serial_pipeline value, in order.
+func clouddeployDeliveryPipelineStageTargets(v interface{}) []string {
+	l, _ := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	stages, _ := l[0].(map[string]interface{})["stages"].([]interface{})
+	targets := make([]string, 0, len(stages))
+	for _, raw := range stages {
+		stage, _ := raw.(map[string]interface{})
+		targetId, _ := stage["target_id"].(string)
+		targets = append(targets, targetId)
+	}
+	return targets
+}
+
+// The API accepts stages that reference targets that don't exist yet and only
+// surfaces that later through the pipeline's condition, so check them upfront.
+func clouddeployDeliveryPipelineCheckStageTargets(d *schema.ResourceData, config *Config) error {
+	for i, targetId := range clouddeployDeliveryPipelineStageTargets(d.Get("serial_pipeline")) {
+		url, err := replaceVars(d, config, "{{ClouddeployBasePath}}projects/{{project}}/locations/{{location}}/targets/"+targetId)
+		if err != nil {
+			return err
+		}
+		if _, err := sendRequest(config, "GET", "", url, nil); err != nil {
+			if isGoogleApiErrorWithCode(err, 404) {
+				return fmt.Errorf("serial_pipeline.0.stages.%d references target %q, which doesn't exist in location %q", i, targetId, d.Get("location").(string))
+			}
+			return fmt.Errorf("Error reading Target %q for stage %d: %s", targetId, i, err)
+		}
+	}
+	return nil
+}
+
+func resourceClouddeployDeliveryPipelineCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if err := clouddeployDeliveryPipelineCheckStageTargets(d, config); err != nil {
+		return err
+	}
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandClouddeployDeliveryPipelineDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	suspendedProp, err := expandClouddeployDeliveryPipelineSuspended(d.Get("suspended"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("suspended"); !isEmptyValue(reflect.ValueOf(suspendedProp)) && (ok || !reflect.DeepEqual(v, suspendedProp)) {
+		obj["suspended"] = suspendedProp
+	}
+	serialPipelineProp, err := expandClouddeployDeliveryPipelineSerialPipeline(d.Get("serial_pipeline"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("serial_pipeline"); !isEmptyValue(reflect.ValueOf(serialPipelineProp)) && (ok || !reflect.DeepEqual(v, serialPipelineProp)) {
+		obj["serialPipeline"] = serialPipelineProp
+	}
+
+	url, err := replaceVars(d, config, "{{ClouddeployBasePath}}projects/{{project}}/locations/{{location}}/deliveryPipelines?deliveryPipelineId={{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new DeliveryPipeline: %#v", obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating DeliveryPipeline: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/deliveryPipelines/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	waitErr := clouddeployOperationWaitTime(
+		config, res, project, "Creating DeliveryPipeline",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create DeliveryPipeline: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating DeliveryPipeline %q: %#v", d.Id(), res)
+
+	return resourceClouddeployDeliveryPipelineRead(d, meta)
+}
+
+func resourceClouddeployDeliveryPipelineRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{ClouddeployBasePath}}projects/{{project}}/locations/{{location}}/deliveryPipelines/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ClouddeployDeliveryPipeline %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading DeliveryPipeline: %s", err)
+	}
+
+	if err := d.Set("uid", flattenClouddeployDeliveryPipelineUid(res["uid"], d)); err != nil {
+		return fmt.Errorf("Error reading DeliveryPipeline: %s", err)
+	}
+	if err := d.Set("description", flattenClouddeployDeliveryPipelineDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading DeliveryPipeline: %s", err)
+	}
+	if err := d.Set("suspended", flattenClouddeployDeliveryPipelineSuspended(res["suspended"], d)); err != nil {
+		return fmt.Errorf("Error reading DeliveryPipeline: %s", err)
+	}
+	if err := d.Set("serial_pipeline", flattenClouddeployDeliveryPipelineSerialPipeline(res["serialPipeline"], d)); err != nil {
+		return fmt.Errorf("Error reading DeliveryPipeline: %s", err)
+	}
+	if err := d.Set("create_time", flattenClouddeployDeliveryPipelineCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading DeliveryPipeline: %s", err)
+	}
+	if err := d.Set("update_time", flattenClouddeployDeliveryPipelineUpdateTime(res["updateTime"], d)); err != nil {
+		return fmt.Errorf("Error reading DeliveryPipeline: %s", err)
+	}
+	if err := d.Set("etag", flattenClouddeployDeliveryPipelineEtag(res["etag"], d)); err != nil {
+		return fmt.Errorf("Error reading DeliveryPipeline: %s", err)
+	}
+
+	return nil
+}
+
+func resourceClouddeployDeliveryPipelineUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if d.HasChange("serial_pipeline") {
+		if err := clouddeployDeliveryPipelineCheckStageTargets(d, config); err != nil {
+			return err
+		}
+	}
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandClouddeployDeliveryPipelineDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	suspendedProp, err := expandClouddeployDeliveryPipelineSuspended(d.Get("suspended"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("suspended"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, suspendedProp)) {
+		obj["suspended"] = suspendedProp
+	}
+	serialPipelineProp, err := expandClouddeployDeliveryPipelineSerialPipeline(d.Get("serial_pipeline"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("serial_pipeline"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, serialPipelineProp)) {
+		obj["serialPipeline"] = serialPipelineProp
+	}
+
+	url, err := replaceVars(d, config, "{{ClouddeployBasePath}}projects/{{project}}/locations/{{location}}/deliveryPipelines/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating DeliveryPipeline %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("description") {
+		updateMask = append(updateMask, "description")
+	}
+
+	if d.HasChange("suspended") {
+		updateMask = append(updateMask, "suspended")
+	}
+
+	if d.HasChange("serial_pipeline") {
+		updateMask = append(updateMask, "serialPipeline")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating DeliveryPipeline %q: %s", d.Id(), err)
+	}
+
+	err = clouddeployOperationWaitTime(
+		config, res, project, "Updating DeliveryPipeline",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceClouddeployDeliveryPipelineRead(d, meta)
+}
+
+func resourceClouddeployDeliveryPipelineDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{ClouddeployBasePath}}projects/{{project}}/locations/{{location}}/deliveryPipelines/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting DeliveryPipeline %q", d.Id())
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "DeliveryPipeline")
+	}
+
+	err = clouddeployOperationWaitTime(
+		config, res, project, "Deleting DeliveryPipeline",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting DeliveryPipeline %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceClouddeployDeliveryPipelineImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/deliveryPipelines/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/deliveryPipelines/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenClouddeployDeliveryPipelineUid(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployDeliveryPipelineDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployDeliveryPipelineSuspended(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployDeliveryPipelineSerialPipeline(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["stages"] =
+		flattenClouddeployDeliveryPipelineSerialPipelineStages(original["stages"], d)
+	return []interface{}{transformed}
+}
+func flattenClouddeployDeliveryPipelineSerialPipelineStages(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"target_id": flattenClouddeployDeliveryPipelineSerialPipelineStagesTargetId(original["targetId"], d),
+			"profiles":  flattenClouddeployDeliveryPipelineSerialPipelineStagesProfiles(original["profiles"], d),
+		})
+	}
+	return transformed
+}
+func flattenClouddeployDeliveryPipelineSerialPipelineStagesTargetId(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployDeliveryPipelineSerialPipelineStagesProfiles(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployDeliveryPipelineCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployDeliveryPipelineUpdateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployDeliveryPipelineEtag(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandClouddeployDeliveryPipelineDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandClouddeployDeliveryPipelineSuspended(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandClouddeployDeliveryPipelineSerialPipeline(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedStages, err := expandClouddeployDeliveryPipelineSerialPipelineStages(original["stages"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedStages); val.IsValid() && !isEmptyValue(val) {
+		transformed["stages"] = transformedStages
+	}
+
+	return transformed, nil
+}
+
+func expandClouddeployDeliveryPipelineSerialPipelineStages(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedTargetId, err := expandClouddeployDeliveryPipelineSerialPipelineStagesTargetId(original["target_id"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedTargetId); val.IsValid() && !isEmptyValue(val) {
+			transformed["targetId"] = transformedTargetId
+		}
+
+		transformedProfiles, err := expandClouddeployDeliveryPipelineSerialPipelineStagesProfiles(original["profiles"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedProfiles); val.IsValid() && !isEmptyValue(val) {
+			transformed["profiles"] = transformedProfiles
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandClouddeployDeliveryPipelineSerialPipelineStagesTargetId(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandClouddeployDeliveryPipelineSerialPipelineStagesProfiles(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
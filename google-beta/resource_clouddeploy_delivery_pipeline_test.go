@@ -0,0 +1,160 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestClouddeployDeliveryPipelineStagesCustomizeDiff(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Targets     []string
+		ExpectError bool
+	}{
+		"no stages": {},
+		"two targets": {
+			Targets: []string{"dev", "prod"},
+		},
+		"unknown targets": {
+			Targets: []string{"", ""},
+		},
+		"repeated target": {
+			Targets:     []string{"dev", "prod", "dev"},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		stages := make([]interface{}, 0, len(tc.Targets))
+		for _, target := range tc.Targets {
+			stages = append(stages, map[string]interface{}{"target_id": target})
+		}
+		d := &ResourceDiffMock{
+			After: map[string]interface{}{
+				"serial_pipeline": []interface{}{
+					map[string]interface{}{"stages": stages},
+				},
+			},
+		}
+		err := clouddeployDeliveryPipelineStagesCustomizeDiffFunc(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
+
+func TestAccClouddeployDeliveryPipeline_gkeStages(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"project":       getTestProjectFromEnv(),
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckClouddeployDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClouddeployDeliveryPipeline_gkeStages(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_clouddeploy_delivery_pipeline.pipeline", "serial_pipeline.0.stages.#", "2"),
+					resource.TestCheckResourceAttr("google_clouddeploy_target.prod", "require_approval", "true"),
+				),
+			},
+			{
+				ResourceName:      "google_clouddeploy_target.dev",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "google_clouddeploy_target.prod",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "google_clouddeploy_delivery_pipeline.pipeline",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccClouddeployDeliveryPipeline_gkeStages(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_clouddeploy_target" "dev" {
+  name     = "tf-test-dev-%{random_suffix}"
+  location = "us-central1"
+
+  gke {
+    cluster = "projects/%{project}/locations/us-central1/clusters/dev"
+  }
+}
+
+resource "google_clouddeploy_target" "prod" {
+  name             = "tf-test-prod-%{random_suffix}"
+  location         = "us-central1"
+  require_approval = true
+
+  gke {
+    cluster     = "projects/%{project}/locations/us-central1/clusters/prod"
+    internal_ip = true
+  }
+
+  execution_configs {
+    usages            = ["RENDER", "DEPLOY"]
+    execution_timeout = "3600s"
+  }
+}
+
+resource "google_clouddeploy_delivery_pipeline" "pipeline" {
+  name        = "tf-test-pipeline-%{random_suffix}"
+  location    = "us-central1"
+  description = "dev to prod"
+
+  serial_pipeline {
+    stages {
+      target_id = google_clouddeploy_target.dev.name
+      profiles  = ["dev"]
+    }
+
+    stages {
+      target_id = google_clouddeploy_target.prod.name
+      profiles  = ["prod"]
+    }
+  }
+}
+`, context)
+}
+
+func testAccCheckClouddeployDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_clouddeploy_delivery_pipeline" && rs.Type != "google_clouddeploy_target" && rs.Type != "google_clouddeploy_automation" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url := fmt.Sprintf("%s%s", config.ClouddeployBasePath, rs.Primary.ID)
+		_, err := sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("%s still exists at %s", rs.Type, url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,768 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceClouddeployTarget() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceClouddeployTargetCreate,
+		Read:   resourceClouddeployTargetRead,
+		Update: resourceClouddeployTargetUpdate,
+		Delete: resourceClouddeployTargetDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceClouddeployTargetImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: clouddeployTargetDeploymentCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"anthos_cluster": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"membership": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"execution_configs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"usages": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{"RENDER", "DEPLOY", "VERIFY"}, false),
+							},
+						},
+						"artifact_storage": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Optional: true,
+						},
+						"execution_timeout": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Optional: true,
+						},
+						"service_account": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Optional: true,
+						},
+						"worker_pool": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"gke": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cluster": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: compareSelfLinkOrResourceName,
+						},
+						"internal_ip": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"require_approval": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"run": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"location": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"uid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func clouddeployTargetDeploymentCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	// separate func to allow unit testing
+	return clouddeployTargetDeploymentCustomizeDiffFunc(diff)
+}
+
+// A target deploys to exactly one kind of runtime, and the API has no default.
+func clouddeployTargetDeploymentCustomizeDiffFunc(diff TerraformResourceDiff) error {
+	count := 0
+	for _, key := range []string{"gke", "run", "anthos_cluster"} {
+		_, n := diff.GetChange(key + ".#")
+		c, _ := n.(int)
+		count += c
+	}
+	if count != 1 {
+		return fmt.Errorf("exactly one of gke, run or anthos_cluster must be set")
+	}
+	return nil
+}
+
+func resourceClouddeployTargetCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandClouddeployTargetDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	requireApprovalProp, err := expandClouddeployTargetRequireApproval(d.Get("require_approval"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("require_approval"); !isEmptyValue(reflect.ValueOf(requireApprovalProp)) && (ok || !reflect.DeepEqual(v, requireApprovalProp)) {
+		obj["requireApproval"] = requireApprovalProp
+	}
+	gkeProp, err := expandClouddeployTargetGke(d.Get("gke"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("gke"); !isEmptyValue(reflect.ValueOf(gkeProp)) && (ok || !reflect.DeepEqual(v, gkeProp)) {
+		obj["gke"] = gkeProp
+	}
+	runProp, err := expandClouddeployTargetRun(d.Get("run"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("run"); !isEmptyValue(reflect.ValueOf(runProp)) && (ok || !reflect.DeepEqual(v, runProp)) {
+		obj["run"] = runProp
+	}
+	anthosClusterProp, err := expandClouddeployTargetAnthosCluster(d.Get("anthos_cluster"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("anthos_cluster"); !isEmptyValue(reflect.ValueOf(anthosClusterProp)) && (ok || !reflect.DeepEqual(v, anthosClusterProp)) {
+		obj["anthosCluster"] = anthosClusterProp
+	}
+	executionConfigsProp, err := expandClouddeployTargetExecutionConfigs(d.Get("execution_configs"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("execution_configs"); !isEmptyValue(reflect.ValueOf(executionConfigsProp)) && (ok || !reflect.DeepEqual(v, executionConfigsProp)) {
+		obj["executionConfigs"] = executionConfigsProp
+	}
+
+	url, err := replaceVars(d, config, "{{ClouddeployBasePath}}projects/{{project}}/locations/{{location}}/targets?targetId={{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Target: %#v", obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Target: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/targets/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	waitErr := clouddeployOperationWaitTime(
+		config, res, project, "Creating Target",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create Target: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating Target %q: %#v", d.Id(), res)
+
+	return resourceClouddeployTargetRead(d, meta)
+}
+
+func resourceClouddeployTargetRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{ClouddeployBasePath}}projects/{{project}}/locations/{{location}}/targets/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ClouddeployTarget %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Target: %s", err)
+	}
+
+	if err := d.Set("target_id", flattenClouddeployTargetTargetId(res["targetId"], d)); err != nil {
+		return fmt.Errorf("Error reading Target: %s", err)
+	}
+	if err := d.Set("uid", flattenClouddeployTargetUid(res["uid"], d)); err != nil {
+		return fmt.Errorf("Error reading Target: %s", err)
+	}
+	if err := d.Set("description", flattenClouddeployTargetDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading Target: %s", err)
+	}
+	if err := d.Set("require_approval", flattenClouddeployTargetRequireApproval(res["requireApproval"], d)); err != nil {
+		return fmt.Errorf("Error reading Target: %s", err)
+	}
+	if err := d.Set("gke", flattenClouddeployTargetGke(res["gke"], d)); err != nil {
+		return fmt.Errorf("Error reading Target: %s", err)
+	}
+	if err := d.Set("run", flattenClouddeployTargetRun(res["run"], d)); err != nil {
+		return fmt.Errorf("Error reading Target: %s", err)
+	}
+	if err := d.Set("anthos_cluster", flattenClouddeployTargetAnthosCluster(res["anthosCluster"], d)); err != nil {
+		return fmt.Errorf("Error reading Target: %s", err)
+	}
+	if err := d.Set("execution_configs", flattenClouddeployTargetExecutionConfigs(res["executionConfigs"], d)); err != nil {
+		return fmt.Errorf("Error reading Target: %s", err)
+	}
+	if err := d.Set("create_time", flattenClouddeployTargetCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Target: %s", err)
+	}
+	if err := d.Set("update_time", flattenClouddeployTargetUpdateTime(res["updateTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Target: %s", err)
+	}
+	if err := d.Set("etag", flattenClouddeployTargetEtag(res["etag"], d)); err != nil {
+		return fmt.Errorf("Error reading Target: %s", err)
+	}
+
+	return nil
+}
+
+func resourceClouddeployTargetUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandClouddeployTargetDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	requireApprovalProp, err := expandClouddeployTargetRequireApproval(d.Get("require_approval"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("require_approval"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, requireApprovalProp)) {
+		obj["requireApproval"] = requireApprovalProp
+	}
+	gkeProp, err := expandClouddeployTargetGke(d.Get("gke"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("gke"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, gkeProp)) {
+		obj["gke"] = gkeProp
+	}
+	runProp, err := expandClouddeployTargetRun(d.Get("run"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("run"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, runProp)) {
+		obj["run"] = runProp
+	}
+	anthosClusterProp, err := expandClouddeployTargetAnthosCluster(d.Get("anthos_cluster"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("anthos_cluster"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, anthosClusterProp)) {
+		obj["anthosCluster"] = anthosClusterProp
+	}
+	executionConfigsProp, err := expandClouddeployTargetExecutionConfigs(d.Get("execution_configs"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("execution_configs"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, executionConfigsProp)) {
+		obj["executionConfigs"] = executionConfigsProp
+	}
+
+	url, err := replaceVars(d, config, "{{ClouddeployBasePath}}projects/{{project}}/locations/{{location}}/targets/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Target %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("description") {
+		updateMask = append(updateMask, "description")
+	}
+
+	if d.HasChange("require_approval") {
+		updateMask = append(updateMask, "requireApproval")
+	}
+
+	if d.HasChange("gke") {
+		updateMask = append(updateMask, "gke")
+	}
+
+	if d.HasChange("run") {
+		updateMask = append(updateMask, "run")
+	}
+
+	if d.HasChange("anthos_cluster") {
+		updateMask = append(updateMask, "anthosCluster")
+	}
+
+	if d.HasChange("execution_configs") {
+		updateMask = append(updateMask, "executionConfigs")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Target %q: %s", d.Id(), err)
+	}
+
+	err = clouddeployOperationWaitTime(
+		config, res, project, "Updating Target",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceClouddeployTargetRead(d, meta)
+}
+
+func resourceClouddeployTargetDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{ClouddeployBasePath}}projects/{{project}}/locations/{{location}}/targets/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Target %q", d.Id())
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Target")
+	}
+
+	err = clouddeployOperationWaitTime(
+		config, res, project, "Deleting Target",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting Target %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceClouddeployTargetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/targets/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/targets/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenClouddeployTargetTargetId(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployTargetUid(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployTargetDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployTargetRequireApproval(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployTargetGke(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["cluster"] =
+		flattenClouddeployTargetGkeCluster(original["cluster"], d)
+	transformed["internal_ip"] =
+		flattenClouddeployTargetGkeInternalIp(original["internalIp"], d)
+	return []interface{}{transformed}
+}
+func flattenClouddeployTargetGkeCluster(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployTargetGkeInternalIp(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployTargetRun(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["location"] =
+		flattenClouddeployTargetRunLocation(original["location"], d)
+	return []interface{}{transformed}
+}
+func flattenClouddeployTargetRunLocation(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployTargetAnthosCluster(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["membership"] =
+		flattenClouddeployTargetAnthosClusterMembership(original["membership"], d)
+	return []interface{}{transformed}
+}
+func flattenClouddeployTargetAnthosClusterMembership(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployTargetExecutionConfigs(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"usages":            flattenClouddeployTargetExecutionConfigsUsages(original["usages"], d),
+			"worker_pool":       flattenClouddeployTargetExecutionConfigsWorkerPool(original["workerPool"], d),
+			"service_account":   flattenClouddeployTargetExecutionConfigsServiceAccount(original["serviceAccount"], d),
+			"artifact_storage":  flattenClouddeployTargetExecutionConfigsArtifactStorage(original["artifactStorage"], d),
+			"execution_timeout": flattenClouddeployTargetExecutionConfigsExecutionTimeout(original["executionTimeout"], d),
+		})
+	}
+	return transformed
+}
+func flattenClouddeployTargetExecutionConfigsUsages(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployTargetExecutionConfigsWorkerPool(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployTargetExecutionConfigsServiceAccount(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployTargetExecutionConfigsArtifactStorage(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployTargetExecutionConfigsExecutionTimeout(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployTargetCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployTargetUpdateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenClouddeployTargetEtag(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandClouddeployTargetDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandClouddeployTargetRequireApproval(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandClouddeployTargetGke(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedCluster, err := expandClouddeployTargetGkeCluster(original["cluster"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedCluster); val.IsValid() && !isEmptyValue(val) {
+		transformed["cluster"] = transformedCluster
+	}
+
+	transformedInternalIp, err := expandClouddeployTargetGkeInternalIp(original["internal_ip"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedInternalIp); val.IsValid() && !isEmptyValue(val) {
+		transformed["internalIp"] = transformedInternalIp
+	}
+
+	return transformed, nil
+}
+
+func expandClouddeployTargetGkeCluster(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandClouddeployTargetGkeInternalIp(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandClouddeployTargetRun(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedLocation, err := expandClouddeployTargetRunLocation(original["location"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedLocation); val.IsValid() && !isEmptyValue(val) {
+		transformed["location"] = transformedLocation
+	}
+
+	return transformed, nil
+}
+
+func expandClouddeployTargetRunLocation(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandClouddeployTargetAnthosCluster(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedMembership, err := expandClouddeployTargetAnthosClusterMembership(original["membership"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMembership); val.IsValid() && !isEmptyValue(val) {
+		transformed["membership"] = transformedMembership
+	}
+
+	return transformed, nil
+}
+
+func expandClouddeployTargetAnthosClusterMembership(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandClouddeployTargetExecutionConfigs(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedUsages, err := expandClouddeployTargetExecutionConfigsUsages(original["usages"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedUsages); val.IsValid() && !isEmptyValue(val) {
+			transformed["usages"] = transformedUsages
+		}
+
+		transformedWorkerPool, err := expandClouddeployTargetExecutionConfigsWorkerPool(original["worker_pool"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedWorkerPool); val.IsValid() && !isEmptyValue(val) {
+			transformed["workerPool"] = transformedWorkerPool
+		}
+
+		transformedServiceAccount, err := expandClouddeployTargetExecutionConfigsServiceAccount(original["service_account"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedServiceAccount); val.IsValid() && !isEmptyValue(val) {
+			transformed["serviceAccount"] = transformedServiceAccount
+		}
+
+		transformedArtifactStorage, err := expandClouddeployTargetExecutionConfigsArtifactStorage(original["artifact_storage"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedArtifactStorage); val.IsValid() && !isEmptyValue(val) {
+			transformed["artifactStorage"] = transformedArtifactStorage
+		}
+
+		transformedExecutionTimeout, err := expandClouddeployTargetExecutionConfigsExecutionTimeout(original["execution_timeout"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedExecutionTimeout); val.IsValid() && !isEmptyValue(val) {
+			transformed["executionTimeout"] = transformedExecutionTimeout
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandClouddeployTargetExecutionConfigsUsages(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandClouddeployTargetExecutionConfigsWorkerPool(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandClouddeployTargetExecutionConfigsServiceAccount(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandClouddeployTargetExecutionConfigsArtifactStorage(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandClouddeployTargetExecutionConfigsExecutionTimeout(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
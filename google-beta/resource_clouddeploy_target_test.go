@@ -0,0 +1,49 @@
+package google
+
+import (
+	"testing"
+)
+
+func TestClouddeployTargetDeploymentCustomizeDiff(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Gke, Run, AnthosCluster int
+		ExpectError             bool
+	}{
+		"gke": {
+			Gke: 1,
+		},
+		"run": {
+			Run: 1,
+		},
+		"anthos cluster": {
+			AnthosCluster: 1,
+		},
+		"none": {
+			ExpectError: true,
+		},
+		"gke and run": {
+			Gke:         1,
+			Run:         1,
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &ResourceDiffMock{
+			After: map[string]interface{}{
+				"gke.#":            tc.Gke,
+				"run.#":            tc.Run,
+				"anthos_cluster.#": tc.AnthosCluster,
+			},
+		}
+		err := clouddeployTargetDeploymentCustomizeDiffFunc(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
@@ -107,6 +107,8 @@ func resourceCloudFunctionsFunction() *schema.Resource {
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
+		CustomizeDiff: setLabelsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -182,6 +184,18 @@ func resourceCloudFunctionsFunction() *schema.Resource {
 				Optional: true,
 			},
 
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"effective_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"runtime": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -352,7 +366,7 @@ func resourceCloudFunctionsCreate(d *schema.ResourceData, meta interface{}) erro
 			"You must specify a trigger when deploying a new function.")
 	}
 
-	if _, ok := d.GetOk("labels"); ok {
+	if _, ok := d.GetOk("terraform_labels"); ok {
 		function.Labels = expandLabels(d)
 	}
 
@@ -406,7 +420,9 @@ func resourceCloudFunctionsRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 	d.Set("timeout", timeout)
-	d.Set("labels", function.Labels)
+	if err := setLabelsFields(d, config, function.Labels); err != nil {
+		return err
+	}
 	d.Set("runtime", function.Runtime)
 	d.Set("service_account_email", function.ServiceAccountEmail)
 	d.Set("environment_variables", function.EnvironmentVariables)
@@ -486,7 +502,7 @@ func resourceCloudFunctionsUpdate(d *schema.ResourceData, meta interface{}) erro
 		updateMaskArr = append(updateMaskArr, "timeout")
 	}
 
-	if d.HasChange("labels") {
+	if d.HasChange("labels") || d.HasChange("terraform_labels") {
 		function.Labels = expandLabels(d)
 		updateMaskArr = append(updateMaskArr, "labels")
 	}
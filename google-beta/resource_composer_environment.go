@@ -3,6 +3,7 @@ package google
 import (
 	"fmt"
 	"log"
+	"reflect"
 	"regexp"
 	"strings"
 	"time"
@@ -53,6 +54,8 @@ func resourceComposerEnvironment() *schema.Resource {
 			Delete: schema.DefaultTimeout(15 * time.Minute),
 		},
 
+		CustomizeDiff: setLabelsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -260,6 +263,19 @@ func resourceComposerEnvironment() *schema.Resource {
 								},
 							},
 						},
+						"workloads_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"scheduler":  composerWorkloadsConfigComponentSchema("count"),
+									"web_server": composerWorkloadsConfigComponentSchema(),
+									"worker":     composerWorkloadsConfigComponentSchema("min_count", "max_count"),
+								},
+							},
+						},
 						"airflow_uri": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -280,6 +296,57 @@ func resourceComposerEnvironment() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"effective_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// composerWorkloadsConfigComponentSchema returns the schema of the resources
+// of a Composer 2 Airflow component, with the given extra count fields.
+func composerWorkloadsConfigComponentSchema(counts ...string) *schema.Schema {
+	component := map[string]*schema.Schema{
+		"cpu": {
+			Type:     schema.TypeFloat,
+			Optional: true,
+			Computed: true,
+		},
+		"memory_gb": {
+			Type:     schema.TypeFloat,
+			Optional: true,
+			Computed: true,
+		},
+		"storage_gb": {
+			Type:     schema.TypeFloat,
+			Optional: true,
+			Computed: true,
+		},
+	}
+	for _, count := range counts {
+		component[count] = &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: component,
 		},
 	}
 }
@@ -306,11 +373,30 @@ func resourceComposerEnvironmentCreate(d *schema.ResourceData, meta interface{})
 	// Some fields cannot be specified during create and must be updated post-creation.
 	updateOnlyEnv := getComposerEnvironmentPostCreateUpdateObj(env)
 
+	// The vendored client doesn't know about Composer 2 workloads, so the
+	// environment is sent as JSON with the workloads config added to it.
+	rawEnv, err := ConvertToMap(env)
+	if err != nil {
+		return err
+	}
+	if v, ok := d.GetOk("config.0.workloads_config"); ok {
+		rawConfig, _ := rawEnv["config"].(map[string]interface{})
+		if rawConfig == nil {
+			rawConfig = make(map[string]interface{})
+			rawEnv["config"] = rawConfig
+		}
+		rawConfig["workloadsConfig"] = expandComposerEnvironmentConfigWorkloadsConfig(v)
+	}
+
 	log.Printf("[DEBUG] Creating new Environment %q", envName.parentName())
-	op, err := config.clientComposer.Projects.Locations.Environments.Create(envName.parentName(), env).Do()
+	res, err := sendRequestWithTimeout(config, "POST", "", config.ComposerBasePath+envName.parentName()+"/environments", rawEnv, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
+	op := &composer.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
 
 	// Store the ID now
 	id, err := replaceVars(d, config, "{{project}}/{{region}}/{{name}}")
@@ -354,10 +440,16 @@ func resourceComposerEnvironmentRead(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	res, err := config.clientComposer.Projects.Locations.Environments.Get(envName.resourceName()).Do()
+	// The environment is read as JSON so the Composer 2 workloads config can
+	// be read from it as well.
+	rawEnv, err := sendRequest(config, "GET", "", config.ComposerBasePath+envName.resourceName(), nil)
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("ComposerEnvironment %q", d.Id()))
 	}
+	res := &composer.Environment{}
+	if err := Convert(rawEnv, res); err != nil {
+		return err
+	}
 
 	// Set from getProject(d)
 	if err := d.Set("project", envName.Project); err != nil {
@@ -370,10 +462,11 @@ func resourceComposerEnvironmentRead(d *schema.ResourceData, meta interface{}) e
 	if err := d.Set("name", GetResourceNameFromSelfLink(res.Name)); err != nil {
 		return fmt.Errorf("Error reading Environment: %s", err)
 	}
-	if err := d.Set("config", flattenComposerEnvironmentConfig(res.Config)); err != nil {
+	rawConfig, _ := rawEnv["config"].(map[string]interface{})
+	if err := d.Set("config", flattenComposerEnvironmentConfig(res.Config, rawConfig)); err != nil {
 		return fmt.Errorf("Error reading Environment: %s", err)
 	}
-	if err := d.Set("labels", res.Labels); err != nil {
+	if err := setLabelsFields(d, config, res.Labels); err != nil {
 		return fmt.Errorf("Error reading Environment: %s", err)
 	}
 	return nil
@@ -468,6 +561,19 @@ func resourceComposerEnvironmentUpdate(d *schema.ResourceData, meta interface{})
 			d.SetPartial("config")
 		}
 
+		if d.HasChange("config.0.workloads_config") {
+			patchObj := map[string]interface{}{
+				"config": map[string]interface{}{
+					"workloadsConfig": expandComposerEnvironmentConfigWorkloadsConfig(d.Get("config.0.workloads_config")),
+				},
+			}
+			err = resourceComposerEnvironmentPatchRawField("config.workloadsConfig", patchObj, d, tfConfig)
+			if err != nil {
+				return err
+			}
+			d.SetPartial("config")
+		}
+
 		if d.HasChange("config.0.node_count") {
 			patchObj := &composer.Environment{Config: &composer.EnvironmentConfig{}}
 			if config != nil {
@@ -481,7 +587,7 @@ func resourceComposerEnvironmentUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("labels") {
+	if d.HasChange("labels") || d.HasChange("terraform_labels") {
 		patchEnv := &composer.Environment{Labels: expandLabels(d)}
 		err := resourceComposerEnvironmentPatchField("labels", patchEnv, d, tfConfig)
 		if err != nil {
@@ -542,6 +648,40 @@ func resourceComposerEnvironmentPatchField(updateMask string, env *composer.Envi
 	return nil
 }
 
+// resourceComposerEnvironmentPatchRawField updates a field the vendored client
+// doesn't know about, env is the JSON representation of the patch.
+func resourceComposerEnvironmentPatchRawField(updateMask string, env map[string]interface{}, d *schema.ResourceData, config *Config) error {
+	log.Printf("[DEBUG] Updating Environment %q (updateMask = %q): %#v", d.Id(), updateMask, env)
+	envName, err := resourceComposerEnvironmentName(d, config)
+	if err != nil {
+		return err
+	}
+
+	url, err := addQueryParams(config.ComposerBasePath+envName.resourceName(), map[string]string{"updateMask": updateMask})
+	if err != nil {
+		return err
+	}
+	res, err := sendRequestWithTimeout(config, "PATCH", "", url, env, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return err
+	}
+	op := &composer.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
+
+	waitErr := composerOperationWaitTime(
+		config.clientComposer, op, envName.Project, "Updating Environment",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+	if waitErr != nil {
+		// The resource didn't actually update.
+		return fmt.Errorf("Error waiting to update Environment: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished updating Environment %q (updateMask = %q)", d.Id(), updateMask)
+	return nil
+}
+
 func resourceComposerEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -583,7 +723,7 @@ func resourceComposerEnvironmentImport(d *schema.ResourceData, meta interface{})
 	return []*schema.ResourceData{d}, nil
 }
 
-func flattenComposerEnvironmentConfig(envCfg *composer.EnvironmentConfig) interface{} {
+func flattenComposerEnvironmentConfig(envCfg *composer.EnvironmentConfig, rawCfg map[string]interface{}) interface{} {
 	if envCfg == nil {
 		return nil
 	}
@@ -595,10 +735,56 @@ func flattenComposerEnvironmentConfig(envCfg *composer.EnvironmentConfig) interf
 	transformed["node_config"] = flattenComposerEnvironmentConfigNodeConfig(envCfg.NodeConfig)
 	transformed["software_config"] = flattenComposerEnvironmentConfigSoftwareConfig(envCfg.SoftwareConfig)
 	transformed["private_environment_config"] = flattenComposerEnvironmentConfigPrivateEnvironmentConfig(envCfg.PrivateEnvironmentConfig)
+	transformed["workloads_config"] = flattenComposerEnvironmentConfigWorkloadsConfig(rawCfg["workloadsConfig"])
 
 	return []interface{}{transformed}
 }
 
+// The workloads config isn't part of the vendored client, so it's flattened
+// from and expanded to its JSON representation.
+var composerWorkloadsConfigFields = map[string]string{
+	"cpu":        "cpu",
+	"memory_gb":  "memoryGb",
+	"storage_gb": "storageGb",
+	"count":      "count",
+	"min_count":  "minCount",
+	"max_count":  "maxCount",
+}
+
+var composerWorkloadsConfigComponents = map[string]string{
+	"scheduler":  "scheduler",
+	"web_server": "webServer",
+	"worker":     "worker",
+}
+
+func flattenComposerEnvironmentConfigWorkloadsConfig(v interface{}) interface{} {
+	workloadsCfg, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	for tfComponent, apiComponent := range composerWorkloadsConfigComponents {
+		component, ok := workloadsCfg[apiComponent].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		transformedComponent := make(map[string]interface{})
+		for tfField, apiField := range composerWorkloadsConfigFields {
+			fieldValue, ok := component[apiField]
+			if !ok {
+				continue
+			}
+			if f, ok := fieldValue.(float64); ok && strings.HasSuffix(tfField, "count") {
+				// Counts are integers in the schema, JSON numbers are decoded as floats.
+				fieldValue = int(f)
+			}
+			transformedComponent[tfField] = fieldValue
+		}
+		transformed[tfComponent] = []interface{}{transformedComponent}
+	}
+	return []interface{}{transformed}
+}
+
 func flattenComposerEnvironmentConfigPrivateEnvironmentConfig(envCfg *composer.PrivateEnvironmentConfig) interface{} {
 	if envCfg == nil {
 		return nil
@@ -706,6 +892,30 @@ func expandComposerEnvironmentConfig(v interface{}, d *schema.ResourceData, conf
 	return transformed, nil
 }
 
+func expandComposerEnvironmentConfigWorkloadsConfig(v interface{}) map[string]interface{} {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	original := l[0].(map[string]interface{})
+	transformed := make(map[string]interface{})
+	for tfComponent, apiComponent := range composerWorkloadsConfigComponents {
+		components, _ := original[tfComponent].([]interface{})
+		if len(components) == 0 || components[0] == nil {
+			continue
+		}
+		component := components[0].(map[string]interface{})
+		transformedComponent := make(map[string]interface{})
+		for tfField, apiField := range composerWorkloadsConfigFields {
+			if fieldValue, ok := component[tfField]; ok && !isEmptyValue(reflect.ValueOf(fieldValue)) {
+				transformedComponent[apiField] = fieldValue
+			}
+		}
+		transformed[apiComponent] = transformedComponent
+	}
+	return transformed
+}
+
 func expandComposerEnvironmentConfigNodeCount(v interface{}, d *schema.ResourceData, config *Config) (int64, error) {
 	if v == nil {
 		return 0, nil
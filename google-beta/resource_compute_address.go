@@ -42,6 +42,8 @@ func resourceComputeAddress() *schema.Resource {
 			Delete: schema.DefaultTimeout(4 * time.Minute),
 		},
 
+		CustomizeDiff: setLabelsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -72,6 +74,16 @@ func resourceComputeAddress() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"effective_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"network_tier": {
 				Type:         schema.TypeString,
 				Computed:     true,
@@ -162,10 +174,10 @@ func resourceComputeAddressCreate(d *schema.ResourceData, meta interface{}) erro
 	} else if v, ok := d.GetOkExists("subnetwork"); !isEmptyValue(reflect.ValueOf(subnetworkProp)) && (ok || !reflect.DeepEqual(v, subnetworkProp)) {
 		obj["subnetwork"] = subnetworkProp
 	}
-	labelsProp, err := expandComputeAddressLabels(d.Get("labels"), d, config)
+	labelsProp, err := expandComputeAddressLabels(d.Get("terraform_labels"), d, config)
 	if err != nil {
 		return err
-	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+	} else if v, ok := d.GetOkExists("terraform_labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
 		obj["labels"] = labelsProp
 	}
 	labelFingerprintProp, err := expandComputeAddressLabelFingerprint(d.Get("label_fingerprint"), d, config)
@@ -187,7 +199,12 @@ func resourceComputeAddressCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	log.Printf("[DEBUG] Creating new Address: %#v", obj)
-	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("Error creating Address: %s", err)
 	}
@@ -199,10 +216,6 @@ func resourceComputeAddressCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 	d.SetId(id)
 
-	project, err := getProject(d, config)
-	if err != nil {
-		return err
-	}
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -210,7 +223,7 @@ func resourceComputeAddressCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating Address",
+		config, op, project, "Creating Address",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
@@ -221,7 +234,7 @@ func resourceComputeAddressCreate(d *schema.ResourceData, meta interface{}) erro
 
 	log.Printf("[DEBUG] Finished creating Address %q: %#v", d.Id(), res)
 
-	if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+	if v, ok := d.GetOkExists("terraform_labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
 		// Labels cannot be set in a create.  We'll have to set them here.
 		err = resourceComputeAddressRead(d, meta)
 		if err != nil {
@@ -229,7 +242,7 @@ func resourceComputeAddressCreate(d *schema.ResourceData, meta interface{}) erro
 		}
 
 		obj := make(map[string]interface{})
-		// d.Get("labels") will have been overridden by the Read call.
+		// d.Get("terraform_labels") will have been overridden by the Read call.
 		labelsProp, err := expandComputeAddressLabels(v, d, config)
 		if err != nil {
 			return err
@@ -242,7 +255,7 @@ func resourceComputeAddressCreate(d *schema.ResourceData, meta interface{}) erro
 		if err != nil {
 			return err
 		}
-		res, err = sendRequest(config, "POST", url, obj)
+		res, err = sendComputeSetLabelsRequest(config, project, url, obj, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return fmt.Errorf("Error adding labels to ComputeAddress %q: %s", d.Id(), err)
 		}
@@ -253,8 +266,8 @@ func resourceComputeAddressCreate(d *schema.ResourceData, meta interface{}) erro
 		}
 
 		err = computeOperationWaitTime(
-			config.clientCompute, op, project, "Updating ComputeAddress Labels",
-			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+			config, op, project, "Updating ComputeAddress Labels",
+			int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 		if err != nil {
 			return err
@@ -273,15 +286,16 @@ func resourceComputeAddressRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	res, err := sendRequest(config, "GET", url, nil)
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("ComputeAddress %q", d.Id()))
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequest(config, "GET", project, url, nil)
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeAddress %q", d.Id()))
 	}
+
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("Error reading Address: %s", err)
 	}
@@ -310,7 +324,7 @@ func resourceComputeAddressRead(d *schema.ResourceData, meta interface{}) error
 	if err := d.Set("users", flattenComputeAddressUsers(res["users"], d)); err != nil {
 		return fmt.Errorf("Error reading Address: %s", err)
 	}
-	if err := d.Set("labels", flattenComputeAddressLabels(res["labels"], d)); err != nil {
+	if err := setLabelsFields(d, config, res["labels"]); err != nil {
 		return fmt.Errorf("Error reading Address: %s", err)
 	}
 	if err := d.Set("label_fingerprint", flattenComputeAddressLabelFingerprint(res["labelFingerprint"], d)); err != nil {
@@ -331,12 +345,12 @@ func resourceComputeAddressUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	d.Partial(true)
 
-	if d.HasChange("labels") || d.HasChange("label_fingerprint") {
+	if d.HasChange("labels") || d.HasChange("terraform_labels") || d.HasChange("label_fingerprint") {
 		obj := make(map[string]interface{})
-		labelsProp, err := expandComputeAddressLabels(d.Get("labels"), d, config)
+		labelsProp, err := expandComputeAddressLabels(d.Get("terraform_labels"), d, config)
 		if err != nil {
 			return err
-		} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		} else if v, ok := d.GetOkExists("terraform_labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
 			obj["labels"] = labelsProp
 		}
 		labelFingerprintProp, err := expandComputeAddressLabelFingerprint(d.Get("label_fingerprint"), d, config)
@@ -350,15 +364,16 @@ func resourceComputeAddressUpdate(d *schema.ResourceData, meta interface{}) erro
 		if err != nil {
 			return err
 		}
-		res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutUpdate))
+		project, err := getProject(d, config)
 		if err != nil {
-			return fmt.Errorf("Error updating Address %q: %s", d.Id(), err)
+			return err
 		}
 
-		project, err := getProject(d, config)
+		res, err := sendComputeSetLabelsRequest(config, project, url, obj, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return err
+			return fmt.Errorf("Error updating Address %q: %s", d.Id(), err)
 		}
+
 		op := &compute.Operation{}
 		err = Convert(res, op)
 		if err != nil {
@@ -366,7 +381,7 @@ func resourceComputeAddressUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 
 		err = computeOperationWaitTime(
-			config.clientCompute, op, project, "Updating Address",
+			config, op, project, "Updating Address",
 			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 
 		if err != nil {
@@ -392,15 +407,16 @@ func resourceComputeAddressDelete(d *schema.ResourceData, meta interface{}) erro
 
 	var obj map[string]interface{}
 	log.Printf("[DEBUG] Deleting Address %q", d.Id())
-	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, "Address")
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, "Address")
 	}
+
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -408,7 +424,7 @@ func resourceComputeAddressDelete(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting Address",
+		config, op, project, "Deleting Address",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 
 	if err != nil {
@@ -477,10 +493,6 @@ func flattenComputeAddressUsers(v interface{}, d *schema.ResourceData) interface
 	return v
 }
 
-func flattenComputeAddressLabels(v interface{}, d *schema.ResourceData) interface{} {
-	return v
-}
-
 func flattenComputeAddressLabelFingerprint(v interface{}, d *schema.ResourceData) interface{} {
 	return v
 }
@@ -175,7 +175,7 @@ func testAccCheckComputeAddressDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("ComputeAddress still exists at %s", url)
 		}
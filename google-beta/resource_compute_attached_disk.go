@@ -102,7 +102,7 @@ func resourceAttachedDiskCreate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(fmt.Sprintf("%s:%s", zv.Name, diskName))
 
-	waitErr := computeSharedOperationWaitTime(config.clientCompute, op, zv.Project,
+	waitErr := computeSharedOperationWaitTime(config, op, zv.Project,
 		int(d.Timeout(schema.TimeoutCreate).Minutes()), "disk to attach")
 	if waitErr != nil {
 		d.SetId("")
@@ -183,7 +183,7 @@ func resourceAttachedDiskDelete(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	waitErr := computeSharedOperationWaitTime(config.clientCompute, op, zv.Project,
+	waitErr := computeSharedOperationWaitTime(config, op, zv.Project,
 		int(d.Timeout(schema.TimeoutDelete).Minutes()), fmt.Sprintf("Detaching disk from %s", zv.Name))
 	if waitErr != nil {
 		return waitErr
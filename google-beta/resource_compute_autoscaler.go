@@ -203,7 +203,12 @@ func resourceComputeAutoscalerCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	log.Printf("[DEBUG] Creating new Autoscaler: %#v", obj)
-	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("Error creating Autoscaler: %s", err)
 	}
@@ -215,10 +220,6 @@ func resourceComputeAutoscalerCreate(d *schema.ResourceData, meta interface{}) e
 	}
 	d.SetId(id)
 
-	project, err := getProject(d, config)
-	if err != nil {
-		return err
-	}
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -226,7 +227,7 @@ func resourceComputeAutoscalerCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating Autoscaler",
+		config, op, project, "Creating Autoscaler",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
@@ -248,15 +249,16 @@ func resourceComputeAutoscalerRead(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
-	res, err := sendRequest(config, "GET", url, nil)
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("ComputeAutoscaler %q", d.Id()))
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequest(config, "GET", project, url, nil)
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeAutoscaler %q", d.Id()))
 	}
+
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("Error reading Autoscaler: %s", err)
 	}
@@ -327,16 +329,17 @@ func resourceComputeAutoscalerUpdate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	log.Printf("[DEBUG] Updating Autoscaler %q: %#v", d.Id(), obj)
-	res, err := sendRequestWithTimeout(config, "PUT", url, obj, d.Timeout(schema.TimeoutUpdate))
-
+	project, err := getProject(d, config)
 	if err != nil {
-		return fmt.Errorf("Error updating Autoscaler %q: %s", d.Id(), err)
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequestWithTimeout(config, "PUT", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+
 	if err != nil {
-		return err
+		return fmt.Errorf("Error updating Autoscaler %q: %s", d.Id(), err)
 	}
+
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -344,7 +347,7 @@ func resourceComputeAutoscalerUpdate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Updating Autoscaler",
+		config, op, project, "Updating Autoscaler",
 		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 
 	if err != nil {
@@ -364,15 +367,16 @@ func resourceComputeAutoscalerDelete(d *schema.ResourceData, meta interface{}) e
 
 	var obj map[string]interface{}
 	log.Printf("[DEBUG] Deleting Autoscaler %q", d.Id())
-	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, "Autoscaler")
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, "Autoscaler")
 	}
+
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -380,7 +384,7 @@ func resourceComputeAutoscalerDelete(d *schema.ResourceData, meta interface{}) e
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting Autoscaler",
+		config, op, project, "Deleting Autoscaler",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 
 	if err != nil {
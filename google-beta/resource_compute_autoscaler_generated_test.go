@@ -229,7 +229,7 @@ func testAccCheckComputeAutoscalerDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("ComputeAutoscaler still exists at %s", url)
 		}
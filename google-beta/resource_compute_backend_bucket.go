@@ -135,7 +135,12 @@ func resourceComputeBackendBucketCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	log.Printf("[DEBUG] Creating new BackendBucket: %#v", obj)
-	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("Error creating BackendBucket: %s", err)
 	}
@@ -147,10 +152,6 @@ func resourceComputeBackendBucketCreate(d *schema.ResourceData, meta interface{}
 	}
 	d.SetId(id)
 
-	project, err := getProject(d, config)
-	if err != nil {
-		return err
-	}
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -158,7 +159,7 @@ func resourceComputeBackendBucketCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating BackendBucket",
+		config, op, project, "Creating BackendBucket",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
@@ -180,15 +181,16 @@ func resourceComputeBackendBucketRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	res, err := sendRequest(config, "GET", url, nil)
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("ComputeBackendBucket %q", d.Id()))
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequest(config, "GET", project, url, nil)
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeBackendBucket %q", d.Id()))
 	}
+
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("Error reading BackendBucket: %s", err)
 	}
@@ -259,16 +261,17 @@ func resourceComputeBackendBucketUpdate(d *schema.ResourceData, meta interface{}
 	}
 
 	log.Printf("[DEBUG] Updating BackendBucket %q: %#v", d.Id(), obj)
-	res, err := sendRequestWithTimeout(config, "PUT", url, obj, d.Timeout(schema.TimeoutUpdate))
-
+	project, err := getProject(d, config)
 	if err != nil {
-		return fmt.Errorf("Error updating BackendBucket %q: %s", d.Id(), err)
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequestWithTimeout(config, "PUT", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+
 	if err != nil {
-		return err
+		return fmt.Errorf("Error updating BackendBucket %q: %s", d.Id(), err)
 	}
+
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -276,7 +279,7 @@ func resourceComputeBackendBucketUpdate(d *schema.ResourceData, meta interface{}
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Updating BackendBucket",
+		config, op, project, "Updating BackendBucket",
 		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 
 	if err != nil {
@@ -296,15 +299,16 @@ func resourceComputeBackendBucketDelete(d *schema.ResourceData, meta interface{}
 
 	var obj map[string]interface{}
 	log.Printf("[DEBUG] Deleting BackendBucket %q", d.Id())
-	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, "BackendBucket")
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, "BackendBucket")
 	}
+
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -312,7 +316,7 @@ func resourceComputeBackendBucketDelete(d *schema.ResourceData, meta interface{}
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting BackendBucket",
+		config, op, project, "Deleting BackendBucket",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 
 	if err != nil {
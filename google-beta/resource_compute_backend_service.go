@@ -505,7 +505,12 @@ func resourceComputeBackendServiceCreate(d *schema.ResourceData, meta interface{
 	}
 
 	log.Printf("[DEBUG] Creating new BackendService: %#v", obj)
-	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("Error creating BackendService: %s", err)
 	}
@@ -517,10 +522,6 @@ func resourceComputeBackendServiceCreate(d *schema.ResourceData, meta interface{
 	}
 	d.SetId(id)
 
-	project, err := getProject(d, config)
-	if err != nil {
-		return err
-	}
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -528,7 +529,7 @@ func resourceComputeBackendServiceCreate(d *schema.ResourceData, meta interface{
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating BackendService",
+		config, op, project, "Creating BackendService",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
@@ -552,7 +553,7 @@ func resourceComputeBackendServiceCreate(d *schema.ResourceData, meta interface{
 		if err != nil {
 			return errwrap.Wrapf("Error setting Backend Service security policy: {{err}}", err)
 		}
-		waitErr := computeSharedOperationWait(config.clientCompute, op, project, "Setting Backend Service Security Policy")
+		waitErr := computeSharedOperationWaitTime(config, op, project, int(d.Timeout(schema.TimeoutCreate).Minutes()), "Setting Backend Service Security Policy")
 		if waitErr != nil {
 			return waitErr
 		}
@@ -569,20 +570,21 @@ func resourceComputeBackendServiceRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	res, err := sendRequest(config, "GET", url, nil)
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("ComputeBackendService %q", d.Id()))
+		return err
 	}
 
-	res, err = resourceComputeBackendServiceDecoder(d, meta, res)
+	res, err := sendRequest(config, "GET", project, url, nil)
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeBackendService %q", d.Id()))
 	}
 
-	project, err := getProject(d, config)
+	res, err = resourceComputeBackendServiceDecoder(d, meta, res)
 	if err != nil {
 		return err
 	}
+
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("Error reading BackendService: %s", err)
 	}
@@ -773,16 +775,17 @@ func resourceComputeBackendServiceUpdate(d *schema.ResourceData, meta interface{
 	}
 
 	log.Printf("[DEBUG] Updating BackendService %q: %#v", d.Id(), obj)
-	res, err := sendRequestWithTimeout(config, "PUT", url, obj, d.Timeout(schema.TimeoutUpdate))
-
+	project, err := getProject(d, config)
 	if err != nil {
-		return fmt.Errorf("Error updating BackendService %q: %s", d.Id(), err)
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequestWithTimeout(config, "PUT", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+
 	if err != nil {
-		return err
+		return fmt.Errorf("Error updating BackendService %q: %s", d.Id(), err)
 	}
+
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -790,7 +793,7 @@ func resourceComputeBackendServiceUpdate(d *schema.ResourceData, meta interface{
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Updating BackendService",
+		config, op, project, "Updating BackendService",
 		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 
 	if err != nil {
@@ -810,7 +813,7 @@ func resourceComputeBackendServiceUpdate(d *schema.ResourceData, meta interface{
 		if err != nil {
 			return errwrap.Wrapf("Error setting Backend Service security policy: {{err}}", err)
 		}
-		waitErr := computeSharedOperationWait(config.clientCompute, op, project, "Setting Backend Service Security Policy")
+		waitErr := computeSharedOperationWaitTime(config, op, project, int(d.Timeout(schema.TimeoutUpdate).Minutes()), "Setting Backend Service Security Policy")
 		if waitErr != nil {
 			return waitErr
 		}
@@ -828,15 +831,16 @@ func resourceComputeBackendServiceDelete(d *schema.ResourceData, meta interface{
 
 	var obj map[string]interface{}
 	log.Printf("[DEBUG] Deleting BackendService %q", d.Id())
-	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, "BackendService")
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, "BackendService")
 	}
+
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -844,7 +848,7 @@ func resourceComputeBackendServiceDelete(d *schema.ResourceData, meta interface{
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting BackendService",
+		config, op, project, "Deleting BackendService",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 
 	if err != nil {
@@ -80,7 +80,7 @@ func testAccCheckComputeBackendServiceDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("ComputeBackendService still exists at %s", url)
 		}
@@ -37,6 +37,73 @@ func isDiskShrinkage(old, new, _ interface{}) bool {
 	return new.(int) < old.(int)
 }
 
+// Disk types that accept a provisioned IOPS or throughput.
+var (
+	diskProvisionedIopsTypes       = []string{"pd-extreme", "hyperdisk-balanced", "hyperdisk-balanced-high-availability", "hyperdisk-extreme"}
+	diskProvisionedThroughputTypes = []string{"hyperdisk-balanced", "hyperdisk-balanced-high-availability", "hyperdisk-throughput"}
+)
+
+// Disk types that can be attached to several instances in read-write mode.
+var diskMultiWriterTypes = []string{"pd-standard", "pd-ssd"}
+
+func diskProvisionedPerformanceCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	// separate func to allow unit testing
+	return diskProvisionedPerformanceCustomizeDiffFunc(diff)
+}
+
+// Only some disk types have a configurable performance. The values read back
+// for a disk are kept when its type changes, so only set values are checked.
+func diskProvisionedPerformanceCustomizeDiffFunc(diff TerraformResourceDiff) error {
+	_, t := diff.GetChange("type")
+	diskType, _ := t.(string)
+	diskType = GetResourceNameFromSelfLink(diskType)
+	if diskType == "" {
+		return nil
+	}
+
+	for field, types := range map[string][]string{
+		"provisioned_iops":       diskProvisionedIopsTypes,
+		"provisioned_throughput": diskProvisionedThroughputTypes,
+	} {
+		o, n := diff.GetChange(field)
+		oldValue, _ := o.(int)
+		newValue, _ := n.(int)
+		if newValue == 0 || newValue == oldValue {
+			continue
+		}
+		supported := false
+		for _, t := range types {
+			supported = supported || t == diskType
+		}
+		if !supported {
+			return fmt.Errorf("%s can't be set for disks of type %q, it's only supported by the types %s", field, diskType, strings.Join(types, ", "))
+		}
+	}
+	return nil
+}
+
+func diskMultiWriterCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	// separate func to allow unit testing
+	return diskMultiWriterCustomizeDiffFunc(diff)
+}
+
+func diskMultiWriterCustomizeDiffFunc(diff TerraformResourceDiff) error {
+	_, n := diff.GetChange("multi_writer")
+	if multiWriter, _ := n.(bool); !multiWriter {
+		return nil
+	}
+
+	_, t := diff.GetChange("type")
+	diskType, _ := t.(string)
+	diskType = GetResourceNameFromSelfLink(diskType)
+	for _, supported := range diskMultiWriterTypes {
+		if diskType == supported {
+			return nil
+		}
+	}
+	return fmt.Errorf("multi_writer can't be enabled for disks of type %q, it's only supported by the types %s", diskType, strings.Join(diskMultiWriterTypes, ", "))
+}
+
 // We cannot suppress the diff for the case when family name is not part of the image name since we can't
 // make a network call in a DiffSuppressFunc.
 func diskImageDiffSuppress(_, old, new string, _ *schema.ResourceData) bool {
@@ -241,7 +308,11 @@ func resourceComputeDisk() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			customdiff.ForceNewIfChange("size", isDiskShrinkage)),
+			customdiff.ForceNewIfChange("size", isDiskShrinkage),
+			diskProvisionedPerformanceCustomizeDiff,
+			diskMultiWriterCustomizeDiff,
+			setLabelsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -290,12 +361,37 @@ func resourceComputeDisk() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"effective_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"physical_block_size_bytes": {
 				Type:     schema.TypeInt,
 				Computed: true,
 				Optional: true,
 				ForceNew: true,
 			},
+			"multi_writer": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"provisioned_iops": {
+				Type:     schema.TypeInt,
+				Computed: true,
+				Optional: true,
+			},
+			"provisioned_throughput": {
+				Type:     schema.TypeInt,
+				Computed: true,
+				Optional: true,
+			},
 			"size": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -446,10 +542,10 @@ func resourceComputeDiskCreate(d *schema.ResourceData, meta interface{}) error {
 	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
 		obj["description"] = descriptionProp
 	}
-	labelsProp, err := expandComputeDiskLabels(d.Get("labels"), d, config)
+	labelsProp, err := expandComputeDiskLabels(d.Get("terraform_labels"), d, config)
 	if err != nil {
 		return err
-	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+	} else if v, ok := d.GetOkExists("terraform_labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
 		obj["labels"] = labelsProp
 	}
 	nameProp, err := expandComputeDiskName(d.Get("name"), d, config)
@@ -470,6 +566,24 @@ func resourceComputeDiskCreate(d *schema.ResourceData, meta interface{}) error {
 	} else if v, ok := d.GetOkExists("physical_block_size_bytes"); !isEmptyValue(reflect.ValueOf(physicalBlockSizeBytesProp)) && (ok || !reflect.DeepEqual(v, physicalBlockSizeBytesProp)) {
 		obj["physicalBlockSizeBytes"] = physicalBlockSizeBytesProp
 	}
+	multiWriterProp, err := expandComputeDiskMultiWriter(d.Get("multi_writer"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("multi_writer"); !isEmptyValue(reflect.ValueOf(multiWriterProp)) && (ok || !reflect.DeepEqual(v, multiWriterProp)) {
+		obj["multiWriter"] = multiWriterProp
+	}
+	provisionedIopsProp, err := expandComputeDiskProvisionedIops(d.Get("provisioned_iops"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("provisioned_iops"); !isEmptyValue(reflect.ValueOf(provisionedIopsProp)) && (ok || !reflect.DeepEqual(v, provisionedIopsProp)) {
+		obj["provisionedIops"] = provisionedIopsProp
+	}
+	provisionedThroughputProp, err := expandComputeDiskProvisionedThroughput(d.Get("provisioned_throughput"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("provisioned_throughput"); !isEmptyValue(reflect.ValueOf(provisionedThroughputProp)) && (ok || !reflect.DeepEqual(v, provisionedThroughputProp)) {
+		obj["provisionedThroughput"] = provisionedThroughputProp
+	}
 	typeProp, err := expandComputeDiskType(d.Get("type"), d, config)
 	if err != nil {
 		return err
@@ -524,7 +638,12 @@ func resourceComputeDiskCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] Creating new Disk: %#v", obj)
-	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("Error creating Disk: %s", err)
 	}
@@ -536,10 +655,6 @@ func resourceComputeDiskCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId(id)
 
-	project, err := getProject(d, config)
-	if err != nil {
-		return err
-	}
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -547,7 +662,7 @@ func resourceComputeDiskCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating Disk",
+		config, op, project, "Creating Disk",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
@@ -569,20 +684,21 @@ func resourceComputeDiskRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	res, err := sendRequest(config, "GET", url, nil)
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("ComputeDisk %q", d.Id()))
+		return err
 	}
 
-	res, err = resourceComputeDiskDecoder(d, meta, res)
+	res, err := sendRequest(config, "GET", project, url, nil)
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeDisk %q", d.Id()))
 	}
 
-	project, err := getProject(d, config)
+	res, err = resourceComputeDiskDecoder(d, meta, res)
 	if err != nil {
 		return err
 	}
+
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("Error reading Disk: %s", err)
 	}
@@ -602,7 +718,7 @@ func resourceComputeDiskRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("last_detach_timestamp", flattenComputeDiskLastDetachTimestamp(res["lastDetachTimestamp"], d)); err != nil {
 		return fmt.Errorf("Error reading Disk: %s", err)
 	}
-	if err := d.Set("labels", flattenComputeDiskLabels(res["labels"], d)); err != nil {
+	if err := setLabelsFields(d, config, res["labels"]); err != nil {
 		return fmt.Errorf("Error reading Disk: %s", err)
 	}
 	if err := d.Set("name", flattenComputeDiskName(res["name"], d)); err != nil {
@@ -617,6 +733,15 @@ func resourceComputeDiskRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("physical_block_size_bytes", flattenComputeDiskPhysicalBlockSizeBytes(res["physicalBlockSizeBytes"], d)); err != nil {
 		return fmt.Errorf("Error reading Disk: %s", err)
 	}
+	if err := d.Set("multi_writer", flattenComputeDiskMultiWriter(res["multiWriter"], d)); err != nil {
+		return fmt.Errorf("Error reading Disk: %s", err)
+	}
+	if err := d.Set("provisioned_iops", flattenComputeDiskProvisionedIops(res["provisionedIops"], d)); err != nil {
+		return fmt.Errorf("Error reading Disk: %s", err)
+	}
+	if err := d.Set("provisioned_throughput", flattenComputeDiskProvisionedThroughput(res["provisionedThroughput"], d)); err != nil {
+		return fmt.Errorf("Error reading Disk: %s", err)
+	}
 	if err := d.Set("type", flattenComputeDiskType(res["type"], d)); err != nil {
 		return fmt.Errorf("Error reading Disk: %s", err)
 	}
@@ -656,7 +781,7 @@ func resourceComputeDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	d.Partial(true)
 
-	if d.HasChange("label_fingerprint") || d.HasChange("labels") {
+	if d.HasChange("label_fingerprint") || d.HasChange("labels") || d.HasChange("terraform_labels") {
 		obj := make(map[string]interface{})
 		labelFingerprintProp, err := expandComputeDiskLabelFingerprint(d.Get("label_fingerprint"), d, config)
 		if err != nil {
@@ -664,10 +789,10 @@ func resourceComputeDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 		} else if v, ok := d.GetOkExists("label_fingerprint"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelFingerprintProp)) {
 			obj["labelFingerprint"] = labelFingerprintProp
 		}
-		labelsProp, err := expandComputeDiskLabels(d.Get("labels"), d, config)
+		labelsProp, err := expandComputeDiskLabels(d.Get("terraform_labels"), d, config)
 		if err != nil {
 			return err
-		} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		} else if v, ok := d.GetOkExists("terraform_labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
 			obj["labels"] = labelsProp
 		}
 
@@ -675,15 +800,16 @@ func resourceComputeDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			return err
 		}
-		res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutUpdate))
+		project, err := getProject(d, config)
 		if err != nil {
-			return fmt.Errorf("Error updating Disk %q: %s", d.Id(), err)
+			return err
 		}
 
-		project, err := getProject(d, config)
+		res, err := sendComputeSetLabelsRequest(config, project, url, obj, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return err
+			return fmt.Errorf("Error updating Disk %q: %s", d.Id(), err)
 		}
+
 		op := &compute.Operation{}
 		err = Convert(res, op)
 		if err != nil {
@@ -691,7 +817,7 @@ func resourceComputeDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		err = computeOperationWaitTime(
-			config.clientCompute, op, project, "Updating Disk",
+			config, op, project, "Updating Disk",
 			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 
 		if err != nil {
@@ -714,15 +840,94 @@ func resourceComputeDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			return err
 		}
-		res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutUpdate))
+		project, err := getProject(d, config)
+		if err != nil {
+			return err
+		}
+
+		res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("Error updating Disk %q: %s", d.Id(), err)
+		}
+
+		op := &compute.Operation{}
+		err = Convert(res, op)
+		if err != nil {
+			return err
+		}
+
+		err = computeOperationWaitTime(
+			config, op, project, "Updating Disk",
+			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+		if err != nil {
+			return err
+		}
+
+		d.SetPartial("size")
+	}
+	if d.HasChange("provisioned_iops") {
+		obj := make(map[string]interface{})
+		provisionedIopsProp, err := expandComputeDiskProvisionedIops(d.Get("provisioned_iops"), d, config)
+		if err != nil {
+			return err
+		} else if v, ok := d.GetOkExists("provisioned_iops"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, provisionedIopsProp)) {
+			obj["provisionedIops"] = provisionedIopsProp
+		}
+
+		url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/zones/{{zone}}/disks/{{name}}?paths=provisionedIops")
+		if err != nil {
+			return err
+		}
+		project, err := getProject(d, config)
+		if err != nil {
+			return err
+		}
+
+		res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf("Error updating Disk %q: %s", d.Id(), err)
 		}
 
+		op := &compute.Operation{}
+		err = Convert(res, op)
+		if err != nil {
+			return err
+		}
+
+		err = computeOperationWaitTime(
+			config, op, project, "Updating Disk",
+			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+		if err != nil {
+			return err
+		}
+
+		d.SetPartial("provisioned_iops")
+	}
+	if d.HasChange("provisioned_throughput") {
+		obj := make(map[string]interface{})
+		provisionedThroughputProp, err := expandComputeDiskProvisionedThroughput(d.Get("provisioned_throughput"), d, config)
+		if err != nil {
+			return err
+		} else if v, ok := d.GetOkExists("provisioned_throughput"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, provisionedThroughputProp)) {
+			obj["provisionedThroughput"] = provisionedThroughputProp
+		}
+
+		url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/zones/{{zone}}/disks/{{name}}?paths=provisionedThroughput")
+		if err != nil {
+			return err
+		}
 		project, err := getProject(d, config)
 		if err != nil {
 			return err
 		}
+
+		res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("Error updating Disk %q: %s", d.Id(), err)
+		}
+
 		op := &compute.Operation{}
 		err = Convert(res, op)
 		if err != nil {
@@ -730,14 +935,14 @@ func resourceComputeDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		err = computeOperationWaitTime(
-			config.clientCompute, op, project, "Updating Disk",
+			config, op, project, "Updating Disk",
 			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 
 		if err != nil {
 			return err
 		}
 
-		d.SetPartial("size")
+		d.SetPartial("provisioned_throughput")
 	}
 
 	d.Partial(false)
@@ -754,7 +959,12 @@ func resourceComputeDiskDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	var obj map[string]interface{}
-	readRes, err := sendRequest(config, "GET", url, nil)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	readRes, err := sendRequest(config, "GET", project, url, nil)
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("ComputeDisk %q", d.Id()))
 	}
@@ -797,8 +1007,8 @@ func resourceComputeDiskDelete(d *schema.ResourceData, meta interface{}) error {
 				return fmt.Errorf("Error detaching disk %s from instance %s/%s/%s: %s", call.deviceName, call.project,
 					call.zone, call.instance, err.Error())
 			}
-			err = computeOperationWait(config.clientCompute, op, call.project,
-				fmt.Sprintf("Detaching disk from %s/%s/%s", call.project, call.zone, call.instance))
+			err = computeOperationWaitTimeout(config, op, call.project,
+				fmt.Sprintf("Detaching disk from %s/%s/%s", call.project, call.zone, call.instance), d.Timeout(schema.TimeoutDelete))
 			if err != nil {
 				if opErr, ok := err.(ComputeOperationError); ok && len(opErr.Errors) == 1 && opErr.Errors[0].Code == "RESOURCE_NOT_FOUND" {
 					log.Printf("[WARN] instance %q was deleted while awaiting detach", call.instance)
@@ -809,15 +1019,11 @@ func resourceComputeDiskDelete(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 	log.Printf("[DEBUG] Deleting Disk %q", d.Id())
-	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return handleNotFoundError(err, d, "Disk")
 	}
 
-	project, err := getProject(d, config)
-	if err != nil {
-		return err
-	}
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -825,7 +1031,7 @@ func resourceComputeDiskDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting Disk",
+		config, op, project, "Deleting Disk",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 
 	if err != nil {
@@ -876,10 +1082,6 @@ func flattenComputeDiskLastDetachTimestamp(v interface{}, d *schema.ResourceData
 	return v
 }
 
-func flattenComputeDiskLabels(v interface{}, d *schema.ResourceData) interface{} {
-	return v
-}
-
 func flattenComputeDiskName(v interface{}, d *schema.ResourceData) interface{} {
 	return v
 }
@@ -911,6 +1113,30 @@ func flattenComputeDiskPhysicalBlockSizeBytes(v interface{}, d *schema.ResourceD
 	return v
 }
 
+func flattenComputeDiskMultiWriter(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeDiskProvisionedIops(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenComputeDiskProvisionedThroughput(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
 func flattenComputeDiskType(v interface{}, d *schema.ResourceData) interface{} {
 	if v == nil {
 		return v
@@ -1062,6 +1288,18 @@ func expandComputeDiskPhysicalBlockSizeBytes(v interface{}, d TerraformResourceD
 	return v, nil
 }
 
+func expandComputeDiskMultiWriter(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeDiskProvisionedIops(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeDiskProvisionedThroughput(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
 func expandComputeDiskType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
 	f, err := parseZonalFieldValue("diskTypes", v.(string), "project", "zone", d, config, true)
 	if err != nil {
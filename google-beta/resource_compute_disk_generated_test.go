@@ -79,7 +79,7 @@ func testAccCheckComputeDiskDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("ComputeDisk still exists at %s", url)
 		}
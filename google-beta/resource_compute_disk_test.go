@@ -437,6 +437,170 @@ func TestAccComputeDisk_deleteDetachIGM(t *testing.T) {
 	})
 }
 
+func TestAccComputeDisk_labelsUpdatedInSuccession(t *testing.T) {
+	t.Parallel()
+
+	diskName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	var disk compute.Disk
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeDiskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeDisk_labels(diskName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeDiskExists(
+						"google_compute_disk.foobar", getTestProjectFromEnv(), &disk),
+					testAccCheckComputeDiskHasLabel(&disk, "step", "first"),
+					testAccCheckComputeDiskHasLabelFingerprint(&disk, "google_compute_disk.foobar"),
+				),
+			},
+			{
+				Config: testAccComputeDisk_labels(diskName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeDiskExists(
+						"google_compute_disk.foobar", getTestProjectFromEnv(), &disk),
+					testAccCheckComputeDiskHasLabel(&disk, "step", "second"),
+					testAccCheckComputeDiskHasLabelFingerprint(&disk, "google_compute_disk.foobar"),
+				),
+			},
+			{
+				Config: testAccComputeDisk_labels(diskName, "third"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeDiskExists(
+						"google_compute_disk.foobar", getTestProjectFromEnv(), &disk),
+					testAccCheckComputeDiskHasLabel(&disk, "step", "third"),
+					testAccCheckComputeDiskHasLabelFingerprint(&disk, "google_compute_disk.foobar"),
+				),
+			},
+		},
+	})
+}
+
+func TestDiskProvisionedPerformanceCustomizeDiff(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Before, After map[string]interface{}
+		ExpectError   bool
+	}{
+		"hyperdisk with iops and throughput": {
+			After: map[string]interface{}{"type": "hyperdisk-balanced", "provisioned_iops": 3000, "provisioned_throughput": 140},
+		},
+		"pd-extreme with iops": {
+			After: map[string]interface{}{"type": "projects/my-project/zones/us-central1-a/diskTypes/pd-extreme", "provisioned_iops": 10000},
+		},
+		"pd-ssd without performance": {
+			After: map[string]interface{}{"type": "pd-ssd"},
+		},
+		"pd-ssd with iops": {
+			After:       map[string]interface{}{"type": "pd-ssd", "provisioned_iops": 3000},
+			ExpectError: true,
+		},
+		"hyperdisk-extreme with throughput": {
+			After:       map[string]interface{}{"type": "hyperdisk-extreme", "provisioned_throughput": 140},
+			ExpectError: true,
+		},
+		"iops read back before a type change": {
+			Before: map[string]interface{}{"type": "hyperdisk-balanced", "provisioned_iops": 3000},
+			After:  map[string]interface{}{"type": "pd-ssd", "provisioned_iops": 3000},
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &ResourceDiffMock{
+			Before: tc.Before,
+			After:  tc.After,
+		}
+		err := diskProvisionedPerformanceCustomizeDiffFunc(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
+
+func TestDiskMultiWriterCustomizeDiff(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		After       map[string]interface{}
+		ExpectError bool
+	}{
+		"pd-ssd": {
+			After: map[string]interface{}{"type": "pd-ssd", "multi_writer": true},
+		},
+		"pd-standard self link": {
+			After: map[string]interface{}{"type": "projects/my-project/zones/us-central1-a/diskTypes/pd-standard", "multi_writer": true},
+		},
+		"pd-balanced without multi-writer": {
+			After: map[string]interface{}{"type": "pd-balanced", "multi_writer": false},
+		},
+		"pd-balanced": {
+			After:       map[string]interface{}{"type": "pd-balanced", "multi_writer": true},
+			ExpectError: true,
+		},
+		"hyperdisk-throughput": {
+			After:       map[string]interface{}{"type": "hyperdisk-throughput", "multi_writer": true},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &ResourceDiffMock{
+			After: tc.After,
+		}
+		err := diskMultiWriterCustomizeDiffFunc(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
+
+func TestAccComputeDisk_provisionedIops(t *testing.T) {
+	t.Parallel()
+
+	diskName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeDiskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeDisk_provisionedIops(diskName, 3000),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_disk.foobar", "provisioned_iops", "3000"),
+					resource.TestCheckResourceAttrSet("google_compute_disk.foobar", "provisioned_throughput"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_disk.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeDisk_provisionedIops(diskName, 4000),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_disk.foobar", "provisioned_iops", "4000"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_disk.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckComputeDiskExists(n, p string, disk *compute.Disk) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -595,6 +759,30 @@ resource "google_compute_disk" "foobar" {
 }`, diskName)
 }
 
+func testAccComputeDisk_labels(diskName, step string) string {
+	return fmt.Sprintf(`
+resource "google_compute_disk" "foobar" {
+	name = "%s"
+	size = 10
+	type = "pd-ssd"
+	zone = "us-central1-a"
+	labels = {
+		step = "%s"
+	}
+}`, diskName, step)
+}
+
+func testAccComputeDisk_provisionedIops(diskName string, iops int) string {
+	return fmt.Sprintf(`
+resource "google_compute_disk" "foobar" {
+	name             = "%s"
+	size             = 50
+	type             = "hyperdisk-balanced"
+	zone             = "us-central1-a"
+	provisioned_iops = %d
+}`, diskName, iops)
+}
+
 func testAccComputeDisk_fromSnapshot(projectName, firstDiskName, snapshotName, diskName, ref_selector string) string {
 	return fmt.Sprintf(`
 data "google_compute_image" "my_image" {
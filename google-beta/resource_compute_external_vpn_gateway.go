@@ -26,6 +26,29 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+// The number of interfaces of an external VPN gateway is determined by its
+// redundancy type.
+var computeExternalVpnGatewayInterfaceCounts = map[string]int{
+	"SINGLE_IP_INTERNALLY_REDUNDANT": 1,
+	"TWO_IPS_REDUNDANCY":             2,
+	"FOUR_IPS_REDUNDANCY":            4,
+}
+
+func resourceComputeExternalVpnGatewayInterfaceCountCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	redundancyType := diff.Get("redundancy_type").(string)
+	want, ok := computeExternalVpnGatewayInterfaceCounts[redundancyType]
+	if !ok || !diff.NewValueKnown("interface") {
+		return nil
+	}
+
+	got := len(diff.Get("interface").([]interface{}))
+	if got != want {
+		return fmt.Errorf("redundancy_type %s requires %d interface(s), got %d", redundancyType, want, got)
+	}
+
+	return nil
+}
+
 func resourceComputeExternalVpnGateway() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceComputeExternalVpnGatewayCreate,
@@ -41,6 +64,8 @@ func resourceComputeExternalVpnGateway() *schema.Resource {
 			Delete: schema.DefaultTimeout(4 * time.Minute),
 		},
 
+		CustomizeDiff: resourceComputeExternalVpnGatewayInterfaceCountCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -126,7 +151,12 @@ func resourceComputeExternalVpnGatewayCreate(d *schema.ResourceData, meta interf
 	}
 
 	log.Printf("[DEBUG] Creating new ExternalVpnGateway: %#v", obj)
-	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("Error creating ExternalVpnGateway: %s", err)
 	}
@@ -138,10 +168,6 @@ func resourceComputeExternalVpnGatewayCreate(d *schema.ResourceData, meta interf
 	}
 	d.SetId(id)
 
-	project, err := getProject(d, config)
-	if err != nil {
-		return err
-	}
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -149,7 +175,7 @@ func resourceComputeExternalVpnGatewayCreate(d *schema.ResourceData, meta interf
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating ExternalVpnGateway",
+		config, op, project, "Creating ExternalVpnGateway",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
@@ -171,15 +197,16 @@ func resourceComputeExternalVpnGatewayRead(d *schema.ResourceData, meta interfac
 		return err
 	}
 
-	res, err := sendRequest(config, "GET", url, nil)
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("ComputeExternalVpnGateway %q", d.Id()))
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequest(config, "GET", project, url, nil)
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeExternalVpnGateway %q", d.Id()))
 	}
+
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("Error reading ExternalVpnGateway: %s", err)
 	}
@@ -213,15 +240,16 @@ func resourceComputeExternalVpnGatewayDelete(d *schema.ResourceData, meta interf
 
 	var obj map[string]interface{}
 	log.Printf("[DEBUG] Deleting ExternalVpnGateway %q", d.Id())
-	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, "ExternalVpnGateway")
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, "ExternalVpnGateway")
 	}
+
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -229,7 +257,7 @@ func resourceComputeExternalVpnGatewayDelete(d *schema.ResourceData, meta interf
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting ExternalVpnGateway",
+		config, op, project, "Deleting ExternalVpnGateway",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 
 	if err != nil {
@@ -177,7 +177,7 @@ func testAccCheckComputeExternalVpnGatewayDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("ComputeExternalVpnGateway still exists at %s", url)
 		}
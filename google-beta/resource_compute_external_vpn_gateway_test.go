@@ -0,0 +1,90 @@
+package google
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccComputeExternalVpnGateway_twoInterfaces(t *testing.T) {
+	t.Parallel()
+
+	rnd := acctest.RandString(10)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeExternalVpnGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeExternalVpnGateway_twoInterfaces(rnd),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_external_vpn_gateway.external_gateway", "interface.#", "2"),
+					resource.TestCheckResourceAttr("google_compute_external_vpn_gateway.external_gateway", "interface.1.ip_address", "8.8.4.4"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_external_vpn_gateway.external_gateway",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeExternalVpnGateway_interfaceCountMismatch(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeExternalVpnGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccComputeExternalVpnGateway_interfaceCountMismatch(acctest.RandString(10)),
+				ExpectError: regexp.MustCompile("redundancy_type FOUR_IPS_REDUNDANCY requires 4 interface\\(s\\), got 2"),
+			},
+		},
+	})
+}
+
+func testAccComputeExternalVpnGateway_twoInterfaces(suffix string) string {
+	return fmt.Sprintf(`
+resource "google_compute_external_vpn_gateway" "external_gateway" {
+  name            = "external-gateway-%s"
+  redundancy_type = "TWO_IPS_REDUNDANCY"
+  description     = "An externally managed VPN gateway"
+
+  interface {
+    id         = 0
+    ip_address = "8.8.8.8"
+  }
+
+  interface {
+    id         = 1
+    ip_address = "8.8.4.4"
+  }
+}
+`, suffix)
+}
+
+func testAccComputeExternalVpnGateway_interfaceCountMismatch(suffix string) string {
+	return fmt.Sprintf(`
+resource "google_compute_external_vpn_gateway" "external_gateway" {
+  name            = "external-gateway-%s"
+  redundancy_type = "FOUR_IPS_REDUNDANCY"
+
+  interface {
+    id         = 0
+    ip_address = "8.8.8.8"
+  }
+
+  interface {
+    id         = 1
+    ip_address = "8.8.4.4"
+  }
+}
+`, suffix)
+}
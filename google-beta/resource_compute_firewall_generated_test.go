@@ -88,7 +88,7 @@ func testAccCheckComputeFirewallDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("ComputeFirewall still exists at %s", url)
 		}
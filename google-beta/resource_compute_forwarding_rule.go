@@ -43,6 +43,8 @@ func resourceComputeForwardingRule() *schema.Resource {
 			Delete: schema.DefaultTimeout(4 * time.Minute),
 		},
 
+		CustomizeDiff: setLabelsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -91,11 +93,21 @@ func resourceComputeForwardingRule() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"effective_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"load_balancing_scheme": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.StringInSlice([]string{"INTERNAL", "EXTERNAL", ""}, false),
+				ValidateFunc: validation.StringInSlice([]string{"INTERNAL", "INTERNAL_MANAGED", "EXTERNAL", ""}, false),
 				Default:      "EXTERNAL",
 			},
 			"network": {
@@ -255,10 +267,10 @@ func resourceComputeForwardingRuleCreate(d *schema.ResourceData, meta interface{
 	} else if v, ok := d.GetOkExists("target"); !isEmptyValue(reflect.ValueOf(targetProp)) && (ok || !reflect.DeepEqual(v, targetProp)) {
 		obj["target"] = targetProp
 	}
-	labelsProp, err := expandComputeForwardingRuleLabels(d.Get("labels"), d, config)
+	labelsProp, err := expandComputeForwardingRuleLabels(d.Get("terraform_labels"), d, config)
 	if err != nil {
 		return err
-	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+	} else if v, ok := d.GetOkExists("terraform_labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
 		obj["labels"] = labelsProp
 	}
 	labelFingerprintProp, err := expandComputeForwardingRuleLabelFingerprint(d.Get("label_fingerprint"), d, config)
@@ -298,7 +310,12 @@ func resourceComputeForwardingRuleCreate(d *schema.ResourceData, meta interface{
 	}
 
 	log.Printf("[DEBUG] Creating new ForwardingRule: %#v", obj)
-	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("Error creating ForwardingRule: %s", err)
 	}
@@ -310,10 +327,6 @@ func resourceComputeForwardingRuleCreate(d *schema.ResourceData, meta interface{
 	}
 	d.SetId(id)
 
-	project, err := getProject(d, config)
-	if err != nil {
-		return err
-	}
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -321,7 +334,7 @@ func resourceComputeForwardingRuleCreate(d *schema.ResourceData, meta interface{
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating ForwardingRule",
+		config, op, project, "Creating ForwardingRule",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
@@ -332,7 +345,7 @@ func resourceComputeForwardingRuleCreate(d *schema.ResourceData, meta interface{
 
 	log.Printf("[DEBUG] Finished creating ForwardingRule %q: %#v", d.Id(), res)
 
-	if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+	if v, ok := d.GetOkExists("terraform_labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
 		// Labels cannot be set in a create.  We'll have to set them here.
 		err = resourceComputeForwardingRuleRead(d, meta)
 		if err != nil {
@@ -340,7 +353,7 @@ func resourceComputeForwardingRuleCreate(d *schema.ResourceData, meta interface{
 		}
 
 		obj := make(map[string]interface{})
-		// d.Get("labels") will have been overridden by the Read call.
+		// d.Get("terraform_labels") will have been overridden by the Read call.
 		labelsProp, err := expandComputeForwardingRuleLabels(v, d, config)
 		if err != nil {
 			return err
@@ -353,7 +366,7 @@ func resourceComputeForwardingRuleCreate(d *schema.ResourceData, meta interface{
 		if err != nil {
 			return err
 		}
-		res, err = sendRequest(config, "POST", url, obj)
+		res, err = sendComputeSetLabelsRequest(config, project, url, obj, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return fmt.Errorf("Error adding labels to ComputeForwardingRule %q: %s", d.Id(), err)
 		}
@@ -364,8 +377,8 @@ func resourceComputeForwardingRuleCreate(d *schema.ResourceData, meta interface{
 		}
 
 		err = computeOperationWaitTime(
-			config.clientCompute, op, project, "Updating ComputeForwardingRule Labels",
-			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+			config, op, project, "Updating ComputeForwardingRule Labels",
+			int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 		if err != nil {
 			return err
@@ -384,15 +397,16 @@ func resourceComputeForwardingRuleRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	res, err := sendRequest(config, "GET", url, nil)
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("ComputeForwardingRule %q", d.Id()))
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequest(config, "GET", project, url, nil)
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeForwardingRule %q", d.Id()))
 	}
+
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("Error reading ForwardingRule: %s", err)
 	}
@@ -436,7 +450,7 @@ func resourceComputeForwardingRuleRead(d *schema.ResourceData, meta interface{})
 	if err := d.Set("target", flattenComputeForwardingRuleTarget(res["target"], d)); err != nil {
 		return fmt.Errorf("Error reading ForwardingRule: %s", err)
 	}
-	if err := d.Set("labels", flattenComputeForwardingRuleLabels(res["labels"], d)); err != nil {
+	if err := setLabelsFields(d, config, res["labels"]); err != nil {
 		return fmt.Errorf("Error reading ForwardingRule: %s", err)
 	}
 	if err := d.Set("label_fingerprint", flattenComputeForwardingRuleLabelFingerprint(res["labelFingerprint"], d)); err != nil {
@@ -482,15 +496,16 @@ func resourceComputeForwardingRuleUpdate(d *schema.ResourceData, meta interface{
 		if err != nil {
 			return err
 		}
-		res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutUpdate))
+		project, err := getProject(d, config)
 		if err != nil {
-			return fmt.Errorf("Error updating ForwardingRule %q: %s", d.Id(), err)
+			return err
 		}
 
-		project, err := getProject(d, config)
+		res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return err
+			return fmt.Errorf("Error updating ForwardingRule %q: %s", d.Id(), err)
 		}
+
 		op := &compute.Operation{}
 		err = Convert(res, op)
 		if err != nil {
@@ -498,7 +513,7 @@ func resourceComputeForwardingRuleUpdate(d *schema.ResourceData, meta interface{
 		}
 
 		err = computeOperationWaitTime(
-			config.clientCompute, op, project, "Updating ForwardingRule",
+			config, op, project, "Updating ForwardingRule",
 			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 
 		if err != nil {
@@ -507,12 +522,12 @@ func resourceComputeForwardingRuleUpdate(d *schema.ResourceData, meta interface{
 
 		d.SetPartial("target")
 	}
-	if d.HasChange("labels") || d.HasChange("label_fingerprint") {
+	if d.HasChange("labels") || d.HasChange("terraform_labels") || d.HasChange("label_fingerprint") {
 		obj := make(map[string]interface{})
-		labelsProp, err := expandComputeForwardingRuleLabels(d.Get("labels"), d, config)
+		labelsProp, err := expandComputeForwardingRuleLabels(d.Get("terraform_labels"), d, config)
 		if err != nil {
 			return err
-		} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		} else if v, ok := d.GetOkExists("terraform_labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
 			obj["labels"] = labelsProp
 		}
 		labelFingerprintProp, err := expandComputeForwardingRuleLabelFingerprint(d.Get("label_fingerprint"), d, config)
@@ -526,15 +541,16 @@ func resourceComputeForwardingRuleUpdate(d *schema.ResourceData, meta interface{
 		if err != nil {
 			return err
 		}
-		res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutUpdate))
+		project, err := getProject(d, config)
 		if err != nil {
-			return fmt.Errorf("Error updating ForwardingRule %q: %s", d.Id(), err)
+			return err
 		}
 
-		project, err := getProject(d, config)
+		res, err := sendComputeSetLabelsRequest(config, project, url, obj, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return err
+			return fmt.Errorf("Error updating ForwardingRule %q: %s", d.Id(), err)
 		}
+
 		op := &compute.Operation{}
 		err = Convert(res, op)
 		if err != nil {
@@ -542,7 +558,7 @@ func resourceComputeForwardingRuleUpdate(d *schema.ResourceData, meta interface{
 		}
 
 		err = computeOperationWaitTime(
-			config.clientCompute, op, project, "Updating ForwardingRule",
+			config, op, project, "Updating ForwardingRule",
 			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 
 		if err != nil {
@@ -568,15 +584,16 @@ func resourceComputeForwardingRuleDelete(d *schema.ResourceData, meta interface{
 
 	var obj map[string]interface{}
 	log.Printf("[DEBUG] Deleting ForwardingRule %q", d.Id())
-	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, "ForwardingRule")
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, "ForwardingRule")
 	}
+
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -584,7 +601,7 @@ func resourceComputeForwardingRuleDelete(d *schema.ResourceData, meta interface{
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting ForwardingRule",
+		config, op, project, "Deleting ForwardingRule",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 
 	if err != nil {
@@ -682,10 +699,6 @@ func flattenComputeForwardingRuleTarget(v interface{}, d *schema.ResourceData) i
 	return ConvertSelfLinkToV1(v.(string))
 }
 
-func flattenComputeForwardingRuleLabels(v interface{}, d *schema.ResourceData) interface{} {
-	return v
-}
-
 func flattenComputeForwardingRuleLabelFingerprint(v interface{}, d *schema.ResourceData) interface{} {
 	return v
 }
@@ -146,7 +146,7 @@ func testAccCheckComputeForwardingRuleDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("ComputeForwardingRule still exists at %s", url)
 		}
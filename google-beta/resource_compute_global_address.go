@@ -43,6 +43,8 @@ func resourceComputeGlobalAddress() *schema.Resource {
 			Delete: schema.DefaultTimeout(4 * time.Minute),
 		},
 
+		CustomizeDiff: setLabelsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -80,6 +82,16 @@ func resourceComputeGlobalAddress() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"effective_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"network": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -141,10 +153,10 @@ func resourceComputeGlobalAddressCreate(d *schema.ResourceData, meta interface{}
 	} else if v, ok := d.GetOkExists("name"); !isEmptyValue(reflect.ValueOf(nameProp)) && (ok || !reflect.DeepEqual(v, nameProp)) {
 		obj["name"] = nameProp
 	}
-	labelsProp, err := expandComputeGlobalAddressLabels(d.Get("labels"), d, config)
+	labelsProp, err := expandComputeGlobalAddressLabels(d.Get("terraform_labels"), d, config)
 	if err != nil {
 		return err
-	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+	} else if v, ok := d.GetOkExists("terraform_labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
 		obj["labels"] = labelsProp
 	}
 	labelFingerprintProp, err := expandComputeGlobalAddressLabelFingerprint(d.Get("label_fingerprint"), d, config)
@@ -190,7 +202,12 @@ func resourceComputeGlobalAddressCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	log.Printf("[DEBUG] Creating new GlobalAddress: %#v", obj)
-	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("Error creating GlobalAddress: %s", err)
 	}
@@ -202,10 +219,6 @@ func resourceComputeGlobalAddressCreate(d *schema.ResourceData, meta interface{}
 	}
 	d.SetId(id)
 
-	project, err := getProject(d, config)
-	if err != nil {
-		return err
-	}
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -213,7 +226,7 @@ func resourceComputeGlobalAddressCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating GlobalAddress",
+		config, op, project, "Creating GlobalAddress",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
@@ -224,7 +237,7 @@ func resourceComputeGlobalAddressCreate(d *schema.ResourceData, meta interface{}
 
 	log.Printf("[DEBUG] Finished creating GlobalAddress %q: %#v", d.Id(), res)
 
-	if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+	if v, ok := d.GetOkExists("terraform_labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
 		// Labels cannot be set in a create.  We'll have to set them here.
 		err = resourceComputeGlobalAddressRead(d, meta)
 		if err != nil {
@@ -232,7 +245,7 @@ func resourceComputeGlobalAddressCreate(d *schema.ResourceData, meta interface{}
 		}
 
 		obj := make(map[string]interface{})
-		// d.Get("labels") will have been overridden by the Read call.
+		// d.Get("terraform_labels") will have been overridden by the Read call.
 		labelsProp, err := expandComputeGlobalAddressLabels(v, d, config)
 		if err != nil {
 			return err
@@ -245,7 +258,7 @@ func resourceComputeGlobalAddressCreate(d *schema.ResourceData, meta interface{}
 		if err != nil {
 			return err
 		}
-		res, err = sendRequest(config, "POST", url, obj)
+		res, err = sendComputeSetLabelsRequest(config, project, url, obj, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return fmt.Errorf("Error adding labels to ComputeGlobalAddress %q: %s", d.Id(), err)
 		}
@@ -256,8 +269,8 @@ func resourceComputeGlobalAddressCreate(d *schema.ResourceData, meta interface{}
 		}
 
 		err = computeOperationWaitTime(
-			config.clientCompute, op, project, "Updating ComputeGlobalAddress Labels",
-			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+			config, op, project, "Updating ComputeGlobalAddress Labels",
+			int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 		if err != nil {
 			return err
@@ -276,15 +289,16 @@ func resourceComputeGlobalAddressRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	res, err := sendRequest(config, "GET", url, nil)
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("ComputeGlobalAddress %q", d.Id()))
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequest(config, "GET", project, url, nil)
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeGlobalAddress %q", d.Id()))
 	}
+
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("Error reading GlobalAddress: %s", err)
 	}
@@ -301,7 +315,7 @@ func resourceComputeGlobalAddressRead(d *schema.ResourceData, meta interface{})
 	if err := d.Set("name", flattenComputeGlobalAddressName(res["name"], d)); err != nil {
 		return fmt.Errorf("Error reading GlobalAddress: %s", err)
 	}
-	if err := d.Set("labels", flattenComputeGlobalAddressLabels(res["labels"], d)); err != nil {
+	if err := setLabelsFields(d, config, res["labels"]); err != nil {
 		return fmt.Errorf("Error reading GlobalAddress: %s", err)
 	}
 	if err := d.Set("label_fingerprint", flattenComputeGlobalAddressLabelFingerprint(res["labelFingerprint"], d)); err != nil {
@@ -334,12 +348,12 @@ func resourceComputeGlobalAddressUpdate(d *schema.ResourceData, meta interface{}
 
 	d.Partial(true)
 
-	if d.HasChange("labels") || d.HasChange("label_fingerprint") {
+	if d.HasChange("labels") || d.HasChange("terraform_labels") || d.HasChange("label_fingerprint") {
 		obj := make(map[string]interface{})
-		labelsProp, err := expandComputeGlobalAddressLabels(d.Get("labels"), d, config)
+		labelsProp, err := expandComputeGlobalAddressLabels(d.Get("terraform_labels"), d, config)
 		if err != nil {
 			return err
-		} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		} else if v, ok := d.GetOkExists("terraform_labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
 			obj["labels"] = labelsProp
 		}
 		labelFingerprintProp, err := expandComputeGlobalAddressLabelFingerprint(d.Get("label_fingerprint"), d, config)
@@ -353,15 +367,16 @@ func resourceComputeGlobalAddressUpdate(d *schema.ResourceData, meta interface{}
 		if err != nil {
 			return err
 		}
-		res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutUpdate))
+		project, err := getProject(d, config)
 		if err != nil {
-			return fmt.Errorf("Error updating GlobalAddress %q: %s", d.Id(), err)
+			return err
 		}
 
-		project, err := getProject(d, config)
+		res, err := sendComputeSetLabelsRequest(config, project, url, obj, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return err
+			return fmt.Errorf("Error updating GlobalAddress %q: %s", d.Id(), err)
 		}
+
 		op := &compute.Operation{}
 		err = Convert(res, op)
 		if err != nil {
@@ -369,7 +384,7 @@ func resourceComputeGlobalAddressUpdate(d *schema.ResourceData, meta interface{}
 		}
 
 		err = computeOperationWaitTime(
-			config.clientCompute, op, project, "Updating GlobalAddress",
+			config, op, project, "Updating GlobalAddress",
 			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 
 		if err != nil {
@@ -395,15 +410,16 @@ func resourceComputeGlobalAddressDelete(d *schema.ResourceData, meta interface{}
 
 	var obj map[string]interface{}
 	log.Printf("[DEBUG] Deleting GlobalAddress %q", d.Id())
-	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, "GlobalAddress")
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, "GlobalAddress")
 	}
+
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -411,7 +427,7 @@ func resourceComputeGlobalAddressDelete(d *schema.ResourceData, meta interface{}
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting GlobalAddress",
+		config, op, project, "Deleting GlobalAddress",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 
 	if err != nil {
@@ -458,10 +474,6 @@ func flattenComputeGlobalAddressName(v interface{}, d *schema.ResourceData) inte
 	return v
 }
 
-func flattenComputeGlobalAddressLabels(v interface{}, d *schema.ResourceData) interface{} {
-	return v
-}
-
 func flattenComputeGlobalAddressLabelFingerprint(v interface{}, d *schema.ResourceData) interface{} {
 	return v
 }
@@ -72,7 +72,7 @@ func testAccCheckComputeGlobalAddressDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("ComputeGlobalAddress still exists at %s", url)
 		}
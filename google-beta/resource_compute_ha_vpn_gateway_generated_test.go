@@ -301,7 +301,7 @@ func testAccCheckComputeHaVpnGatewayDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("ComputeHaVpnGateway still exists at %s", url)
 		}
@@ -413,7 +413,12 @@ func resourceComputeHealthCheckCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	log.Printf("[DEBUG] Creating new HealthCheck: %#v", obj)
-	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("Error creating HealthCheck: %s", err)
 	}
@@ -425,10 +430,6 @@ func resourceComputeHealthCheckCreate(d *schema.ResourceData, meta interface{})
 	}
 	d.SetId(id)
 
-	project, err := getProject(d, config)
-	if err != nil {
-		return err
-	}
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -436,7 +437,7 @@ func resourceComputeHealthCheckCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating HealthCheck",
+		config, op, project, "Creating HealthCheck",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
@@ -458,15 +459,16 @@ func resourceComputeHealthCheckRead(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
-	res, err := sendRequest(config, "GET", url, nil)
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("ComputeHealthCheck %q", d.Id()))
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequest(config, "GET", project, url, nil)
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeHealthCheck %q", d.Id()))
 	}
+
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("Error reading HealthCheck: %s", err)
 	}
@@ -590,16 +592,17 @@ func resourceComputeHealthCheckUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	log.Printf("[DEBUG] Updating HealthCheck %q: %#v", d.Id(), obj)
-	res, err := sendRequestWithTimeout(config, "PUT", url, obj, d.Timeout(schema.TimeoutUpdate))
-
+	project, err := getProject(d, config)
 	if err != nil {
-		return fmt.Errorf("Error updating HealthCheck %q: %s", d.Id(), err)
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequestWithTimeout(config, "PUT", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+
 	if err != nil {
-		return err
+		return fmt.Errorf("Error updating HealthCheck %q: %s", d.Id(), err)
 	}
+
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -607,7 +610,7 @@ func resourceComputeHealthCheckUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Updating HealthCheck",
+		config, op, project, "Updating HealthCheck",
 		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 
 	if err != nil {
@@ -627,15 +630,16 @@ func resourceComputeHealthCheckDelete(d *schema.ResourceData, meta interface{})
 
 	var obj map[string]interface{}
 	log.Printf("[DEBUG] Deleting HealthCheck %q", d.Id())
-	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, "HealthCheck")
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, "HealthCheck")
 	}
+
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -643,7 +647,7 @@ func resourceComputeHealthCheckDelete(d *schema.ResourceData, meta interface{})
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting HealthCheck",
+		config, op, project, "Deleting HealthCheck",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 
 	if err != nil {
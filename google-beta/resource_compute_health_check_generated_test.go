@@ -79,7 +79,7 @@ func testAccCheckComputeHealthCheckDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("ComputeHealthCheck still exists at %s", url)
 		}
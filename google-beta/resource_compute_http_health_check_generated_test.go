@@ -76,7 +76,7 @@ func testAccCheckComputeHttpHealthCheckDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("ComputeHttpHealthCheck still exists at %s", url)
 		}
@@ -273,6 +273,12 @@ func resourceComputeInstance() *schema.Resource {
 							ValidateFunc: validation.StringInSlice([]string{"READ_WRITE", "READ_ONLY"}, false),
 						},
 
+						"force_attach": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
 						"disk_encryption_key_raw": {
 							Type:      schema.TypeString,
 							Optional:  true,
@@ -408,6 +414,18 @@ func resourceComputeInstance() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"effective_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"metadata": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -432,6 +450,47 @@ func resourceComputeInstance() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"reservation_affinity": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"ANY_RESERVATION", "SPECIFIC_RESERVATION", "NO_RESERVATION"}, false),
+						},
+
+						"specific_reservation": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"scheduling": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -461,10 +520,62 @@ func resourceComputeInstance() *schema.Resource {
 						"node_affinities": {
 							Type:             schema.TypeSet,
 							Optional:         true,
-							ForceNew:         true,
 							Elem:             instanceSchedulingNodeAffinitiesElemSchema(),
 							DiffSuppressFunc: emptyOrDefaultStringSuppress(""),
 						},
+
+						"provisioning_model": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"STANDARD", "SPOT"}, false),
+						},
+
+						"instance_termination_action": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"STOP", "DELETE"}, false),
+						},
+
+						"max_run_duration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem:     instanceSchedulingMaxRunDurationElemSchema(),
+						},
+
+						"local_ssd_recovery_timeout": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							// Up to 168 hours
+							Elem: instanceSchedulingDurationElemSchema(0, 604800),
+						},
+
+						"graceful_shutdown": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"max_duration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										// Between 1 second and 1 hour
+										Elem: instanceSchedulingDurationElemSchema(1, 3600),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -600,24 +711,88 @@ func resourceComputeInstance() *schema.Resource {
 				},
 				suppressEmptyGuestAcceleratorDiff,
 			),
+			setLabelsDiff,
 		),
 	}
 }
 
-func getInstance(config *Config, d *schema.ResourceData) (*computeBeta.Instance, error) {
+// getInstance reads the instance as raw JSON so the scheduling fields the
+// vendored client doesn't model yet can be returned alongside it. See
+// expandSchedulingRawFields.
+func getInstance(config *Config, d *schema.ResourceData) (*computeBeta.Instance, map[string]interface{}, error) {
 	project, err := getProject(d, config)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	zone, err := getZone(d, config)
+	if err != nil {
+		return nil, nil, err
+	}
+	url := fmt.Sprintf("%sprojects/%s/zones/%s/instances/%s", config.ComputeBetaBasePath, project, zone, d.Id())
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return nil, nil, handleNotFoundError(err, d, fmt.Sprintf("Instance %s", d.Get("name").(string)))
+	}
+	instance := &computeBeta.Instance{}
+	if err := Convert(res, instance); err != nil {
+		return nil, nil, err
+	}
+	return instance, rawSchedulingFromResponse(res, "scheduling"), nil
+}
+
+func insertComputeInstance(config *Config, d *schema.ResourceData, project, zone string, instance *computeBeta.Instance) (*computeBeta.Operation, error) {
+	fields, err := expandSchedulingRawFields(d.Get("scheduling"))
 	if err != nil {
 		return nil, err
 	}
-	instance, err := config.clientComputeBeta.Instances.Get(project, zone, d.Id()).Do()
+	forceAttach, err := expandAttachedDisksForceAttach(d, config)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 && len(forceAttach) == 0 {
+		return config.clientComputeBeta.Instances.Insert(project, zone, instance).Do()
+	}
+
+	obj, err := mergeSchedulingRawFields(instance, fields, "scheduling")
+	if err != nil {
+		return nil, err
+	}
+	mergeAttachedDisksForceAttach(obj, forceAttach)
+	url := fmt.Sprintf("%sprojects/%s/zones/%s/instances", config.ComputeBetaBasePath, project, zone)
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return nil, handleNotFoundError(err, d, fmt.Sprintf("Instance %s", d.Get("name").(string)))
+		return nil, err
+	}
+	op := &computeBeta.Operation{}
+	if err := Convert(res, op); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+func setComputeInstanceScheduling(config *Config, d *schema.ResourceData, project, zone, name string, scheduling *computeBeta.Scheduling) (*computeBeta.Operation, error) {
+	fields, err := expandSchedulingRawFields(d.Get("scheduling"))
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return config.clientComputeBeta.Instances.SetScheduling(project, zone, name, scheduling).Do()
+	}
+
+	obj, err := mergeSchedulingRawFields(scheduling, fields)
+	if err != nil {
+		return nil, err
+	}
+	url := fmt.Sprintf("%sprojects/%s/zones/%s/instances/%s/setScheduling", config.ComputeBetaBasePath, project, zone, name)
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return nil, err
+	}
+	op := &computeBeta.Operation{}
+	if err := Convert(res, op); err != nil {
+		return nil, err
 	}
-	return instance, nil
+	return op, nil
 }
 
 func getDisk(diskUri string, d *schema.ResourceData, config *Config) (*compute.Disk, error) {
@@ -685,6 +860,11 @@ func expandComputeInstance(project string, zone *compute.Zone, d *schema.Resourc
 		return nil, fmt.Errorf("Error creating scheduling: %s", err)
 	}
 
+	reservationAffinity, err := expandReservationAffinity(d)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating reservation affinity: %s", err)
+	}
+
 	metadata, err := resourceInstanceMetadata(d)
 	if err != nil {
 		return nil, fmt.Errorf("Error creating metadata: %s", err)
@@ -702,23 +882,24 @@ func expandComputeInstance(project string, zone *compute.Zone, d *schema.Resourc
 
 	// Create the instance information
 	return &computeBeta.Instance{
-		CanIpForward:       d.Get("can_ip_forward").(bool),
-		Description:        d.Get("description").(string),
-		Disks:              disks,
-		MachineType:        machineTypeUrl,
-		Metadata:           metadata,
-		Name:               d.Get("name").(string),
-		NetworkInterfaces:  networkInterfaces,
-		Tags:               resourceInstanceTags(d),
-		Labels:             expandLabels(d),
-		ServiceAccounts:    expandServiceAccounts(d.Get("service_account").([]interface{})),
-		GuestAccelerators:  accels,
-		MinCpuPlatform:     d.Get("min_cpu_platform").(string),
-		Scheduling:         scheduling,
-		DeletionProtection: d.Get("deletion_protection").(bool),
-		Hostname:           d.Get("hostname").(string),
-		ForceSendFields:    []string{"CanIpForward", "DeletionProtection"},
-		ShieldedVmConfig:   expandShieldedVmConfigs(d),
+		CanIpForward:        d.Get("can_ip_forward").(bool),
+		Description:         d.Get("description").(string),
+		Disks:               disks,
+		MachineType:         machineTypeUrl,
+		Metadata:            metadata,
+		Name:                d.Get("name").(string),
+		NetworkInterfaces:   networkInterfaces,
+		Tags:                resourceInstanceTags(d),
+		Labels:              expandLabels(d),
+		ServiceAccounts:     expandServiceAccounts(d.Get("service_account").([]interface{})),
+		GuestAccelerators:   accels,
+		MinCpuPlatform:      d.Get("min_cpu_platform").(string),
+		Scheduling:          scheduling,
+		DeletionProtection:  d.Get("deletion_protection").(bool),
+		Hostname:            d.Get("hostname").(string),
+		ForceSendFields:     []string{"CanIpForward", "DeletionProtection"},
+		ShieldedVmConfig:    expandShieldedVmConfigs(d),
+		ReservationAffinity: reservationAffinity,
 	}, nil
 }
 
@@ -751,7 +932,7 @@ func resourceComputeInstanceCreate(d *schema.ResourceData, meta interface{}) err
 	createTimeout := int(d.Timeout(schema.TimeoutCreate).Minutes())
 
 	log.Printf("[INFO] Requesting instance creation")
-	op, err := config.clientComputeBeta.Instances.Insert(project, zone.Name, instance).Do()
+	op, err := insertComputeInstance(config, d, project, zone.Name, instance)
 	if err != nil {
 		return fmt.Errorf("Error creating instance: %s", err)
 	}
@@ -760,7 +941,7 @@ func resourceComputeInstanceCreate(d *schema.ResourceData, meta interface{}) err
 	d.SetId(instance.Name)
 
 	// Wait for the operation to complete
-	waitErr := computeSharedOperationWaitTime(config.clientCompute, op, project, createTimeout, "instance to create")
+	waitErr := computeSharedOperationWaitTime(config, op, project, createTimeout, "instance to create")
 	if waitErr != nil {
 		// The resource didn't actually create
 		d.SetId("")
@@ -778,7 +959,7 @@ func resourceComputeInstanceRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	instance, err := getInstance(config, d)
+	instance, rawScheduling, err := getInstance(config, d)
 	if err != nil || instance == nil {
 		return err
 	}
@@ -836,7 +1017,7 @@ func resourceComputeInstanceRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("tags", convertStringArrToInterface(instance.Tags.Items))
 	}
 
-	if err := d.Set("labels", instance.Labels); err != nil {
+	if err := setLabelsFields(d, config, instance.Labels); err != nil {
 		return err
 	}
 
@@ -899,6 +1080,10 @@ func resourceComputeInstanceRead(d *schema.ResourceData, meta interface{}) error
 				"device_name": disk.DeviceName,
 				"mode":        disk.Mode,
 			}
+			// force_attach is input only, so it's kept from the config.
+			if inConfig {
+				di["force_attach"] = d.Get(fmt.Sprintf("attached_disk.%d.force_attach", adIndex))
+			}
 			if key := disk.DiskEncryptionKey; key != nil {
 				if inConfig {
 					rawKey := d.Get(fmt.Sprintf("attached_disk.%d.disk_encryption_key_raw", adIndex))
@@ -936,7 +1121,10 @@ func resourceComputeInstanceRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("service_account", flattenServiceAccounts(instance.ServiceAccounts))
 	d.Set("attached_disk", ads)
 	d.Set("scratch_disk", scratchDisks)
-	d.Set("scheduling", flattenScheduling(instance.Scheduling))
+	scheduling := flattenScheduling(instance.Scheduling)
+	flattenSchedulingRawFields(rawScheduling, scheduling)
+	d.Set("scheduling", scheduling)
+	d.Set("reservation_affinity", flattenReservationAffinity(instance.ReservationAffinity))
 	d.Set("guest_accelerator", flattenGuestAccelerators(instance.GuestAccelerators))
 	d.Set("shielded_instance_config", flattenShieldedVmConfig(instance.ShieldedVmConfig))
 	d.Set("cpu_platform", instance.CpuPlatform)
@@ -1004,7 +1192,7 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 					return fmt.Errorf("Error updating metadata: %s", err)
 				}
 
-				opErr := computeOperationWaitTime(config.clientCompute, op, project, "metadata to update", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+				opErr := computeOperationWaitTime(config, op, project, "metadata to update", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 				if opErr != nil {
 					return opErr
 				}
@@ -1032,7 +1220,7 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 			return fmt.Errorf("Error updating tags: %s", err)
 		}
 
-		opErr := computeOperationWaitTime(config.clientCompute, op, project, "tags to update", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+		opErr := computeOperationWaitTime(config, op, project, "tags to update", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 		if opErr != nil {
 			return opErr
 		}
@@ -1040,17 +1228,23 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 		d.SetPartial("tags")
 	}
 
-	if d.HasChange("labels") {
-		labels := expandLabels(d)
-		labelFingerprint := d.Get("label_fingerprint").(string)
-		req := compute.InstancesSetLabelsRequest{Labels: labels, LabelFingerprint: labelFingerprint}
+	if d.HasChange("labels") || d.HasChange("terraform_labels") {
+		url := fmt.Sprintf("%sprojects/%s/zones/%s/instances/%s/setLabels", config.ComputeBasePath, project, zone, d.Id())
+		obj := map[string]interface{}{
+			"labels": expandLabels(d),
+		}
 
-		op, err := config.clientCompute.Instances.SetLabels(project, zone, d.Id(), &req).Do()
+		res, err := sendComputeSetLabelsRequest(config, project, url, obj, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf("Error updating labels: %s", err)
 		}
 
-		opErr := computeOperationWaitTime(config.clientCompute, op, project, "labels to update", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+		op := &compute.Operation{}
+		if err := Convert(res, op); err != nil {
+			return err
+		}
+
+		opErr := computeOperationWaitTime(config, op, project, "labels to update", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 		if opErr != nil {
 			return opErr
 		}
@@ -1058,20 +1252,21 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 		d.SetPartial("labels")
 	}
 
-	if d.HasChange("scheduling") {
+	// Node affinities can only be changed while the instance is stopped, so
+	// those scheduling changes are applied further down.
+	if d.HasChange("scheduling") && !d.HasChange("scheduling.0.node_affinities") {
 		scheduling, err := expandScheduling(d.Get("scheduling"))
 		if err != nil {
 			return fmt.Errorf("Error creating request data to update scheduling: %s", err)
 		}
 
-		op, err := config.clientComputeBeta.Instances.SetScheduling(
-			project, zone, d.Id(), scheduling).Do()
+		op, err := setComputeInstanceScheduling(config, d, project, zone, d.Id(), scheduling)
 		if err != nil {
 			return fmt.Errorf("Error updating scheduling policy: %s", err)
 		}
 
 		opErr := computeBetaOperationWaitTime(
-			config.clientCompute, op, project, "scheduling policy update",
+			config, op, project, "scheduling policy update",
 			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 		if opErr != nil {
 			return opErr
@@ -1110,7 +1305,7 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 				if err != nil {
 					return fmt.Errorf("Error deleting old access_config: %s", err)
 				}
-				opErr := computeOperationWaitTime(config.clientCompute, op, project, "old access_config to delete", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+				opErr := computeOperationWaitTime(config, op, project, "old access_config to delete", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 				if opErr != nil {
 					return opErr
 				}
@@ -1135,7 +1330,7 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 				if err != nil {
 					return fmt.Errorf("Error adding new access_config: %s", err)
 				}
-				opErr := computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutUpdate).Minutes()), "new access_config to add")
+				opErr := computeSharedOperationWaitTime(config, op, project, int(d.Timeout(schema.TimeoutUpdate).Minutes()), "new access_config to add")
 				if opErr != nil {
 					return opErr
 				}
@@ -1155,7 +1350,7 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 				if err != nil {
 					return errwrap.Wrapf("Error removing alias_ip_range: {{err}}", err)
 				}
-				opErr := computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutUpdate).Minutes()), "updaing alias ip ranges")
+				opErr := computeSharedOperationWaitTime(config, op, project, int(d.Timeout(schema.TimeoutUpdate).Minutes()), "updaing alias ip ranges")
 				if opErr != nil {
 					return opErr
 				}
@@ -1179,7 +1374,7 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 				if err != nil {
 					return errwrap.Wrapf("Error adding alias_ip_range: {{err}}", err)
 				}
-				opErr := computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutUpdate).Minutes()), "updaing alias ip ranges")
+				opErr := computeSharedOperationWaitTime(config, op, project, int(d.Timeout(schema.TimeoutUpdate).Minutes()), "updaing alias ip ranges")
 				if opErr != nil {
 					return opErr
 				}
@@ -1226,12 +1421,14 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 		// If a disk with a certain hash is only in the new config, it should be attached.
 		nDisks := map[uint64]struct{}{}
 		var attach []*compute.AttachedDisk
+		forceAttach := map[string]bool{}
 		for _, disk := range n.([]interface{}) {
 			diskConfig := disk.(map[string]interface{})
 			computeDisk, err := expandAttachedDisk(diskConfig, d, config)
 			if err != nil {
 				return err
 			}
+			forceAttach[computeDisk.Source] = diskConfig["force_attach"].(bool)
 			hash, err := hashstructure.Hash(*computeDisk, nil)
 			if err != nil {
 				return err
@@ -1257,7 +1454,7 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 					return errwrap.Wrapf("Error detaching disk: %s", err)
 				}
 
-				opErr := computeOperationWaitTime(config.clientCompute, op, project, "detaching disk", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+				opErr := computeOperationWaitTime(config, op, project, "detaching disk", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 				if opErr != nil {
 					return opErr
 				}
@@ -1267,12 +1464,12 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 
 		// Attach the new disks
 		for _, disk := range attach {
-			op, err := config.clientCompute.Instances.AttachDisk(project, zone, instance.Name, disk).Do()
+			op, err := config.clientCompute.Instances.AttachDisk(project, zone, instance.Name, disk).ForceAttach(forceAttach[disk.Source]).Do()
 			if err != nil {
 				return errwrap.Wrapf("Error attaching disk : {{err}}", err)
 			}
 
-			opErr := computeOperationWaitTime(config.clientCompute, op, project, "attaching disk", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+			opErr := computeOperationWaitTime(config, op, project, "attaching disk", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 			if opErr != nil {
 				return opErr
 			}
@@ -1306,7 +1503,7 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 			return fmt.Errorf("Error updating deletion protection flag: %s", err)
 		}
 
-		opErr := computeOperationWaitTime(config.clientCompute, op, project, "deletion protection to update", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+		opErr := computeOperationWaitTime(config, op, project, "deletion protection to update", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 		if opErr != nil {
 			return opErr
 		}
@@ -1315,9 +1512,9 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	// Attributes which can only be changed if the instance is stopped
-	if scopesChange || d.HasChange("service_account.0.email") || d.HasChange("machine_type") || d.HasChange("min_cpu_platform") {
+	if scopesChange || d.HasChange("service_account.0.email") || d.HasChange("machine_type") || d.HasChange("min_cpu_platform") || d.HasChange("scheduling.0.node_affinities") {
 		if !d.Get("allow_stopping_for_update").(bool) {
-			return fmt.Errorf("Changing the machine_type, min_cpu_platform, or service_account on an instance requires stopping it. " +
+			return fmt.Errorf("Changing the machine_type, min_cpu_platform, service_account, or scheduling.node_affinities on an instance requires stopping it. " +
 				"To acknowledge this, please set allow_stopping_for_update = true in your config.")
 		}
 		op, err := config.clientCompute.Instances.Stop(project, zone, instance.Name).Do()
@@ -1325,7 +1522,7 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 			return errwrap.Wrapf("Error stopping instance: {{err}}", err)
 		}
 
-		opErr := computeOperationWaitTime(config.clientCompute, op, project, "stopping instance", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+		opErr := computeOperationWaitTime(config, op, project, "stopping instance", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 		if opErr != nil {
 			return opErr
 		}
@@ -1342,7 +1539,7 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 			if err != nil {
 				return err
 			}
-			opErr := computeOperationWaitTime(config.clientCompute, op, project, "updating machinetype", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+			opErr := computeOperationWaitTime(config, op, project, "updating machinetype", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 			if opErr != nil {
 				return opErr
 			}
@@ -1364,7 +1561,7 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 			if err != nil {
 				return err
 			}
-			opErr := computeOperationWaitTime(config.clientCompute, op, project, "updating min cpu platform", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+			opErr := computeOperationWaitTime(config, op, project, "updating min cpu platform", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 			if opErr != nil {
 				return opErr
 			}
@@ -1383,19 +1580,36 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 			if err != nil {
 				return err
 			}
-			opErr := computeOperationWaitTime(config.clientCompute, op, project, "updating service account", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+			opErr := computeOperationWaitTime(config, op, project, "updating service account", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 			if opErr != nil {
 				return opErr
 			}
 			d.SetPartial("service_account")
 		}
 
+		if d.HasChange("scheduling.0.node_affinities") {
+			scheduling, err := expandScheduling(d.Get("scheduling"))
+			if err != nil {
+				return fmt.Errorf("Error creating request data to update scheduling: %s", err)
+			}
+
+			op, err := setComputeInstanceScheduling(config, d, project, zone, instance.Name, scheduling)
+			if err != nil {
+				return fmt.Errorf("Error updating scheduling policy: %s", err)
+			}
+			opErr := computeBetaOperationWaitTime(config, op, project, "updating scheduling policy", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+			if opErr != nil {
+				return opErr
+			}
+			d.SetPartial("scheduling")
+		}
+
 		op, err = config.clientCompute.Instances.Start(project, zone, instance.Name).Do()
 		if err != nil {
 			return errwrap.Wrapf("Error starting instance: {{err}}", err)
 		}
 
-		opErr = computeOperationWaitTime(config.clientCompute, op, project, "starting instance", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+		opErr = computeOperationWaitTime(config, op, project, "starting instance", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 		if opErr != nil {
 			return opErr
 		}
@@ -1409,7 +1623,7 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 			return fmt.Errorf("Error updating shielded vm config: %s", err)
 		}
 
-		opErr := computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutUpdate).Minutes()), "shielded vm config update")
+		opErr := computeSharedOperationWaitTime(config, op, project, int(d.Timeout(schema.TimeoutUpdate).Minutes()), "shielded vm config update")
 		if opErr != nil {
 			return opErr
 		}
@@ -1477,6 +1691,42 @@ func expandAttachedDisk(diskConfig map[string]interface{}, d *schema.ResourceDat
 	return disk, nil
 }
 
+// expandAttachedDisksForceAttach returns the sources of the attached disks
+// that are configured with force_attach. The vendored client doesn't model
+// forceAttach, see mergeAttachedDisksForceAttach.
+func expandAttachedDisksForceAttach(d *schema.ResourceData, config *Config) (map[string]struct{}, error) {
+	sources := map[string]struct{}{}
+	for i := 0; i < d.Get("attached_disk.#").(int); i++ {
+		diskConfig := d.Get(fmt.Sprintf("attached_disk.%d", i)).(map[string]interface{})
+		if !diskConfig["force_attach"].(bool) {
+			continue
+		}
+		disk, err := expandAttachedDisk(diskConfig, d, config)
+		if err != nil {
+			return nil, err
+		}
+		sources[disk.Source] = struct{}{}
+	}
+	return sources, nil
+}
+
+// mergeAttachedDisksForceAttach sets forceAttach on the disks of a raw
+// instance whose source is in sources.
+func mergeAttachedDisksForceAttach(obj map[string]interface{}, sources map[string]struct{}) {
+	disks, _ := obj["disks"].([]interface{})
+	for _, raw := range disks {
+		disk, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if source, _ := disk["source"].(string); source != "" {
+			if _, ok := sources[source]; ok {
+				disk["forceAttach"] = true
+			}
+		}
+	}
+}
+
 // See comment on expandInstanceTemplateGuestAccelerators regarding why this
 // code is duplicated.
 func expandInstanceGuestAccelerators(d TerraformResourceData, config *Config) ([]*computeBeta.AcceleratorConfig, error) {
@@ -1569,7 +1819,7 @@ func resourceComputeInstanceDelete(d *schema.ResourceData, meta interface{}) err
 		}
 
 		// Wait for the operation to complete
-		opErr := computeOperationWaitTime(config.clientCompute, op, project, "instance to delete", int(d.Timeout(schema.TimeoutDelete).Minutes()))
+		opErr := computeOperationWaitTime(config, op, project, "instance to delete", int(d.Timeout(schema.TimeoutDelete).Minutes()))
 		if opErr != nil {
 			return opErr
 		}
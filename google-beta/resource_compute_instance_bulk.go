@@ -0,0 +1,295 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"google.golang.org/api/compute/v1"
+)
+
+// A name pattern is a valid instance name with exactly one run of '#'
+// characters, which the API replaces with a zero-padded sequence number.
+var instanceBulkNamePatternRegex = regexp.MustCompile(`#+`)
+
+func resourceComputeInstanceBulk() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeInstanceBulkCreate,
+		Read:   resourceComputeInstanceBulkRead,
+		Delete: resourceComputeInstanceBulkDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: computeInstanceBulkMinCountCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name_pattern": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegexp(`^[a-z][-a-z0-9]*#+[-a-z0-9]*$`),
+			},
+
+			"instance_count": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"source_instance_template": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"min_instance_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"instances": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      selfLinkRelativePathHash,
+			},
+		},
+	}
+}
+
+func resourceComputeInstanceBulkCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return err
+	}
+
+	namePattern := d.Get("name_pattern").(string)
+	count := d.Get("instance_count").(int)
+	minCount := count
+	if v, ok := d.GetOk("min_instance_count"); ok {
+		minCount = v.(int)
+	}
+
+	tpl, err := ParseInstanceTemplateFieldValue(d.Get("source_instance_template").(string), d, config)
+	if err != nil {
+		return err
+	}
+
+	obj := map[string]interface{}{
+		"count":                  count,
+		"minCount":               minCount,
+		"namePattern":            namePattern,
+		"sourceInstanceTemplate": tpl.RelativeLink(),
+	}
+
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/zones/{{zone}}/instances/bulkInsert")
+	if err != nil {
+		return err
+	}
+
+	// Instances matching the pattern that already exist weren't created by
+	// this resource, so they must never end up in its state.
+	existing, err := listComputeInstanceBulkInstances(config, project, zone, namePattern)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Bulk creating %d instances with pattern %q: %#v", count, namePattern, obj)
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error bulk creating instances: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", project, zone, namePattern))
+
+	op := &compute.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
+
+	waitErr := computeOperationWaitTime(
+		config, op, project, "Bulk creating instances",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	listed, err := listComputeInstanceBulkInstances(config, project, zone, namePattern)
+	if err != nil {
+		return err
+	}
+	instances := schema.NewSet(selfLinkRelativePathHash, convertStringArrToInterface(listed)).Difference(
+		schema.NewSet(selfLinkRelativePathHash, convertStringArrToInterface(existing)))
+
+	if waitErr != nil {
+		if instances.Len() == 0 {
+			// Nothing was created
+			d.SetId("")
+			return fmt.Errorf("Error waiting to bulk create instances: %s", waitErr)
+		}
+
+		// Keep track of the instances that did get created so they are cleaned
+		// up when the resource is tainted and replaced.
+		log.Printf("[WARN] Bulk create with pattern %q only partially succeeded (%d of %d instances)", namePattern, instances.Len(), count)
+		if err := d.Set("instances", instances); err != nil {
+			return fmt.Errorf("Error setting instances: %s", err)
+		}
+		return fmt.Errorf("Error waiting to bulk create instances, %d of %d were created: %s", instances.Len(), count, waitErr)
+	}
+
+	if err := d.Set("instances", instances); err != nil {
+		return fmt.Errorf("Error setting instances: %s", err)
+	}
+
+	log.Printf("[DEBUG] Finished bulk creating instances %q: %v", d.Id(), instances.List())
+
+	return resourceComputeInstanceBulkRead(d, meta)
+}
+
+func resourceComputeInstanceBulkRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return err
+	}
+
+	instances := []string{}
+	for _, v := range d.Get("instances").(*schema.Set).List() {
+		name := GetResourceNameFromSelfLink(v.(string))
+		instance, err := config.clientCompute.Instances.Get(project, zone, name).Do()
+		if err != nil {
+			if isGoogleApiErrorWithCode(err, 404) {
+				log.Printf("[WARN] Removing instance %q from bulk instances %q because it's gone", name, d.Id())
+				continue
+			}
+			return fmt.Errorf("Error reading instance %q: %s", name, err)
+		}
+		instances = append(instances, ConvertSelfLinkToV1(instance.SelfLink))
+	}
+
+	if len(instances) == 0 {
+		log.Printf("[WARN] Removing bulk instances %q because all instances are gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("project", project)
+	d.Set("zone", zone)
+	if err := d.Set("instances", instances); err != nil {
+		return fmt.Errorf("Error setting instances: %s", err)
+	}
+
+	return nil
+}
+
+func resourceComputeInstanceBulkDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return err
+	}
+
+	// Start all deletions before waiting on any of them, so the instances are
+	// deleted in parallel.
+	ops := []*compute.Operation{}
+	for _, v := range d.Get("instances").(*schema.Set).List() {
+		name := GetResourceNameFromSelfLink(v.(string))
+		log.Printf("[DEBUG] Deleting instance %q of bulk instances %q", name, d.Id())
+		op, err := config.clientCompute.Instances.Delete(project, zone, name).Do()
+		if err != nil {
+			if isGoogleApiErrorWithCode(err, 404) {
+				continue
+			}
+			return fmt.Errorf("Error deleting instance %q: %s", name, err)
+		}
+		ops = append(ops, op)
+	}
+
+	for _, op := range ops {
+		err = computeOperationWaitTime(config, op, project, "instance to delete", int(d.Timeout(schema.TimeoutDelete).Minutes()))
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func computeInstanceBulkMinCountCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	// separate func to allow unit testing
+	return computeInstanceBulkMinCountCustomizeDiffFunc(diff)
+}
+
+func computeInstanceBulkMinCountCustomizeDiffFunc(diff TerraformResourceDiff) error {
+	_, count := diff.GetChange("instance_count")
+	_, minCount := diff.GetChange("min_instance_count")
+	c, _ := count.(int)
+	m, _ := minCount.(int)
+	if c > 0 && m > c {
+		return fmt.Errorf("min_instance_count (%d) cannot be greater than instance_count (%d)", m, c)
+	}
+	return nil
+}
+
+// listComputeInstanceBulkInstances returns the self_links of all instances in
+// the zone whose name matches the given bulk insert name pattern.
+func listComputeInstanceBulkInstances(config *Config, project, zone, namePattern string) ([]string, error) {
+	parts := instanceBulkNamePatternRegex.Split(namePattern, 2)
+	filter := fmt.Sprintf("name eq %s[0-9]+%s", regexp.QuoteMeta(parts[0]), regexp.QuoteMeta(parts[1]))
+
+	instances := []string{}
+	token := ""
+	for paginate := true; paginate; {
+		resp, err := config.clientCompute.Instances.List(project, zone).Filter(filter).PageToken(token).Do()
+		if err != nil {
+			return nil, fmt.Errorf("Error listing instances matching %q: %s", namePattern, err)
+		}
+		for _, instance := range resp.Items {
+			instances = append(instances, ConvertSelfLinkToV1(instance.SelfLink))
+		}
+		token = resp.NextPageToken
+		paginate = token != ""
+	}
+
+	return instances, nil
+}
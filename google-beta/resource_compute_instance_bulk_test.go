@@ -0,0 +1,111 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestComputeInstanceBulkMinCountCustomizeDiff(t *testing.T) {
+	cases := map[string]struct {
+		Count, MinCount interface{}
+		ExpectError     bool
+	}{
+		"min count unset":     {Count: 3},
+		"min count equal":     {Count: 3, MinCount: 3},
+		"min count lower":     {Count: 3, MinCount: 1},
+		"min count greater":   {Count: 3, MinCount: 4, ExpectError: true},
+		"count not yet known": {MinCount: 4},
+	}
+
+	for tn, tc := range cases {
+		d := &ResourceDiffMock{
+			After: map[string]interface{}{
+				"instance_count":     tc.Count,
+				"min_instance_count": tc.MinCount,
+			},
+		}
+		err := computeInstanceBulkMinCountCustomizeDiffFunc(d)
+		if tc.ExpectError != (err != nil) {
+			t.Errorf("%s: expected error %t, got %v", tn, tc.ExpectError, err)
+		}
+	}
+}
+
+func TestAccComputeInstanceBulk_basic(t *testing.T) {
+	t.Parallel()
+
+	prefix := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	templateName := fmt.Sprintf("terraform-test-%s", acctest.RandString(10))
+	resourceName := "google_compute_instance_bulk.foobar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceBulkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstanceBulk_basic(templateName, prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "instances.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeInstanceBulkDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_instance_bulk" {
+			continue
+		}
+
+		instances, err := listComputeInstanceBulkInstances(
+			config, config.Project, rs.Primary.Attributes["zone"], rs.Primary.Attributes["name_pattern"])
+		if err != nil {
+			return err
+		}
+		if len(instances) > 0 {
+			return fmt.Errorf("Bulk instances still exist: %v", instances)
+		}
+	}
+
+	return nil
+}
+
+func testAccComputeInstanceBulk_basic(template, prefix string) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+	family  = "debian-9"
+	project = "debian-cloud"
+}
+
+resource "google_compute_instance_template" "foobar" {
+	name         = "%s"
+	machine_type = "n1-standard-1"
+
+	disk {
+		source_image = "${data.google_compute_image.my_image.self_link}"
+		auto_delete  = true
+		boot         = true
+	}
+
+	network_interface {
+		network = "default"
+	}
+}
+
+resource "google_compute_instance_bulk" "foobar" {
+	name_pattern   = "%s-###"
+	instance_count = 3
+	zone           = "us-central1-a"
+
+	source_instance_template = "${google_compute_instance_template.foobar.self_link}"
+}
+`, template, prefix)
+}
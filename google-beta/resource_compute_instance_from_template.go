@@ -157,7 +157,7 @@ func resourceComputeInstanceFromTemplateCreate(d *schema.ResourceData, meta inte
 	d.SetId(instance.Name)
 
 	// Wait for the operation to complete
-	waitErr := computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutCreate).Minutes()), "instance to create")
+	waitErr := computeSharedOperationWaitTime(config, op, project, int(d.Timeout(schema.TimeoutCreate).Minutes()), "instance to create")
 	if waitErr != nil {
 		// The resource didn't actually create
 		d.SetId("")
@@ -5,6 +5,7 @@ import (
 	"reflect"
 
 	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -20,8 +21,12 @@ func resourceComputeInstanceTemplate() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 		SchemaVersion: 1,
-		CustomizeDiff: resourceComputeInstanceTemplateSourceImageCustomizeDiff,
-		MigrateState:  resourceComputeInstanceTemplateMigrateState,
+		CustomizeDiff: customdiff.All(
+			resourceComputeInstanceTemplateSourceImageCustomizeDiff,
+			setLabelsDiff,
+			forceNewIfTerraformLabelsChange,
+		),
+		MigrateState: resourceComputeInstanceTemplateMigrateState,
 
 		// A compute instance template is more or less a subset of a compute
 		// instance. Please attempt to maintain consistency with the
@@ -351,6 +356,30 @@ func resourceComputeInstanceTemplate() *schema.Resource {
 							Elem:             instanceSchedulingNodeAffinitiesElemSchema(),
 							DiffSuppressFunc: emptyOrDefaultStringSuppress(""),
 						},
+
+						"provisioning_model": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"STANDARD", "SPOT"}, false),
+						},
+
+						"instance_termination_action": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"STOP", "DELETE"}, false),
+						},
+
+						"max_run_duration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem:     instanceSchedulingMaxRunDurationElemSchema(),
+						},
 					},
 				},
 			},
@@ -472,6 +501,18 @@ func resourceComputeInstanceTemplate() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"effective_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -694,7 +735,7 @@ func resourceComputeInstanceTemplateCreate(d *schema.ResourceData, meta interfac
 		ShieldedVmConfig:  expandShieldedVmConfigs(d),
 	}
 
-	if _, ok := d.GetOk("labels"); ok {
+	if _, ok := d.GetOk("terraform_labels"); ok {
 		instanceProperties.Labels = expandLabels(d)
 	}
 
@@ -712,7 +753,7 @@ func resourceComputeInstanceTemplateCreate(d *schema.ResourceData, meta interfac
 		Name:        itName,
 	}
 
-	op, err := config.clientComputeBeta.InstanceTemplates.Insert(project, instanceTemplate).Do()
+	op, err := insertComputeInstanceTemplate(config, d, project, instanceTemplate)
 	if err != nil {
 		return fmt.Errorf("Error creating instance template: %s", err)
 	}
@@ -720,7 +761,7 @@ func resourceComputeInstanceTemplateCreate(d *schema.ResourceData, meta interfac
 	// Store the ID now
 	d.SetId(instanceTemplate.Name)
 
-	err = computeSharedOperationWait(config.clientCompute, op, project, "Creating Instance Template")
+	err = computeSharedOperationWait(config, op, project, "Creating Instance Template")
 	if err != nil {
 		return err
 	}
@@ -728,6 +769,31 @@ func resourceComputeInstanceTemplateCreate(d *schema.ResourceData, meta interfac
 	return resourceComputeInstanceTemplateRead(d, meta)
 }
 
+func insertComputeInstanceTemplate(config *Config, d *schema.ResourceData, project string, instanceTemplate *computeBeta.InstanceTemplate) (*computeBeta.Operation, error) {
+	fields, err := expandSchedulingRawFields(d.Get("scheduling"))
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return config.clientComputeBeta.InstanceTemplates.Insert(project, instanceTemplate).Do()
+	}
+
+	obj, err := mergeSchedulingRawFields(instanceTemplate, fields, "properties", "scheduling")
+	if err != nil {
+		return nil, err
+	}
+	url := fmt.Sprintf("%sprojects/%s/global/instanceTemplates", config.ComputeBetaBasePath, project)
+	res, err := sendRequest(config, "POST", project, url, obj)
+	if err != nil {
+		return nil, err
+	}
+	op := &computeBeta.Operation{}
+	if err := Convert(res, op); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
 type diskCharacteristics struct {
 	mode        string
 	diskType    string
@@ -943,10 +1009,17 @@ func resourceComputeInstanceTemplateRead(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	instanceTemplate, err := config.clientComputeBeta.InstanceTemplates.Get(project, d.Id()).Do()
+	// Read the template as raw JSON so the scheduling fields the vendored
+	// client doesn't model yet are available. See expandSchedulingRawFields.
+	url := fmt.Sprintf("%sprojects/%s/global/instanceTemplates/%s", config.ComputeBetaBasePath, project, d.Id())
+	res, err := sendRequest(config, "GET", project, url, nil)
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("Instance Template %q", d.Get("name").(string)))
 	}
+	instanceTemplate := &computeBeta.InstanceTemplate{}
+	if err := Convert(res, instanceTemplate); err != nil {
+		return err
+	}
 
 	// Set the metadata fingerprint if there is one.
 	if instanceTemplate.Properties.Metadata != nil {
@@ -977,8 +1050,8 @@ func resourceComputeInstanceTemplateRead(d *schema.ResourceData, meta interface{
 	} else {
 		d.Set("tags_fingerprint", "")
 	}
-	if instanceTemplate.Properties.Labels != nil {
-		d.Set("labels", instanceTemplate.Properties.Labels)
+	if err = setLabelsFields(d, config, instanceTemplate.Properties.Labels); err != nil {
+		return fmt.Errorf("Error setting labels: %s", err)
 	}
 	if err = d.Set("self_link", instanceTemplate.SelfLink); err != nil {
 		return fmt.Errorf("Error setting self_link: %s", err)
@@ -1032,6 +1105,7 @@ func resourceComputeInstanceTemplateRead(d *schema.ResourceData, meta interface{
 	}
 	if instanceTemplate.Properties.Scheduling != nil {
 		scheduling := flattenScheduling(instanceTemplate.Properties.Scheduling)
+		flattenSchedulingRawFields(rawSchedulingFromResponse(res, "properties", "scheduling"), scheduling)
 		if err = d.Set("scheduling", scheduling); err != nil {
 			return fmt.Errorf("Error setting scheduling: %s", err)
 		}
@@ -1077,7 +1151,7 @@ func resourceComputeInstanceTemplateDelete(d *schema.ResourceData, meta interfac
 		return fmt.Errorf("Error deleting instance template: %s", err)
 	}
 
-	err = computeOperationWait(config.clientCompute, op, project, "Deleting Instance Template")
+	err = computeOperationWait(config, op, project, "Deleting Instance Template")
 	if err != nil {
 		return err
 	}
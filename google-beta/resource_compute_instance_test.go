@@ -2,6 +2,7 @@ package google
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -395,6 +396,38 @@ func TestAccComputeInstance_attachedDisk_modeRo(t *testing.T) {
 	})
 }
 
+func TestAccComputeInstance_attachedDisk_modeRoShared(t *testing.T) {
+	t.Parallel()
+
+	var instance, instance2 compute.Instance
+	var instanceName = fmt.Sprintf("instance-test-%s", acctest.RandString(10))
+	var instanceName2 = fmt.Sprintf("instance-test-%s", acctest.RandString(10))
+	var diskName = fmt.Sprintf("instance-testd-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstance_attachedDisk_modeRoShared(diskName, instanceName, instanceName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(
+						"google_compute_instance.foobar", &instance),
+					testAccCheckComputeInstanceDisk(&instance, diskName, false, false),
+					testAccCheckComputeInstanceExists(
+						"google_compute_instance.foobar2", &instance2),
+					testAccCheckComputeInstanceDisk(&instance2, diskName, false, false),
+					resource.TestCheckResourceAttr("google_compute_instance.foobar", "attached_disk.0.mode", "READ_ONLY"),
+					resource.TestCheckResourceAttr("google_compute_instance.foobar2", "attached_disk.0.mode", "READ_ONLY"),
+				),
+			},
+			computeInstanceImportStep("us-central1-a", instanceName, []string{}),
+			computeInstanceImportStep("us-central1-a", instanceName2, []string{}),
+		},
+	})
+}
+
 func TestAccComputeInstance_attachedDiskUpdate(t *testing.T) {
 	t.Parallel()
 
@@ -713,6 +746,56 @@ func TestAccComputeInstance_scheduling(t *testing.T) {
 	})
 }
 
+func TestAccComputeInstance_spotVM(t *testing.T) {
+	t.Parallel()
+
+	var instance compute.Instance
+	var instanceName = fmt.Sprintf("instance-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstance_spotVM(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(
+						"google_compute_instance.foobar", &instance),
+					resource.TestCheckResourceAttr("google_compute_instance.foobar", "scheduling.0.provisioning_model", "SPOT"),
+					resource.TestCheckResourceAttr("google_compute_instance.foobar", "scheduling.0.instance_termination_action", "DELETE"),
+				),
+			},
+			computeInstanceImportStep("us-central1-a", instanceName, []string{}),
+		},
+	})
+}
+
+func TestAccComputeInstance_gracefulShutdown(t *testing.T) {
+	t.Parallel()
+
+	var instance compute.Instance
+	var instanceName = fmt.Sprintf("instance-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstance_gracefulShutdown(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(
+						"google_compute_instance.foobar", &instance),
+					resource.TestCheckResourceAttr("google_compute_instance.foobar", "scheduling.0.graceful_shutdown.0.enabled", "true"),
+					resource.TestCheckResourceAttr("google_compute_instance.foobar", "scheduling.0.graceful_shutdown.0.max_duration.0.seconds", "120"),
+				),
+			},
+			computeInstanceImportStep("us-central1-a", instanceName, []string{}),
+		},
+	})
+}
+
 func TestAccComputeInstance_soleTenantNodeAffinities(t *testing.T) {
 	t.Parallel()
 
@@ -732,7 +815,7 @@ func TestAccComputeInstance_soleTenantNodeAffinities(t *testing.T) {
 			{
 				Config: testAccComputeInstance_soleTenantNodeAffinitiesUpdated(instanceName, templateName, groupName),
 			},
-			computeInstanceImportStep("us-central1-a", instanceName, []string{}),
+			computeInstanceImportStep("us-central1-a", instanceName, []string{"allow_stopping_for_update"}),
 		},
 	})
 }
@@ -1001,6 +1084,30 @@ func TestAccComputeInstance_minCpuPlatform(t *testing.T) {
 	})
 }
 
+func TestAccComputeInstance_specificReservationAffinity(t *testing.T) {
+	t.Parallel()
+
+	var instance compute.Instance
+	instanceName := fmt.Sprintf("terraform-test-%s", acctest.RandString(10))
+	reservationName := fmt.Sprintf("terraform-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstance_specificReservationAffinity(reservationName, instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists("google_compute_instance.foobar", &instance),
+					resource.TestCheckResourceAttr("google_compute_instance.foobar", "reservation_affinity.0.type", "SPECIFIC_RESERVATION"),
+				),
+			},
+			computeInstanceImportStep("us-central1-a", instanceName, []string{}),
+		},
+	})
+}
+
 func TestAccComputeInstance_deletionProtectionExplicitFalse(t *testing.T) {
 	t.Parallel()
 
@@ -1044,6 +1151,11 @@ func TestAccComputeInstance_deletionProtectionExplicitTrueAndUpdateFalse(t *test
 				),
 			},
 			computeInstanceImportStep("us-central1-a", instanceName, []string{"metadata.foo"}),
+			{
+				Config:      testAccComputeInstance_basic_deletionProtectionTrue(instanceName),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("deletion_protection"),
+			},
 			// Update deletion_protection to false, otherwise the test harness can't delete the instance
 			{
 				Config: testAccComputeInstance_basic_deletionProtectionFalse(instanceName),
@@ -1199,7 +1311,7 @@ func testAccCheckComputeInstanceUpdateMachineType(n string) resource.TestCheckFu
 		if err != nil {
 			return fmt.Errorf("Could not stop instance: %s", err)
 		}
-		err = computeOperationWait(config.clientCompute, op, config.Project, "Waiting on stop")
+		err = computeOperationWait(config, op, config.Project, "Waiting on stop")
 		if err != nil {
 			return fmt.Errorf("Could not stop instance: %s", err)
 		}
@@ -1213,7 +1325,7 @@ func testAccCheckComputeInstanceUpdateMachineType(n string) resource.TestCheckFu
 		if err != nil {
 			return fmt.Errorf("Could not change machine type: %s", err)
 		}
-		err = computeOperationWait(config.clientCompute, op, config.Project, "Waiting machine type change")
+		err = computeOperationWait(config, op, config.Project, "Waiting machine type change")
 		if err != nil {
 			return fmt.Errorf("Could not change machine type: %s", err)
 		}
@@ -2471,6 +2583,64 @@ resource "google_compute_instance" "foobar" {
 `, disk, instance)
 }
 
+func testAccComputeInstance_attachedDisk_modeRoShared(disk, instance, instance2 string) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+	family  = "debian-9"
+	project = "debian-cloud"
+}
+
+resource "google_compute_disk" "foobar" {
+	name = "%s"
+	size = 10
+	type = "pd-ssd"
+	zone = "us-central1-a"
+}
+
+resource "google_compute_instance" "foobar" {
+	name         = "%s"
+	machine_type = "n1-standard-1"
+	zone         = "us-central1-a"
+
+	boot_disk {
+		initialize_params {
+			image = "${data.google_compute_image.my_image.self_link}"
+		}
+	}
+
+	attached_disk {
+		source = "${google_compute_disk.foobar.self_link}"
+		mode = "READ_ONLY"
+	}
+
+	network_interface {
+		network = "default"
+	}
+}
+
+resource "google_compute_instance" "foobar2" {
+	name         = "%s"
+	machine_type = "n1-standard-1"
+	zone         = "us-central1-a"
+
+	boot_disk {
+		initialize_params {
+			image = "${data.google_compute_image.my_image.self_link}"
+		}
+	}
+
+	attached_disk {
+		source = "${google_compute_disk.foobar.self_link}"
+		mode = "READ_ONLY"
+	}
+
+	network_interface {
+		network = "default"
+	}
+}
+`, disk, instance, instance2)
+}
+
 func testAccComputeInstance_addAttachedDisk(disk, disk2, instance string) string {
 	return fmt.Sprintf(`
 data "google_compute_image" "my_image" {
@@ -3176,6 +3346,53 @@ resource "google_compute_instance" "foobar" {
 }`, instance)
 }
 
+func testAccComputeInstance_specificReservationAffinity(reservation, instance string) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+	family  = "debian-9"
+	project = "debian-cloud"
+}
+
+resource "google_compute_reservation" "reservation" {
+	name = "%s"
+	zone = "us-central1-a"
+
+	specific_reservation_required = true
+
+	specific_reservation {
+		count = 1
+		instance_properties {
+			machine_type = "n1-standard-1"
+		}
+	}
+}
+
+resource "google_compute_instance" "foobar" {
+	name = "%s"
+	machine_type = "n1-standard-1"
+	zone = "us-central1-a"
+
+	boot_disk {
+		initialize_params {
+			image = "${data.google_compute_image.my_image.self_link}"
+		}
+	}
+
+	network_interface {
+		network = "default"
+	}
+
+	reservation_affinity {
+		type = "SPECIFIC_RESERVATION"
+
+		specific_reservation {
+			key    = "compute.googleapis.com/reservation-name"
+			values = ["${google_compute_reservation.reservation.name}"]
+		}
+	}
+}`, reservation, instance)
+}
+
 func testAccComputeInstance_primaryAliasIpRange(instance string) string {
 	return fmt.Sprintf(`
 data "google_compute_image" "my_image" {
@@ -3427,6 +3644,74 @@ resource "google_compute_instance" "foobar" {
 `, instance)
 }
 
+func testAccComputeInstance_spotVM(instance string) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+	family  = "debian-9"
+	project = "debian-cloud"
+}
+
+resource "google_compute_instance" "foobar" {
+	name         = "%s"
+	machine_type = "n1-standard-1"
+	zone         = "us-central1-a"
+
+	boot_disk {
+		initialize_params{
+			image = "${data.google_compute_image.my_image.self_link}"
+		}
+	}
+
+	network_interface {
+		network = "default"
+	}
+
+	scheduling {
+		provisioning_model          = "SPOT"
+		preemptible                 = true
+		automatic_restart           = false
+		on_host_maintenance         = "TERMINATE"
+		instance_termination_action = "DELETE"
+	}
+}
+`, instance)
+}
+
+func testAccComputeInstance_gracefulShutdown(instance string) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+	family  = "debian-9"
+	project = "debian-cloud"
+}
+
+resource "google_compute_instance" "foobar" {
+	name         = "%s"
+	machine_type = "n1-standard-1"
+	zone         = "us-central1-a"
+
+	boot_disk {
+		initialize_params{
+			image = "${data.google_compute_image.my_image.self_link}"
+		}
+	}
+
+	network_interface {
+		network = "default"
+	}
+
+	scheduling {
+		graceful_shutdown {
+			enabled = true
+
+			max_duration {
+				seconds = 120
+			}
+		}
+	}
+}
+`, instance)
+}
+
 func testAccComputeInstance_soleTenantNodeAffinities(instance, nodeTemplate, nodeGroup string) string {
 	return fmt.Sprintf(`
 data "google_compute_image" "my_image" {
@@ -3502,6 +3787,8 @@ resource "google_compute_instance" "foobar" {
   machine_type = "n1-standard-2"
   zone = "us-central1-a"
 
+  allow_stopping_for_update = true
+
   boot_disk {
     initialize_params {
       image = "${data.google_compute_image.my_image.self_link}"
@@ -0,0 +1,606 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"google.golang.org/api/compute/v1"
+)
+
+func resourceComputeInterconnect() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeInterconnectCreate,
+		Read:   resourceComputeInterconnectRead,
+		Update: resourceComputeInterconnectUpdate,
+		Delete: resourceComputeInterconnectDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeInterconnectImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Update: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"interconnect_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"DEDICATED", "PARTNER", "IT_PRIVATE"}, false),
+			},
+			"link_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"LINK_TYPE_ETHERNET_10G_LR", "LINK_TYPE_ETHERNET_100G_LR"}, false),
+			},
+			"location": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegexp(`^[a-z]([-a-z0-9]*[a-z0-9])?$`),
+			},
+			"requested_link_count": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"admin_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"customer_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Optional: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"noc_contact_email": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"circuit_infos": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"customer_demarc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"google_circuit_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"google_demarc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"creation_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"google_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"google_reference_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operational_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"peer_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"provisioned_link_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"self_link": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeInterconnectCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	nameProp, err := expandComputeInterconnectName(d.Get("name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("name"); !isEmptyValue(reflect.ValueOf(nameProp)) && (ok || !reflect.DeepEqual(v, nameProp)) {
+		obj["name"] = nameProp
+	}
+	descriptionProp, err := expandComputeInterconnectDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	interconnectTypeProp, err := expandComputeInterconnectInterconnectType(d.Get("interconnect_type"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("interconnect_type"); !isEmptyValue(reflect.ValueOf(interconnectTypeProp)) && (ok || !reflect.DeepEqual(v, interconnectTypeProp)) {
+		obj["interconnectType"] = interconnectTypeProp
+	}
+	linkTypeProp, err := expandComputeInterconnectLinkType(d.Get("link_type"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("link_type"); !isEmptyValue(reflect.ValueOf(linkTypeProp)) && (ok || !reflect.DeepEqual(v, linkTypeProp)) {
+		obj["linkType"] = linkTypeProp
+	}
+	locationProp, err := expandComputeInterconnectLocation(d.Get("location"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("location"); !isEmptyValue(reflect.ValueOf(locationProp)) && (ok || !reflect.DeepEqual(v, locationProp)) {
+		obj["location"] = locationProp
+	}
+	requestedLinkCountProp, err := expandComputeInterconnectRequestedLinkCount(d.Get("requested_link_count"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("requested_link_count"); !isEmptyValue(reflect.ValueOf(requestedLinkCountProp)) && (ok || !reflect.DeepEqual(v, requestedLinkCountProp)) {
+		obj["requestedLinkCount"] = requestedLinkCountProp
+	}
+	adminEnabledProp, err := expandComputeInterconnectAdminEnabled(d.Get("admin_enabled"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("admin_enabled"); ok || !reflect.DeepEqual(v, adminEnabledProp) {
+		obj["adminEnabled"] = adminEnabledProp
+	}
+	customerNameProp, err := expandComputeInterconnectCustomerName(d.Get("customer_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("customer_name"); !isEmptyValue(reflect.ValueOf(customerNameProp)) && (ok || !reflect.DeepEqual(v, customerNameProp)) {
+		obj["customerName"] = customerNameProp
+	}
+	nocContactEmailProp, err := expandComputeInterconnectNocContactEmail(d.Get("noc_contact_email"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("noc_contact_email"); !isEmptyValue(reflect.ValueOf(nocContactEmailProp)) && (ok || !reflect.DeepEqual(v, nocContactEmailProp)) {
+		obj["nocContactEmail"] = nocContactEmailProp
+	}
+
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/interconnects")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Interconnect: %#v", obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Interconnect: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	waitErr := computeOperationWaitTime(
+		config, op, project, "Creating Interconnect",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create Interconnect: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating Interconnect %q: %#v", d.Id(), res)
+
+	return resourceComputeInterconnectRead(d, meta)
+}
+
+func resourceComputeInterconnectRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/interconnects/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeInterconnect %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Interconnect: %s", err)
+	}
+
+	if err := d.Set("name", flattenComputeInterconnectName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading Interconnect: %s", err)
+	}
+	if err := d.Set("description", flattenComputeInterconnectDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading Interconnect: %s", err)
+	}
+	if err := d.Set("interconnect_type", flattenComputeInterconnectInterconnectType(res["interconnectType"], d)); err != nil {
+		return fmt.Errorf("Error reading Interconnect: %s", err)
+	}
+	if err := d.Set("link_type", flattenComputeInterconnectLinkType(res["linkType"], d)); err != nil {
+		return fmt.Errorf("Error reading Interconnect: %s", err)
+	}
+	if err := d.Set("location", flattenComputeInterconnectLocation(res["location"], d)); err != nil {
+		return fmt.Errorf("Error reading Interconnect: %s", err)
+	}
+	if err := d.Set("requested_link_count", flattenComputeInterconnectRequestedLinkCount(res["requestedLinkCount"], d)); err != nil {
+		return fmt.Errorf("Error reading Interconnect: %s", err)
+	}
+	if err := d.Set("admin_enabled", flattenComputeInterconnectAdminEnabled(res["adminEnabled"], d)); err != nil {
+		return fmt.Errorf("Error reading Interconnect: %s", err)
+	}
+	if err := d.Set("customer_name", flattenComputeInterconnectCustomerName(res["customerName"], d)); err != nil {
+		return fmt.Errorf("Error reading Interconnect: %s", err)
+	}
+	if err := d.Set("noc_contact_email", flattenComputeInterconnectNocContactEmail(res["nocContactEmail"], d)); err != nil {
+		return fmt.Errorf("Error reading Interconnect: %s", err)
+	}
+	if err := d.Set("operational_status", flattenComputeInterconnectOperationalStatus(res["operationalStatus"], d)); err != nil {
+		return fmt.Errorf("Error reading Interconnect: %s", err)
+	}
+	if err := d.Set("provisioned_link_count", flattenComputeInterconnectProvisionedLinkCount(res["provisionedLinkCount"], d)); err != nil {
+		return fmt.Errorf("Error reading Interconnect: %s", err)
+	}
+	if err := d.Set("circuit_infos", flattenComputeInterconnectCircuitInfos(res["circuitInfos"], d)); err != nil {
+		return fmt.Errorf("Error reading Interconnect: %s", err)
+	}
+	if err := d.Set("google_ip_address", flattenComputeInterconnectGoogleIpAddress(res["googleIpAddress"], d)); err != nil {
+		return fmt.Errorf("Error reading Interconnect: %s", err)
+	}
+	if err := d.Set("google_reference_id", flattenComputeInterconnectGoogleReferenceId(res["googleReferenceId"], d)); err != nil {
+		return fmt.Errorf("Error reading Interconnect: %s", err)
+	}
+	if err := d.Set("peer_ip_address", flattenComputeInterconnectPeerIpAddress(res["peerIpAddress"], d)); err != nil {
+		return fmt.Errorf("Error reading Interconnect: %s", err)
+	}
+	if err := d.Set("state", flattenComputeInterconnectState(res["state"], d)); err != nil {
+		return fmt.Errorf("Error reading Interconnect: %s", err)
+	}
+	if err := d.Set("creation_timestamp", flattenComputeInterconnectCreationTimestamp(res["creationTimestamp"], d)); err != nil {
+		return fmt.Errorf("Error reading Interconnect: %s", err)
+	}
+	if err := d.Set("self_link", ConvertSelfLinkToV1(res["selfLink"].(string))); err != nil {
+		return fmt.Errorf("Error reading Interconnect: %s", err)
+	}
+
+	return nil
+}
+
+func resourceComputeInterconnectUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandComputeInterconnectDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	requestedLinkCountProp, err := expandComputeInterconnectRequestedLinkCount(d.Get("requested_link_count"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("requested_link_count"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, requestedLinkCountProp)) {
+		obj["requestedLinkCount"] = requestedLinkCountProp
+	}
+	adminEnabledProp, err := expandComputeInterconnectAdminEnabled(d.Get("admin_enabled"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("admin_enabled"); ok || !reflect.DeepEqual(v, adminEnabledProp) {
+		obj["adminEnabled"] = adminEnabledProp
+	}
+	nocContactEmailProp, err := expandComputeInterconnectNocContactEmail(d.Get("noc_contact_email"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("noc_contact_email"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, nocContactEmailProp)) {
+		obj["nocContactEmail"] = nocContactEmailProp
+	}
+
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/interconnects/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Interconnect %q: %#v", d.Id(), obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Interconnect %q: %s", d.Id(), err)
+	}
+
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	err = computeOperationWaitTime(
+		config, op, project, "Updating Interconnect",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceComputeInterconnectRead(d, meta)
+}
+
+func resourceComputeInterconnectDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/interconnects/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Interconnect %q", d.Id())
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Interconnect")
+	}
+
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	err = computeOperationWaitTime(
+		config, op, project, "Deleting Interconnect",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting Interconnect %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceComputeInterconnectImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/global/interconnects/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<name>[^/]+)",
+		"(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenComputeInterconnectName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeInterconnectDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeInterconnectInterconnectType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeInterconnectLinkType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeInterconnectLocation(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeInterconnectRequestedLinkCount(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenComputeInterconnectAdminEnabled(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeInterconnectCustomerName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeInterconnectNocContactEmail(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeInterconnectOperationalStatus(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeInterconnectProvisionedLinkCount(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenComputeInterconnectCircuitInfos(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"google_circuit_id":  flattenComputeInterconnectCircuitInfosGoogleCircuitId(original["googleCircuitId"], d),
+			"google_demarc_id":   flattenComputeInterconnectCircuitInfosGoogleDemarcId(original["googleDemarcId"], d),
+			"customer_demarc_id": flattenComputeInterconnectCircuitInfosCustomerDemarcId(original["customerDemarcId"], d),
+		})
+	}
+	return transformed
+}
+func flattenComputeInterconnectCircuitInfosGoogleCircuitId(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeInterconnectCircuitInfosGoogleDemarcId(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeInterconnectCircuitInfosCustomerDemarcId(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeInterconnectGoogleIpAddress(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeInterconnectGoogleReferenceId(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeInterconnectPeerIpAddress(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeInterconnectState(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeInterconnectCreationTimestamp(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandComputeInterconnectName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeInterconnectDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeInterconnectInterconnectType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeInterconnectLinkType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeInterconnectLocation(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	f, err := parseGlobalFieldValue("interconnectLocations", v.(string), "project", d, config, true)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid value for location: %s", err)
+	}
+	return f.RelativeLink(), nil
+}
+
+func expandComputeInterconnectRequestedLinkCount(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeInterconnectAdminEnabled(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeInterconnectCustomerName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeInterconnectNocContactEmail(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -148,7 +148,12 @@ func resourceComputeManagedSslCertificateCreate(d *schema.ResourceData, meta int
 	}
 
 	log.Printf("[DEBUG] Creating new ManagedSslCertificate: %#v", obj)
-	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("Error creating ManagedSslCertificate: %s", err)
 	}
@@ -160,10 +165,6 @@ func resourceComputeManagedSslCertificateCreate(d *schema.ResourceData, meta int
 	}
 	d.SetId(id)
 
-	project, err := getProject(d, config)
-	if err != nil {
-		return err
-	}
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -171,7 +172,7 @@ func resourceComputeManagedSslCertificateCreate(d *schema.ResourceData, meta int
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating ManagedSslCertificate",
+		config, op, project, "Creating ManagedSslCertificate",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
@@ -193,15 +194,16 @@ func resourceComputeManagedSslCertificateRead(d *schema.ResourceData, meta inter
 		return err
 	}
 
-	res, err := sendRequest(config, "GET", url, nil)
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("ComputeManagedSslCertificate %q", d.Id()))
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequest(config, "GET", project, url, nil)
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeManagedSslCertificate %q", d.Id()))
 	}
+
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("Error reading ManagedSslCertificate: %s", err)
 	}
@@ -247,15 +249,16 @@ func resourceComputeManagedSslCertificateDelete(d *schema.ResourceData, meta int
 
 	var obj map[string]interface{}
 	log.Printf("[DEBUG] Deleting ManagedSslCertificate %q", d.Id())
-	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, "ManagedSslCertificate")
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, "ManagedSslCertificate")
 	}
+
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -263,7 +266,7 @@ func resourceComputeManagedSslCertificateDelete(d *schema.ResourceData, meta int
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting ManagedSslCertificate",
+		config, op, project, "Deleting ManagedSslCertificate",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 
 	if err != nil {
@@ -155,7 +155,7 @@ func testAccCheckComputeManagedSslCertificateDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("ComputeManagedSslCertificate still exists at %s", url)
 		}
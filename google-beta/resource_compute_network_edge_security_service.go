@@ -0,0 +1,472 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/compute/v1"
+)
+
+// computeNetworkEdgeSecurityServiceCheckPolicy verifies that the security policy
+// attached to a network edge security service is a regional CLOUD_ARMOR_NETWORK
+// policy in the service's region with advanced network DDoS protection.
+func computeNetworkEdgeSecurityServiceCheckPolicy(d *schema.ResourceData, config *Config) error {
+	policy := d.Get("security_policy").(string)
+	if policy == "" {
+		return nil
+	}
+	if strings.Contains(policy, "/global/") {
+		return fmt.Errorf("security policy %q must be a regional security policy", policy)
+	}
+
+	f, err := parseRegionalFieldValue("securityPolicies", policy, "project", "region", "", d, config, false)
+	if err != nil {
+		return fmt.Errorf("Invalid value for security_policy: %s", err)
+	}
+	region, err := getRegionFromSchema("region", "", d, config)
+	if err != nil {
+		return err
+	}
+	if GetResourceNameFromSelfLink(f.Region) != region {
+		return fmt.Errorf("security policy %q must be in the region of the network edge security service, %s", policy, region)
+	}
+
+	res, err := sendRequest(config, "GET", f.Project, config.ComputeBasePath+f.RelativeLink(), nil)
+	if err != nil {
+		return fmt.Errorf("Error reading security policy %q: %s", policy, err)
+	}
+
+	if t := res["type"]; t != "CLOUD_ARMOR_NETWORK" {
+		return fmt.Errorf("security policy %q must be of type CLOUD_ARMOR_NETWORK, got %v", policy, t)
+	}
+	ddos, _ := res["ddosProtectionConfig"].(map[string]interface{})
+	if ddos["ddosProtection"] != "ADVANCED" {
+		return fmt.Errorf("security policy %q must have ADVANCED ddos protection", policy)
+	}
+	return nil
+}
+
+func resourceComputeNetworkEdgeSecurityService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeNetworkEdgeSecurityServiceCreate,
+		Read:   resourceComputeNetworkEdgeSecurityServiceRead,
+		Update: resourceComputeNetworkEdgeSecurityServiceUpdate,
+		Delete: resourceComputeNetworkEdgeSecurityServiceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeNetworkEdgeSecurityServiceImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Update: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"region": {
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"security_policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"creation_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"self_link_with_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"self_link": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeNetworkEdgeSecurityServiceCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	nameProp, err := expandComputeNetworkEdgeSecurityServiceName(d.Get("name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("name"); !isEmptyValue(reflect.ValueOf(nameProp)) && (ok || !reflect.DeepEqual(v, nameProp)) {
+		obj["name"] = nameProp
+	}
+	descriptionProp, err := expandComputeNetworkEdgeSecurityServiceDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	securityPolicyProp, err := expandComputeNetworkEdgeSecurityServiceSecurityPolicy(d.Get("security_policy"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("security_policy"); !isEmptyValue(reflect.ValueOf(securityPolicyProp)) && (ok || !reflect.DeepEqual(v, securityPolicyProp)) {
+		obj["securityPolicy"] = securityPolicyProp
+	}
+	fingerprintProp, err := expandComputeNetworkEdgeSecurityServiceFingerprint(d.Get("fingerprint"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("fingerprint"); !isEmptyValue(reflect.ValueOf(fingerprintProp)) && (ok || !reflect.DeepEqual(v, fingerprintProp)) {
+		obj["fingerprint"] = fingerprintProp
+	}
+	regionProp, err := expandComputeNetworkEdgeSecurityServiceRegion(d.Get("region"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("region"); !isEmptyValue(reflect.ValueOf(regionProp)) && (ok || !reflect.DeepEqual(v, regionProp)) {
+		obj["region"] = regionProp
+	}
+
+	if err := computeNetworkEdgeSecurityServiceCheckPolicy(d, config); err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/regions/{{region}}/networkEdgeSecurityServices")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new NetworkEdgeSecurityService: %#v", obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating NetworkEdgeSecurityService: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/regions/{{region}}/networkEdgeSecurityServices/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	waitErr := computeOperationWaitTime(
+		config, op, project, "Creating NetworkEdgeSecurityService",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create NetworkEdgeSecurityService: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating NetworkEdgeSecurityService %q: %#v", d.Id(), res)
+
+	return resourceComputeNetworkEdgeSecurityServiceRead(d, meta)
+}
+
+func resourceComputeNetworkEdgeSecurityServiceRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/regions/{{region}}/networkEdgeSecurityServices/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeNetworkEdgeSecurityService %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading NetworkEdgeSecurityService: %s", err)
+	}
+
+	if err := d.Set("name", flattenComputeNetworkEdgeSecurityServiceName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading NetworkEdgeSecurityService: %s", err)
+	}
+	if err := d.Set("description", flattenComputeNetworkEdgeSecurityServiceDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading NetworkEdgeSecurityService: %s", err)
+	}
+	if err := d.Set("security_policy", flattenComputeNetworkEdgeSecurityServiceSecurityPolicy(res["securityPolicy"], d)); err != nil {
+		return fmt.Errorf("Error reading NetworkEdgeSecurityService: %s", err)
+	}
+	if err := d.Set("fingerprint", flattenComputeNetworkEdgeSecurityServiceFingerprint(res["fingerprint"], d)); err != nil {
+		return fmt.Errorf("Error reading NetworkEdgeSecurityService: %s", err)
+	}
+	if err := d.Set("service_id", flattenComputeNetworkEdgeSecurityServiceServiceId(res["id"], d)); err != nil {
+		return fmt.Errorf("Error reading NetworkEdgeSecurityService: %s", err)
+	}
+	if err := d.Set("creation_timestamp", flattenComputeNetworkEdgeSecurityServiceCreationTimestamp(res["creationTimestamp"], d)); err != nil {
+		return fmt.Errorf("Error reading NetworkEdgeSecurityService: %s", err)
+	}
+	if err := d.Set("self_link_with_id", flattenComputeNetworkEdgeSecurityServiceSelfLinkWithId(res["selfLinkWithId"], d)); err != nil {
+		return fmt.Errorf("Error reading NetworkEdgeSecurityService: %s", err)
+	}
+	if err := d.Set("region", flattenComputeNetworkEdgeSecurityServiceRegion(res["region"], d)); err != nil {
+		return fmt.Errorf("Error reading NetworkEdgeSecurityService: %s", err)
+	}
+	if err := d.Set("self_link", ConvertSelfLinkToV1(res["selfLink"].(string))); err != nil {
+		return fmt.Errorf("Error reading NetworkEdgeSecurityService: %s", err)
+	}
+
+	return nil
+}
+
+func resourceComputeNetworkEdgeSecurityServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if err := computeNetworkEdgeSecurityServiceCheckPolicy(d, config); err != nil {
+		return err
+	}
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandComputeNetworkEdgeSecurityServiceDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	securityPolicyProp, err := expandComputeNetworkEdgeSecurityServiceSecurityPolicy(d.Get("security_policy"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("security_policy"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, securityPolicyProp)) {
+		obj["securityPolicy"] = securityPolicyProp
+	}
+	fingerprintProp, err := expandComputeNetworkEdgeSecurityServiceFingerprint(d.Get("fingerprint"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("fingerprint"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, fingerprintProp)) {
+		obj["fingerprint"] = fingerprintProp
+	}
+
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/regions/{{region}}/networkEdgeSecurityServices/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating NetworkEdgeSecurityService %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("description") {
+		updateMask = append(updateMask, "description")
+	}
+
+	if d.HasChange("security_policy") {
+		updateMask = append(updateMask, "securityPolicy")
+	}
+
+	if d.HasChange("fingerprint") {
+		updateMask = append(updateMask, "fingerprint")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating NetworkEdgeSecurityService %q: %s", d.Id(), err)
+	}
+
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	err = computeOperationWaitTime(
+		config, op, project, "Updating NetworkEdgeSecurityService",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceComputeNetworkEdgeSecurityServiceRead(d, meta)
+}
+
+func resourceComputeNetworkEdgeSecurityServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/regions/{{region}}/networkEdgeSecurityServices/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting NetworkEdgeSecurityService %q", d.Id())
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "NetworkEdgeSecurityService")
+	}
+
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	err = computeOperationWaitTime(
+		config, op, project, "Deleting NetworkEdgeSecurityService",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting NetworkEdgeSecurityService %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceComputeNetworkEdgeSecurityServiceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/networkEdgeSecurityServices/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)",
+		"(?P<region>[^/]+)/(?P<name>[^/]+)",
+		"(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/regions/{{region}}/networkEdgeSecurityServices/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenComputeNetworkEdgeSecurityServiceName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeNetworkEdgeSecurityServiceDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeNetworkEdgeSecurityServiceSecurityPolicy(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeNetworkEdgeSecurityServiceFingerprint(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeNetworkEdgeSecurityServiceServiceId(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeNetworkEdgeSecurityServiceCreationTimestamp(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeNetworkEdgeSecurityServiceSelfLinkWithId(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeNetworkEdgeSecurityServiceRegion(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	return NameFromSelfLinkStateFunc(v)
+}
+
+func expandComputeNetworkEdgeSecurityServiceName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeNetworkEdgeSecurityServiceDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeNetworkEdgeSecurityServiceSecurityPolicy(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	f, err := parseRegionalFieldValue("securityPolicies", v.(string), "project", "region", "", d, config, true)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid value for security_policy: %s", err)
+	}
+	return f.RelativeLink(), nil
+}
+
+func expandComputeNetworkEdgeSecurityServiceFingerprint(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeNetworkEdgeSecurityServiceRegion(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	f, err := parseGlobalFieldValue("regions", v.(string), "project", d, config, true)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid value for region: %s", err)
+	}
+	return f.RelativeLink(), nil
+}
@@ -0,0 +1,95 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeNetworkEdgeSecurityService_computeNetworkEdgeSecurityServiceBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeNetworkEdgeSecurityServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeNetworkEdgeSecurityService_computeNetworkEdgeSecurityServiceBasicExample(context),
+			},
+			{
+				ResourceName:      "google_compute_network_edge_security_service.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccComputeNetworkEdgeSecurityService_computeNetworkEdgeSecurityServiceBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_region_security_policy" "policy" {
+  name   = "tf-test-my-edge-policy%{random_suffix}"
+  region = "us-central1"
+  type   = "CLOUD_ARMOR_NETWORK"
+
+  ddos_protection_config {
+    ddos_protection = "ADVANCED"
+  }
+}
+
+resource "google_compute_network_edge_security_service" "default" {
+  name            = "tf-test-my-edge-security-service%{random_suffix}"
+  region          = "us-central1"
+  description     = "My basic resource"
+  security_policy = "${google_compute_region_security_policy.policy.self_link}"
+}
+`, context)
+}
+
+func testAccCheckComputeNetworkEdgeSecurityServiceDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_network_edge_security_service" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(config, rs, "{{ComputeBasePath}}projects/{{project}}/regions/{{region}}/networkEdgeSecurityServices/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("ComputeNetworkEdgeSecurityService still exists at %s", url)
+		}
+	}
+
+	return nil
+}
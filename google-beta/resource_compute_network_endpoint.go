@@ -120,7 +120,12 @@ func resourceComputeNetworkEndpointCreate(d *schema.ResourceData, meta interface
 	}
 
 	log.Printf("[DEBUG] Creating new NetworkEndpoint: %#v", obj)
-	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("Error creating NetworkEndpoint: %s", err)
 	}
@@ -132,10 +137,6 @@ func resourceComputeNetworkEndpointCreate(d *schema.ResourceData, meta interface
 	}
 	d.SetId(id)
 
-	project, err := getProject(d, config)
-	if err != nil {
-		return err
-	}
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -143,7 +144,7 @@ func resourceComputeNetworkEndpointCreate(d *schema.ResourceData, meta interface
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating NetworkEndpoint",
+		config, op, project, "Creating NetworkEndpoint",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
@@ -165,7 +166,12 @@ func resourceComputeNetworkEndpointRead(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	res, err := sendRequest(config, "POST", url, nil)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "POST", project, url, nil)
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("ComputeNetworkEndpoint %q", d.Id()))
 	}
@@ -187,10 +193,6 @@ func resourceComputeNetworkEndpointRead(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	project, err := getProject(d, config)
-	if err != nil {
-		return err
-	}
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("Error reading NetworkEndpoint: %s", err)
 	}
@@ -247,15 +249,16 @@ func resourceComputeNetworkEndpointDelete(d *schema.ResourceData, meta interface
 		"networkEndpoints": []map[string]interface{}{toDelete},
 	}
 	log.Printf("[DEBUG] Deleting NetworkEndpoint %q", d.Id())
-	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutDelete))
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, "NetworkEndpoint")
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, "NetworkEndpoint")
 	}
+
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -263,7 +266,7 @@ func resourceComputeNetworkEndpointDelete(d *schema.ResourceData, meta interface
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting NetworkEndpoint",
+		config, op, project, "Deleting NetworkEndpoint",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 
 	if err != nil {
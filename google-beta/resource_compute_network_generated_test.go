@@ -72,7 +72,7 @@ func testAccCheckComputeNetworkDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("ComputeNetwork still exists at %s", url)
 		}
@@ -22,9 +22,19 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"google.golang.org/api/compute/v1"
 )
 
+// suppressNodeGroupSizeWhileAutoscaling suppresses changes to size made by
+// the autoscaler, which owns the number of nodes while its mode is ON.
+func suppressNodeGroupSizeWhileAutoscaling(_, old, new string, d *schema.ResourceData) bool {
+	if d.Id() == "" {
+		return false
+	}
+	return d.Get("autoscaling_policy.0.mode").(string) == "ON"
+}
+
 func resourceComputeNodeGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceComputeNodeGroupCreate,
@@ -49,20 +59,89 @@ func resourceComputeNodeGroup() *schema.Resource {
 				DiffSuppressFunc: compareSelfLinkOrResourceName,
 			},
 			"size": {
-				Type:     schema.TypeInt,
-				Required: true,
-				ForceNew: true,
+				Type:             schema.TypeInt,
+				Required:         true,
+				DiffSuppressFunc: suppressNodeGroupSizeWhileAutoscaling,
+			},
+			"autoscaling_policy": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_nodes": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"OFF", "ON", "ONLY_SCALE_OUT"}, false),
+						},
+						"min_nodes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+							Optional: true,
+						},
+					},
+				},
 			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
 				ForceNew: true,
 			},
+			"maintenance_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"DEFAULT", "RESTART_IN_PLACE", "MIGRATE_WITHIN_NODE_GROUP", ""}, false),
+				Default:      "DEFAULT",
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Optional: true,
 				ForceNew: true,
 			},
+			"share_settings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"share_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"ORGANIZATION", "SPECIFIC_PROJECTS", "LOCAL"}, false),
+						},
+						"project_map": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"project_id": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+							// Default schema.HashSchema is used.
+						},
+					},
+				},
+			},
 			"zone": {
 				Type:             schema.TypeString,
 				Computed:         true,
@@ -116,6 +195,24 @@ func resourceComputeNodeGroupCreate(d *schema.ResourceData, meta interface{}) er
 	} else if v, ok := d.GetOkExists("size"); ok || !reflect.DeepEqual(v, sizeProp) {
 		obj["size"] = sizeProp
 	}
+	maintenancePolicyProp, err := expandComputeNodeGroupMaintenancePolicy(d.Get("maintenance_policy"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("maintenance_policy"); !isEmptyValue(reflect.ValueOf(maintenancePolicyProp)) && (ok || !reflect.DeepEqual(v, maintenancePolicyProp)) {
+		obj["maintenancePolicy"] = maintenancePolicyProp
+	}
+	autoscalingPolicyProp, err := expandComputeNodeGroupAutoscalingPolicy(d.Get("autoscaling_policy"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("autoscaling_policy"); !isEmptyValue(reflect.ValueOf(autoscalingPolicyProp)) && (ok || !reflect.DeepEqual(v, autoscalingPolicyProp)) {
+		obj["autoscalingPolicy"] = autoscalingPolicyProp
+	}
+	shareSettingsProp, err := expandComputeNodeGroupShareSettings(d.Get("share_settings"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("share_settings"); !isEmptyValue(reflect.ValueOf(shareSettingsProp)) && (ok || !reflect.DeepEqual(v, shareSettingsProp)) {
+		obj["shareSettings"] = shareSettingsProp
+	}
 	zoneProp, err := expandComputeNodeGroupZone(d.Get("zone"), d, config)
 	if err != nil {
 		return err
@@ -129,7 +226,12 @@ func resourceComputeNodeGroupCreate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	log.Printf("[DEBUG] Creating new NodeGroup: %#v", obj)
-	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("Error creating NodeGroup: %s", err)
 	}
@@ -141,10 +243,6 @@ func resourceComputeNodeGroupCreate(d *schema.ResourceData, meta interface{}) er
 	}
 	d.SetId(id)
 
-	project, err := getProject(d, config)
-	if err != nil {
-		return err
-	}
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -152,7 +250,7 @@ func resourceComputeNodeGroupCreate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating NodeGroup",
+		config, op, project, "Creating NodeGroup",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
@@ -174,15 +272,16 @@ func resourceComputeNodeGroupRead(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	res, err := sendRequest(config, "GET", url, nil)
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("ComputeNodeGroup %q", d.Id()))
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequest(config, "GET", project, url, nil)
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeNodeGroup %q", d.Id()))
 	}
+
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("Error reading NodeGroup: %s", err)
 	}
@@ -202,6 +301,15 @@ func resourceComputeNodeGroupRead(d *schema.ResourceData, meta interface{}) erro
 	if err := d.Set("size", flattenComputeNodeGroupSize(res["size"], d)); err != nil {
 		return fmt.Errorf("Error reading NodeGroup: %s", err)
 	}
+	if err := d.Set("maintenance_policy", flattenComputeNodeGroupMaintenancePolicy(res["maintenancePolicy"], d)); err != nil {
+		return fmt.Errorf("Error reading NodeGroup: %s", err)
+	}
+	if err := d.Set("autoscaling_policy", flattenComputeNodeGroupAutoscalingPolicy(res["autoscalingPolicy"], d)); err != nil {
+		return fmt.Errorf("Error reading NodeGroup: %s", err)
+	}
+	if err := d.Set("share_settings", flattenComputeNodeGroupShareSettings(res["shareSettings"], d)); err != nil {
+		return fmt.Errorf("Error reading NodeGroup: %s", err)
+	}
 	if err := d.Set("zone", flattenComputeNodeGroupZone(res["zone"], d)); err != nil {
 		return fmt.Errorf("Error reading NodeGroup: %s", err)
 	}
@@ -230,15 +338,56 @@ func resourceComputeNodeGroupUpdate(d *schema.ResourceData, meta interface{}) er
 		if err != nil {
 			return err
 		}
-		res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutUpdate))
+		project, err := getProject(d, config)
+		if err != nil {
+			return err
+		}
+
+		res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf("Error updating NodeGroup %q: %s", d.Id(), err)
 		}
 
+		op := &compute.Operation{}
+		err = Convert(res, op)
+		if err != nil {
+			return err
+		}
+
+		err = computeOperationWaitTime(
+			config, op, project, "Updating NodeGroup",
+			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+		if err != nil {
+			return err
+		}
+
+		d.SetPartial("node_template")
+	}
+
+	if d.HasChange("autoscaling_policy") {
+		obj := make(map[string]interface{})
+		autoscalingPolicyProp, err := expandComputeNodeGroupAutoscalingPolicy(d.Get("autoscaling_policy"), d, config)
+		if err != nil {
+			return err
+		} else if v, ok := d.GetOkExists("autoscaling_policy"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, autoscalingPolicyProp)) {
+			obj["autoscalingPolicy"] = autoscalingPolicyProp
+		}
+
+		url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/zones/{{zone}}/nodeGroups/{{name}}")
+		if err != nil {
+			return err
+		}
 		project, err := getProject(d, config)
 		if err != nil {
 			return err
 		}
+
+		res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("Error updating NodeGroup %q: %s", d.Id(), err)
+		}
+
 		op := &compute.Operation{}
 		err = Convert(res, op)
 		if err != nil {
@@ -246,14 +395,66 @@ func resourceComputeNodeGroupUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 
 		err = computeOperationWaitTime(
-			config.clientCompute, op, project, "Updating NodeGroup",
+			config, op, project, "Updating NodeGroup",
 			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 
 		if err != nil {
 			return err
 		}
 
-		d.SetPartial("node_template")
+		d.SetPartial("autoscaling_policy")
+	}
+
+	if d.HasChange("size") {
+		oldSize, newSize := d.GetChange("size")
+		delta := newSize.(int) - oldSize.(int)
+
+		obj := make(map[string]interface{})
+		verb := "addNodes"
+		if delta > 0 {
+			obj["additionalNodeCount"] = delta
+		} else {
+			nodes, err := listComputeNodeGroupNodes(d, config)
+			if err != nil {
+				return err
+			}
+			if len(nodes) < -delta {
+				return fmt.Errorf("Error resizing NodeGroup %q: cannot remove %d nodes, only %d exist", d.Id(), -delta, len(nodes))
+			}
+			// Remove the most recently added nodes first
+			obj["nodes"] = nodes[len(nodes)+delta:]
+			verb = "deleteNodes"
+		}
+
+		url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/zones/{{zone}}/nodeGroups/{{name}}/"+verb)
+		if err != nil {
+			return err
+		}
+		project, err := getProject(d, config)
+		if err != nil {
+			return err
+		}
+
+		res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("Error resizing NodeGroup %q: %s", d.Id(), err)
+		}
+
+		op := &compute.Operation{}
+		err = Convert(res, op)
+		if err != nil {
+			return err
+		}
+
+		err = computeOperationWaitTime(
+			config, op, project, "Resizing NodeGroup",
+			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+		if err != nil {
+			return err
+		}
+
+		d.SetPartial("size")
 	}
 
 	d.Partial(false)
@@ -271,15 +472,16 @@ func resourceComputeNodeGroupDelete(d *schema.ResourceData, meta interface{}) er
 
 	var obj map[string]interface{}
 	log.Printf("[DEBUG] Deleting NodeGroup %q", d.Id())
-	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, "NodeGroup")
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, "NodeGroup")
 	}
+
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -287,7 +489,7 @@ func resourceComputeNodeGroupDelete(d *schema.ResourceData, meta interface{}) er
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting NodeGroup",
+		config, op, project, "Deleting NodeGroup",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 
 	if err != nil {
@@ -318,6 +520,38 @@ func resourceComputeNodeGroupImport(d *schema.ResourceData, meta interface{}) ([
 	return []*schema.ResourceData{d}, nil
 }
 
+// listComputeNodeGroupNodes returns the names of the nodes in the node group,
+// in the order they are returned by the API.
+func listComputeNodeGroupNodes(d *schema.ResourceData, config *Config) ([]string, error) {
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/zones/{{zone}}/nodeGroups/{{name}}/listNodes")
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := []string{}
+	for {
+		res, err := sendRequest(config, "POST", "", url, nil)
+		if err != nil {
+			return nil, fmt.Errorf("Error listing nodes of NodeGroup %q: %s", d.Id(), err)
+		}
+		if items, ok := res["items"].([]interface{}); ok {
+			for _, item := range items {
+				nodes = append(nodes, item.(map[string]interface{})["name"].(string))
+			}
+		}
+		token, ok := res["nextPageToken"].(string)
+		if !ok || token == "" {
+			break
+		}
+		url, err = addQueryParams(url, map[string]string{"pageToken": token})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return nodes, nil
+}
+
 func flattenComputeNodeGroupCreationTimestamp(v interface{}, d *schema.ResourceData) interface{} {
 	return v
 }
@@ -347,6 +581,90 @@ func flattenComputeNodeGroupSize(v interface{}, d *schema.ResourceData) interfac
 	return v
 }
 
+func flattenComputeNodeGroupMaintenancePolicy(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeNodeGroupAutoscalingPolicy(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["mode"] =
+		flattenComputeNodeGroupAutoscalingPolicyMode(original["mode"], d)
+	transformed["min_nodes"] =
+		flattenComputeNodeGroupAutoscalingPolicyMinNodes(original["minNodes"], d)
+	transformed["max_nodes"] =
+		flattenComputeNodeGroupAutoscalingPolicyMaxNodes(original["maxNodes"], d)
+	return []interface{}{transformed}
+}
+func flattenComputeNodeGroupAutoscalingPolicyMode(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeNodeGroupAutoscalingPolicyMinNodes(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenComputeNodeGroupAutoscalingPolicyMaxNodes(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenComputeNodeGroupShareSettings(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["share_type"] =
+		flattenComputeNodeGroupShareSettingsShareType(original["shareType"], d)
+	transformed["project_map"] =
+		flattenComputeNodeGroupShareSettingsProjectMap(original["projectMap"], d)
+	return []interface{}{transformed}
+}
+func flattenComputeNodeGroupShareSettingsShareType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeNodeGroupShareSettingsProjectMap(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.(map[string]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for k, raw := range l {
+		original := raw.(map[string]interface{})
+		transformed = append(transformed, map[string]interface{}{
+			"id":         k,
+			"project_id": flattenComputeNodeGroupShareSettingsProjectMapProjectId(original["projectId"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenComputeNodeGroupShareSettingsProjectMapProjectId(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
 func flattenComputeNodeGroupZone(v interface{}, d *schema.ResourceData) interface{} {
 	if v == nil {
 		return v
@@ -374,6 +692,110 @@ func expandComputeNodeGroupSize(v interface{}, d TerraformResourceData, config *
 	return v, nil
 }
 
+func expandComputeNodeGroupMaintenancePolicy(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeNodeGroupAutoscalingPolicy(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedMode, err := expandComputeNodeGroupAutoscalingPolicyMode(original["mode"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMode); val.IsValid() && !isEmptyValue(val) {
+		transformed["mode"] = transformedMode
+	}
+
+	transformedMinNodes, err := expandComputeNodeGroupAutoscalingPolicyMinNodes(original["min_nodes"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMinNodes); val.IsValid() && !isEmptyValue(val) {
+		transformed["minNodes"] = transformedMinNodes
+	}
+
+	transformedMaxNodes, err := expandComputeNodeGroupAutoscalingPolicyMaxNodes(original["max_nodes"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMaxNodes); val.IsValid() && !isEmptyValue(val) {
+		transformed["maxNodes"] = transformedMaxNodes
+	}
+
+	return transformed, nil
+}
+
+func expandComputeNodeGroupAutoscalingPolicyMode(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeNodeGroupAutoscalingPolicyMinNodes(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeNodeGroupAutoscalingPolicyMaxNodes(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeNodeGroupShareSettings(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedShareType, err := expandComputeNodeGroupShareSettingsShareType(original["share_type"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedShareType); val.IsValid() && !isEmptyValue(val) {
+		transformed["shareType"] = transformedShareType
+	}
+
+	transformedProjectMap, err := expandComputeNodeGroupShareSettingsProjectMap(original["project_map"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedProjectMap); val.IsValid() && !isEmptyValue(val) {
+		transformed["projectMap"] = transformedProjectMap
+	}
+
+	return transformed, nil
+}
+
+func expandComputeNodeGroupShareSettingsShareType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeNodeGroupShareSettingsProjectMap(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	if v == nil {
+		return map[string]interface{}{}, nil
+	}
+	m := make(map[string]interface{})
+	for _, raw := range v.(*schema.Set).List() {
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedProjectId, err := expandComputeNodeGroupShareSettingsProjectMapProjectId(original["project_id"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedProjectId); val.IsValid() && !isEmptyValue(val) {
+			transformed["projectId"] = transformedProjectId
+		}
+
+		m[original["id"].(string)] = transformed
+	}
+	return m, nil
+}
+
+func expandComputeNodeGroupShareSettingsProjectMapProjectId(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
 func expandComputeNodeGroupZone(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
 	f, err := parseGlobalFieldValue("zones", v.(string), "project", d, config, true)
 	if err != nil {
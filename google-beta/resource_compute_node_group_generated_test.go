@@ -87,7 +87,7 @@ func testAccCheckComputeNodeGroupDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("ComputeNodeGroup still exists at %s", url)
 		}
@@ -9,9 +9,63 @@ import (
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func TestSuppressNodeGroupSizeWhileAutoscaling(t *testing.T) {
+	cases := map[string]struct {
+		Id       string
+		Mode     string
+		Suppress bool
+	}{
+		"autoscaling on": {
+			Id:       "group",
+			Mode:     "ON",
+			Suppress: true,
+		},
+		"only scale out": {
+			Id:       "group",
+			Mode:     "ONLY_SCALE_OUT",
+			Suppress: false,
+		},
+		"autoscaling off": {
+			Id:       "group",
+			Mode:     "OFF",
+			Suppress: false,
+		},
+		"no autoscaling policy": {
+			Id:       "group",
+			Suppress: false,
+		},
+		"creating with autoscaling on": {
+			Mode:     "ON",
+			Suppress: false,
+		},
+	}
+
+	for tn, tc := range cases {
+		raw := map[string]interface{}{
+			"node_template": "tmpl",
+			"size":          3,
+		}
+		if tc.Mode != "" {
+			raw["autoscaling_policy"] = []interface{}{
+				map[string]interface{}{
+					"mode":      tc.Mode,
+					"max_nodes": 10,
+				},
+			}
+		}
+		d := schema.TestResourceDataRaw(t, resourceComputeNodeGroup().Schema, raw)
+		d.SetId(tc.Id)
+
+		if got := suppressNodeGroupSizeWhileAutoscaling("size", "1", "3", d); got != tc.Suppress {
+			t.Errorf("%s: expected suppress to be %t, got %t", tn, tc.Suppress, got)
+		}
+	}
+}
+
 func TestAccComputeNodeGroup_updateNodeTemplate(t *testing.T) {
 	t.Parallel()
 
@@ -50,6 +104,53 @@ func TestAccComputeNodeGroup_updateNodeTemplate(t *testing.T) {
 	})
 }
 
+func TestAccComputeNodeGroup_resizeWithAutoscaling(t *testing.T) {
+	t.Parallel()
+
+	groupName := acctest.RandomWithPrefix("group-")
+	tmplName := acctest.RandomWithPrefix("tmpl-")
+
+	var timeCreated time.Time
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeNodeGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeNodeGroup_resizeWithAutoscaling(groupName, tmplName, 1, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeNodeGroupCreationTimeBefore(&timeCreated),
+				),
+			},
+			{
+				ResourceName:      "google_compute_node_group.nodes",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeNodeGroup_resizeWithAutoscaling(groupName, tmplName, 2, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeNodeGroupCreationTimeBefore(&timeCreated),
+					resource.TestCheckResourceAttr("google_compute_node_group.nodes", "size", "2"),
+					resource.TestCheckResourceAttr("google_compute_node_group.nodes", "autoscaling_policy.0.max_nodes", "3"),
+				),
+			},
+			{
+				Config: testAccComputeNodeGroup_resizeWithAutoscaling(groupName, tmplName, 1, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeNodeGroupCreationTimeBefore(&timeCreated),
+					resource.TestCheckResourceAttr("google_compute_node_group.nodes", "size", "1"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_node_group.nodes",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckComputeNodeGroupCreationTimeBefore(prevTimeCreated *time.Time) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for name, rs := range s.RootModule().Resources {
@@ -113,3 +214,35 @@ resource "google_compute_node_group" "nodes" {
 }
 `, tmplPrefix, tmplPrefix, groupName, tmplToUse)
 }
+
+func testAccComputeNodeGroup_resizeWithAutoscaling(groupName, tmplName string, size, maxNodes int) string {
+	return fmt.Sprintf(`
+data "google_compute_node_types" "central1a" {
+  zone = "us-central1-a"
+}
+
+resource "google_compute_node_template" "soletenant-tmpl" {
+  name = "%s"
+  region = "us-central1"
+  node_type = "${data.google_compute_node_types.central1a.names[0]}"
+
+  cpu_overcommit_type = "NONE"
+}
+
+resource "google_compute_node_group" "nodes" {
+  name = "%s"
+  zone = "us-central1-a"
+  description = "example google_compute_node_group for Terraform Google Provider"
+
+  size = %d
+  node_template = "${google_compute_node_template.soletenant-tmpl.self_link}"
+  maintenance_policy = "RESTART_IN_PLACE"
+
+  autoscaling_policy {
+    mode = "OFF"
+    min_nodes = 1
+    max_nodes = %d
+  }
+}
+`, tmplName, groupName, size, maxNodes)
+}
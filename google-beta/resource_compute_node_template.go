@@ -41,6 +41,13 @@ func resourceComputeNodeTemplate() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"cpu_overcommit_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ENABLED", "NONE", ""}, false),
+				Default:      "NONE",
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -170,6 +177,12 @@ func resourceComputeNodeTemplateCreate(d *schema.ResourceData, meta interface{})
 	} else if v, ok := d.GetOkExists("server_binding"); !isEmptyValue(reflect.ValueOf(serverBindingProp)) && (ok || !reflect.DeepEqual(v, serverBindingProp)) {
 		obj["serverBinding"] = serverBindingProp
 	}
+	cpuOvercommitTypeProp, err := expandComputeNodeTemplateCpuOvercommitType(d.Get("cpu_overcommit_type"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("cpu_overcommit_type"); !isEmptyValue(reflect.ValueOf(cpuOvercommitTypeProp)) && (ok || !reflect.DeepEqual(v, cpuOvercommitTypeProp)) {
+		obj["cpuOvercommitType"] = cpuOvercommitTypeProp
+	}
 	regionProp, err := expandComputeNodeTemplateRegion(d.Get("region"), d, config)
 	if err != nil {
 		return err
@@ -183,7 +196,12 @@ func resourceComputeNodeTemplateCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	log.Printf("[DEBUG] Creating new NodeTemplate: %#v", obj)
-	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("Error creating NodeTemplate: %s", err)
 	}
@@ -195,10 +213,6 @@ func resourceComputeNodeTemplateCreate(d *schema.ResourceData, meta interface{})
 	}
 	d.SetId(id)
 
-	project, err := getProject(d, config)
-	if err != nil {
-		return err
-	}
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -206,7 +220,7 @@ func resourceComputeNodeTemplateCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating NodeTemplate",
+		config, op, project, "Creating NodeTemplate",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
@@ -228,15 +242,16 @@ func resourceComputeNodeTemplateRead(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	res, err := sendRequest(config, "GET", url, nil)
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("ComputeNodeTemplate %q", d.Id()))
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequest(config, "GET", project, url, nil)
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeNodeTemplate %q", d.Id()))
 	}
+
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("Error reading NodeTemplate: %s", err)
 	}
@@ -262,6 +277,9 @@ func resourceComputeNodeTemplateRead(d *schema.ResourceData, meta interface{}) e
 	if err := d.Set("server_binding", flattenComputeNodeTemplateServerBinding(res["serverBinding"], d)); err != nil {
 		return fmt.Errorf("Error reading NodeTemplate: %s", err)
 	}
+	if err := d.Set("cpu_overcommit_type", flattenComputeNodeTemplateCpuOvercommitType(res["cpuOvercommitType"], d)); err != nil {
+		return fmt.Errorf("Error reading NodeTemplate: %s", err)
+	}
 	if err := d.Set("region", flattenComputeNodeTemplateRegion(res["region"], d)); err != nil {
 		return fmt.Errorf("Error reading NodeTemplate: %s", err)
 	}
@@ -282,15 +300,16 @@ func resourceComputeNodeTemplateDelete(d *schema.ResourceData, meta interface{})
 
 	var obj map[string]interface{}
 	log.Printf("[DEBUG] Deleting NodeTemplate %q", d.Id())
-	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, "NodeTemplate")
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, "NodeTemplate")
 	}
+
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -298,7 +317,7 @@ func resourceComputeNodeTemplateDelete(d *schema.ResourceData, meta interface{})
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting NodeTemplate",
+		config, op, project, "Deleting NodeTemplate",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 
 	if err != nil {
@@ -395,6 +414,10 @@ func flattenComputeNodeTemplateServerBindingType(v interface{}, d *schema.Resour
 	return v
 }
 
+func flattenComputeNodeTemplateCpuOvercommitType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
 func flattenComputeNodeTemplateRegion(v interface{}, d *schema.ResourceData) interface{} {
 	if v == nil {
 		return v
@@ -493,6 +516,10 @@ func expandComputeNodeTemplateServerBindingType(v interface{}, d TerraformResour
 	return v, nil
 }
 
+func expandComputeNodeTemplateCpuOvercommitType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
 func expandComputeNodeTemplateRegion(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
 	f, err := parseGlobalFieldValue("regions", v.(string), "project", d, config, true)
 	if err != nil {
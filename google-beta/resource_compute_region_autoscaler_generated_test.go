@@ -227,7 +227,7 @@ func testAccCheckComputeRegionAutoscalerDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("ComputeRegionAutoscaler still exists at %s", url)
 		}
@@ -86,7 +86,7 @@ func testAccCheckComputeRegionBackendServiceDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("ComputeRegionBackendService still exists at %s", url)
 		}
@@ -10,6 +10,93 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+func TestRegionBackendServiceConnectionTrackingCustomizeDiff(t *testing.T) {
+	cases := map[string]struct {
+		After       map[string]interface{}
+		ExpectError bool
+	}{
+		"no connection tracking policy": {
+			After: map[string]interface{}{
+				"load_balancing_scheme":        "INTERNAL_MANAGED",
+				"protocol":                     "HTTP",
+				"connection_tracking_policy.#": 0,
+			},
+		},
+		"internal tcp": {
+			After: map[string]interface{}{
+				"load_balancing_scheme":                         "INTERNAL",
+				"protocol":                                      "TCP",
+				"connection_tracking_policy.#":                  1,
+				"connection_tracking_policy.0.idle_timeout_sec": 600,
+			},
+		},
+		"internal managed": {
+			After: map[string]interface{}{
+				"load_balancing_scheme":        "INTERNAL_MANAGED",
+				"connection_tracking_policy.#": 1,
+			},
+			ExpectError: true,
+		},
+		"http protocol": {
+			After: map[string]interface{}{
+				"load_balancing_scheme":        "INTERNAL",
+				"protocol":                     "HTTP",
+				"connection_tracking_policy.#": 1,
+			},
+			ExpectError: true,
+		},
+		"strong affinity on external": {
+			After: map[string]interface{}{
+				"load_balancing_scheme":        "EXTERNAL",
+				"protocol":                     "TCP",
+				"connection_tracking_policy.#": 1,
+				"connection_tracking_policy.0.enable_strong_affinity": true,
+			},
+		},
+		"strong affinity on internal": {
+			After: map[string]interface{}{
+				"load_balancing_scheme":                               "INTERNAL",
+				"connection_tracking_policy.#":                        1,
+				"connection_tracking_policy.0.enable_strong_affinity": true,
+			},
+			ExpectError: true,
+		},
+		"60s idle timeout on external": {
+			After: map[string]interface{}{
+				"load_balancing_scheme":                         "EXTERNAL",
+				"connection_tracking_policy.#":                  1,
+				"connection_tracking_policy.0.idle_timeout_sec": 60,
+			},
+		},
+		"60s idle timeout on internal": {
+			After: map[string]interface{}{
+				"load_balancing_scheme":                         "INTERNAL",
+				"connection_tracking_policy.#":                  1,
+				"connection_tracking_policy.0.idle_timeout_sec": 60,
+			},
+			ExpectError: true,
+		},
+		"idle timeout above 16 hours": {
+			After: map[string]interface{}{
+				"load_balancing_scheme":                         "EXTERNAL",
+				"connection_tracking_policy.#":                  1,
+				"connection_tracking_policy.0.idle_timeout_sec": 57601,
+			},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &ResourceDiffMock{
+			After: tc.After,
+		}
+		err := regionBackendServiceConnectionTrackingCustomizeDiffFunc(d)
+		if tc.ExpectError != (err != nil) {
+			t.Errorf("%s: expected error %t, got %v", tn, tc.ExpectError, err)
+		}
+	}
+}
+
 func TestAccComputeRegionBackendService_basic(t *testing.T) {
 	t.Parallel()
 
@@ -154,6 +241,99 @@ func TestAccComputeRegionBackendService_withConnectionDrainingAndUpdate(t *testi
 	}
 }
 
+func TestAccComputeRegionBackendService_withConnectionTrackingPolicyAndUpdate(t *testing.T) {
+	t.Parallel()
+
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	checkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	var svc compute.BackendService
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeRegionBackendServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeRegionBackendService_withConnectionTrackingPolicy(serviceName, checkName, "NEVER_PERSIST", 600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeRegionBackendServiceExists(
+						"google_compute_region_backend_service.foobar", &svc),
+					resource.TestCheckResourceAttr(
+						"google_compute_region_backend_service.foobar", "connection_tracking_policy.0.tracking_mode", "PER_SESSION"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_region_backend_service.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeRegionBackendService_withConnectionTrackingPolicy(serviceName, checkName, "ALWAYS_PERSIST", 1200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeRegionBackendServiceExists(
+						"google_compute_region_backend_service.foobar", &svc),
+				),
+			},
+			{
+				ResourceName:      "google_compute_region_backend_service.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeRegionBackendService_withSubsettingAndFailoverPolicy(t *testing.T) {
+	t.Parallel()
+
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	checkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	var svc compute.BackendService
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeRegionBackendServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeRegionBackendService_basic(serviceName, checkName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeRegionBackendServiceExists(
+						"google_compute_region_backend_service.foobar", &svc),
+				),
+			},
+			{
+				Config: testAccComputeRegionBackendService_withSubsettingAndFailoverPolicy(serviceName, checkName, 0.4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeRegionBackendServiceExists(
+						"google_compute_region_backend_service.foobar", &svc),
+					resource.TestCheckResourceAttr(
+						"google_compute_region_backend_service.foobar", "subsetting.0.policy", "CONSISTENT_HASH_SUBSETTING"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_region_backend_service.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeRegionBackendService_withSubsettingAndFailoverPolicy(serviceName, checkName, 0.8),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeRegionBackendServiceExists(
+						"google_compute_region_backend_service.foobar", &svc),
+					resource.TestCheckResourceAttr(
+						"google_compute_region_backend_service.foobar", "failover_policy.0.failover_ratio", "0.8"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_region_backend_service.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckComputeRegionBackendServiceExists(n string, svc *compute.BackendService) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -318,3 +498,62 @@ resource "google_compute_health_check" "zero" {
 }
 `, serviceName, drainingTimeout, checkName)
 }
+
+func testAccComputeRegionBackendService_withConnectionTrackingPolicy(serviceName, checkName, persistence string, idleTimeout int64) string {
+	return fmt.Sprintf(`
+resource "google_compute_region_backend_service" "foobar" {
+  name             = "%s"
+  health_checks    = ["${google_compute_health_check.zero.self_link}"]
+  region           = "us-central1"
+  protocol         = "TCP"
+  session_affinity = "CLIENT_IP"
+
+  connection_tracking_policy {
+    tracking_mode                                = "PER_SESSION"
+    connection_persistence_on_unhealthy_backends = "%s"
+    idle_timeout_sec                             = %v
+  }
+}
+
+resource "google_compute_health_check" "zero" {
+  name               = "%s"
+  check_interval_sec = 1
+  timeout_sec        = 1
+
+  tcp_health_check {
+    port = "80"
+  }
+}
+`, serviceName, persistence, idleTimeout, checkName)
+}
+
+func testAccComputeRegionBackendService_withSubsettingAndFailoverPolicy(serviceName, checkName string, failoverRatio float64) string {
+	return fmt.Sprintf(`
+resource "google_compute_region_backend_service" "foobar" {
+  name          = "%s"
+  health_checks = ["${google_compute_health_check.zero.self_link}"]
+  region        = "us-central1"
+  protocol      = "TCP"
+
+  subsetting {
+    policy = "CONSISTENT_HASH_SUBSETTING"
+  }
+
+  failover_policy {
+    disable_connection_drain_on_failover = true
+    drop_traffic_if_unhealthy            = true
+    failover_ratio                       = %v
+  }
+}
+
+resource "google_compute_health_check" "zero" {
+  name               = "%s"
+  check_interval_sec = 1
+  timeout_sec        = 1
+
+  tcp_health_check {
+    port = "80"
+  }
+}
+`, serviceName, failoverRatio, checkName)
+}
@@ -0,0 +1,598 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/customdiff"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"google.golang.org/api/compute/v1"
+)
+
+// The fields of a commitment that can't be updated. A commitment can't be
+// cancelled before it ends, so it can't be replaced either.
+var regionCommitmentImmutableFields = []string{"name", "plan", "type", "category", "description", "resources"}
+
+func regionCommitmentReplacementCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	// separate func to allow unit testing
+	return regionCommitmentReplacementCustomizeDiffFunc(diff)
+}
+
+func regionCommitmentReplacementCustomizeDiffFunc(diff TerraformResourceDiff) error {
+	for _, field := range regionCommitmentImmutableFields {
+		o, n := diff.GetChange(field)
+		if !reflect.DeepEqual(o, n) {
+			return fmt.Errorf("%s can't be changed: commitments can't be cancelled before their end_timestamp, so they can't be replaced. Create a new commitment instead", field)
+		}
+	}
+	return nil
+}
+
+func resourceComputeRegionCommitment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeRegionCommitmentCreate,
+		Read:   resourceComputeRegionCommitmentRead,
+		Update: resourceComputeRegionCommitmentUpdate,
+		Delete: resourceComputeRegionCommitmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeRegionCommitmentImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Update: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.All(
+			regionCommitmentReplacementCustomizeDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"plan": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"TWELVE_MONTH", "THIRTY_SIX_MONTH"}, false),
+			},
+			"auto_renew": {
+				Type:     schema.TypeBool,
+				Computed: true,
+				Optional: true,
+			},
+			"category": {
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"LICENSE", "MACHINE", ""}, false),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"accelerator_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"amount": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"VCPU", "MEMORY", "LOCAL_SSD", "ACCELERATOR", ""}, false),
+						},
+					},
+				},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{"ACCELERATOR_OPTIMIZED", "COMPUTE_OPTIMIZED", "COMPUTE_OPTIMIZED_C2D", "GENERAL_PURPOSE", "GENERAL_PURPOSE_E2", "GENERAL_PURPOSE_N2",
+					"GENERAL_PURPOSE_N2D", "GENERAL_PURPOSE_T2D", "MEMORY_OPTIMIZED", "MEMORY_OPTIMIZED_M3", ""}, false),
+			},
+			"region": {
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"commitment_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"creation_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"self_link": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeRegionCommitmentCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	nameProp, err := expandComputeRegionCommitmentName(d.Get("name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("name"); !isEmptyValue(reflect.ValueOf(nameProp)) && (ok || !reflect.DeepEqual(v, nameProp)) {
+		obj["name"] = nameProp
+	}
+	descriptionProp, err := expandComputeRegionCommitmentDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	planProp, err := expandComputeRegionCommitmentPlan(d.Get("plan"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("plan"); !isEmptyValue(reflect.ValueOf(planProp)) && (ok || !reflect.DeepEqual(v, planProp)) {
+		obj["plan"] = planProp
+	}
+	resourcesProp, err := expandComputeRegionCommitmentResources(d.Get("resources"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("resources"); !isEmptyValue(reflect.ValueOf(resourcesProp)) && (ok || !reflect.DeepEqual(v, resourcesProp)) {
+		obj["resources"] = resourcesProp
+	}
+	typeProp, err := expandComputeRegionCommitmentType(d.Get("type"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("type"); !isEmptyValue(reflect.ValueOf(typeProp)) && (ok || !reflect.DeepEqual(v, typeProp)) {
+		obj["type"] = typeProp
+	}
+	categoryProp, err := expandComputeRegionCommitmentCategory(d.Get("category"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("category"); !isEmptyValue(reflect.ValueOf(categoryProp)) && (ok || !reflect.DeepEqual(v, categoryProp)) {
+		obj["category"] = categoryProp
+	}
+	autoRenewProp, err := expandComputeRegionCommitmentAutoRenew(d.Get("auto_renew"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("auto_renew"); !isEmptyValue(reflect.ValueOf(autoRenewProp)) && (ok || !reflect.DeepEqual(v, autoRenewProp)) {
+		obj["autoRenew"] = autoRenewProp
+	}
+	regionProp, err := expandComputeRegionCommitmentRegion(d.Get("region"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("region"); !isEmptyValue(reflect.ValueOf(regionProp)) && (ok || !reflect.DeepEqual(v, regionProp)) {
+		obj["region"] = regionProp
+	}
+
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/regions/{{region}}/commitments")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new RegionCommitment: %#v", obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating RegionCommitment: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/regions/{{region}}/commitments/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	waitErr := computeOperationWaitTime(
+		config, op, project, "Creating RegionCommitment",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create RegionCommitment: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating RegionCommitment %q: %#v", d.Id(), res)
+
+	return resourceComputeRegionCommitmentRead(d, meta)
+}
+
+func resourceComputeRegionCommitmentRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/regions/{{region}}/commitments/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeRegionCommitment %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading RegionCommitment: %s", err)
+	}
+
+	if err := d.Set("commitment_id", flattenComputeRegionCommitmentCommitmentId(res["id"], d)); err != nil {
+		return fmt.Errorf("Error reading RegionCommitment: %s", err)
+	}
+	if err := d.Set("creation_timestamp", flattenComputeRegionCommitmentCreationTimestamp(res["creationTimestamp"], d)); err != nil {
+		return fmt.Errorf("Error reading RegionCommitment: %s", err)
+	}
+	if err := d.Set("name", flattenComputeRegionCommitmentName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading RegionCommitment: %s", err)
+	}
+	if err := d.Set("description", flattenComputeRegionCommitmentDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading RegionCommitment: %s", err)
+	}
+	if err := d.Set("status", flattenComputeRegionCommitmentStatus(res["status"], d)); err != nil {
+		return fmt.Errorf("Error reading RegionCommitment: %s", err)
+	}
+	if err := d.Set("status_message", flattenComputeRegionCommitmentStatusMessage(res["statusMessage"], d)); err != nil {
+		return fmt.Errorf("Error reading RegionCommitment: %s", err)
+	}
+	if err := d.Set("plan", flattenComputeRegionCommitmentPlan(res["plan"], d)); err != nil {
+		return fmt.Errorf("Error reading RegionCommitment: %s", err)
+	}
+	if err := d.Set("start_timestamp", flattenComputeRegionCommitmentStartTimestamp(res["startTimestamp"], d)); err != nil {
+		return fmt.Errorf("Error reading RegionCommitment: %s", err)
+	}
+	if err := d.Set("end_timestamp", flattenComputeRegionCommitmentEndTimestamp(res["endTimestamp"], d)); err != nil {
+		return fmt.Errorf("Error reading RegionCommitment: %s", err)
+	}
+	if err := d.Set("resources", flattenComputeRegionCommitmentResources(res["resources"], d)); err != nil {
+		return fmt.Errorf("Error reading RegionCommitment: %s", err)
+	}
+	if err := d.Set("type", flattenComputeRegionCommitmentType(res["type"], d)); err != nil {
+		return fmt.Errorf("Error reading RegionCommitment: %s", err)
+	}
+	if err := d.Set("category", flattenComputeRegionCommitmentCategory(res["category"], d)); err != nil {
+		return fmt.Errorf("Error reading RegionCommitment: %s", err)
+	}
+	if err := d.Set("auto_renew", flattenComputeRegionCommitmentAutoRenew(res["autoRenew"], d)); err != nil {
+		return fmt.Errorf("Error reading RegionCommitment: %s", err)
+	}
+	if err := d.Set("region", flattenComputeRegionCommitmentRegion(res["region"], d)); err != nil {
+		return fmt.Errorf("Error reading RegionCommitment: %s", err)
+	}
+	if err := d.Set("self_link", ConvertSelfLinkToV1(res["selfLink"].(string))); err != nil {
+		return fmt.Errorf("Error reading RegionCommitment: %s", err)
+	}
+
+	return nil
+}
+
+func resourceComputeRegionCommitmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	autoRenewProp, err := expandComputeRegionCommitmentAutoRenew(d.Get("auto_renew"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("auto_renew"); ok || !reflect.DeepEqual(v, autoRenewProp) {
+		obj["autoRenew"] = autoRenewProp
+	}
+
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/regions/{{region}}/commitments/{{name}}?paths=autoRenew")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating RegionCommitment %q: %#v", d.Id(), obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating RegionCommitment %q: %s", d.Id(), err)
+	}
+
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	err = computeOperationWaitTime(
+		config, op, project, "Updating RegionCommitment",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceComputeRegionCommitmentRead(d, meta)
+}
+
+func resourceComputeRegionCommitmentDelete(d *schema.ResourceData, meta interface{}) error {
+	endTimestamp := d.Get("end_timestamp").(string)
+	if end, err := time.Parse(time.RFC3339, endTimestamp); err != nil || time.Now().Before(end) {
+		return fmt.Errorf("RegionCommitment %q cannot be deleted before its end_timestamp (%s). "+
+			"Remove it from the Terraform state with `terraform state rm` to stop managing it", d.Id(), endTimestamp)
+	}
+
+	log.Printf("[DEBUG] RegionCommitment %q ended at %s, removing it from state", d.Id(), endTimestamp)
+	d.SetId("")
+
+	return nil
+}
+
+func resourceComputeRegionCommitmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/commitments/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)",
+		"(?P<region>[^/]+)/(?P<name>[^/]+)",
+		"(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/regions/{{region}}/commitments/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenComputeRegionCommitmentCommitmentId(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenComputeRegionCommitmentCreationTimestamp(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeRegionCommitmentName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeRegionCommitmentDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeRegionCommitmentStatus(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeRegionCommitmentStatusMessage(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeRegionCommitmentPlan(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeRegionCommitmentStartTimestamp(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeRegionCommitmentEndTimestamp(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeRegionCommitmentResources(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"type":             flattenComputeRegionCommitmentResourcesType(original["type"], d),
+			"amount":           flattenComputeRegionCommitmentResourcesAmount(original["amount"], d),
+			"accelerator_type": flattenComputeRegionCommitmentResourcesAcceleratorType(original["acceleratorType"], d),
+		})
+	}
+	return transformed
+}
+func flattenComputeRegionCommitmentResourcesType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeRegionCommitmentResourcesAmount(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeRegionCommitmentResourcesAcceleratorType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeRegionCommitmentType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeRegionCommitmentCategory(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeRegionCommitmentAutoRenew(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeRegionCommitmentRegion(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	return NameFromSelfLinkStateFunc(v)
+}
+
+func expandComputeRegionCommitmentName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeRegionCommitmentDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeRegionCommitmentPlan(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeRegionCommitmentResources(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedType, err := expandComputeRegionCommitmentResourcesType(original["type"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedType); val.IsValid() && !isEmptyValue(val) {
+			transformed["type"] = transformedType
+		}
+
+		transformedAmount, err := expandComputeRegionCommitmentResourcesAmount(original["amount"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedAmount); val.IsValid() && !isEmptyValue(val) {
+			transformed["amount"] = transformedAmount
+		}
+
+		transformedAcceleratorType, err := expandComputeRegionCommitmentResourcesAcceleratorType(original["accelerator_type"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedAcceleratorType); val.IsValid() && !isEmptyValue(val) {
+			transformed["acceleratorType"] = transformedAcceleratorType
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandComputeRegionCommitmentResourcesType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeRegionCommitmentResourcesAmount(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeRegionCommitmentResourcesAcceleratorType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeRegionCommitmentType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeRegionCommitmentCategory(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeRegionCommitmentAutoRenew(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeRegionCommitmentRegion(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	f, err := parseGlobalFieldValue("regions", v.(string), "project", d, config, true)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid value for region: %s", err)
+	}
+	return f.RelativeLink(), nil
+}
@@ -0,0 +1,185 @@
+package google
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestRegionCommitmentReplacementCustomizeDiff(t *testing.T) {
+	t.Parallel()
+
+	resources := []interface{}{
+		map[string]interface{}{"type": "VCPU", "amount": "4"},
+	}
+	cases := map[string]struct {
+		Before, After map[string]interface{}
+		ExpectError   bool
+	}{
+		"unchanged": {
+			Before: map[string]interface{}{"plan": "TWELVE_MONTH", "resources": resources, "auto_renew": false},
+			After:  map[string]interface{}{"plan": "TWELVE_MONTH", "resources": resources, "auto_renew": true},
+		},
+		"plan changed": {
+			Before:      map[string]interface{}{"plan": "TWELVE_MONTH", "resources": resources},
+			After:       map[string]interface{}{"plan": "THIRTY_SIX_MONTH", "resources": resources},
+			ExpectError: true,
+		},
+		"resources changed": {
+			Before: map[string]interface{}{"plan": "TWELVE_MONTH", "resources": resources},
+			After: map[string]interface{}{"plan": "TWELVE_MONTH", "resources": []interface{}{
+				map[string]interface{}{"type": "VCPU", "amount": "8"},
+			}},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &ResourceDiffMock{
+			Before: tc.Before,
+			After:  tc.After,
+		}
+		err := regionCommitmentReplacementCustomizeDiffFunc(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
+
+func TestRegionCommitmentDelete(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		EndTimestamp string
+		ExpectError  bool
+	}{
+		"active": {
+			EndTimestamp: time.Now().Add(24 * time.Hour).Format("2006-01-02T15:04:05.000-07:00"),
+			ExpectError:  true,
+		},
+		"ended": {
+			EndTimestamp: time.Now().Add(-24 * time.Hour).Format("2006-01-02T15:04:05.000-07:00"),
+		},
+		"unknown end": {
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := schema.TestResourceDataRaw(t, resourceComputeRegionCommitment().Schema, map[string]interface{}{})
+		d.SetId("projects/p/regions/us-central1/commitments/c")
+		d.Set("end_timestamp", tc.EndTimestamp)
+
+		err := resourceComputeRegionCommitmentDelete(d, nil)
+		if tc.ExpectError {
+			if err == nil || !regexp.MustCompile("end_timestamp").MatchString(err.Error()) {
+				t.Errorf("%s: expected an error naming end_timestamp, got %v", tn, err)
+			}
+			if d.Id() == "" {
+				t.Errorf("%s: expected the commitment to stay in state", tn)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+		if d.Id() != "" {
+			t.Errorf("%s: expected the ended commitment to be removed from state", tn)
+		}
+	}
+}
+
+// Commitments can't be deleted before they end, so this test buys one in a
+// throwaway project rather than in the shared test project. Destroying the
+// commitment fails until its end_timestamp, so the final destroy reports
+// dangling resources and the project has to be deleted by hand. It only runs
+// when explicitly requested.
+func TestAccComputeRegionCommitment_computeOptimized(t *testing.T) {
+	t.Parallel()
+	skipIfEnvNotSet(t, "GOOGLE_TEST_PURCHASE_COMMITMENTS")
+
+	context := map[string]interface{}{
+		"org_id":          getTestOrgFromEnv(t),
+		"billing_account": getTestBillingAccountFromEnv(t),
+		"random_suffix":   acctest.RandString(10),
+		"auto_renew":      false,
+	}
+	updated := map[string]interface{}{
+		"org_id":          context["org_id"],
+		"billing_account": context["billing_account"],
+		"random_suffix":   context["random_suffix"],
+		"auto_renew":      true,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeRegionCommitment_computeOptimized(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_region_commitment.foobar", "type", "COMPUTE_OPTIMIZED"),
+					resource.TestCheckResourceAttrSet("google_compute_region_commitment.foobar", "end_timestamp"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_region_commitment.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeRegionCommitment_computeOptimized(updated),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_region_commitment.foobar", "auto_renew", "true"),
+				),
+			},
+			{
+				Config:      testAccComputeRegionCommitment_computeOptimized(updated),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("cannot be deleted before its end_timestamp"),
+			},
+		},
+	})
+}
+
+func testAccComputeRegionCommitment_computeOptimized(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_project" "project" {
+  name            = "tf-test-cud"
+  project_id      = "tf-test-%{random_suffix}"
+  org_id          = "%{org_id}"
+  billing_account = "%{billing_account}"
+}
+
+resource "google_project_service" "compute" {
+  project = google_project.project.project_id
+  service = "compute.googleapis.com"
+}
+
+resource "google_compute_region_commitment" "foobar" {
+  project = google_project_service.compute.project
+  name    = "tf-test-commitment-%{random_suffix}"
+  region  = "us-central1"
+  plan    = "TWELVE_MONTH"
+  type    = "COMPUTE_OPTIMIZED"
+
+  resources {
+    type   = "VCPU"
+    amount = "4"
+  }
+  resources {
+    type   = "MEMORY"
+    amount = "16384"
+  }
+
+  auto_renew = %{auto_renew}
+}
+`, context)
+}
@@ -28,6 +28,59 @@ import (
 	"google.golang.org/api/googleapi"
 )
 
+func regionDiskReplicaZonesCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	// separate func to allow unit testing
+	_, r := diff.GetChange("region")
+	region, _ := r.(string)
+	if region == "" {
+		region = meta.(*Config).Region
+	}
+	return regionDiskReplicaZonesCustomizeDiffFunc(diff, region)
+}
+
+// The replicas of a regional disk are in two distinct zones of its region.
+func regionDiskReplicaZonesCustomizeDiffFunc(diff TerraformResourceDiff, region string) error {
+	_, n := diff.GetChange("replica_zones")
+	zones, _ := n.([]interface{})
+	seen := make(map[string]bool)
+	for _, z := range zones {
+		// Zones that aren't known yet are checked by the API
+		zone, _ := z.(string)
+		if zone == "" {
+			continue
+		}
+		zone = GetResourceNameFromSelfLink(zone)
+		if seen[zone] {
+			return fmt.Errorf("replica_zones must be two distinct zones, got %q twice", zone)
+		}
+		seen[zone] = true
+		if region != "" && getRegionFromZone(zone) != region {
+			return fmt.Errorf("replica zone %q isn't in the region of the disk, %q", zone, region)
+		}
+	}
+	return nil
+}
+
+// validateRegionDiskKmsKey checks that a customer-managed encryption key can
+// encrypt a disk of a region, and that it can be read, so that misconfigured
+// keys fail before the disk is created.
+func validateRegionDiskKmsKey(config *Config, key, region string) error {
+	if path, err := getRelativePath(key); err == nil {
+		key = path
+	}
+	keyId, err := parseKmsCryptoKeyId(key, config)
+	if err != nil {
+		return err
+	}
+	if location := keyId.KeyRingId.Location; location != "global" && location != region {
+		return fmt.Errorf("the key %q is in %q, it must be in the region of the disk, %q, or global", keyId.cryptoKeyId(), location, region)
+	}
+	if _, err := config.clientKms.Projects.Locations.KeyRings.CryptoKeys.Get(keyId.cryptoKeyId()).Do(); err != nil {
+		return fmt.Errorf("Error reading the key %q, check that it exists and that you can access it: %s", keyId.cryptoKeyId(), err)
+	}
+	return nil
+}
+
 func resourceComputeRegionDisk() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceComputeRegionDiskCreate,
@@ -46,7 +99,11 @@ func resourceComputeRegionDisk() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			customdiff.ForceNewIfChange("size", isDiskShrinkage)),
+			customdiff.ForceNewIfChange("size", isDiskShrinkage),
+			regionDiskReplicaZonesCustomizeDiff,
+			diskProvisionedPerformanceCustomizeDiff,
+			setLabelsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -78,9 +135,10 @@ func resourceComputeRegionDisk() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"kms_key_name": {
-							Type:     schema.TypeString,
-							Optional: true,
-							ForceNew: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: compareSelfLinkRelativePaths,
 						},
 						"raw_key": {
 							Type:     schema.TypeString,
@@ -99,12 +157,32 @@ func resourceComputeRegionDisk() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"effective_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"physical_block_size_bytes": {
 				Type:     schema.TypeInt,
 				Computed: true,
 				Optional: true,
 				ForceNew: true,
 			},
+			"provisioned_iops": {
+				Type:     schema.TypeInt,
+				Computed: true,
+				Optional: true,
+			},
+			"provisioned_throughput": {
+				Type:     schema.TypeInt,
+				Computed: true,
+				Optional: true,
+			},
 			"region": {
 				Type:             schema.TypeString,
 				Computed:         true,
@@ -122,6 +200,14 @@ func resourceComputeRegionDisk() *schema.Resource {
 				Optional:         true,
 				ForceNew:         true,
 				DiffSuppressFunc: compareSelfLinkOrResourceName,
+				ConflictsWith:    []string{"source_disk"},
+			},
+			"source_disk": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+				ConflictsWith:    []string{"snapshot"},
 			},
 			"source_snapshot_encryption_key": {
 				Type:     schema.TypeList,
@@ -131,9 +217,10 @@ func resourceComputeRegionDisk() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"kms_key_name": {
-							Type:     schema.TypeString,
-							Optional: true,
-							ForceNew: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: compareSelfLinkRelativePaths,
 						},
 						"raw_key": {
 							Type:     schema.TypeString,
@@ -170,6 +257,10 @@ func resourceComputeRegionDisk() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"source_disk_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"source_snapshot_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -212,10 +303,10 @@ func resourceComputeRegionDiskCreate(d *schema.ResourceData, meta interface{}) e
 	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
 		obj["description"] = descriptionProp
 	}
-	labelsProp, err := expandComputeRegionDiskLabels(d.Get("labels"), d, config)
+	labelsProp, err := expandComputeRegionDiskLabels(d.Get("terraform_labels"), d, config)
 	if err != nil {
 		return err
-	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+	} else if v, ok := d.GetOkExists("terraform_labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
 		obj["labels"] = labelsProp
 	}
 	nameProp, err := expandComputeRegionDiskName(d.Get("name"), d, config)
@@ -236,6 +327,18 @@ func resourceComputeRegionDiskCreate(d *schema.ResourceData, meta interface{}) e
 	} else if v, ok := d.GetOkExists("physical_block_size_bytes"); !isEmptyValue(reflect.ValueOf(physicalBlockSizeBytesProp)) && (ok || !reflect.DeepEqual(v, physicalBlockSizeBytesProp)) {
 		obj["physicalBlockSizeBytes"] = physicalBlockSizeBytesProp
 	}
+	provisionedIopsProp, err := expandComputeRegionDiskProvisionedIops(d.Get("provisioned_iops"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("provisioned_iops"); !isEmptyValue(reflect.ValueOf(provisionedIopsProp)) && (ok || !reflect.DeepEqual(v, provisionedIopsProp)) {
+		obj["provisionedIops"] = provisionedIopsProp
+	}
+	provisionedThroughputProp, err := expandComputeRegionDiskProvisionedThroughput(d.Get("provisioned_throughput"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("provisioned_throughput"); !isEmptyValue(reflect.ValueOf(provisionedThroughputProp)) && (ok || !reflect.DeepEqual(v, provisionedThroughputProp)) {
+		obj["provisionedThroughput"] = provisionedThroughputProp
+	}
 	replicaZonesProp, err := expandComputeRegionDiskReplicaZones(d.Get("replica_zones"), d, config)
 	if err != nil {
 		return err
@@ -266,6 +369,12 @@ func resourceComputeRegionDiskCreate(d *schema.ResourceData, meta interface{}) e
 	} else if v, ok := d.GetOkExists("snapshot"); !isEmptyValue(reflect.ValueOf(sourceSnapshotProp)) && (ok || !reflect.DeepEqual(v, sourceSnapshotProp)) {
 		obj["sourceSnapshot"] = sourceSnapshotProp
 	}
+	sourceDiskProp, err := expandComputeRegionDiskSourceDisk(d.Get("source_disk"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("source_disk"); !isEmptyValue(reflect.ValueOf(sourceDiskProp)) && (ok || !reflect.DeepEqual(v, sourceDiskProp)) {
+		obj["sourceDisk"] = sourceDiskProp
+	}
 	sourceSnapshotEncryptionKeyProp, err := expandComputeRegionDiskSourceSnapshotEncryptionKey(d.Get("source_snapshot_encryption_key"), d, config)
 	if err != nil {
 		return err
@@ -284,7 +393,12 @@ func resourceComputeRegionDiskCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	log.Printf("[DEBUG] Creating new RegionDisk: %#v", obj)
-	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("Error creating RegionDisk: %s", err)
 	}
@@ -296,10 +410,6 @@ func resourceComputeRegionDiskCreate(d *schema.ResourceData, meta interface{}) e
 	}
 	d.SetId(id)
 
-	project, err := getProject(d, config)
-	if err != nil {
-		return err
-	}
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -307,7 +417,7 @@ func resourceComputeRegionDiskCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating RegionDisk",
+		config, op, project, "Creating RegionDisk",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
@@ -329,20 +439,21 @@ func resourceComputeRegionDiskRead(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
-	res, err := sendRequest(config, "GET", url, nil)
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("ComputeRegionDisk %q", d.Id()))
+		return err
 	}
 
-	res, err = resourceComputeRegionDiskDecoder(d, meta, res)
+	res, err := sendRequest(config, "GET", project, url, nil)
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeRegionDisk %q", d.Id()))
 	}
 
-	project, err := getProject(d, config)
+	res, err = resourceComputeRegionDiskDecoder(d, meta, res)
 	if err != nil {
 		return err
 	}
+
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("Error reading RegionDisk: %s", err)
 	}
@@ -362,7 +473,7 @@ func resourceComputeRegionDiskRead(d *schema.ResourceData, meta interface{}) err
 	if err := d.Set("last_detach_timestamp", flattenComputeRegionDiskLastDetachTimestamp(res["lastDetachTimestamp"], d)); err != nil {
 		return fmt.Errorf("Error reading RegionDisk: %s", err)
 	}
-	if err := d.Set("labels", flattenComputeRegionDiskLabels(res["labels"], d)); err != nil {
+	if err := setLabelsFields(d, config, res["labels"]); err != nil {
 		return fmt.Errorf("Error reading RegionDisk: %s", err)
 	}
 	if err := d.Set("name", flattenComputeRegionDiskName(res["name"], d)); err != nil {
@@ -377,6 +488,12 @@ func resourceComputeRegionDiskRead(d *schema.ResourceData, meta interface{}) err
 	if err := d.Set("physical_block_size_bytes", flattenComputeRegionDiskPhysicalBlockSizeBytes(res["physicalBlockSizeBytes"], d)); err != nil {
 		return fmt.Errorf("Error reading RegionDisk: %s", err)
 	}
+	if err := d.Set("provisioned_iops", flattenComputeRegionDiskProvisionedIops(res["provisionedIops"], d)); err != nil {
+		return fmt.Errorf("Error reading RegionDisk: %s", err)
+	}
+	if err := d.Set("provisioned_throughput", flattenComputeRegionDiskProvisionedThroughput(res["provisionedThroughput"], d)); err != nil {
+		return fmt.Errorf("Error reading RegionDisk: %s", err)
+	}
 	if err := d.Set("replica_zones", flattenComputeRegionDiskReplicaZones(res["replicaZones"], d)); err != nil {
 		return fmt.Errorf("Error reading RegionDisk: %s", err)
 	}
@@ -398,6 +515,12 @@ func resourceComputeRegionDiskRead(d *schema.ResourceData, meta interface{}) err
 	if err := d.Set("source_snapshot_id", flattenComputeRegionDiskSourceSnapshotId(res["sourceSnapshotId"], d)); err != nil {
 		return fmt.Errorf("Error reading RegionDisk: %s", err)
 	}
+	if err := d.Set("source_disk", flattenComputeRegionDiskSourceDisk(res["sourceDisk"], d)); err != nil {
+		return fmt.Errorf("Error reading RegionDisk: %s", err)
+	}
+	if err := d.Set("source_disk_id", flattenComputeRegionDiskSourceDiskId(res["sourceDiskId"], d)); err != nil {
+		return fmt.Errorf("Error reading RegionDisk: %s", err)
+	}
 	if err := d.Set("self_link", ConvertSelfLinkToV1(res["selfLink"].(string))); err != nil {
 		return fmt.Errorf("Error reading RegionDisk: %s", err)
 	}
@@ -410,7 +533,7 @@ func resourceComputeRegionDiskUpdate(d *schema.ResourceData, meta interface{}) e
 
 	d.Partial(true)
 
-	if d.HasChange("label_fingerprint") || d.HasChange("labels") {
+	if d.HasChange("label_fingerprint") || d.HasChange("labels") || d.HasChange("terraform_labels") {
 		obj := make(map[string]interface{})
 		labelFingerprintProp, err := expandComputeRegionDiskLabelFingerprint(d.Get("label_fingerprint"), d, config)
 		if err != nil {
@@ -418,10 +541,10 @@ func resourceComputeRegionDiskUpdate(d *schema.ResourceData, meta interface{}) e
 		} else if v, ok := d.GetOkExists("label_fingerprint"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelFingerprintProp)) {
 			obj["labelFingerprint"] = labelFingerprintProp
 		}
-		labelsProp, err := expandComputeRegionDiskLabels(d.Get("labels"), d, config)
+		labelsProp, err := expandComputeRegionDiskLabels(d.Get("terraform_labels"), d, config)
 		if err != nil {
 			return err
-		} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		} else if v, ok := d.GetOkExists("terraform_labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
 			obj["labels"] = labelsProp
 		}
 
@@ -429,15 +552,16 @@ func resourceComputeRegionDiskUpdate(d *schema.ResourceData, meta interface{}) e
 		if err != nil {
 			return err
 		}
-		res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutUpdate))
+		project, err := getProject(d, config)
 		if err != nil {
-			return fmt.Errorf("Error updating RegionDisk %q: %s", d.Id(), err)
+			return err
 		}
 
-		project, err := getProject(d, config)
+		res, err := sendComputeSetLabelsRequest(config, project, url, obj, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return err
+			return fmt.Errorf("Error updating RegionDisk %q: %s", d.Id(), err)
 		}
+
 		op := &compute.Operation{}
 		err = Convert(res, op)
 		if err != nil {
@@ -445,7 +569,7 @@ func resourceComputeRegionDiskUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 
 		err = computeOperationWaitTime(
-			config.clientCompute, op, project, "Updating RegionDisk",
+			config, op, project, "Updating RegionDisk",
 			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 
 		if err != nil {
@@ -468,15 +592,94 @@ func resourceComputeRegionDiskUpdate(d *schema.ResourceData, meta interface{}) e
 		if err != nil {
 			return err
 		}
-		res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutUpdate))
+		project, err := getProject(d, config)
+		if err != nil {
+			return err
+		}
+
+		res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("Error updating RegionDisk %q: %s", d.Id(), err)
+		}
+
+		op := &compute.Operation{}
+		err = Convert(res, op)
+		if err != nil {
+			return err
+		}
+
+		err = computeOperationWaitTime(
+			config, op, project, "Updating RegionDisk",
+			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+		if err != nil {
+			return err
+		}
+
+		d.SetPartial("size")
+	}
+	if d.HasChange("provisioned_iops") {
+		obj := make(map[string]interface{})
+		provisionedIopsProp, err := expandComputeRegionDiskProvisionedIops(d.Get("provisioned_iops"), d, config)
+		if err != nil {
+			return err
+		} else if v, ok := d.GetOkExists("provisioned_iops"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, provisionedIopsProp)) {
+			obj["provisionedIops"] = provisionedIopsProp
+		}
+
+		url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/regions/{{region}}/disks/{{name}}?paths=provisionedIops")
+		if err != nil {
+			return err
+		}
+		project, err := getProject(d, config)
+		if err != nil {
+			return err
+		}
+
+		res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf("Error updating RegionDisk %q: %s", d.Id(), err)
 		}
 
+		op := &compute.Operation{}
+		err = Convert(res, op)
+		if err != nil {
+			return err
+		}
+
+		err = computeOperationWaitTime(
+			config, op, project, "Updating RegionDisk",
+			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+		if err != nil {
+			return err
+		}
+
+		d.SetPartial("provisioned_iops")
+	}
+	if d.HasChange("provisioned_throughput") {
+		obj := make(map[string]interface{})
+		provisionedThroughputProp, err := expandComputeRegionDiskProvisionedThroughput(d.Get("provisioned_throughput"), d, config)
+		if err != nil {
+			return err
+		} else if v, ok := d.GetOkExists("provisioned_throughput"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, provisionedThroughputProp)) {
+			obj["provisionedThroughput"] = provisionedThroughputProp
+		}
+
+		url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/regions/{{region}}/disks/{{name}}?paths=provisionedThroughput")
+		if err != nil {
+			return err
+		}
 		project, err := getProject(d, config)
 		if err != nil {
 			return err
 		}
+
+		res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("Error updating RegionDisk %q: %s", d.Id(), err)
+		}
+
 		op := &compute.Operation{}
 		err = Convert(res, op)
 		if err != nil {
@@ -484,14 +687,14 @@ func resourceComputeRegionDiskUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 
 		err = computeOperationWaitTime(
-			config.clientCompute, op, project, "Updating RegionDisk",
+			config, op, project, "Updating RegionDisk",
 			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 
 		if err != nil {
 			return err
 		}
 
-		d.SetPartial("size")
+		d.SetPartial("provisioned_throughput")
 	}
 
 	d.Partial(false)
@@ -508,7 +711,12 @@ func resourceComputeRegionDiskDelete(d *schema.ResourceData, meta interface{}) e
 	}
 
 	var obj map[string]interface{}
-	readRes, err := sendRequest(config, "GET", url, nil)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	readRes, err := sendRequest(config, "GET", project, url, nil)
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("ComputeDisk %q", d.Id()))
 	}
@@ -551,8 +759,8 @@ func resourceComputeRegionDiskDelete(d *schema.ResourceData, meta interface{}) e
 				return fmt.Errorf("Error detaching disk %s from instance %s/%s/%s: %s", call.deviceName, call.project,
 					call.zone, call.instance, err.Error())
 			}
-			err = computeOperationWait(config.clientCompute, op, call.project,
-				fmt.Sprintf("Detaching disk from %s/%s/%s", call.project, call.zone, call.instance))
+			err = computeOperationWaitTimeout(config, op, call.project,
+				fmt.Sprintf("Detaching disk from %s/%s/%s", call.project, call.zone, call.instance), d.Timeout(schema.TimeoutDelete))
 			if err != nil {
 				if opErr, ok := err.(ComputeOperationError); ok && len(opErr.Errors) == 1 && opErr.Errors[0].Code == "RESOURCE_NOT_FOUND" {
 					log.Printf("[WARN] instance %q was deleted while awaiting detach", call.instance)
@@ -563,15 +771,11 @@ func resourceComputeRegionDiskDelete(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 	log.Printf("[DEBUG] Deleting RegionDisk %q", d.Id())
-	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return handleNotFoundError(err, d, "RegionDisk")
 	}
 
-	project, err := getProject(d, config)
-	if err != nil {
-		return err
-	}
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -579,7 +783,7 @@ func resourceComputeRegionDiskDelete(d *schema.ResourceData, meta interface{}) e
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting RegionDisk",
+		config, op, project, "Deleting RegionDisk",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 
 	if err != nil {
@@ -630,10 +834,6 @@ func flattenComputeRegionDiskLastDetachTimestamp(v interface{}, d *schema.Resour
 	return v
 }
 
-func flattenComputeRegionDiskLabels(v interface{}, d *schema.ResourceData) interface{} {
-	return v
-}
-
 func flattenComputeRegionDiskName(v interface{}, d *schema.ResourceData) interface{} {
 	return v
 }
@@ -672,6 +872,26 @@ func flattenComputeRegionDiskReplicaZones(v interface{}, d *schema.ResourceData)
 	return convertAndMapStringArr(v.([]interface{}), ConvertSelfLinkToV1)
 }
 
+func flattenComputeRegionDiskProvisionedIops(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenComputeRegionDiskProvisionedThroughput(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
 func flattenComputeRegionDiskType(v interface{}, d *schema.ResourceData) interface{} {
 	if v == nil {
 		return v
@@ -755,6 +975,17 @@ func flattenComputeRegionDiskSourceSnapshotId(v interface{}, d *schema.ResourceD
 	return v
 }
 
+func flattenComputeRegionDiskSourceDisk(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	return ConvertSelfLinkToV1(v.(string))
+}
+
+func flattenComputeRegionDiskSourceDiskId(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
 func expandComputeRegionDiskLabelFingerprint(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
 	return v, nil
 }
@@ -799,6 +1030,14 @@ func expandComputeRegionDiskReplicaZones(v interface{}, d TerraformResourceData,
 	return req, nil
 }
 
+func expandComputeRegionDiskProvisionedIops(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeRegionDiskProvisionedThroughput(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
 func expandComputeRegionDiskType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
 	f, err := parseRegionalFieldValue("diskTypes", v.(string), "project", "region", "zone", d, config, true)
 	if err != nil {
@@ -868,6 +1107,10 @@ func expandComputeRegionDiskSnapshot(v interface{}, d TerraformResourceData, con
 	return f.RelativeLink(), nil
 }
 
+func expandComputeRegionDiskSourceDisk(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
 func expandComputeRegionDiskSourceSnapshotEncryptionKey(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
 	l := v.([]interface{})
 	if len(l) == 0 || l[0] == nil {
@@ -945,6 +1188,16 @@ func resourceComputeRegionDiskEncoder(d *schema.ResourceData, meta interface{},
 		log.Printf("[DEBUG] Image name resolved to: %s", imageUrl)
 	}
 
+	if v, ok := d.GetOk("disk_encryption_key.0.kms_key_name"); ok {
+		region, err := getRegion(d, config)
+		if err != nil {
+			return nil, err
+		}
+		if err := validateRegionDiskKmsKey(config, v.(string), region); err != nil {
+			return nil, fmt.Errorf("Invalid value for disk_encryption_key.0.kms_key_name: %s", err)
+		}
+	}
+
 	return obj, nil
 }
 
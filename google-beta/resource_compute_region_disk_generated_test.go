@@ -92,7 +92,7 @@ func testAccCheckComputeRegionDiskDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("ComputeRegionDisk still exists at %s", url)
 		}
@@ -119,6 +119,73 @@ func TestAccComputeRegionDisk_encryption(t *testing.T) {
 	})
 }
 
+func TestAccComputeRegionDisk_encryptionKMSFromSnapshot(t *testing.T) {
+	t.Parallel()
+
+	diskName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	kms := BootstrapKMSKeyInLocation(t, "us-central1")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeRegionDiskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeRegionDisk_encryptionKMSFromSnapshot(getTestProjectFromEnv(), diskName, kms.CryptoKey.Name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_region_disk.regiondisk", "disk_encryption_key.0.kms_key_name", kms.CryptoKey.Name),
+					resource.TestCheckResourceAttrSet("google_compute_region_disk.regiondisk", "source_snapshot_id"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_region_disk.regiondisk",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestRegionDiskReplicaZonesCustomizeDiff(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Zones       []interface{}
+		ExpectError bool
+	}{
+		"zones in region": {
+			Zones: []interface{}{"us-central1-a", "us-central1-f"},
+		},
+		"zone self links": {
+			Zones: []interface{}{"https://www.googleapis.com/compute/v1/projects/my-project/zones/us-central1-a", "us-central1-b"},
+		},
+		"unknown zone": {
+			Zones: []interface{}{"us-central1-a", ""},
+		},
+		"zone in another region": {
+			Zones:       []interface{}{"us-central1-a", "us-east1-b"},
+			ExpectError: true,
+		},
+		"same zone twice": {
+			Zones:       []interface{}{"us-central1-a", "us-central1-a"},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &ResourceDiffMock{
+			After: map[string]interface{}{"replica_zones": tc.Zones},
+		}
+		err := regionDiskReplicaZonesCustomizeDiffFunc(d, "us-central1")
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
+
 func TestAccComputeRegionDisk_deleteDetach(t *testing.T) {
 	t.Parallel()
 
@@ -366,6 +433,49 @@ resource "google_compute_region_disk" "regiondisk" {
 }`, diskName, diskName, diskName)
 }
 
+func testAccComputeRegionDisk_encryptionKMSFromSnapshot(pid, diskName, kmsKey string) string {
+	return fmt.Sprintf(`
+data "google_project" "project" {
+  project_id = "%s"
+}
+
+resource "google_project_iam_member" "kms-project-binding" {
+  project = data.google_project.project.project_id
+  role    = "roles/cloudkms.cryptoKeyEncrypterDecrypter"
+  member  = "serviceAccount:service-${data.google_project.project.number}@compute-system.iam.gserviceaccount.com"
+}
+
+resource "google_compute_disk" "disk" {
+  name  = "%s"
+  image = "debian-cloud/debian-9"
+  size  = 50
+  type  = "pd-ssd"
+  zone  = "us-central1-a"
+}
+
+resource "google_compute_snapshot" "snapdisk" {
+  name        = "%s"
+  source_disk = google_compute_disk.disk.name
+  zone        = "us-central1-a"
+}
+
+resource "google_compute_region_disk" "regiondisk" {
+  name     = "%s"
+  snapshot = google_compute_snapshot.snapdisk.self_link
+  type     = "pd-ssd"
+  region   = "us-central1"
+
+  replica_zones = ["us-central1-a", "us-central1-f"]
+
+  disk_encryption_key {
+    kms_key_name = "%s"
+  }
+
+  depends_on = [google_project_iam_member.kms-project-binding]
+}
+`, pid, diskName, diskName, diskName, kmsKey)
+}
+
 func testAccComputeRegionDisk_deleteDetach(instanceName, diskName, regionDiskName string) string {
 	return fmt.Sprintf(`
 resource "google_compute_disk" "disk" {
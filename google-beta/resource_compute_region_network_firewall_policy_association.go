@@ -0,0 +1,192 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceComputeRegionNetworkFirewallPolicyAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeRegionNetworkFirewallPolicyAssociationCreate,
+		Read:   resourceComputeRegionNetworkFirewallPolicyAssociationRead,
+		Delete: resourceComputeRegionNetworkFirewallPolicyAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeRegionNetworkFirewallPolicyAssociationImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"firewall_policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+
+			"attachment_target": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkRelativePaths,
+			},
+
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"short_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeRegionNetworkFirewallPolicyAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	policyUrl, err := computeRegionNetworkFirewallPolicyUrl(d, config)
+	if err != nil {
+		return err
+	}
+
+	obj := map[string]interface{}{
+		"name":             d.Get("name").(string),
+		"attachmentTarget": d.Get("attachment_target").(string),
+	}
+
+	log.Printf("[DEBUG] Creating new RegionNetworkFirewallPolicyAssociation: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", "", policyUrl+"/addAssociation", obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating RegionNetworkFirewallPolicyAssociation: %s", err)
+	}
+
+	id, err := replaceVars(d, config, "projects/{{project}}/regions/{{region}}/firewallPolicies/")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(fmt.Sprintf("%s%s/associations/%s", id, GetResourceNameFromSelfLink(d.Get("firewall_policy").(string)), d.Get("name").(string)))
+
+	if err := computeRegionNetworkFirewallPolicyOperationWait(d, config, res, "Creating RegionNetworkFirewallPolicyAssociation", d.Timeout(schema.TimeoutCreate)); err != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create RegionNetworkFirewallPolicyAssociation: %s", err)
+	}
+
+	log.Printf("[DEBUG] Finished creating RegionNetworkFirewallPolicyAssociation %q: %#v", d.Id(), res)
+
+	return resourceComputeRegionNetworkFirewallPolicyAssociationRead(d, meta)
+}
+
+func resourceComputeRegionNetworkFirewallPolicyAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	policyUrl, err := computeRegionNetworkFirewallPolicyUrl(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, policyUrl, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeRegionNetworkFirewallPolicyAssociation %q", d.Id()))
+	}
+
+	var association map[string]interface{}
+	associations, _ := res["associations"].([]interface{})
+	for _, raw := range associations {
+		a := raw.(map[string]interface{})
+		if a["name"] == d.Get("name").(string) {
+			association = a
+			break
+		}
+	}
+	if association == nil {
+		log.Printf("[WARN] Removing RegionNetworkFirewallPolicyAssociation %q because it's gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("project", project)
+	d.Set("region", region)
+	d.Set("attachment_target", association["attachmentTarget"])
+	d.Set("short_name", association["shortName"])
+
+	return nil
+}
+
+func resourceComputeRegionNetworkFirewallPolicyAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	policyUrl, err := computeRegionNetworkFirewallPolicyUrl(d, config)
+	if err != nil {
+		return err
+	}
+	url := fmt.Sprintf("%s/removeAssociation?name=%s", policyUrl, d.Get("name").(string))
+
+	log.Printf("[DEBUG] Deleting RegionNetworkFirewallPolicyAssociation %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "POST", "", url, nil, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "RegionNetworkFirewallPolicyAssociation")
+	}
+
+	if err := computeRegionNetworkFirewallPolicyOperationWait(d, config, res, "Deleting RegionNetworkFirewallPolicyAssociation", d.Timeout(schema.TimeoutDelete)); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting RegionNetworkFirewallPolicyAssociation %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceComputeRegionNetworkFirewallPolicyAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/firewallPolicies/(?P<firewall_policy>[^/]+)/associations/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<firewall_policy>[^/]+)/(?P<name>[^/]+)",
+		"(?P<region>[^/]+)/(?P<firewall_policy>[^/]+)/(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/regions/{{region}}/firewallPolicies/{{firewall_policy}}/associations/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
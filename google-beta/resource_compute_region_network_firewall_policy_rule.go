@@ -0,0 +1,411 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"google.golang.org/api/compute/v1"
+)
+
+func resourceComputeRegionNetworkFirewallPolicyRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeRegionNetworkFirewallPolicyRuleCreate,
+		Read:   resourceComputeRegionNetworkFirewallPolicyRuleRead,
+		Update: resourceComputeRegionNetworkFirewallPolicyRuleUpdate,
+		Delete: resourceComputeRegionNetworkFirewallPolicyRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeRegionNetworkFirewallPolicyRuleImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Update: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"firewall_policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(0, 2147483647),
+			},
+
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"allow", "deny", "goto_next"}, false),
+			},
+
+			"direction": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"INGRESS", "EGRESS"}, false),
+			},
+
+			"match": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"layer4_configs": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ip_protocol": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"ports": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"src_ip_ranges": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"dest_ip_ranges": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"enable_logging": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"rule_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"target_service_accounts": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"kind": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"rule_tuple_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeRegionNetworkFirewallPolicyRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	policyUrl, err := computeRegionNetworkFirewallPolicyUrl(d, config)
+	if err != nil {
+		return err
+	}
+
+	// A rule is identified by its priority, so check for a conflicting rule
+	// up front instead of relying on the API's generic error.
+	priority := d.Get("priority").(int)
+	existing, err := findComputeRegionNetworkFirewallPolicyRule(config, policyUrl, priority)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return fmt.Errorf("Firewall policy %q already has a rule with priority %d, priorities must be unique within a policy", d.Get("firewall_policy").(string), priority)
+	}
+
+	obj := expandComputeRegionNetworkFirewallPolicyRule(d)
+
+	log.Printf("[DEBUG] Creating new RegionNetworkFirewallPolicyRule: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", "", policyUrl+"/addRule", obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating RegionNetworkFirewallPolicyRule: %s", err)
+	}
+
+	id, err := replaceVars(d, config, "projects/{{project}}/regions/{{region}}/firewallPolicies/")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(fmt.Sprintf("%s%s/rules/%d", id, GetResourceNameFromSelfLink(d.Get("firewall_policy").(string)), priority))
+
+	if err := computeRegionNetworkFirewallPolicyOperationWait(d, config, res, "Creating RegionNetworkFirewallPolicyRule", d.Timeout(schema.TimeoutCreate)); err != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create RegionNetworkFirewallPolicyRule: %s", err)
+	}
+
+	log.Printf("[DEBUG] Finished creating RegionNetworkFirewallPolicyRule %q: %#v", d.Id(), res)
+
+	return resourceComputeRegionNetworkFirewallPolicyRuleRead(d, meta)
+}
+
+func resourceComputeRegionNetworkFirewallPolicyRuleRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	policyUrl, err := computeRegionNetworkFirewallPolicyUrl(d, config)
+	if err != nil {
+		return err
+	}
+
+	rule, err := findComputeRegionNetworkFirewallPolicyRule(config, policyUrl, d.Get("priority").(int))
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeRegionNetworkFirewallPolicyRule %q", d.Id()))
+	}
+	if rule == nil {
+		log.Printf("[WARN] Removing RegionNetworkFirewallPolicyRule %q because it's gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("project", project)
+	d.Set("region", region)
+	d.Set("action", rule["action"])
+	d.Set("direction", rule["direction"])
+	d.Set("description", rule["description"])
+	d.Set("disabled", rule["disabled"])
+	d.Set("enable_logging", rule["enableLogging"])
+	d.Set("rule_name", rule["ruleName"])
+	d.Set("kind", rule["kind"])
+	d.Set("rule_tuple_count", rule["ruleTupleCount"])
+	if err := d.Set("target_service_accounts", rule["targetServiceAccounts"]); err != nil {
+		return fmt.Errorf("Error setting target_service_accounts: %s", err)
+	}
+	if err := d.Set("match", flattenComputeRegionNetworkFirewallPolicyRuleMatch(rule["match"])); err != nil {
+		return fmt.Errorf("Error setting match: %s", err)
+	}
+
+	return nil
+}
+
+func resourceComputeRegionNetworkFirewallPolicyRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	policyUrl, err := computeRegionNetworkFirewallPolicyUrl(d, config)
+	if err != nil {
+		return err
+	}
+
+	obj := expandComputeRegionNetworkFirewallPolicyRule(d)
+	url := fmt.Sprintf("%s/patchRule?priority=%d", policyUrl, d.Get("priority").(int))
+
+	log.Printf("[DEBUG] Updating RegionNetworkFirewallPolicyRule %q: %#v", d.Id(), obj)
+	res, err := sendRequestWithTimeout(config, "POST", "", url, obj, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("Error updating RegionNetworkFirewallPolicyRule %q: %s", d.Id(), err)
+	}
+
+	if err := computeRegionNetworkFirewallPolicyOperationWait(d, config, res, "Updating RegionNetworkFirewallPolicyRule", d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	return resourceComputeRegionNetworkFirewallPolicyRuleRead(d, meta)
+}
+
+func resourceComputeRegionNetworkFirewallPolicyRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	policyUrl, err := computeRegionNetworkFirewallPolicyUrl(d, config)
+	if err != nil {
+		return err
+	}
+	url := fmt.Sprintf("%s/removeRule?priority=%d", policyUrl, d.Get("priority").(int))
+
+	log.Printf("[DEBUG] Deleting RegionNetworkFirewallPolicyRule %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "POST", "", url, nil, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "RegionNetworkFirewallPolicyRule")
+	}
+
+	if err := computeRegionNetworkFirewallPolicyOperationWait(d, config, res, "Deleting RegionNetworkFirewallPolicyRule", d.Timeout(schema.TimeoutDelete)); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting RegionNetworkFirewallPolicyRule %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceComputeRegionNetworkFirewallPolicyRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/firewallPolicies/(?P<firewall_policy>[^/]+)/rules/(?P<priority>[^/]+)",
+		"(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<firewall_policy>[^/]+)/(?P<priority>[^/]+)",
+		"(?P<region>[^/]+)/(?P<firewall_policy>[^/]+)/(?P<priority>[^/]+)",
+		"(?P<firewall_policy>[^/]+)/(?P<priority>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/regions/{{region}}/firewallPolicies/{{firewall_policy}}/rules/{{priority}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// computeRegionNetworkFirewallPolicyUrl returns the URL of the regional
+// network firewall policy referenced by the resource's firewall_policy field,
+// which may be either a name or a self_link.
+func computeRegionNetworkFirewallPolicyUrl(d TerraformResourceData, config *Config) (string, error) {
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/regions/{{region}}/firewallPolicies/")
+	if err != nil {
+		return "", err
+	}
+	return url + GetResourceNameFromSelfLink(d.Get("firewall_policy").(string)), nil
+}
+
+func computeRegionNetworkFirewallPolicyOperationWait(d *schema.ResourceData, config *Config, res map[string]interface{}, activity string, timeout time.Duration) error {
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	op := &compute.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
+
+	return computeOperationWaitTime(config, op, project, activity, int(timeout.Minutes()))
+}
+
+// findComputeRegionNetworkFirewallPolicyRule returns the rule with the given
+// priority in the policy at policyUrl, or nil if the policy has no such rule.
+func findComputeRegionNetworkFirewallPolicyRule(config *Config, policyUrl string, priority int) (map[string]interface{}, error) {
+	res, err := sendRequest(config, "GET", "", policyUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, _ := res["rules"].([]interface{})
+	for _, raw := range rules {
+		rule := raw.(map[string]interface{})
+		// JSON numbers are decoded as float64
+		if p, ok := rule["priority"].(float64); ok && int(p) == priority {
+			return rule, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func expandComputeRegionNetworkFirewallPolicyRule(d *schema.ResourceData) map[string]interface{} {
+	obj := map[string]interface{}{
+		"priority":              d.Get("priority").(int),
+		"action":                d.Get("action").(string),
+		"direction":             d.Get("direction").(string),
+		"description":           d.Get("description").(string),
+		"disabled":              d.Get("disabled").(bool),
+		"enableLogging":         d.Get("enable_logging").(bool),
+		"ruleName":              d.Get("rule_name").(string),
+		"targetServiceAccounts": convertStringArr(d.Get("target_service_accounts").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("match"); ok {
+		raw := v.([]interface{})[0].(map[string]interface{})
+		match := map[string]interface{}{
+			"srcIpRanges":  convertStringArr(raw["src_ip_ranges"].([]interface{})),
+			"destIpRanges": convertStringArr(raw["dest_ip_ranges"].([]interface{})),
+		}
+
+		layer4Configs := make([]interface{}, 0, len(raw["layer4_configs"].([]interface{})))
+		for _, c := range raw["layer4_configs"].([]interface{}) {
+			l4 := c.(map[string]interface{})
+			layer4Configs = append(layer4Configs, map[string]interface{}{
+				"ipProtocol": l4["ip_protocol"].(string),
+				"ports":      convertStringArr(l4["ports"].([]interface{})),
+			})
+		}
+		match["layer4Configs"] = layer4Configs
+
+		obj["match"] = match
+	}
+
+	return obj
+}
+
+func flattenComputeRegionNetworkFirewallPolicyRuleMatch(v interface{}) []interface{} {
+	match, ok := v.(map[string]interface{})
+	if !ok || len(match) == 0 {
+		return nil
+	}
+
+	layer4Configs := []interface{}{}
+	if l, ok := match["layer4Configs"].([]interface{}); ok {
+		for _, raw := range l {
+			l4 := raw.(map[string]interface{})
+			layer4Configs = append(layer4Configs, map[string]interface{}{
+				"ip_protocol": l4["ipProtocol"],
+				"ports":       l4["ports"],
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"layer4_configs": layer4Configs,
+			"src_ip_ranges":  match["srcIpRanges"],
+			"dest_ip_ranges": match["destIpRanges"],
+		},
+	}
+}
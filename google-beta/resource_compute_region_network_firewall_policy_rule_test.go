@@ -0,0 +1,136 @@
+package google
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccComputeRegionNetworkFirewallPolicyRule_update(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeRegionNetworkFirewallPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeRegionNetworkFirewallPolicyRule_allow(suffix, "Allow SSH", "22"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_region_network_firewall_policy_rule.allow", "match.0.layer4_configs.0.ports.0", "22"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_region_network_firewall_policy_rule.allow",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "google_compute_region_network_firewall_policy_association.association",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeRegionNetworkFirewallPolicyRule_allow(suffix, "Allow SSH on a custom port", "2222"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_region_network_firewall_policy_rule.allow", "description", "Allow SSH on a custom port"),
+					resource.TestCheckResourceAttr("google_compute_region_network_firewall_policy_rule.allow", "match.0.layer4_configs.0.ports.0", "2222"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_region_network_firewall_policy_rule.allow",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeRegionNetworkFirewallPolicyRule_duplicatePriority(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeRegionNetworkFirewallPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeRegionNetworkFirewallPolicyRule_allow(suffix, "Allow SSH", "22"),
+			},
+			{
+				Config:      testAccComputeRegionNetworkFirewallPolicyRule_duplicatePriority(suffix),
+				ExpectError: regexp.MustCompile("already has a rule with priority 1000"),
+			},
+		},
+	})
+}
+
+func testAccComputeRegionNetworkFirewallPolicyRule_allow(suffix, description, port string) string {
+	return fmt.Sprintf(`
+resource "google_compute_network" "network" {
+  name                    = "tf-test-network-%s"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_region_network_firewall_policy" "policy" {
+  name        = "tf-test-policy-%s"
+  description = "regional network firewall policy"
+  region      = "us-west1"
+}
+
+resource "google_compute_region_network_firewall_policy_rule" "allow" {
+  firewall_policy = "${google_compute_region_network_firewall_policy.policy.name}"
+  region          = "us-west1"
+  priority        = 1000
+  action          = "allow"
+  direction       = "INGRESS"
+  description     = "%s"
+  enable_logging  = true
+
+  match {
+    src_ip_ranges = ["10.100.0.0/16"]
+
+    layer4_configs {
+      ip_protocol = "tcp"
+      ports       = ["%s"]
+    }
+  }
+}
+
+resource "google_compute_region_network_firewall_policy_association" "association" {
+  name              = "tf-test-association-%s"
+  firewall_policy   = "${google_compute_region_network_firewall_policy.policy.name}"
+  attachment_target = "${google_compute_network.network.self_link}"
+  region            = "us-west1"
+}
+`, suffix, suffix, description, port, suffix)
+}
+
+func testAccComputeRegionNetworkFirewallPolicyRule_duplicatePriority(suffix string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "google_compute_region_network_firewall_policy_rule" "deny" {
+  firewall_policy = "${google_compute_region_network_firewall_policy.policy.name}"
+  region          = "us-west1"
+  priority        = "${google_compute_region_network_firewall_policy_rule.allow.priority}"
+  action          = "deny"
+  direction       = "INGRESS"
+
+  match {
+    src_ip_ranges = ["0.0.0.0/0"]
+
+    layer4_configs {
+      ip_protocol = "all"
+    }
+  }
+}
+`, testAccComputeRegionNetworkFirewallPolicyRule_allow(suffix, "Allow SSH", "22"))
+}
@@ -0,0 +1,79 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestComputeRegionSecurityPolicyDdosProtectionCustomizeDiff(t *testing.T) {
+	cases := map[string]struct {
+		Type        interface{}
+		DdosCount   interface{}
+		ExpectError bool
+	}{
+		"no ddos protection config": {Type: "CLOUD_ARMOR", DdosCount: 0},
+		"network policy":            {Type: "CLOUD_ARMOR_NETWORK", DdosCount: 1},
+		"backend policy":            {Type: "CLOUD_ARMOR", DdosCount: 1, ExpectError: true},
+		"type unset":                {DdosCount: 1, ExpectError: true},
+	}
+
+	for tn, tc := range cases {
+		d := &ResourceDiffMock{
+			After: map[string]interface{}{
+				"type":                     tc.Type,
+				"ddos_protection_config.#": tc.DdosCount,
+			},
+		}
+		err := resourceComputeRegionSecurityPolicyDdosProtectionCustomizeDiffFunc(d)
+		if tc.ExpectError != (err != nil) {
+			t.Errorf("%s: expected error %t, got %v", tn, tc.ExpectError, err)
+		}
+	}
+}
+
+func TestAccComputeRegionSecurityPolicy_ddosProtectionUpdate(t *testing.T) {
+	t.Parallel()
+
+	spName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeRegionSecurityPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeRegionSecurityPolicy_ddosProtection(spName, "STANDARD"),
+			},
+			{
+				ResourceName:      "google_compute_region_security_policy.policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeRegionSecurityPolicy_ddosProtection(spName, "ADVANCED"),
+			},
+			{
+				ResourceName:      "google_compute_region_security_policy.policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccComputeRegionSecurityPolicy_ddosProtection(spName, ddosProtection string) string {
+	return fmt.Sprintf(`
+resource "google_compute_region_security_policy" "policy" {
+  name   = "%s"
+  region = "us-central1"
+  type   = "CLOUD_ARMOR_NETWORK"
+
+  ddos_protection_config {
+    ddos_protection = "%s"
+  }
+}
+`, spName, ddosProtection)
+}
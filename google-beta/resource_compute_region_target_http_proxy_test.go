@@ -0,0 +1,112 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccComputeRegionTargetHttpProxy_forwardingRule(t *testing.T) {
+	t.Parallel()
+
+	randomSuffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeRegionTargetHttpProxyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeRegionTargetHttpProxy_forwardingRule(randomSuffix, "foo"),
+			},
+			{
+				ResourceName:      "google_compute_region_target_http_proxy.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeRegionTargetHttpProxy_forwardingRule(randomSuffix, "bar"),
+			},
+			{
+				ResourceName:      "google_compute_region_target_http_proxy.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccComputeRegionTargetHttpProxy_forwardingRule(randomSuffix, urlMap string) string {
+	return fmt.Sprintf(`
+resource "google_compute_forwarding_rule" "foobar" {
+  region                = "us-central1"
+  name                  = "rule-test-%s"
+  load_balancing_scheme = "INTERNAL_MANAGED"
+  port_range            = "80"
+  target                = "${google_compute_region_target_http_proxy.foobar.self_link}"
+  network               = "${google_compute_network.foobar.self_link}"
+  subnetwork            = "${google_compute_subnetwork.foobar.self_link}"
+
+  depends_on = ["google_compute_subnetwork.proxy"]
+}
+
+resource "google_compute_region_target_http_proxy" "foobar" {
+  region  = "us-central1"
+  name    = "proxy-test-%s"
+  url_map = "${google_compute_region_url_map.%s.self_link}"
+}
+
+resource "google_compute_region_url_map" "foo" {
+  region          = "us-central1"
+  name            = "urlmap-test-foo-%s"
+  default_service = "${google_compute_region_backend_service.foobar.self_link}"
+}
+
+resource "google_compute_region_url_map" "bar" {
+  region          = "us-central1"
+  name            = "urlmap-test-bar-%s"
+  default_service = "${google_compute_region_backend_service.foobar.self_link}"
+}
+
+resource "google_compute_region_backend_service" "foobar" {
+  region                = "us-central1"
+  name                  = "backend-test-%s"
+  protocol              = "HTTP"
+  load_balancing_scheme = "INTERNAL_MANAGED"
+  health_checks         = ["${google_compute_health_check.zero.self_link}"]
+}
+
+resource "google_compute_health_check" "zero" {
+  name               = "hc-test-%s"
+  check_interval_sec = 1
+  timeout_sec        = 1
+
+  http_health_check {
+    port = 80
+  }
+}
+
+resource "google_compute_network" "foobar" {
+  name                    = "network-test-%s"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "foobar" {
+  region        = "us-central1"
+  name          = "subnet-test-%s"
+  ip_cidr_range = "10.0.1.0/24"
+  network       = "${google_compute_network.foobar.self_link}"
+}
+
+resource "google_compute_subnetwork" "proxy" {
+  region        = "us-central1"
+  name          = "proxy-subnet-test-%s"
+  ip_cidr_range = "10.0.2.0/24"
+  network       = "${google_compute_network.foobar.self_link}"
+  purpose       = "INTERNAL_HTTPS_LOAD_BALANCER"
+  role          = "ACTIVE"
+}
+`, randomSuffix, randomSuffix, urlMap, randomSuffix, randomSuffix, randomSuffix, randomSuffix, randomSuffix, randomSuffix, randomSuffix)
+}
@@ -0,0 +1,108 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccComputeRegionUrlMap_routeRulesWithUpdate(t *testing.T) {
+	t.Parallel()
+
+	randomSuffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeRegionUrlMapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeRegionUrlMap_routeRules(randomSuffix, "/api/", 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_region_url_map.foobar", "path_matcher.0.route_rules.0.route_action.0.url_rewrite.0.path_prefix_rewrite", "/api/"),
+					resource.TestCheckResourceAttr("google_compute_region_url_map.foobar", "path_matcher.0.route_rules.0.route_action.0.cors_policy.0.max_age", "3600"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_region_url_map.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeRegionUrlMap_routeRules(randomSuffix, "/v2/", 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_region_url_map.foobar", "path_matcher.0.route_rules.0.route_action.0.url_rewrite.0.path_prefix_rewrite", "/v2/"),
+					resource.TestCheckResourceAttr("google_compute_region_url_map.foobar", "path_matcher.0.route_rules.0.route_action.0.cors_policy.0.max_age", "60"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_region_url_map.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccComputeRegionUrlMap_routeRules(randomSuffix, pathPrefixRewrite string, maxAge int) string {
+	return fmt.Sprintf(`
+resource "google_compute_region_url_map" "foobar" {
+  region          = "us-central1"
+  name            = "regionurlmap-test-%s"
+  default_service = "${google_compute_region_backend_service.foobar.self_link}"
+
+  host_rule {
+    hosts        = ["mysite.com"]
+    path_matcher = "api"
+  }
+
+  path_matcher {
+    name            = "api"
+    default_service = "${google_compute_region_backend_service.foobar.self_link}"
+
+    route_rules {
+      priority = 1
+      service  = "${google_compute_region_backend_service.foobar.self_link}"
+
+      match_rules {
+        prefix_match = "/legacy/"
+      }
+
+      route_action {
+        url_rewrite {
+          host_rewrite        = "api.mysite.com"
+          path_prefix_rewrite = "%s"
+        }
+
+        cors_policy {
+          allow_origins     = ["https://mysite.com"]
+          allow_methods     = ["GET", "POST"]
+          allow_credentials = true
+          max_age           = %d
+        }
+      }
+    }
+  }
+}
+
+resource "google_compute_region_backend_service" "foobar" {
+  region                = "us-central1"
+  name                  = "regionurlmap-test-%s"
+  protocol              = "HTTP"
+  load_balancing_scheme = "INTERNAL_MANAGED"
+  health_checks         = ["${google_compute_health_check.zero.self_link}"]
+}
+
+resource "google_compute_health_check" "zero" {
+  name               = "regionurlmap-test-%s"
+  check_interval_sec = 1
+  timeout_sec        = 1
+
+  http_health_check {
+    port = 80
+  }
+}
+`, randomSuffix, pathPrefixRewrite, maxAge, randomSuffix, randomSuffix)
+}
@@ -135,7 +135,7 @@ func testAccCheckComputeResourcePolicyDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("ComputeResourcePolicy still exists at %s", url)
 		}
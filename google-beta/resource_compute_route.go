@@ -17,14 +17,37 @@ package google
 import (
 	"fmt"
 	"log"
+	"net"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"google.golang.org/api/compute/v1"
 )
 
+// A route has exactly one next hop. Unknown values are skipped, since a next hop
+// may reference a resource created in the same plan.
+func resourceComputeRouteNextHopCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	nextHops := []string{"next_hop_gateway", "next_hop_instance", "next_hop_ip", "next_hop_vpn_tunnel", "next_hop_ilb"}
+
+	count := 0
+	for _, nextHop := range nextHops {
+		if !diff.NewValueKnown(nextHop) {
+			return nil
+		}
+		if _, ok := diff.GetOk(nextHop); ok {
+			count++
+		}
+	}
+	if count != 1 {
+		return fmt.Errorf("exactly one of %s must be set", strings.Join(nextHops, ", "))
+	}
+
+	return nil
+}
+
 func resourceComputeRoute() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceComputeRouteCreate,
@@ -40,6 +63,8 @@ func resourceComputeRoute() *schema.Resource {
 			Delete: schema.DefaultTimeout(4 * time.Minute),
 		},
 
+		CustomizeDiff: resourceComputeRouteNextHopCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"dest_range": {
 				Type:     schema.TypeString,
@@ -86,6 +111,12 @@ func resourceComputeRoute() *schema.Resource {
 				ForceNew:         true,
 				DiffSuppressFunc: compareSelfLinkOrResourceName,
 			},
+			"next_hop_ilb": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
 			"priority": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -188,6 +219,12 @@ func resourceComputeRouteCreate(d *schema.ResourceData, meta interface{}) error
 	} else if v, ok := d.GetOkExists("next_hop_vpn_tunnel"); !isEmptyValue(reflect.ValueOf(nextHopVpnTunnelProp)) && (ok || !reflect.DeepEqual(v, nextHopVpnTunnelProp)) {
 		obj["nextHopVpnTunnel"] = nextHopVpnTunnelProp
 	}
+	nextHopIlbProp, err := expandComputeRouteNextHopIlb(d.Get("next_hop_ilb"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("next_hop_ilb"); !isEmptyValue(reflect.ValueOf(nextHopIlbProp)) && (ok || !reflect.DeepEqual(v, nextHopIlbProp)) {
+		obj["nextHopIlb"] = nextHopIlbProp
+	}
 
 	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/global/routes")
 	if err != nil {
@@ -195,7 +232,12 @@ func resourceComputeRouteCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[DEBUG] Creating new Route: %#v", obj)
-	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("Error creating Route: %s", err)
 	}
@@ -207,10 +249,6 @@ func resourceComputeRouteCreate(d *schema.ResourceData, meta interface{}) error
 	}
 	d.SetId(id)
 
-	project, err := getProject(d, config)
-	if err != nil {
-		return err
-	}
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -218,7 +256,7 @@ func resourceComputeRouteCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating Route",
+		config, op, project, "Creating Route",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
@@ -240,20 +278,21 @@ func resourceComputeRouteRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	res, err := sendRequest(config, "GET", url, nil)
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("ComputeRoute %q", d.Id()))
+		return err
 	}
 
-	res, err = resourceComputeRouteDecoder(d, meta, res)
+	res, err := sendRequest(config, "GET", project, url, nil)
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeRoute %q", d.Id()))
 	}
 
-	project, err := getProject(d, config)
+	res, err = resourceComputeRouteDecoder(d, meta, res)
 	if err != nil {
 		return err
 	}
+
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("Error reading Route: %s", err)
 	}
@@ -288,6 +327,9 @@ func resourceComputeRouteRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("next_hop_vpn_tunnel", flattenComputeRouteNextHopVpnTunnel(res["nextHopVpnTunnel"], d)); err != nil {
 		return fmt.Errorf("Error reading Route: %s", err)
 	}
+	if err := d.Set("next_hop_ilb", flattenComputeRouteNextHopIlb(res["nextHopIlb"], d)); err != nil {
+		return fmt.Errorf("Error reading Route: %s", err)
+	}
 	if err := d.Set("next_hop_network", flattenComputeRouteNextHopNetwork(res["nextHopNetwork"], d)); err != nil {
 		return fmt.Errorf("Error reading Route: %s", err)
 	}
@@ -308,15 +350,16 @@ func resourceComputeRouteDelete(d *schema.ResourceData, meta interface{}) error
 
 	var obj map[string]interface{}
 	log.Printf("[DEBUG] Deleting Route %q", d.Id())
-	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, "Route")
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, "Route")
 	}
+
 	op := &compute.Operation{}
 	err = Convert(res, op)
 	if err != nil {
@@ -324,7 +367,7 @@ func resourceComputeRouteDelete(d *schema.ResourceData, meta interface{}) error
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting Route",
+		config, op, project, "Deleting Route",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 
 	if err != nil {
@@ -413,6 +456,17 @@ func flattenComputeRouteNextHopVpnTunnel(v interface{}, d *schema.ResourceData)
 	return ConvertSelfLinkToV1(v.(string))
 }
 
+func flattenComputeRouteNextHopIlb(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	// An IP address is a valid next hop, so only convert self links
+	if net.ParseIP(v.(string)) != nil {
+		return v
+	}
+	return ConvertSelfLinkToV1(v.(string))
+}
+
 func flattenComputeRouteNextHopNetwork(v interface{}, d *schema.ResourceData) interface{} {
 	return v
 }
@@ -484,6 +538,21 @@ func expandComputeRouteNextHopVpnTunnel(v interface{}, d TerraformResourceData,
 	return f.RelativeLink(), nil
 }
 
+func expandComputeRouteNextHopIlb(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	if v == "" {
+		return v, nil
+	}
+	// The internal load balancer can be referenced by its IP address
+	if net.ParseIP(v.(string)) != nil {
+		return v, nil
+	}
+	f, err := parseRegionalFieldValue("forwardingRules", v.(string), "project", "region", "zone", d, config, true)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid value for next_hop_ilb: %s", err)
+	}
+	return f.RelativeLink(), nil
+}
+
 func resourceComputeRouteDecoder(d *schema.ResourceData, meta interface{}, res map[string]interface{}) (map[string]interface{}, error) {
 	if v, ok := res["nextHopInstance"]; ok {
 		val, err := parseZonalFieldValue("instances", v.(string), "project", "next_hop_instance_zone", d, meta.(*Config), true)
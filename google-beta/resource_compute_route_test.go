@@ -67,6 +67,48 @@ func TestAccComputeRoute_hopInstance(t *testing.T) {
 	})
 }
 
+func TestAccComputeRoute_hopIlb(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeRoute_hopIlb(suffix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("google_compute_route.foobar", "next_hop_ilb",
+						regexp.MustCompile(fmt.Sprintf("regions/us-central1/forwardingRules/route-ilb-%s$", suffix))),
+				),
+			},
+			{
+				ResourceName:      "google_compute_route.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeRoute_multipleNextHops(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccComputeRoute_multipleNextHops(acctest.RandString(10)),
+				ExpectError: regexp.MustCompile("exactly one of next_hop_gateway, next_hop_instance, next_hop_ip, next_hop_vpn_tunnel, next_hop_ilb must be set"),
+			},
+		},
+	})
+}
+
 func testAccCheckComputeRouteExists(n string, route *compute.Route) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -139,3 +181,65 @@ resource "google_compute_route" "foobar" {
 	priority = 100
 }`, instanceName, zone, acctest.RandString(10))
 }
+
+func testAccComputeRoute_hopIlb(suffix string) string {
+	return fmt.Sprintf(`
+resource "google_compute_network" "foobar" {
+  name                    = "route-ilb-%s"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "foobar" {
+  name          = "route-ilb-%s"
+  ip_cidr_range = "10.0.1.0/24"
+  region        = "us-central1"
+  network       = "${google_compute_network.foobar.self_link}"
+}
+
+resource "google_compute_health_check" "foobar" {
+  name               = "route-ilb-%s"
+  check_interval_sec = 1
+  timeout_sec        = 1
+
+  tcp_health_check {
+    port = "80"
+  }
+}
+
+resource "google_compute_region_backend_service" "foobar" {
+  name          = "route-ilb-%s"
+  region        = "us-central1"
+  health_checks = ["${google_compute_health_check.foobar.self_link}"]
+}
+
+resource "google_compute_forwarding_rule" "foobar" {
+  name                  = "route-ilb-%s"
+  region                = "us-central1"
+  load_balancing_scheme = "INTERNAL"
+  backend_service       = "${google_compute_region_backend_service.foobar.self_link}"
+  all_ports             = true
+  network               = "${google_compute_network.foobar.name}"
+  subnetwork            = "${google_compute_subnetwork.foobar.name}"
+}
+
+resource "google_compute_route" "foobar" {
+  name         = "route-ilb-%s"
+  dest_range   = "0.0.0.0/0"
+  network      = "${google_compute_network.foobar.name}"
+  next_hop_ilb = "${google_compute_forwarding_rule.foobar.self_link}"
+  priority     = 2000
+}
+`, suffix, suffix, suffix, suffix, suffix, suffix)
+}
+
+func testAccComputeRoute_multipleNextHops(suffix string) string {
+	return fmt.Sprintf(`
+resource "google_compute_route" "foobar" {
+  name             = "route-test-%s"
+  dest_range       = "0.0.0.0/0"
+  network          = "default"
+  next_hop_gateway = "default-internet-gateway"
+  next_hop_ip      = "10.132.1.5"
+}
+`, suffix)
+}
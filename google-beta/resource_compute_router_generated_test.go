@@ -89,7 +89,7 @@ func testAccCheckComputeRouterDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("ComputeRouter still exists at %s", url)
 		}
@@ -228,7 +228,7 @@ func resourceComputeRouterNatCreate(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Error patching router %s/%s: %s", region, routerName, err)
 	}
 	d.SetId(fmt.Sprintf("%s/%s/%s/%s", project, region, routerName, natName))
-	err = computeBetaOperationWaitTime(config.clientCompute, op, project, "Patching router", int(d.Timeout(schema.TimeoutCreate).Minutes()))
+	err = computeBetaOperationWaitTime(config, op, project, "Patching router", int(d.Timeout(schema.TimeoutCreate).Minutes()))
 	if err != nil {
 		d.SetId("")
 		return fmt.Errorf("Error waiting to patch router %s/%s: %s", region, routerName, err)
@@ -362,7 +362,7 @@ func resourceComputeRouterNatDelete(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Error patching router %s/%s: %s", region, routerName, err)
 	}
 
-	err = computeBetaOperationWaitTime(config.clientCompute, op, project, "Patching router", int(d.Timeout(schema.TimeoutDelete).Minutes()))
+	err = computeBetaOperationWaitTime(config, op, project, "Patching router", int(d.Timeout(schema.TimeoutDelete).Minutes()))
 	if err != nil {
 		return fmt.Errorf("Error waiting to patch router %s/%s: %s", region, routerName, err)
 	}
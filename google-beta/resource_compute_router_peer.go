@@ -7,14 +7,15 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"google.golang.org/api/compute/v1"
-	"google.golang.org/api/googleapi"
 )
 
 func resourceComputeRouterPeer() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceComputeRouterPeerCreate,
 		Read:   resourceComputeRouterPeerRead,
+		Update: resourceComputeRouterPeerUpdate,
 		Delete: resourceComputeRouterPeerDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceComputeRouterPeerImportState,
@@ -55,6 +56,26 @@ func resourceComputeRouterPeer() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"custom_learned_route_priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+			},
+
+			"custom_learned_ip_ranges": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"range": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateIpCidrRange,
+						},
+					},
+				},
+			},
+
 			"ip_address": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -98,10 +119,9 @@ func resourceComputeRouterPeerCreate(d *schema.ResourceData, meta interface{}) e
 	mutexKV.Lock(routerLock)
 	defer mutexKV.Unlock(routerLock)
 
-	routersService := config.clientCompute.Routers
-	router, err := routersService.Get(project, region, routerName).Do()
+	router, err := getComputeRouterForPeer(config, project, region, routerName)
 	if err != nil {
-		if gerr, ok := err.(*googleapi.Error); ok && gerr.Code == 404 {
+		if isGoogleApiErrorWithCode(err, 404) {
 			log.Printf("[WARN] Removing router peer %s because its router %s/%s is gone", peerName, region, routerName)
 			d.SetId("")
 
@@ -111,47 +131,41 @@ func resourceComputeRouterPeerCreate(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error Reading router %s/%s: %s", region, routerName, err)
 	}
 
-	peers := router.BgpPeers
+	peers := computeRouterBgpPeers(router)
 	for _, peer := range peers {
-		if peer.Name == peerName {
+		if peer["name"] == peerName {
 			d.SetId("")
 			return fmt.Errorf("Router %s has peer %s already", routerName, peerName)
 		}
 	}
 
-	ifaceName := d.Get("interface").(string)
-
-	peer := &compute.RouterBgpPeer{Name: peerName,
-		InterfaceName: ifaceName}
+	peer := map[string]interface{}{
+		"name":          peerName,
+		"interfaceName": d.Get("interface").(string),
+	}
 
 	if v, ok := d.GetOk("peer_ip_address"); ok {
-		peer.PeerIpAddress = v.(string)
+		peer["peerIpAddress"] = v.(string)
 	}
 
 	if v, ok := d.GetOk("peer_asn"); ok {
-		peer.PeerAsn = int64(v.(int))
+		peer["peerAsn"] = v.(int)
 	}
 
 	if v, ok := d.GetOk("advertised_route_priority"); ok {
-		peer.AdvertisedRoutePriority = int64(v.(int))
+		peer["advertisedRoutePriority"] = v.(int)
 	}
 
+	expandComputeRouterPeerCustomLearnedRoutes(d, peer)
+
 	log.Printf("[INFO] Adding peer %s", peerName)
 	peers = append(peers, peer)
-	patchRouter := &compute.Router{
-		BgpPeers: peers,
-	}
 
 	log.Printf("[DEBUG] Updating router %s/%s with peers: %+v", region, routerName, peers)
-	op, err := routersService.Patch(project, region, router.Name, patchRouter).Do()
-	if err != nil {
-		return fmt.Errorf("Error patching router %s/%s: %s", region, routerName, err)
-	}
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, routerName, peerName))
-	err = computeOperationWait(config.clientCompute, op, project, "Patching router")
-	if err != nil {
+	if err := patchComputeRouterPeers(config, project, region, routerName, peers); err != nil {
 		d.SetId("")
-		return fmt.Errorf("Error waiting to patch router %s/%s: %s", region, routerName, err)
+		return err
 	}
 
 	return resourceComputeRouterPeerRead(d, meta)
@@ -174,10 +188,9 @@ func resourceComputeRouterPeerRead(d *schema.ResourceData, meta interface{}) err
 	routerName := d.Get("router").(string)
 	peerName := d.Get("name").(string)
 
-	routersService := config.clientCompute.Routers
-	router, err := routersService.Get(project, region, routerName).Do()
+	router, err := getComputeRouterForPeer(config, project, region, routerName)
 	if err != nil {
-		if gerr, ok := err.(*googleapi.Error); ok && gerr.Code == 404 {
+		if isGoogleApiErrorWithCode(err, 404) {
 			log.Printf("[WARN] Removing router peer %s because its router %s/%s is gone", peerName, region, routerName)
 			d.SetId("")
 
@@ -187,15 +200,17 @@ func resourceComputeRouterPeerRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error Reading router %s/%s: %s", region, routerName, err)
 	}
 
-	for _, peer := range router.BgpPeers {
+	for _, peer := range computeRouterBgpPeers(router) {
 
-		if peer.Name == peerName {
+		if peer["name"] == peerName {
 			d.SetId(fmt.Sprintf("%s/%s/%s", region, routerName, peerName))
-			d.Set("interface", peer.InterfaceName)
-			d.Set("peer_ip_address", peer.PeerIpAddress)
-			d.Set("peer_asn", peer.PeerAsn)
-			d.Set("advertised_route_priority", peer.AdvertisedRoutePriority)
-			d.Set("ip_address", peer.IpAddress)
+			d.Set("interface", peer["interfaceName"])
+			d.Set("peer_ip_address", peer["peerIpAddress"])
+			d.Set("peer_asn", peer["peerAsn"])
+			d.Set("advertised_route_priority", peer["advertisedRoutePriority"])
+			d.Set("custom_learned_route_priority", peer["customLearnedRoutePriority"])
+			d.Set("custom_learned_ip_ranges", flattenComputeRouterPeerCustomLearnedIpRanges(peer["customLearnedIpRanges"]))
+			d.Set("ip_address", peer["ipAddress"])
 			d.Set("region", region)
 			d.Set("project", project)
 			return nil
@@ -207,6 +222,53 @@ func resourceComputeRouterPeerRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
+func resourceComputeRouterPeerUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	config := meta.(*Config)
+
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	routerName := d.Get("router").(string)
+	peerName := d.Get("name").(string)
+
+	routerLock := getRouterLockName(region, routerName)
+	mutexKV.Lock(routerLock)
+	defer mutexKV.Unlock(routerLock)
+
+	router, err := getComputeRouterForPeer(config, project, region, routerName)
+	if err != nil {
+		return fmt.Errorf("Error Reading router %s/%s: %s", region, routerName, err)
+	}
+
+	peers := computeRouterBgpPeers(router)
+	found := false
+	for _, peer := range peers {
+		if peer["name"] == peerName {
+			expandComputeRouterPeerCustomLearnedRoutes(d, peer)
+			found = true
+		}
+	}
+	if !found {
+		return fmt.Errorf("Router %s/%s has no peer %s", region, routerName, peerName)
+	}
+
+	log.Printf("[INFO] Updating peer %s", peerName)
+	log.Printf("[DEBUG] Updating router %s/%s with peers: %+v", region, routerName, peers)
+	if err := patchComputeRouterPeers(config, project, region, routerName, peers); err != nil {
+		return err
+	}
+
+	return resourceComputeRouterPeerRead(d, meta)
+}
+
 func resourceComputeRouterPeerDelete(d *schema.ResourceData, meta interface{}) error {
 
 	config := meta.(*Config)
@@ -228,10 +290,9 @@ func resourceComputeRouterPeerDelete(d *schema.ResourceData, meta interface{}) e
 	mutexKV.Lock(routerLock)
 	defer mutexKV.Unlock(routerLock)
 
-	routersService := config.clientCompute.Routers
-	router, err := routersService.Get(project, region, routerName).Do()
+	router, err := getComputeRouterForPeer(config, project, region, routerName)
 	if err != nil {
-		if gerr, ok := err.(*googleapi.Error); ok && gerr.Code == 404 {
+		if isGoogleApiErrorWithCode(err, 404) {
 			log.Printf("[WARN] Removing router peer %s because its router %s/%s is gone", peerName, region, routerName)
 
 			return nil
@@ -240,16 +301,17 @@ func resourceComputeRouterPeerDelete(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error Reading Router %s: %s", routerName, err)
 	}
 
-	var newPeers []*compute.RouterBgpPeer = make([]*compute.RouterBgpPeer, 0, len(router.BgpPeers))
-	for _, peer := range router.BgpPeers {
-		if peer.Name == peerName {
+	peers := computeRouterBgpPeers(router)
+	newPeers := make([]map[string]interface{}, 0, len(peers))
+	for _, peer := range peers {
+		if peer["name"] == peerName {
 			continue
 		} else {
 			newPeers = append(newPeers, peer)
 		}
 	}
 
-	if len(newPeers) == len(router.BgpPeers) {
+	if len(newPeers) == len(peers) {
 		log.Printf("[DEBUG] Router %s/%s had no peer %s already", region, routerName, peerName)
 		d.SetId("")
 		return nil
@@ -257,23 +319,10 @@ func resourceComputeRouterPeerDelete(d *schema.ResourceData, meta interface{}) e
 
 	log.Printf(
 		"[INFO] Removing peer %s from router %s/%s", peerName, region, routerName)
-	patchRouter := &compute.Router{
-		BgpPeers: newPeers,
-	}
-
-	if len(newPeers) == 0 {
-		patchRouter.ForceSendFields = append(patchRouter.ForceSendFields, "BgpPeers")
-	}
 
 	log.Printf("[DEBUG] Updating router %s/%s with peers: %+v", region, routerName, newPeers)
-	op, err := routersService.Patch(project, region, router.Name, patchRouter).Do()
-	if err != nil {
-		return fmt.Errorf("Error patching router %s/%s: %s", region, routerName, err)
-	}
-
-	err = computeOperationWait(config.clientCompute, op, project, "Patching router")
-	if err != nil {
-		return fmt.Errorf("Error waiting to patch router %s/%s: %s", region, routerName, err)
+	if err := patchComputeRouterPeers(config, project, region, routerName, newPeers); err != nil {
+		return err
 	}
 
 	d.SetId("")
@@ -292,3 +341,80 @@ func resourceComputeRouterPeerImportState(d *schema.ResourceData, meta interface
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// The router is read and patched as raw JSON so that peer fields unknown to
+// the compute client library, such as custom learned routes, are preserved
+// for every peer of the router.
+func getComputeRouterForPeer(config *Config, project, region, routerName string) (map[string]interface{}, error) {
+	url := fmt.Sprintf("%sprojects/%s/regions/%s/routers/%s", config.ComputeBasePath, project, region, routerName)
+	return sendRequest(config, "GET", project, url, nil)
+}
+
+func computeRouterBgpPeers(router map[string]interface{}) []map[string]interface{} {
+	raw, _ := router["bgpPeers"].([]interface{})
+	peers := make([]map[string]interface{}, 0, len(raw))
+	for _, p := range raw {
+		if peer, ok := p.(map[string]interface{}); ok {
+			peers = append(peers, peer)
+		}
+	}
+	return peers
+}
+
+func patchComputeRouterPeers(config *Config, project, region, routerName string, peers []map[string]interface{}) error {
+	url := fmt.Sprintf("%sprojects/%s/regions/%s/routers/%s", config.ComputeBasePath, project, region, routerName)
+	obj := map[string]interface{}{
+		"bgpPeers": peers,
+	}
+
+	res, err := sendRequest(config, "PATCH", project, url, obj)
+	if err != nil {
+		return fmt.Errorf("Error patching router %s/%s: %s", region, routerName, err)
+	}
+
+	op := &compute.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
+
+	err = computeOperationWait(config, op, project, "Patching router")
+	if err != nil {
+		return fmt.Errorf("Error waiting to patch router %s/%s: %s", region, routerName, err)
+	}
+	return nil
+}
+
+func expandComputeRouterPeerCustomLearnedRoutes(d *schema.ResourceData, peer map[string]interface{}) {
+	peer["customLearnedRoutePriority"] = d.Get("custom_learned_route_priority").(int)
+
+	ranges := make([]interface{}, 0)
+	for _, raw := range d.Get("custom_learned_ip_ranges").([]interface{}) {
+		original, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		ranges = append(ranges, map[string]interface{}{
+			"range": original["range"],
+		})
+	}
+	peer["customLearnedIpRanges"] = ranges
+}
+
+func flattenComputeRouterPeerCustomLearnedIpRanges(v interface{}) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"range": original["range"],
+		})
+	}
+	return transformed
+}
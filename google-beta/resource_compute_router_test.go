@@ -111,6 +111,42 @@ func TestAccComputeRouter_update(t *testing.T) {
 	})
 }
 
+func TestAccComputeRouter_bgpAdvertisementAndKeepalive(t *testing.T) {
+	t.Parallel()
+
+	testId := acctest.RandString(10)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeRouterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeRouterBgpKeepalive(testId, 20),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_router.foobar", "bgp.0.keepalive_interval", "20"),
+					resource.TestCheckResourceAttr("google_compute_router.foobar", "bgp.0.advertised_ip_ranges.0.range", "10.10.0.0/16"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_router.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeRouterBgpKeepalive(testId, 45),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_router.foobar", "bgp.0.keepalive_interval", "45"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_router.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccComputeRouterBasic(testId, resourceRegion string) string {
 	return fmt.Sprintf(`
 		resource "google_compute_network" "foobar" {
@@ -180,3 +216,27 @@ func testAccComputeRouterFull(testId string) string {
 		}
 	`, testId, testId)
 }
+
+func testAccComputeRouterBgpKeepalive(testId string, keepalive int) string {
+	return fmt.Sprintf(`
+		resource "google_compute_network" "foobar" {
+			name = "router-test-%s"
+			auto_create_subnetworks = false
+		}
+
+		resource "google_compute_router" "foobar" {
+			name = "router-test-%s"
+			network = "${google_compute_network.foobar.name}"
+			bgp {
+				asn = 4200000000
+				advertise_mode = "CUSTOM"
+				advertised_groups = ["ALL_SUBNETS"]
+				advertised_ip_ranges {
+					range = "10.10.0.0/16"
+					description = "custom range"
+				}
+				keepalive_interval = %d
+			}
+		}
+	`, testId, testId, keepalive)
+}
@@ -110,6 +110,14 @@ func resourceComputeSecurityPolicy() *schema.Resource {
 				},
 			},
 
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"CLOUD_ARMOR", "CLOUD_ARMOR_EDGE"}, false),
+			},
+
 			"fingerprint": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -142,15 +150,34 @@ func resourceComputeSecurityPolicyCreate(d *schema.ResourceData, meta interface{
 
 	log.Printf("[DEBUG] SecurityPolicy insert request: %#v", securityPolicy)
 
-	op, err := config.clientComputeBeta.SecurityPolicies.Insert(project, securityPolicy).Do()
+	var op *compute.Operation
+	if _, ok := d.GetOk("type"); ok {
+		// `type` isn't supported by the compute client yet, so the policy is
+		// inserted using a raw request.
+		obj, err := ConvertToMap(securityPolicy)
+		if err != nil {
+			return err
+		}
+		obj["type"] = d.Get("type").(string)
 
-	if err != nil {
-		return errwrap.Wrapf("Error creating SecurityPolicy: {{err}}", err)
+		res, err := sendRequestWithTimeout(config, "POST", project, fmt.Sprintf("%sprojects/%s/global/securityPolicies", config.ComputeBetaBasePath, project), obj, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return errwrap.Wrapf("Error creating SecurityPolicy: {{err}}", err)
+		}
+		op = &compute.Operation{}
+		if err := Convert(res, op); err != nil {
+			return err
+		}
+	} else {
+		op, err = config.clientComputeBeta.SecurityPolicies.Insert(project, securityPolicy).Do()
+		if err != nil {
+			return errwrap.Wrapf("Error creating SecurityPolicy: {{err}}", err)
+		}
 	}
 
 	d.SetId(securityPolicy.Name)
 
-	err = computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutCreate).Minutes()), fmt.Sprintf("Creating SecurityPolicy %q", sp))
+	err = computeSharedOperationWaitTime(config, op, project, int(d.Timeout(schema.TimeoutCreate).Minutes()), fmt.Sprintf("Creating SecurityPolicy %q", sp))
 	if err != nil {
 		return err
 	}
@@ -180,6 +207,14 @@ func resourceComputeSecurityPolicyRead(d *schema.ResourceData, meta interface{})
 	d.Set("project", project)
 	d.Set("self_link", ConvertSelfLinkToV1(securityPolicy.SelfLink))
 
+	// `type` isn't supported by the compute client yet, read it from the raw
+	// resource.
+	res, err := sendRequest(config, "GET", project, fmt.Sprintf("%sprojects/%s/global/securityPolicies/%s", config.ComputeBetaBasePath, project, d.Id()), nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("SecurityPolicy %q", d.Id()))
+	}
+	d.Set("type", res["type"])
+
 	return nil
 }
 
@@ -205,7 +240,7 @@ func resourceComputeSecurityPolicyUpdate(d *schema.ResourceData, meta interface{
 			return errwrap.Wrapf(fmt.Sprintf("Error updating SecurityPolicy %q: {{err}}", sp), err)
 		}
 
-		err = computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutCreate).Minutes()), fmt.Sprintf("Updating SecurityPolicy %q", sp))
+		err = computeSharedOperationWaitTime(config, op, project, int(d.Timeout(schema.TimeoutCreate).Minutes()), fmt.Sprintf("Updating SecurityPolicy %q", sp))
 		if err != nil {
 			return err
 		}
@@ -233,7 +268,7 @@ func resourceComputeSecurityPolicyUpdate(d *schema.ResourceData, meta interface{
 					return errwrap.Wrapf(fmt.Sprintf("Error updating SecurityPolicy %q: {{err}}", sp), err)
 				}
 
-				err = computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutCreate).Minutes()), fmt.Sprintf("Updating SecurityPolicy %q", sp))
+				err = computeSharedOperationWaitTime(config, op, project, int(d.Timeout(schema.TimeoutCreate).Minutes()), fmt.Sprintf("Updating SecurityPolicy %q", sp))
 				if err != nil {
 					return err
 				}
@@ -245,7 +280,7 @@ func resourceComputeSecurityPolicyUpdate(d *schema.ResourceData, meta interface{
 					return errwrap.Wrapf(fmt.Sprintf("Error updating SecurityPolicy %q: {{err}}", sp), err)
 				}
 
-				err = computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutCreate).Minutes()), fmt.Sprintf("Updating SecurityPolicy %q", sp))
+				err = computeSharedOperationWaitTime(config, op, project, int(d.Timeout(schema.TimeoutCreate).Minutes()), fmt.Sprintf("Updating SecurityPolicy %q", sp))
 				if err != nil {
 					return err
 				}
@@ -262,7 +297,7 @@ func resourceComputeSecurityPolicyUpdate(d *schema.ResourceData, meta interface{
 					return errwrap.Wrapf(fmt.Sprintf("Error updating SecurityPolicy %q: {{err}}", sp), err)
 				}
 
-				err = computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutCreate).Minutes()), fmt.Sprintf("Updating SecurityPolicy %q", sp))
+				err = computeSharedOperationWaitTime(config, op, project, int(d.Timeout(schema.TimeoutCreate).Minutes()), fmt.Sprintf("Updating SecurityPolicy %q", sp))
 				if err != nil {
 					return err
 				}
@@ -287,7 +322,7 @@ func resourceComputeSecurityPolicyDelete(d *schema.ResourceData, meta interface{
 		return errwrap.Wrapf("Error deleting SecurityPolicy: {{err}}", err)
 	}
 
-	err = computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutDelete).Minutes()), "Deleting SecurityPolicy")
+	err = computeSharedOperationWaitTime(config, op, project, int(d.Timeout(schema.TimeoutDelete).Minutes()), "Deleting SecurityPolicy")
 	if err != nil {
 		return err
 	}
@@ -91,7 +91,7 @@ func testAccCheckComputeSnapshotDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("ComputeSnapshot still exists at %s", url)
 		}
@@ -226,7 +226,7 @@ func testAccCheckComputeSslCertificateDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("ComputeSslCertificate still exists at %s", url)
 		}
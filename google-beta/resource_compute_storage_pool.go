@@ -0,0 +1,502 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/customdiff"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"google.golang.org/api/compute/v1"
+)
+
+func resourceComputeStoragePool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeStoragePoolCreate,
+		Read:   resourceComputeStoragePoolRead,
+		Update: resourceComputeStoragePoolUpdate,
+		Delete: resourceComputeStoragePoolDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeStoragePoolImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.All(
+			customdiff.ForceNewIfChange("pool_provisioned_capacity_gb", isDiskShrinkage),
+		),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"pool_provisioned_capacity_gb": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"storage_pool_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"capacity_provisioning_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"STANDARD", "ADVANCED", ""}, false),
+				Default:      "STANDARD",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"pool_provisioned_iops": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"pool_provisioned_throughput": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"zone": {
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"creation_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"self_link": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeStoragePoolCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	nameProp, err := expandComputeStoragePoolName(d.Get("name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("name"); !isEmptyValue(reflect.ValueOf(nameProp)) && (ok || !reflect.DeepEqual(v, nameProp)) {
+		obj["name"] = nameProp
+	}
+	descriptionProp, err := expandComputeStoragePoolDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	poolProvisionedCapacityGbProp, err := expandComputeStoragePoolPoolProvisionedCapacityGb(d.Get("pool_provisioned_capacity_gb"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("pool_provisioned_capacity_gb"); !isEmptyValue(reflect.ValueOf(poolProvisionedCapacityGbProp)) && (ok || !reflect.DeepEqual(v, poolProvisionedCapacityGbProp)) {
+		obj["poolProvisionedCapacityGb"] = poolProvisionedCapacityGbProp
+	}
+	poolProvisionedIopsProp, err := expandComputeStoragePoolPoolProvisionedIops(d.Get("pool_provisioned_iops"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("pool_provisioned_iops"); !isEmptyValue(reflect.ValueOf(poolProvisionedIopsProp)) && (ok || !reflect.DeepEqual(v, poolProvisionedIopsProp)) {
+		obj["poolProvisionedIops"] = poolProvisionedIopsProp
+	}
+	poolProvisionedThroughputProp, err := expandComputeStoragePoolPoolProvisionedThroughput(d.Get("pool_provisioned_throughput"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("pool_provisioned_throughput"); !isEmptyValue(reflect.ValueOf(poolProvisionedThroughputProp)) && (ok || !reflect.DeepEqual(v, poolProvisionedThroughputProp)) {
+		obj["poolProvisionedThroughput"] = poolProvisionedThroughputProp
+	}
+	storagePoolTypeProp, err := expandComputeStoragePoolStoragePoolType(d.Get("storage_pool_type"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("storage_pool_type"); !isEmptyValue(reflect.ValueOf(storagePoolTypeProp)) && (ok || !reflect.DeepEqual(v, storagePoolTypeProp)) {
+		obj["storagePoolType"] = storagePoolTypeProp
+	}
+	capacityProvisioningTypeProp, err := expandComputeStoragePoolCapacityProvisioningType(d.Get("capacity_provisioning_type"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("capacity_provisioning_type"); !isEmptyValue(reflect.ValueOf(capacityProvisioningTypeProp)) && (ok || !reflect.DeepEqual(v, capacityProvisioningTypeProp)) {
+		obj["capacityProvisioningType"] = capacityProvisioningTypeProp
+	}
+	zoneProp, err := expandComputeStoragePoolZone(d.Get("zone"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("zone"); !isEmptyValue(reflect.ValueOf(zoneProp)) && (ok || !reflect.DeepEqual(v, zoneProp)) {
+		obj["zone"] = zoneProp
+	}
+
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/zones/{{zone}}/storagePools")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new StoragePool: %#v", obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating StoragePool: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/zones/{{zone}}/storagePools/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	waitErr := computeOperationWaitTime(
+		config, op, project, "Creating StoragePool",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create StoragePool: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating StoragePool %q: %#v", d.Id(), res)
+
+	return resourceComputeStoragePoolRead(d, meta)
+}
+
+func resourceComputeStoragePoolRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/zones/{{zone}}/storagePools/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeStoragePool %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading StoragePool: %s", err)
+	}
+
+	if err := d.Set("creation_timestamp", flattenComputeStoragePoolCreationTimestamp(res["creationTimestamp"], d)); err != nil {
+		return fmt.Errorf("Error reading StoragePool: %s", err)
+	}
+	if err := d.Set("name", flattenComputeStoragePoolName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading StoragePool: %s", err)
+	}
+	if err := d.Set("description", flattenComputeStoragePoolDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading StoragePool: %s", err)
+	}
+	if err := d.Set("pool_provisioned_capacity_gb", flattenComputeStoragePoolPoolProvisionedCapacityGb(res["poolProvisionedCapacityGb"], d)); err != nil {
+		return fmt.Errorf("Error reading StoragePool: %s", err)
+	}
+	if err := d.Set("pool_provisioned_iops", flattenComputeStoragePoolPoolProvisionedIops(res["poolProvisionedIops"], d)); err != nil {
+		return fmt.Errorf("Error reading StoragePool: %s", err)
+	}
+	if err := d.Set("pool_provisioned_throughput", flattenComputeStoragePoolPoolProvisionedThroughput(res["poolProvisionedThroughput"], d)); err != nil {
+		return fmt.Errorf("Error reading StoragePool: %s", err)
+	}
+	if err := d.Set("storage_pool_type", flattenComputeStoragePoolStoragePoolType(res["storagePoolType"], d)); err != nil {
+		return fmt.Errorf("Error reading StoragePool: %s", err)
+	}
+	if err := d.Set("capacity_provisioning_type", flattenComputeStoragePoolCapacityProvisioningType(res["capacityProvisioningType"], d)); err != nil {
+		return fmt.Errorf("Error reading StoragePool: %s", err)
+	}
+	if err := d.Set("zone", flattenComputeStoragePoolZone(res["zone"], d)); err != nil {
+		return fmt.Errorf("Error reading StoragePool: %s", err)
+	}
+	if err := d.Set("self_link", ConvertSelfLinkToV1(res["selfLink"].(string))); err != nil {
+		return fmt.Errorf("Error reading StoragePool: %s", err)
+	}
+
+	return nil
+}
+
+func resourceComputeStoragePoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	poolProvisionedCapacityGbProp, err := expandComputeStoragePoolPoolProvisionedCapacityGb(d.Get("pool_provisioned_capacity_gb"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("pool_provisioned_capacity_gb"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, poolProvisionedCapacityGbProp)) {
+		obj["poolProvisionedCapacityGb"] = poolProvisionedCapacityGbProp
+	}
+	poolProvisionedIopsProp, err := expandComputeStoragePoolPoolProvisionedIops(d.Get("pool_provisioned_iops"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("pool_provisioned_iops"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, poolProvisionedIopsProp)) {
+		obj["poolProvisionedIops"] = poolProvisionedIopsProp
+	}
+	poolProvisionedThroughputProp, err := expandComputeStoragePoolPoolProvisionedThroughput(d.Get("pool_provisioned_throughput"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("pool_provisioned_throughput"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, poolProvisionedThroughputProp)) {
+		obj["poolProvisionedThroughput"] = poolProvisionedThroughputProp
+	}
+
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/zones/{{zone}}/storagePools/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	updateMask := []string{}
+
+	if d.HasChange("pool_provisioned_capacity_gb") {
+		updateMask = append(updateMask, "poolProvisionedCapacityGb")
+	}
+
+	if d.HasChange("pool_provisioned_iops") {
+		updateMask = append(updateMask, "poolProvisionedIops")
+	}
+
+	if d.HasChange("pool_provisioned_throughput") {
+		updateMask = append(updateMask, "poolProvisionedThroughput")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating StoragePool %q: %#v", d.Id(), obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating StoragePool %q: %s", d.Id(), err)
+	}
+
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	err = computeOperationWaitTime(
+		config, op, project, "Updating StoragePool",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceComputeStoragePoolRead(d, meta)
+}
+
+func resourceComputeStoragePoolDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/zones/{{zone}}/storagePools/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting StoragePool %q", d.Id())
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "StoragePool")
+	}
+
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	err = computeOperationWaitTime(
+		config, op, project, "Deleting StoragePool",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting StoragePool %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceComputeStoragePoolImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/storagePools/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<name>[^/]+)",
+		"(?P<zone>[^/]+)/(?P<name>[^/]+)",
+		"(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/zones/{{zone}}/storagePools/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenComputeStoragePoolCreationTimestamp(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeStoragePoolName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeStoragePoolDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeStoragePoolPoolProvisionedCapacityGb(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenComputeStoragePoolPoolProvisionedIops(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenComputeStoragePoolPoolProvisionedThroughput(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenComputeStoragePoolStoragePoolType(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	return NameFromSelfLinkStateFunc(v)
+}
+
+func flattenComputeStoragePoolCapacityProvisioningType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeStoragePoolZone(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	return NameFromSelfLinkStateFunc(v)
+}
+
+func expandComputeStoragePoolName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeStoragePoolDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeStoragePoolPoolProvisionedCapacityGb(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeStoragePoolPoolProvisionedIops(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeStoragePoolPoolProvisionedThroughput(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeStoragePoolStoragePoolType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	f, err := parseZonalFieldValue("storagePoolTypes", v.(string), "project", "zone", d, config, true)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid value for storage_pool_type: %s", err)
+	}
+	return f.RelativeLink(), nil
+}
+
+func expandComputeStoragePoolCapacityProvisioningType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeStoragePoolZone(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	f, err := parseGlobalFieldValue("zones", v.(string), "project", d, config, true)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid value for zone: %s", err)
+	}
+	return f.RelativeLink(), nil
+}
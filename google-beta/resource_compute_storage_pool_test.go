@@ -0,0 +1,96 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeStoragePool_advancedCapacityUpdate(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+		"capacity":      10240,
+	}
+	updated := map[string]interface{}{
+		"random_suffix": context["random_suffix"],
+		"capacity":      11264,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeStoragePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeStoragePool_advancedCapacity(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_storage_pool.pool", "capacity_provisioning_type", "ADVANCED"),
+					resource.TestCheckResourceAttr("google_compute_storage_pool.pool", "pool_provisioned_capacity_gb", "10240"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_storage_pool.pool",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeStoragePool_advancedCapacity(updated),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_storage_pool.pool", "pool_provisioned_capacity_gb", "11264"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_storage_pool.pool",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccComputeStoragePool_advancedCapacity(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_storage_pool" "pool" {
+  name = "tf-test-storage-pool-%{random_suffix}"
+  zone = "us-central1-a"
+
+  storage_pool_type          = "hyperdisk-balanced"
+  capacity_provisioning_type = "ADVANCED"
+
+  pool_provisioned_capacity_gb = %{capacity}
+  pool_provisioned_iops        = 10000
+  pool_provisioned_throughput  = 1024
+}
+`, context)
+}
+
+func testAccCheckComputeStoragePoolDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_storage_pool" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(config, rs, "{{ComputeBasePath}}projects/{{project}}/zones/{{zone}}/storagePools/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("ComputeStoragePool still exists at %s", url)
+		}
+	}
+
+	return nil
+}
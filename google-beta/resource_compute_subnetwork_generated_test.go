@@ -133,7 +133,7 @@ func testAccCheckComputeSubnetworkDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("ComputeSubnetwork still exists at %s", url)
 		}
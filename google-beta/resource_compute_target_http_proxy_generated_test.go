@@ -109,7 +109,7 @@ func testAccCheckComputeTargetHttpProxyDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("ComputeTargetHttpProxy still exists at %s", url)
 		}
@@ -118,7 +118,7 @@ func testAccCheckComputeTargetHttpsProxyDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("ComputeTargetHttpsProxy still exists at %s", url)
 		}
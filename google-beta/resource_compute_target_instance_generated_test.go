@@ -94,7 +94,7 @@ func testAccCheckComputeTargetInstanceDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("ComputeTargetInstance still exists at %s", url)
 		}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"google.golang.org/api/compute/v1"
 	"google.golang.org/api/googleapi"
 )
@@ -23,6 +24,8 @@ func resourceComputeTargetPool() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: targetPoolFailoverRatioCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -43,9 +46,10 @@ func resourceComputeTargetPool() *schema.Resource {
 			},
 
 			"failover_ratio": {
-				Type:     schema.TypeFloat,
-				Optional: true,
-				ForceNew: true,
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ForceNew:     false,
+				ValidateFunc: validation.FloatBetween(0, 1),
 			},
 
 			"health_checks": {
@@ -95,15 +99,34 @@ func resourceComputeTargetPool() *schema.Resource {
 			},
 
 			"session_affinity": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-				Default:  "NONE",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "NONE",
+				ValidateFunc: validation.StringInSlice([]string{"NONE", "CLIENT_IP", "CLIENT_IP_PROTO"}, false),
 			},
 		},
 	}
 }
 
+// failover_ratio only has an effect when a backup_pool is configured, and the
+// API rejects it otherwise.
+func targetPoolFailoverRatioCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	if _, ok := diff.GetOk("failover_ratio"); !ok {
+		return nil
+	}
+
+	// The backup pool may be a reference to a pool that doesn't exist yet
+	if !diff.NewValueKnown("backup_pool") {
+		return nil
+	}
+
+	if diff.Get("backup_pool").(string) == "" {
+		return fmt.Errorf("Error in Target Pool %s: failover_ratio can only be set when backup_pool is set.", diff.Get("name"))
+	}
+	return nil
+}
+
 func canonicalizeInstanceRef(instanceRef string) string {
 	// instances can also be specified in the config as a URL or <zone>/<project>
 	parts := instancesSelfLinkPattern.FindStringSubmatch(instanceRef)
@@ -204,7 +227,7 @@ func resourceComputeTargetPoolCreate(d *schema.ResourceData, meta interface{}) e
 	// It probably maybe worked, so store the ID now
 	d.SetId(tpool.Name)
 
-	err = computeOperationWait(config.clientCompute, op, project, "Creating Target Pool")
+	err = computeOperationWait(config, op, project, "Creating Target Pool")
 	if err != nil {
 		return err
 	}
@@ -281,7 +304,7 @@ func resourceComputeTargetPoolUpdate(d *schema.ResourceData, meta interface{}) e
 			return fmt.Errorf("Error updating health_check: %s", err)
 		}
 
-		err = computeOperationWait(config.clientCompute, op, project, "Updating Target Pool")
+		err = computeOperationWait(config, op, project, "Updating Target Pool")
 		if err != nil {
 			return err
 		}
@@ -297,7 +320,7 @@ func resourceComputeTargetPoolUpdate(d *schema.ResourceData, meta interface{}) e
 			return fmt.Errorf("Error updating health_check: %s", err)
 		}
 
-		err = computeOperationWait(config.clientCompute, op, project, "Updating Target Pool")
+		err = computeOperationWait(config, op, project, "Updating Target Pool")
 		if err != nil {
 			return err
 		}
@@ -331,7 +354,7 @@ func resourceComputeTargetPoolUpdate(d *schema.ResourceData, meta interface{}) e
 			return fmt.Errorf("Error updating instances: %s", err)
 		}
 
-		err = computeOperationWait(config.clientCompute, op, project, "Updating Target Pool")
+		err = computeOperationWait(config, op, project, "Updating Target Pool")
 		if err != nil {
 			return err
 		}
@@ -346,29 +369,33 @@ func resourceComputeTargetPoolUpdate(d *schema.ResourceData, meta interface{}) e
 		if err != nil {
 			return fmt.Errorf("Error updating instances: %s", err)
 		}
-		err = computeOperationWait(config.clientCompute, op, project, "Updating Target Pool")
+		err = computeOperationWait(config, op, project, "Updating Target Pool")
 		if err != nil {
 			return err
 		}
 		d.SetPartial("instances")
 	}
 
-	if d.HasChange("backup_pool") {
+	if d.HasChange("backup_pool") || d.HasChange("failover_ratio") {
 		bpool_name := d.Get("backup_pool").(string)
 		tref := &compute.TargetReference{
 			Target: bpool_name,
 		}
-		op, err := config.clientCompute.TargetPools.SetBackup(
-			project, region, d.Id(), tref).Do()
+		call := config.clientCompute.TargetPools.SetBackup(project, region, d.Id(), tref)
+		if v, ok := d.GetOk("failover_ratio"); ok {
+			call = call.FailoverRatio(v.(float64))
+		}
+		op, err := call.Do()
 		if err != nil {
 			return fmt.Errorf("Error updating backup_pool: %s", err)
 		}
 
-		err = computeOperationWait(config.clientCompute, op, project, "Updating Target Pool")
+		err = computeOperationWait(config, op, project, "Updating Target Pool")
 		if err != nil {
 			return err
 		}
 		d.SetPartial("backup_pool")
+		d.SetPartial("failover_ratio")
 	}
 
 	d.Partial(false)
@@ -442,7 +469,7 @@ func resourceComputeTargetPoolDelete(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error deleting TargetPool: %s", err)
 	}
 
-	err = computeOperationWait(config.clientCompute, op, project, "Deleting Target Pool")
+	err = computeOperationWait(config, op, project, "Deleting Target Pool")
 	if err != nil {
 		return err
 	}
@@ -80,6 +80,51 @@ func TestAccComputeTargetPool_update(t *testing.T) {
 	})
 }
 
+func TestAccComputeTargetPool_backupPoolAddInstance(t *testing.T) {
+	t.Parallel()
+
+	var id uint64
+	tpname := fmt.Sprintf("tptest-%s", acctest.RandString(10))
+	backupName := fmt.Sprintf("tptest-%s", acctest.RandString(10))
+	instanceName := fmt.Sprintf("tptest-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeTargetPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeTargetPool_backupPool(tpname, backupName, instanceName, "", 0.5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeTargetPoolUnchanged("google_compute_target_pool.foo", &id),
+					resource.TestCheckResourceAttr("google_compute_target_pool.foo", "session_affinity", "CLIENT_IP"),
+					resource.TestCheckResourceAttr("google_compute_target_pool.foo", "failover_ratio", "0.5"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_target_pool.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Adding an instance and changing the failover ratio must not recreate the pool
+				Config: testAccComputeTargetPool_backupPool(tpname, backupName, instanceName,
+					`"${google_compute_instance.foo.self_link}"`, 0.7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeTargetPoolUnchanged("google_compute_target_pool.foo", &id),
+					resource.TestCheckResourceAttr("google_compute_target_pool.foo", "instances.#", "1"),
+					resource.TestCheckResourceAttr("google_compute_target_pool.foo", "failover_ratio", "0.7"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_target_pool.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckComputeTargetPoolDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 
@@ -125,6 +170,34 @@ func testAccCheckComputeTargetPoolExists(n string) resource.TestCheckFunc {
 	}
 }
 
+// testAccCheckComputeTargetPoolUnchanged stores the server-assigned id of the
+// target pool on its first call and fails if it differs on later calls, which
+// means the pool was recreated.
+func testAccCheckComputeTargetPoolUnchanged(n string, id *uint64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		found, err := config.clientCompute.TargetPools.Get(
+			config.Project, config.Region, rs.Primary.ID).Do()
+		if err != nil {
+			return err
+		}
+
+		if *id == 0 {
+			*id = found.Id
+		} else if found.Id != *id {
+			return fmt.Errorf("TargetPool was recreated: id changed from %d to %d", *id, found.Id)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckComputeTargetPoolHealthCheck(targetPool, healthCheck string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		targetPoolRes, ok := s.RootModule().Resources[targetPool]
@@ -234,3 +307,37 @@ resource "google_compute_instance" "bar" {
 }
 `, tpname, instances, name1, name2)
 }
+
+func testAccComputeTargetPool_backupPool(tpname, backupName, instanceName, instances string, failoverRatio float64) string {
+	return fmt.Sprintf(`
+resource "google_compute_target_pool" "foo" {
+	description      = "Resource created for Terraform acceptance testing"
+	name             = "%s"
+	instances        = [%s]
+	session_affinity = "CLIENT_IP"
+	backup_pool      = "${google_compute_target_pool.backup.self_link}"
+	failover_ratio   = %f
+}
+
+resource "google_compute_target_pool" "backup" {
+	description = "Resource created for Terraform acceptance testing"
+	name        = "%s"
+}
+
+resource "google_compute_instance" "foo" {
+	name         = "%s"
+	machine_type = "n1-standard-1"
+	zone         = "us-central1-a"
+
+	boot_disk {
+		initialize_params {
+			image = "debian-cloud/debian-9"
+		}
+	}
+
+	network_interface {
+		network = "default"
+	}
+}
+`, tpname, instances, failoverRatio, backupName, instanceName)
+}
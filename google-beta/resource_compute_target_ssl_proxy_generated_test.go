@@ -95,7 +95,7 @@ func testAccCheckComputeTargetSslProxyDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("ComputeTargetSslProxy still exists at %s", url)
 		}
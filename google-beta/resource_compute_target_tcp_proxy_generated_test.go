@@ -91,7 +91,7 @@ func testAccCheckComputeTargetTcpProxyDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("ComputeTargetTcpProxy still exists at %s", url)
 		}
@@ -142,7 +142,7 @@ func testAccCheckComputeUrlMapDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("ComputeUrlMap still exists at %s", url)
 		}
@@ -95,6 +95,36 @@ func TestAccComputeUrlMap_noPathRulesWithUpdate(t *testing.T) {
 	})
 }
 
+func TestAccComputeUrlMap_routeRulesFaultInjection(t *testing.T) {
+	t.Parallel()
+
+	bsName := fmt.Sprintf("urlmap-test-%s", acctest.RandString(10))
+	hcName := fmt.Sprintf("urlmap-test-%s", acctest.RandString(10))
+	umName := fmt.Sprintf("urlmap-test-%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeUrlMapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeUrlMap_routeRulesFaultInjection(bsName, hcName, umName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeUrlMapExists(
+						"google_compute_url_map.foobar"),
+					resource.TestCheckResourceAttr("google_compute_url_map.foobar", "path_matcher.0.route_rules.0.route_action.0.fault_injection_policy.0.abort.0.http_status", "503"),
+					resource.TestCheckResourceAttr("google_compute_url_map.foobar", "path_matcher.0.route_rules.0.route_action.0.fault_injection_policy.0.abort.0.percentage", "2"),
+					resource.TestCheckResourceAttr("google_compute_url_map.foobar", "path_matcher.0.route_rules.0.route_action.0.fault_injection_policy.0.delay.0.fixed_delay.0.seconds", "1"),
+				),
+			},
+			{
+				ResourceName:      "google_compute_url_map.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckComputeUrlMapExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -363,3 +393,68 @@ resource "google_compute_url_map" "foobar" {
 }
 `, bsName, hcName, umName)
 }
+
+func testAccComputeUrlMap_routeRulesFaultInjection(bsName, hcName, umName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_backend_service" "foobar" {
+	name                  = "urlmap-test-%s"
+	load_balancing_scheme = "INTERNAL_SELF_MANAGED"
+	health_checks         = ["${google_compute_health_check.zero.self_link}"]
+}
+
+resource "google_compute_health_check" "zero" {
+	name               = "urlmap-test-%s"
+	check_interval_sec = 1
+	timeout_sec        = 1
+
+	http_health_check {
+		port = 80
+	}
+}
+
+resource "google_compute_url_map" "foobar" {
+	name            = "urlmap-test-%s"
+	default_service = "${google_compute_backend_service.foobar.self_link}"
+
+	host_rule {
+		hosts        = ["mysite.com"]
+		path_matcher = "chaos"
+	}
+
+	path_matcher {
+		default_service = "${google_compute_backend_service.foobar.self_link}"
+		name            = "chaos"
+
+		route_rules {
+			priority = 1
+			service  = "${google_compute_backend_service.foobar.self_link}"
+
+			match_rules {
+				prefix_match = "/"
+			}
+
+			route_action {
+				fault_injection_policy {
+					abort {
+						http_status = 503
+						percentage  = 2
+					}
+
+					delay {
+						fixed_delay {
+							seconds = "1"
+							nanos   = 500000000
+						}
+						percentage = 50
+					}
+				}
+
+				timeout {
+					seconds = "30"
+				}
+			}
+		}
+	}
+}
+`, bsName, hcName, umName)
+}
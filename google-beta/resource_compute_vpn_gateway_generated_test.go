@@ -127,7 +127,7 @@ func testAccCheckComputeVpnGatewayDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("ComputeVpnGateway still exists at %s", url)
 		}
@@ -18,10 +18,12 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceContainerAnalysisNote() *schema.Resource {
@@ -35,6 +37,8 @@ func resourceContainerAnalysisNote() *schema.Resource {
 			State: resourceContainerAnalysisNoteImport,
 		},
 
+		CustomizeDiff: containerAnalysisNoteKindCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(4 * time.Minute),
 			Update: schema.DefaultTimeout(4 * time.Minute),
@@ -44,7 +48,7 @@ func resourceContainerAnalysisNote() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"attestation_authority": {
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -64,11 +68,148 @@ func resourceContainerAnalysisNote() *schema.Resource {
 					},
 				},
 			},
+			"build": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"builder_version": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"signature": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"signature": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"key_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"key_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"PGP_ASCII_ARMORED", "PKIX_PEM", ""}, false),
+									},
+									"public_key": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			"vulnerability": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cvss_score": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+						},
+						"details": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cpe_uri": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"package": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"description": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"max_affected_version": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"kind": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice([]string{"NORMAL", "MINIMUM", "MAXIMUM"}, false),
+												},
+												"epoch": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+												"name": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"revision": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"min_affected_version": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"kind": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice([]string{"NORMAL", "MINIMUM", "MAXIMUM"}, false),
+												},
+												"epoch": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+												"name": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"revision": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"package_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"severity_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"severity": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"MINIMAL", "LOW", "MEDIUM", "HIGH", "CRITICAL", ""}, false),
+						},
+					},
+				},
+			},
 			"project": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -79,6 +220,25 @@ func resourceContainerAnalysisNote() *schema.Resource {
 	}
 }
 
+func containerAnalysisNoteKindCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	// separate func to allow unit testing
+	return containerAnalysisNoteKindCustomizeDiffFunc(diff)
+}
+
+// A note is of exactly one kind, which is set by one of the kind blocks.
+func containerAnalysisNoteKindCustomizeDiffFunc(diff TerraformResourceDiff) error {
+	count := 0
+	for _, key := range []string{"attestation_authority", "vulnerability", "build"} {
+		_, n := diff.GetChange(key + ".#")
+		c, _ := n.(int)
+		count += c
+	}
+	if count != 1 {
+		return fmt.Errorf("exactly one of attestation_authority, vulnerability or build must be set")
+	}
+	return nil
+}
+
 func resourceContainerAnalysisNoteCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -95,6 +255,18 @@ func resourceContainerAnalysisNoteCreate(d *schema.ResourceData, meta interface{
 	} else if v, ok := d.GetOkExists("attestation_authority"); !isEmptyValue(reflect.ValueOf(attestationAuthorityProp)) && (ok || !reflect.DeepEqual(v, attestationAuthorityProp)) {
 		obj["attestationAuthority"] = attestationAuthorityProp
 	}
+	vulnerabilityProp, err := expandContainerAnalysisNoteVulnerability(d.Get("vulnerability"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("vulnerability"); !isEmptyValue(reflect.ValueOf(vulnerabilityProp)) && (ok || !reflect.DeepEqual(v, vulnerabilityProp)) {
+		obj["vulnerability"] = vulnerabilityProp
+	}
+	buildProp, err := expandContainerAnalysisNoteBuild(d.Get("build"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("build"); !isEmptyValue(reflect.ValueOf(buildProp)) && (ok || !reflect.DeepEqual(v, buildProp)) {
+		obj["build"] = buildProp
+	}
 
 	url, err := replaceVars(d, config, "{{ContainerAnalysisBasePath}}projects/{{project}}/notes?noteId={{name}}")
 	if err != nil {
@@ -102,7 +274,12 @@ func resourceContainerAnalysisNoteCreate(d *schema.ResourceData, meta interface{
 	}
 
 	log.Printf("[DEBUG] Creating new Note: %#v", obj)
-	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("Error creating Note: %s", err)
 	}
@@ -127,15 +304,16 @@ func resourceContainerAnalysisNoteRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	res, err := sendRequest(config, "GET", url, nil)
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("ContainerAnalysisNote %q", d.Id()))
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequest(config, "GET", project, url, nil)
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, fmt.Sprintf("ContainerAnalysisNote %q", d.Id()))
 	}
+
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("Error reading Note: %s", err)
 	}
@@ -146,6 +324,12 @@ func resourceContainerAnalysisNoteRead(d *schema.ResourceData, meta interface{})
 	if err := d.Set("attestation_authority", flattenContainerAnalysisNoteAttestationAuthority(res["attestationAuthority"], d)); err != nil {
 		return fmt.Errorf("Error reading Note: %s", err)
 	}
+	if err := d.Set("vulnerability", flattenContainerAnalysisNoteVulnerability(res["vulnerability"], d)); err != nil {
+		return fmt.Errorf("Error reading Note: %s", err)
+	}
+	if err := d.Set("build", flattenContainerAnalysisNoteBuild(res["build"], d)); err != nil {
+		return fmt.Errorf("Error reading Note: %s", err)
+	}
 
 	return nil
 }
@@ -160,6 +344,18 @@ func resourceContainerAnalysisNoteUpdate(d *schema.ResourceData, meta interface{
 	} else if v, ok := d.GetOkExists("attestation_authority"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, attestationAuthorityProp)) {
 		obj["attestationAuthority"] = attestationAuthorityProp
 	}
+	vulnerabilityProp, err := expandContainerAnalysisNoteVulnerability(d.Get("vulnerability"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("vulnerability"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, vulnerabilityProp)) {
+		obj["vulnerability"] = vulnerabilityProp
+	}
+	buildProp, err := expandContainerAnalysisNoteBuild(d.Get("build"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("build"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, buildProp)) {
+		obj["build"] = buildProp
+	}
 
 	url, err := replaceVars(d, config, "{{ContainerAnalysisBasePath}}projects/{{project}}/notes/{{name}}")
 	if err != nil {
@@ -171,13 +367,24 @@ func resourceContainerAnalysisNoteUpdate(d *schema.ResourceData, meta interface{
 	if d.HasChange("attestation_authority.0.hint.0.human_readable_name") {
 		updateMask = append(updateMask, "attestationAuthority.hint.humanReadableName")
 	}
+	if d.HasChange("vulnerability") {
+		updateMask = append(updateMask, "vulnerability")
+	}
+	if d.HasChange("build") {
+		updateMask = append(updateMask, "build")
+	}
 	// updateMask is a URL parameter but not present in the schema, so replaceVars
 	// won't set it
 	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
 	if err != nil {
 		return err
 	}
-	_, err = sendRequestWithTimeout(config, "PATCH", url, obj, d.Timeout(schema.TimeoutUpdate))
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	_, err = sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
 
 	if err != nil {
 		return fmt.Errorf("Error updating Note %q: %s", d.Id(), err)
@@ -196,7 +403,12 @@ func resourceContainerAnalysisNoteDelete(d *schema.ResourceData, meta interface{
 
 	var obj map[string]interface{}
 	log.Printf("[DEBUG] Deleting Note %q", d.Id())
-	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return handleNotFoundError(err, d, "Note")
 	}
@@ -261,6 +473,205 @@ func flattenContainerAnalysisNoteAttestationAuthorityHint(v interface{}, d *sche
 func flattenContainerAnalysisNoteAttestationAuthorityHintHumanReadableName(v interface{}, d *schema.ResourceData) interface{} {
 	return v
 }
+func flattenContainerAnalysisNoteVulnerability(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["cvss_score"] =
+		flattenContainerAnalysisNoteVulnerabilityCvssScore(original["cvssScore"], d)
+	transformed["details"] =
+		flattenContainerAnalysisNoteVulnerabilityDetails(original["details"], d)
+	transformed["severity"] =
+		flattenContainerAnalysisNoteVulnerabilitySeverity(original["severity"], d)
+	return []interface{}{transformed}
+}
+func flattenContainerAnalysisNoteVulnerabilityCvssScore(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+func flattenContainerAnalysisNoteVulnerabilityDetails(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"cpe_uri":              flattenContainerAnalysisNoteVulnerabilityDetailsCpeUri(original["cpeUri"], d),
+			"package":              flattenContainerAnalysisNoteVulnerabilityDetailsPackage(original["package"], d),
+			"description":          flattenContainerAnalysisNoteVulnerabilityDetailsDescription(original["description"], d),
+			"max_affected_version": flattenContainerAnalysisNoteVulnerabilityDetailsMaxAffectedVersion(original["maxAffectedVersion"], d),
+			"min_affected_version": flattenContainerAnalysisNoteVulnerabilityDetailsMinAffectedVersion(original["minAffectedVersion"], d),
+			"package_type":         flattenContainerAnalysisNoteVulnerabilityDetailsPackageType(original["packageType"], d),
+			"severity_name":        flattenContainerAnalysisNoteVulnerabilityDetailsSeverityName(original["severityName"], d),
+		})
+	}
+	return transformed
+}
+func flattenContainerAnalysisNoteVulnerabilityDetailsCpeUri(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+func flattenContainerAnalysisNoteVulnerabilityDetailsPackage(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+func flattenContainerAnalysisNoteVulnerabilityDetailsDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+func flattenContainerAnalysisNoteVulnerabilityDetailsMaxAffectedVersion(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["kind"] =
+		flattenContainerAnalysisNoteVulnerabilityDetailsMaxAffectedVersionKind(original["kind"], d)
+	transformed["epoch"] =
+		flattenContainerAnalysisNoteVulnerabilityDetailsMaxAffectedVersionEpoch(original["epoch"], d)
+	transformed["name"] =
+		flattenContainerAnalysisNoteVulnerabilityDetailsMaxAffectedVersionName(original["name"], d)
+	transformed["revision"] =
+		flattenContainerAnalysisNoteVulnerabilityDetailsMaxAffectedVersionRevision(original["revision"], d)
+	return []interface{}{transformed}
+}
+func flattenContainerAnalysisNoteVulnerabilityDetailsMaxAffectedVersionKind(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+func flattenContainerAnalysisNoteVulnerabilityDetailsMaxAffectedVersionEpoch(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+
+	// number values are represented as float64
+	if floatVal, ok := v.(float64); ok {
+		intVal := int(floatVal)
+		return intVal
+	}
+
+	return v // let terraform core handle it otherwise
+}
+func flattenContainerAnalysisNoteVulnerabilityDetailsMaxAffectedVersionName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+func flattenContainerAnalysisNoteVulnerabilityDetailsMaxAffectedVersionRevision(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+func flattenContainerAnalysisNoteVulnerabilityDetailsMinAffectedVersion(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["kind"] =
+		flattenContainerAnalysisNoteVulnerabilityDetailsMinAffectedVersionKind(original["kind"], d)
+	transformed["epoch"] =
+		flattenContainerAnalysisNoteVulnerabilityDetailsMinAffectedVersionEpoch(original["epoch"], d)
+	transformed["name"] =
+		flattenContainerAnalysisNoteVulnerabilityDetailsMinAffectedVersionName(original["name"], d)
+	transformed["revision"] =
+		flattenContainerAnalysisNoteVulnerabilityDetailsMinAffectedVersionRevision(original["revision"], d)
+	return []interface{}{transformed}
+}
+func flattenContainerAnalysisNoteVulnerabilityDetailsMinAffectedVersionKind(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+func flattenContainerAnalysisNoteVulnerabilityDetailsMinAffectedVersionEpoch(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+
+	// number values are represented as float64
+	if floatVal, ok := v.(float64); ok {
+		intVal := int(floatVal)
+		return intVal
+	}
+
+	return v // let terraform core handle it otherwise
+}
+func flattenContainerAnalysisNoteVulnerabilityDetailsMinAffectedVersionName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+func flattenContainerAnalysisNoteVulnerabilityDetailsMinAffectedVersionRevision(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+func flattenContainerAnalysisNoteVulnerabilityDetailsPackageType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+func flattenContainerAnalysisNoteVulnerabilityDetailsSeverityName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+func flattenContainerAnalysisNoteVulnerabilitySeverity(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+func flattenContainerAnalysisNoteBuild(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["builder_version"] =
+		flattenContainerAnalysisNoteBuildBuilderVersion(original["builderVersion"], d)
+	transformed["signature"] =
+		flattenContainerAnalysisNoteBuildSignature(original["signature"], d)
+	return []interface{}{transformed}
+}
+func flattenContainerAnalysisNoteBuildBuilderVersion(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+func flattenContainerAnalysisNoteBuildSignature(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["signature"] =
+		flattenContainerAnalysisNoteBuildSignatureSignature(original["signature"], d)
+	transformed["key_id"] =
+		flattenContainerAnalysisNoteBuildSignatureKeyId(original["keyId"], d)
+	transformed["key_type"] =
+		flattenContainerAnalysisNoteBuildSignatureKeyType(original["keyType"], d)
+	transformed["public_key"] =
+		flattenContainerAnalysisNoteBuildSignaturePublicKey(original["publicKey"], d)
+	return []interface{}{transformed}
+}
+func flattenContainerAnalysisNoteBuildSignatureSignature(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+func flattenContainerAnalysisNoteBuildSignatureKeyId(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+func flattenContainerAnalysisNoteBuildSignatureKeyType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+func flattenContainerAnalysisNoteBuildSignaturePublicKey(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
 
 func expandContainerAnalysisNoteName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
 	return v, nil
@@ -307,3 +718,326 @@ func expandContainerAnalysisNoteAttestationAuthorityHint(v interface{}, d Terraf
 func expandContainerAnalysisNoteAttestationAuthorityHintHumanReadableName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
 	return v, nil
 }
+
+func expandContainerAnalysisNoteVulnerability(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedCvssScore, err := expandContainerAnalysisNoteVulnerabilityCvssScore(original["cvss_score"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedCvssScore); val.IsValid() && !isEmptyValue(val) {
+		transformed["cvssScore"] = transformedCvssScore
+	}
+
+	transformedDetails, err := expandContainerAnalysisNoteVulnerabilityDetails(original["details"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDetails); val.IsValid() && !isEmptyValue(val) {
+		transformed["details"] = transformedDetails
+	}
+
+	transformedSeverity, err := expandContainerAnalysisNoteVulnerabilitySeverity(original["severity"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSeverity); val.IsValid() && !isEmptyValue(val) {
+		transformed["severity"] = transformedSeverity
+	}
+
+	return transformed, nil
+}
+
+func expandContainerAnalysisNoteVulnerabilityCvssScore(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandContainerAnalysisNoteVulnerabilityDetails(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedCpeUri, err := expandContainerAnalysisNoteVulnerabilityDetailsCpeUri(original["cpe_uri"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedCpeUri); val.IsValid() && !isEmptyValue(val) {
+			transformed["cpeUri"] = transformedCpeUri
+		}
+
+		transformedPackage, err := expandContainerAnalysisNoteVulnerabilityDetailsPackage(original["package"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedPackage); val.IsValid() && !isEmptyValue(val) {
+			transformed["package"] = transformedPackage
+		}
+
+		transformedDescription, err := expandContainerAnalysisNoteVulnerabilityDetailsDescription(original["description"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedDescription); val.IsValid() && !isEmptyValue(val) {
+			transformed["description"] = transformedDescription
+		}
+
+		transformedMaxAffectedVersion, err := expandContainerAnalysisNoteVulnerabilityDetailsMaxAffectedVersion(original["max_affected_version"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedMaxAffectedVersion); val.IsValid() && !isEmptyValue(val) {
+			transformed["maxAffectedVersion"] = transformedMaxAffectedVersion
+		}
+
+		transformedMinAffectedVersion, err := expandContainerAnalysisNoteVulnerabilityDetailsMinAffectedVersion(original["min_affected_version"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedMinAffectedVersion); val.IsValid() && !isEmptyValue(val) {
+			transformed["minAffectedVersion"] = transformedMinAffectedVersion
+		}
+
+		transformedPackageType, err := expandContainerAnalysisNoteVulnerabilityDetailsPackageType(original["package_type"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedPackageType); val.IsValid() && !isEmptyValue(val) {
+			transformed["packageType"] = transformedPackageType
+		}
+
+		transformedSeverityName, err := expandContainerAnalysisNoteVulnerabilityDetailsSeverityName(original["severity_name"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedSeverityName); val.IsValid() && !isEmptyValue(val) {
+			transformed["severityName"] = transformedSeverityName
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandContainerAnalysisNoteVulnerabilityDetailsCpeUri(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandContainerAnalysisNoteVulnerabilityDetailsPackage(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandContainerAnalysisNoteVulnerabilityDetailsDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandContainerAnalysisNoteVulnerabilityDetailsMaxAffectedVersion(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedKind, err := expandContainerAnalysisNoteVulnerabilityDetailsMaxAffectedVersionKind(original["kind"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedKind); val.IsValid() && !isEmptyValue(val) {
+		transformed["kind"] = transformedKind
+	}
+
+	transformedEpoch, err := expandContainerAnalysisNoteVulnerabilityDetailsMaxAffectedVersionEpoch(original["epoch"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedEpoch); val.IsValid() && !isEmptyValue(val) {
+		transformed["epoch"] = transformedEpoch
+	}
+
+	transformedName, err := expandContainerAnalysisNoteVulnerabilityDetailsMaxAffectedVersionName(original["name"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedName); val.IsValid() && !isEmptyValue(val) {
+		transformed["name"] = transformedName
+	}
+
+	transformedRevision, err := expandContainerAnalysisNoteVulnerabilityDetailsMaxAffectedVersionRevision(original["revision"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRevision); val.IsValid() && !isEmptyValue(val) {
+		transformed["revision"] = transformedRevision
+	}
+
+	return transformed, nil
+}
+
+func expandContainerAnalysisNoteVulnerabilityDetailsMaxAffectedVersionKind(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandContainerAnalysisNoteVulnerabilityDetailsMaxAffectedVersionEpoch(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandContainerAnalysisNoteVulnerabilityDetailsMaxAffectedVersionName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandContainerAnalysisNoteVulnerabilityDetailsMaxAffectedVersionRevision(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandContainerAnalysisNoteVulnerabilityDetailsMinAffectedVersion(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedKind, err := expandContainerAnalysisNoteVulnerabilityDetailsMinAffectedVersionKind(original["kind"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedKind); val.IsValid() && !isEmptyValue(val) {
+		transformed["kind"] = transformedKind
+	}
+
+	transformedEpoch, err := expandContainerAnalysisNoteVulnerabilityDetailsMinAffectedVersionEpoch(original["epoch"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedEpoch); val.IsValid() && !isEmptyValue(val) {
+		transformed["epoch"] = transformedEpoch
+	}
+
+	transformedName, err := expandContainerAnalysisNoteVulnerabilityDetailsMinAffectedVersionName(original["name"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedName); val.IsValid() && !isEmptyValue(val) {
+		transformed["name"] = transformedName
+	}
+
+	transformedRevision, err := expandContainerAnalysisNoteVulnerabilityDetailsMinAffectedVersionRevision(original["revision"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRevision); val.IsValid() && !isEmptyValue(val) {
+		transformed["revision"] = transformedRevision
+	}
+
+	return transformed, nil
+}
+
+func expandContainerAnalysisNoteVulnerabilityDetailsMinAffectedVersionKind(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandContainerAnalysisNoteVulnerabilityDetailsMinAffectedVersionEpoch(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandContainerAnalysisNoteVulnerabilityDetailsMinAffectedVersionName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandContainerAnalysisNoteVulnerabilityDetailsMinAffectedVersionRevision(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandContainerAnalysisNoteVulnerabilityDetailsPackageType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandContainerAnalysisNoteVulnerabilityDetailsSeverityName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandContainerAnalysisNoteVulnerabilitySeverity(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandContainerAnalysisNoteBuild(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedBuilderVersion, err := expandContainerAnalysisNoteBuildBuilderVersion(original["builder_version"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedBuilderVersion); val.IsValid() && !isEmptyValue(val) {
+		transformed["builderVersion"] = transformedBuilderVersion
+	}
+
+	transformedSignature, err := expandContainerAnalysisNoteBuildSignature(original["signature"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSignature); val.IsValid() && !isEmptyValue(val) {
+		transformed["signature"] = transformedSignature
+	}
+
+	return transformed, nil
+}
+
+func expandContainerAnalysisNoteBuildBuilderVersion(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandContainerAnalysisNoteBuildSignature(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedSignature, err := expandContainerAnalysisNoteBuildSignatureSignature(original["signature"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSignature); val.IsValid() && !isEmptyValue(val) {
+		transformed["signature"] = transformedSignature
+	}
+
+	transformedKeyId, err := expandContainerAnalysisNoteBuildSignatureKeyId(original["key_id"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedKeyId); val.IsValid() && !isEmptyValue(val) {
+		transformed["keyId"] = transformedKeyId
+	}
+
+	transformedKeyType, err := expandContainerAnalysisNoteBuildSignatureKeyType(original["key_type"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedKeyType); val.IsValid() && !isEmptyValue(val) {
+		transformed["keyType"] = transformedKeyType
+	}
+
+	transformedPublicKey, err := expandContainerAnalysisNoteBuildSignaturePublicKey(original["public_key"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedPublicKey); val.IsValid() && !isEmptyValue(val) {
+		transformed["publicKey"] = transformedPublicKey
+	}
+
+	return transformed, nil
+}
+
+func expandContainerAnalysisNoteBuildSignatureSignature(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandContainerAnalysisNoteBuildSignatureKeyId(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandContainerAnalysisNoteBuildSignatureKeyType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandContainerAnalysisNoteBuildSignaturePublicKey(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,529 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceContainerAnalysisOccurrence() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceContainerAnalysisOccurrenceCreate,
+		Read:   resourceContainerAnalysisOccurrenceRead,
+		Update: resourceContainerAnalysisOccurrenceUpdate,
+		Delete: resourceContainerAnalysisOccurrenceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceContainerAnalysisOccurrenceImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Update: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"attestation": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"serialized_payload": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"signatures": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"public_key_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"signature": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"note_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"resource_uri": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"remediation": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kind": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceContainerAnalysisOccurrenceCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	resourceUriProp, err := expandContainerAnalysisOccurrenceResourceUri(d.Get("resource_uri"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("resource_uri"); !isEmptyValue(reflect.ValueOf(resourceUriProp)) && (ok || !reflect.DeepEqual(v, resourceUriProp)) {
+		obj["resourceUri"] = resourceUriProp
+	}
+	noteNameProp, err := expandContainerAnalysisOccurrenceNoteName(d.Get("note_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("note_name"); !isEmptyValue(reflect.ValueOf(noteNameProp)) && (ok || !reflect.DeepEqual(v, noteNameProp)) {
+		obj["noteName"] = noteNameProp
+	}
+	remediationProp, err := expandContainerAnalysisOccurrenceRemediation(d.Get("remediation"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("remediation"); !isEmptyValue(reflect.ValueOf(remediationProp)) && (ok || !reflect.DeepEqual(v, remediationProp)) {
+		obj["remediation"] = remediationProp
+	}
+	attestationProp, err := expandContainerAnalysisOccurrenceAttestation(d.Get("attestation"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("attestation"); !isEmptyValue(reflect.ValueOf(attestationProp)) && (ok || !reflect.DeepEqual(v, attestationProp)) {
+		obj["attestation"] = attestationProp
+	}
+
+	obj, err = resourceContainerAnalysisOccurrenceEncoder(d, meta, obj)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "{{ContainerAnalysisBasePath}}projects/{{project}}/occurrences")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Occurrence: %#v", obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Occurrence: %s", err)
+	}
+
+	// The occurrence name is server-assigned, so it's read from the response
+	if err := d.Set("name", flattenContainerAnalysisOccurrenceName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error setting name: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/occurrences/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating Occurrence %q: %#v", d.Id(), res)
+
+	return resourceContainerAnalysisOccurrenceRead(d, meta)
+}
+
+func resourceContainerAnalysisOccurrenceRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{ContainerAnalysisBasePath}}projects/{{project}}/occurrences/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ContainerAnalysisOccurrence %q", d.Id()))
+	}
+
+	res, err = resourceContainerAnalysisOccurrenceDecoder(d, meta, res)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Occurrence: %s", err)
+	}
+
+	if err := d.Set("name", flattenContainerAnalysisOccurrenceName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading Occurrence: %s", err)
+	}
+	if err := d.Set("resource_uri", flattenContainerAnalysisOccurrenceResourceUri(res["resourceUri"], d)); err != nil {
+		return fmt.Errorf("Error reading Occurrence: %s", err)
+	}
+	if err := d.Set("note_name", flattenContainerAnalysisOccurrenceNoteName(res["noteName"], d)); err != nil {
+		return fmt.Errorf("Error reading Occurrence: %s", err)
+	}
+	if err := d.Set("kind", flattenContainerAnalysisOccurrenceKind(res["kind"], d)); err != nil {
+		return fmt.Errorf("Error reading Occurrence: %s", err)
+	}
+	if err := d.Set("remediation", flattenContainerAnalysisOccurrenceRemediation(res["remediation"], d)); err != nil {
+		return fmt.Errorf("Error reading Occurrence: %s", err)
+	}
+	if err := d.Set("create_time", flattenContainerAnalysisOccurrenceCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Occurrence: %s", err)
+	}
+	if err := d.Set("update_time", flattenContainerAnalysisOccurrenceUpdateTime(res["updateTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Occurrence: %s", err)
+	}
+	if err := d.Set("attestation", flattenContainerAnalysisOccurrenceAttestation(res["attestation"], d)); err != nil {
+		return fmt.Errorf("Error reading Occurrence: %s", err)
+	}
+
+	return nil
+}
+
+func resourceContainerAnalysisOccurrenceUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	remediationProp, err := expandContainerAnalysisOccurrenceRemediation(d.Get("remediation"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("remediation"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, remediationProp)) {
+		obj["remediation"] = remediationProp
+	}
+	attestationProp, err := expandContainerAnalysisOccurrenceAttestation(d.Get("attestation"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("attestation"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, attestationProp)) {
+		obj["attestation"] = attestationProp
+	}
+
+	obj, err = resourceContainerAnalysisOccurrenceEncoder(d, meta, obj)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "{{ContainerAnalysisBasePath}}projects/{{project}}/occurrences/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Occurrence %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("remediation") {
+		updateMask = append(updateMask, "remediation")
+	}
+
+	if d.HasChange("attestation") {
+		updateMask = append(updateMask, "attestation")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Occurrence %q: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Finished updating Occurrence %q: %#v", d.Id(), res)
+
+	return resourceContainerAnalysisOccurrenceRead(d, meta)
+}
+
+func resourceContainerAnalysisOccurrenceDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{ContainerAnalysisBasePath}}projects/{{project}}/occurrences/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Occurrence %q", d.Id())
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Occurrence")
+	}
+
+	log.Printf("[DEBUG] Finished deleting Occurrence %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceContainerAnalysisOccurrenceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/occurrences/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<name>[^/]+)",
+		"(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/occurrences/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenContainerAnalysisOccurrenceName(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	return NameFromSelfLinkStateFunc(v)
+}
+
+func flattenContainerAnalysisOccurrenceResourceUri(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenContainerAnalysisOccurrenceNoteName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenContainerAnalysisOccurrenceKind(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenContainerAnalysisOccurrenceRemediation(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenContainerAnalysisOccurrenceCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenContainerAnalysisOccurrenceUpdateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenContainerAnalysisOccurrenceAttestation(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["serialized_payload"] =
+		flattenContainerAnalysisOccurrenceAttestationSerializedPayload(original["serializedPayload"], d)
+	transformed["signatures"] =
+		flattenContainerAnalysisOccurrenceAttestationSignatures(original["signatures"], d)
+	return []interface{}{transformed}
+}
+
+func flattenContainerAnalysisOccurrenceAttestationSerializedPayload(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenContainerAnalysisOccurrenceAttestationSignatures(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := schema.NewSet(schema.HashResource(resourceContainerAnalysisOccurrence().Schema["attestation"].Elem.(*schema.Resource).Schema["signatures"].Elem.(*schema.Resource)), []interface{}{})
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed.Add(map[string]interface{}{
+			"signature":     flattenContainerAnalysisOccurrenceAttestationSignaturesSignature(original["signature"], d),
+			"public_key_id": flattenContainerAnalysisOccurrenceAttestationSignaturesPublicKeyId(original["publicKeyId"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenContainerAnalysisOccurrenceAttestationSignaturesSignature(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenContainerAnalysisOccurrenceAttestationSignaturesPublicKeyId(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandContainerAnalysisOccurrenceResourceUri(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandContainerAnalysisOccurrenceNoteName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandContainerAnalysisOccurrenceRemediation(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandContainerAnalysisOccurrenceAttestation(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedSerializedPayload, err := expandContainerAnalysisOccurrenceAttestationSerializedPayload(original["serialized_payload"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSerializedPayload); val.IsValid() && !isEmptyValue(val) {
+		transformed["serializedPayload"] = transformedSerializedPayload
+	}
+
+	transformedSignatures, err := expandContainerAnalysisOccurrenceAttestationSignatures(original["signatures"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSignatures); val.IsValid() && !isEmptyValue(val) {
+		transformed["signatures"] = transformedSignatures
+	}
+
+	return transformed, nil
+}
+
+func expandContainerAnalysisOccurrenceAttestationSerializedPayload(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandContainerAnalysisOccurrenceAttestationSignatures(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	v = v.(*schema.Set).List()
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedSignature, err := expandContainerAnalysisOccurrenceAttestationSignaturesSignature(original["signature"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedSignature); val.IsValid() && !isEmptyValue(val) {
+			transformed["signature"] = transformedSignature
+		}
+
+		transformedPublicKeyId, err := expandContainerAnalysisOccurrenceAttestationSignaturesPublicKeyId(original["public_key_id"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedPublicKeyId); val.IsValid() && !isEmptyValue(val) {
+			transformed["publicKeyId"] = transformedPublicKeyId
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandContainerAnalysisOccurrenceAttestationSignaturesSignature(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandContainerAnalysisOccurrenceAttestationSignaturesPublicKeyId(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func resourceContainerAnalysisOccurrenceEncoder(d *schema.ResourceData, meta interface{}, obj map[string]interface{}) (map[string]interface{}, error) {
+	// The v1beta1 API nests the resource URI in a Resource message and the
+	// attestation in a Details message wrapping a GenericSignedAttestation.
+	if uri, ok := obj["resourceUri"]; ok {
+		obj["resource"] = map[string]interface{}{
+			"uri": uri,
+		}
+		delete(obj, "resourceUri")
+	}
+	if attestation, ok := obj["attestation"].(map[string]interface{}); ok {
+		attestation["contentType"] = "SIMPLE_SIGNING_JSON"
+		obj["attestation"] = map[string]interface{}{
+			"attestation": map[string]interface{}{
+				"genericSignedAttestation": attestation,
+			},
+		}
+	}
+	return obj, nil
+}
+
+func resourceContainerAnalysisOccurrenceDecoder(d *schema.ResourceData, meta interface{}, res map[string]interface{}) (map[string]interface{}, error) {
+	if resource, ok := res["resource"].(map[string]interface{}); ok {
+		res["resourceUri"] = resource["uri"]
+	}
+	if details, ok := res["attestation"].(map[string]interface{}); ok {
+		if attestation, ok := details["attestation"].(map[string]interface{}); ok {
+			res["attestation"] = attestation["genericSignedAttestation"]
+		}
+	}
+	return res, nil
+}
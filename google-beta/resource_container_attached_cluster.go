@@ -0,0 +1,747 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceContainerAttachedCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceContainerAttachedClusterCreate,
+		Read:   resourceContainerAttachedClusterRead,
+		Update: resourceContainerAttachedClusterUpdate,
+		Delete: resourceContainerAttachedClusterDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceContainerAttachedClusterImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"distribution": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"eks", "aks"}, false),
+			},
+			"fleet": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"project": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"membership": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"oidc_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"issuer_url": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"jwks": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"platform_version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"authorization": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"admin_users": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"cluster_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kubernetes_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"reconciling": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"uid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"workload_identity_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identity_provider": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"issuer_uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"workload_pool": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceContainerAttachedClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandContainerAttachedClusterDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	oidcConfigProp, err := expandContainerAttachedClusterOidcConfig(d.Get("oidc_config"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("oidc_config"); !isEmptyValue(reflect.ValueOf(oidcConfigProp)) && (ok || !reflect.DeepEqual(v, oidcConfigProp)) {
+		obj["oidcConfig"] = oidcConfigProp
+	}
+	platformVersionProp, err := expandContainerAttachedClusterPlatformVersion(d.Get("platform_version"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("platform_version"); !isEmptyValue(reflect.ValueOf(platformVersionProp)) && (ok || !reflect.DeepEqual(v, platformVersionProp)) {
+		obj["platformVersion"] = platformVersionProp
+	}
+	distributionProp, err := expandContainerAttachedClusterDistribution(d.Get("distribution"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("distribution"); !isEmptyValue(reflect.ValueOf(distributionProp)) && (ok || !reflect.DeepEqual(v, distributionProp)) {
+		obj["distribution"] = distributionProp
+	}
+	fleetProp, err := expandContainerAttachedClusterFleet(d.Get("fleet"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("fleet"); !isEmptyValue(reflect.ValueOf(fleetProp)) && (ok || !reflect.DeepEqual(v, fleetProp)) {
+		obj["fleet"] = fleetProp
+	}
+	annotationsProp, err := expandContainerAttachedClusterAnnotations(d.Get("annotations"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("annotations"); !isEmptyValue(reflect.ValueOf(annotationsProp)) && (ok || !reflect.DeepEqual(v, annotationsProp)) {
+		obj["annotations"] = annotationsProp
+	}
+	authorizationProp, err := expandContainerAttachedClusterAuthorization(d.Get("authorization"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("authorization"); !isEmptyValue(reflect.ValueOf(authorizationProp)) && (ok || !reflect.DeepEqual(v, authorizationProp)) {
+		obj["authorization"] = authorizationProp
+	}
+
+	url, err := replaceVars(d, config, "{{ContainerAttachedBasePath}}projects/{{project}}/locations/{{location}}/attachedClusters?attached_cluster_id={{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Cluster: %#v", obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Cluster: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/attachedClusters/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	waitErr := containerAttachedOperationWaitTime(
+		config, res, project, "Creating Cluster",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create Cluster: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating Cluster %q: %#v", d.Id(), res)
+
+	return resourceContainerAttachedClusterRead(d, meta)
+}
+
+func resourceContainerAttachedClusterRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{ContainerAttachedBasePath}}projects/{{project}}/locations/{{location}}/attachedClusters/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ContainerAttachedCluster %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Cluster: %s", err)
+	}
+
+	if err := d.Set("description", flattenContainerAttachedClusterDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading Cluster: %s", err)
+	}
+	if err := d.Set("oidc_config", flattenContainerAttachedClusterOidcConfig(res["oidcConfig"], d)); err != nil {
+		return fmt.Errorf("Error reading Cluster: %s", err)
+	}
+	if err := d.Set("platform_version", flattenContainerAttachedClusterPlatformVersion(res["platformVersion"], d)); err != nil {
+		return fmt.Errorf("Error reading Cluster: %s", err)
+	}
+	if err := d.Set("distribution", flattenContainerAttachedClusterDistribution(res["distribution"], d)); err != nil {
+		return fmt.Errorf("Error reading Cluster: %s", err)
+	}
+	if err := d.Set("cluster_region", flattenContainerAttachedClusterClusterRegion(res["clusterRegion"], d)); err != nil {
+		return fmt.Errorf("Error reading Cluster: %s", err)
+	}
+	if err := d.Set("fleet", flattenContainerAttachedClusterFleet(res["fleet"], d)); err != nil {
+		return fmt.Errorf("Error reading Cluster: %s", err)
+	}
+	if err := d.Set("annotations", flattenContainerAttachedClusterAnnotations(res["annotations"], d)); err != nil {
+		return fmt.Errorf("Error reading Cluster: %s", err)
+	}
+	if err := d.Set("authorization", flattenContainerAttachedClusterAuthorization(res["authorization"], d)); err != nil {
+		return fmt.Errorf("Error reading Cluster: %s", err)
+	}
+	if err := d.Set("uid", flattenContainerAttachedClusterUid(res["uid"], d)); err != nil {
+		return fmt.Errorf("Error reading Cluster: %s", err)
+	}
+	if err := d.Set("state", flattenContainerAttachedClusterState(res["state"], d)); err != nil {
+		return fmt.Errorf("Error reading Cluster: %s", err)
+	}
+	if err := d.Set("reconciling", flattenContainerAttachedClusterReconciling(res["reconciling"], d)); err != nil {
+		return fmt.Errorf("Error reading Cluster: %s", err)
+	}
+	if err := d.Set("create_time", flattenContainerAttachedClusterCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Cluster: %s", err)
+	}
+	if err := d.Set("update_time", flattenContainerAttachedClusterUpdateTime(res["updateTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Cluster: %s", err)
+	}
+	if err := d.Set("kubernetes_version", flattenContainerAttachedClusterKubernetesVersion(res["kubernetesVersion"], d)); err != nil {
+		return fmt.Errorf("Error reading Cluster: %s", err)
+	}
+	if err := d.Set("workload_identity_config", flattenContainerAttachedClusterWorkloadIdentityConfig(res["workloadIdentityConfig"], d)); err != nil {
+		return fmt.Errorf("Error reading Cluster: %s", err)
+	}
+
+	return nil
+}
+
+func resourceContainerAttachedClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandContainerAttachedClusterDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	oidcConfigProp, err := expandContainerAttachedClusterOidcConfig(d.Get("oidc_config"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("oidc_config"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, oidcConfigProp)) {
+		obj["oidcConfig"] = oidcConfigProp
+	}
+	platformVersionProp, err := expandContainerAttachedClusterPlatformVersion(d.Get("platform_version"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("platform_version"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, platformVersionProp)) {
+		obj["platformVersion"] = platformVersionProp
+	}
+	annotationsProp, err := expandContainerAttachedClusterAnnotations(d.Get("annotations"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("annotations"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, annotationsProp)) {
+		obj["annotations"] = annotationsProp
+	}
+	authorizationProp, err := expandContainerAttachedClusterAuthorization(d.Get("authorization"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("authorization"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, authorizationProp)) {
+		obj["authorization"] = authorizationProp
+	}
+
+	url, err := replaceVars(d, config, "{{ContainerAttachedBasePath}}projects/{{project}}/locations/{{location}}/attachedClusters/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Cluster %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("description") {
+		updateMask = append(updateMask, "description")
+	}
+
+	if d.HasChange("oidc_config") {
+		updateMask = append(updateMask, "oidcConfig")
+	}
+
+	if d.HasChange("platform_version") {
+		updateMask = append(updateMask, "platformVersion")
+	}
+
+	if d.HasChange("annotations") {
+		updateMask = append(updateMask, "annotations")
+	}
+
+	if d.HasChange("authorization") {
+		updateMask = append(updateMask, "authorization")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Cluster %q: %s", d.Id(), err)
+	}
+
+	err = containerAttachedOperationWaitTime(
+		config, res, project, "Updating Cluster",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceContainerAttachedClusterRead(d, meta)
+}
+
+func resourceContainerAttachedClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{ContainerAttachedBasePath}}projects/{{project}}/locations/{{location}}/attachedClusters/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Cluster %q", d.Id())
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Cluster")
+	}
+
+	err = containerAttachedOperationWaitTime(
+		config, res, project, "Deleting Cluster",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting Cluster %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceContainerAttachedClusterImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/attachedClusters/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/attachedClusters/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenContainerAttachedClusterDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenContainerAttachedClusterOidcConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["issuer_url"] =
+		flattenContainerAttachedClusterOidcConfigIssuerUrl(original["issuerUrl"], d)
+	transformed["jwks"] =
+		flattenContainerAttachedClusterOidcConfigJwks(original["jwks"], d)
+	return []interface{}{transformed}
+}
+func flattenContainerAttachedClusterOidcConfigIssuerUrl(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenContainerAttachedClusterOidcConfigJwks(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenContainerAttachedClusterPlatformVersion(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenContainerAttachedClusterDistribution(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenContainerAttachedClusterClusterRegion(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenContainerAttachedClusterFleet(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["project"] =
+		flattenContainerAttachedClusterFleetProject(original["project"], d)
+	transformed["membership"] =
+		flattenContainerAttachedClusterFleetMembership(original["membership"], d)
+	return []interface{}{transformed}
+}
+func flattenContainerAttachedClusterFleetProject(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenContainerAttachedClusterFleetMembership(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenContainerAttachedClusterAnnotations(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenContainerAttachedClusterAuthorization(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["admin_users"] =
+		flattenContainerAttachedClusterAuthorizationAdminUsers(original["adminUsers"], d)
+	return []interface{}{transformed}
+}
+func flattenContainerAttachedClusterAuthorizationAdminUsers(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original, ok := raw.(map[string]interface{})
+		if !ok || len(original) < 1 {
+			continue
+		}
+		transformed = append(transformed, original["username"])
+	}
+	return transformed
+}
+
+func flattenContainerAttachedClusterUid(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenContainerAttachedClusterState(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenContainerAttachedClusterReconciling(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenContainerAttachedClusterCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenContainerAttachedClusterUpdateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenContainerAttachedClusterKubernetesVersion(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenContainerAttachedClusterWorkloadIdentityConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["identity_provider"] =
+		flattenContainerAttachedClusterWorkloadIdentityConfigIdentityProvider(original["identityProvider"], d)
+	transformed["issuer_uri"] =
+		flattenContainerAttachedClusterWorkloadIdentityConfigIssuerUri(original["issuerUri"], d)
+	transformed["workload_pool"] =
+		flattenContainerAttachedClusterWorkloadIdentityConfigWorkloadPool(original["workloadPool"], d)
+	return []interface{}{transformed}
+}
+func flattenContainerAttachedClusterWorkloadIdentityConfigIdentityProvider(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenContainerAttachedClusterWorkloadIdentityConfigIssuerUri(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenContainerAttachedClusterWorkloadIdentityConfigWorkloadPool(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandContainerAttachedClusterDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandContainerAttachedClusterOidcConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedIssuerUrl, err := expandContainerAttachedClusterOidcConfigIssuerUrl(original["issuer_url"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedIssuerUrl); val.IsValid() && !isEmptyValue(val) {
+		transformed["issuerUrl"] = transformedIssuerUrl
+	}
+
+	transformedJwks, err := expandContainerAttachedClusterOidcConfigJwks(original["jwks"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedJwks); val.IsValid() && !isEmptyValue(val) {
+		transformed["jwks"] = transformedJwks
+	}
+
+	return transformed, nil
+}
+
+func expandContainerAttachedClusterOidcConfigIssuerUrl(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandContainerAttachedClusterOidcConfigJwks(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandContainerAttachedClusterPlatformVersion(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandContainerAttachedClusterDistribution(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandContainerAttachedClusterFleet(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedProject, err := expandContainerAttachedClusterFleetProject(original["project"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedProject); val.IsValid() && !isEmptyValue(val) {
+		transformed["project"] = transformedProject
+	}
+
+	return transformed, nil
+}
+
+func expandContainerAttachedClusterFleetProject(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandContainerAttachedClusterAnnotations(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandContainerAttachedClusterAuthorization(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedAdminUsers, err := expandContainerAttachedClusterAuthorizationAdminUsers(original["admin_users"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedAdminUsers); val.IsValid() && !isEmptyValue(val) {
+		transformed["adminUsers"] = transformedAdminUsers
+	}
+
+	return transformed, nil
+}
+
+func expandContainerAttachedClusterAuthorizationAdminUsers(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	// admin_users is a flat list of usernames in the schema, the API takes a
+	// list of {"username": ...} objects.
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		req = append(req, map[string]interface{}{
+			"username": raw.(string),
+		})
+	}
+	return req, nil
+}
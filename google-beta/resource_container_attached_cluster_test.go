@@ -0,0 +1,131 @@
+package google
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccContainerAttachedCluster_update(t *testing.T) {
+	t.Parallel()
+
+	issuerUrl := getTestAttachedClusterIssuerUrlFromEnv(t)
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerAttachedClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerAttachedCluster_basic(name, issuerUrl, "user1@example.com"),
+			},
+			{
+				ResourceName:            "google_container_attached_cluster.primary",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"location", "name"},
+			},
+			{
+				Config: testAccContainerAttachedCluster_basic(name, issuerUrl, "user2@example.com"),
+			},
+			{
+				ResourceName:            "google_container_attached_cluster.primary",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"location", "name"},
+			},
+		},
+	})
+}
+
+func TestAccContainerAttachedCluster_invalidDistribution(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccContainerAttachedCluster_distribution("gke"),
+				ExpectError: regexp.MustCompile(`expected distribution to be one of \[eks aks\]`),
+			},
+		},
+	})
+}
+
+func testAccContainerAttachedCluster_basic(name, issuerUrl, adminUser string) string {
+	return fmt.Sprintf(`
+data "google_project" "project" {
+}
+
+resource "google_container_attached_cluster" "primary" {
+  name             = "%s"
+  location         = "us-west1"
+  description      = "Test cluster"
+  distribution     = "eks"
+  platform_version = "1.25.5-gke.1"
+
+  oidc_config {
+    issuer_url = "%s"
+  }
+
+  fleet {
+    project = "projects/${data.google_project.project.number}"
+  }
+
+  authorization {
+    admin_users = ["%s"]
+  }
+}
+`, name, issuerUrl, adminUser)
+}
+
+func testAccContainerAttachedCluster_distribution(distribution string) string {
+	return fmt.Sprintf(`
+resource "google_container_attached_cluster" "primary" {
+  name             = "tf-test-invalid"
+  location         = "us-west1"
+  distribution     = "%s"
+  platform_version = "1.25.5-gke.1"
+
+  oidc_config {
+    issuer_url = "https://oidc.issuer.url"
+  }
+
+  fleet {
+    project = "projects/123456789"
+  }
+}
+`, distribution)
+}
+
+func testAccCheckContainerAttachedClusterDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_container_attached_cluster" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(config, rs, "{{ContainerAttachedBasePath}}projects/{{project}}/locations/{{location}}/attachedClusters/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("ContainerAttachedCluster still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -59,6 +59,8 @@ func resourceContainerCluster() *schema.Resource {
 			resourceContainerClusterIpAllocationCustomizeDiff,
 			resourceNodeConfigEmptyGuestAccelerator,
 			containerClusterPrivateClusterConfigCustomDiff,
+			containerClusterConfidentialNodesCustomizeDiff,
+			containerClusterMeshCertificatesCustomizeDiff,
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -301,9 +303,119 @@ func resourceContainerCluster() *schema.Resource {
 			},
 
 			"enable_binary_authorization": {
-				Default:  false,
-				Type:     schema.TypeBool,
+				Default:       false,
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Deprecated:    "Use binary_authorization.evaluation_mode instead.",
+				ConflictsWith: []string{"binary_authorization"},
+			},
+
+			"binary_authorization": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"enable_binary_authorization"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"evaluation_mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"DISABLED", "PROJECT_SINGLETON_POLICY_ENFORCE"}, false),
+						},
+					},
+				},
+			},
+
+			"cost_management_config": {
+				Type:     schema.TypeList,
 				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"fleet": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"project": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateProjectID(),
+						},
+						"membership": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"membership_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"mesh_certificates": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable_certificates": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"identity_service_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"security_posture_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"DISABLED", "BASIC", "ENTERPRISE"}, false),
+						},
+						"vulnerability_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"VULNERABILITY_DISABLED", "VULNERABILITY_BASIC", "VULNERABILITY_ENTERPRISE"}, false),
+						},
+					},
+				},
 			},
 
 			"enable_kubernetes_alpha": {
@@ -326,6 +438,29 @@ func resourceContainerCluster() *schema.Resource {
 				Default:  false,
 			},
 
+			"enable_shielded_nodes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"confidential_nodes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
 			"authenticator_groups_config": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -876,6 +1011,78 @@ func resourceContainerClusterIpAllocationCustomizeDiffFunc(diff TerraformResourc
 	return diff.Clear("ip_allocation_policy")
 }
 
+// Confidential nodes rely on AMD SEV, which is only available on some machine
+// families.
+var confidentialNodesMachineFamilies = []string{"n2d", "c2d"}
+
+func containerClusterConfidentialNodesCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	// separate func to allow unit testing
+	return containerClusterConfidentialNodesCustomizeDiffFunc(diff)
+}
+
+func containerClusterConfidentialNodesCustomizeDiffFunc(diff TerraformResourceDiff) error {
+	_, n := diff.GetChange("confidential_nodes")
+	if l, ok := n.([]interface{}); !ok || len(l) == 0 || l[0] == nil || !l[0].(map[string]interface{})["enabled"].(bool) {
+		return nil
+	}
+
+	nodeConfigs := map[string]interface{}{}
+	_, nc := diff.GetChange("node_config")
+	nodeConfigs["node_config"] = nc
+	_, np := diff.GetChange("node_pool")
+	if pools, ok := np.([]interface{}); ok {
+		for i, pool := range pools {
+			if pool, ok := pool.(map[string]interface{}); ok {
+				nodeConfigs[fmt.Sprintf("node_pool.%d.node_config", i)] = pool["node_config"]
+			}
+		}
+	}
+
+	for k, v := range nodeConfigs {
+		l, ok := v.([]interface{})
+		if !ok || len(l) == 0 || l[0] == nil {
+			continue
+		}
+		// An unset or unknown machine type is left for the API to check.
+		machineType, _ := l[0].(map[string]interface{})["machine_type"].(string)
+		if machineType == "" {
+			continue
+		}
+		family := strings.SplitN(strings.ToLower(machineType), "-", 2)[0]
+		supported := false
+		for _, f := range confidentialNodesMachineFamilies {
+			if family == f {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return fmt.Errorf("confidential_nodes require a machine type from the %s families, got %q in %s.0.machine_type", strings.ToUpper(strings.Join(confidentialNodesMachineFamilies, "/")), machineType, k)
+		}
+	}
+	return nil
+}
+
+func containerClusterMeshCertificatesCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	// separate func to allow unit testing
+	return containerClusterMeshCertificatesCustomizeDiffFunc(diff)
+}
+
+// Mesh certificates are issued to workload identities, so they can't be
+// enabled without Workload Identity.
+func containerClusterMeshCertificatesCustomizeDiffFunc(diff TerraformResourceDiff) error {
+	_, n := diff.GetChange("mesh_certificates")
+	if l, ok := n.([]interface{}); !ok || len(l) == 0 || l[0] == nil || !l[0].(map[string]interface{})["enable_certificates"].(bool) {
+		return nil
+	}
+
+	_, wi := diff.GetChange("workload_identity_config")
+	if l, ok := wi.([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		return nil
+	}
+	return fmt.Errorf("mesh_certificates.0.enable_certificates requires Workload Identity, set workload_identity_config.0.identity_namespace to enable it")
+}
+
 func resourceContainerClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -1042,15 +1249,56 @@ func resourceContainerClusterCreate(d *schema.ResourceData, meta interface{}) er
 	mutexKV.Lock(containerClusterMutexKey(project, location, clusterName))
 	defer mutexKV.Unlock(containerClusterMutexKey(project, location, clusterName))
 
-	parent := fmt.Sprintf("projects/%s/locations/%s", project, location)
-	var op *containerBeta.Operation
-	err = retry(func() error {
-		op, err = config.clientContainerBeta.Projects.Locations.Clusters.Create(parent, req).Do()
+	// The vendored client doesn't know about some of the newer cluster fields,
+	// so the request is sent as JSON with those fields added to it.
+	rawReq, err := ConvertToMap(req)
+	if err != nil {
 		return err
-	})
+	}
+	rawCluster := rawReq["cluster"].(map[string]interface{})
+	if v, ok := d.GetOk("binary_authorization"); ok {
+		rawCluster["binaryAuthorization"] = expandBinaryAuthorization(v)
+	}
+	if v, ok := d.GetOk("security_posture_config"); ok {
+		rawCluster["securityPostureConfig"] = expandSecurityPostureConfig(v)
+	}
+	if v, ok := d.GetOk("cost_management_config"); ok {
+		rawCluster["costManagementConfig"] = expandCostManagementConfig(v)
+	}
+	if v, ok := d.GetOk("fleet"); ok {
+		rawCluster["fleet"] = expandFleet(v)
+	}
+	rawCluster["shieldedNodes"] = map[string]interface{}{
+		"enabled": d.Get("enable_shielded_nodes").(bool),
+	}
+	if v, ok := d.GetOk("confidential_nodes"); ok {
+		rawCluster["confidentialNodes"] = expandConfidentialNodes(v)
+	}
+	if v, ok := d.GetOk("mesh_certificates"); ok {
+		rawCluster["meshCertificates"] = expandMeshCertificates(v)
+	}
+	if v, ok := d.GetOk("identity_service_config"); ok {
+		rawCluster["identityServiceConfig"] = expandIdentityServiceConfig(v)
+	}
+	if nc, ok := rawCluster["nodeConfig"].(map[string]interface{}); ok {
+		expandNodeConfigRawFields(d.Get("node_config"), nc)
+	}
+	if nps, ok := rawCluster["nodePools"].([]interface{}); ok {
+		for i, np := range nps {
+			nc := np.(map[string]interface{})["config"].(map[string]interface{})
+			expandNodeConfigRawFields(d.Get(fmt.Sprintf("node_pool.%d.node_config", i)), nc)
+		}
+	}
+
+	parent := fmt.Sprintf("projects/%s/locations/%s", project, location)
+	res, err := sendRequestWithTimeout(config, "POST", project, config.ContainerBetaBasePath+parent+"/clusters", rawReq, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
+	op := &containerBeta.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
 
 	d.SetId(clusterName)
 
@@ -1118,10 +1366,16 @@ func resourceContainerClusterRead(d *schema.ResourceData, meta interface{}) erro
 
 	clusterName := d.Get("name").(string)
 	name := containerClusterFullName(project, location, clusterName)
-	cluster, err := config.clientContainerBeta.Projects.Locations.Clusters.Get(name).Do()
+	// The cluster is read as JSON so fields the vendored client doesn't know
+	// about can be read from it as well.
+	res, err := sendRequest(config, "GET", project, config.ContainerBetaBasePath+name, nil)
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("Container Cluster %q", d.Get("name").(string)))
 	}
+	cluster := &containerBeta.Cluster{}
+	if err := Convert(res, cluster); err != nil {
+		return err
+	}
 	if cluster.Status == "ERROR" || cluster.Status == "DEGRADED" {
 		return fmt.Errorf("Cluster %q has status %q with message %q", d.Get("name"), cluster.Status, cluster.StatusMessage)
 	}
@@ -1166,6 +1420,28 @@ func resourceContainerClusterRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("network", cluster.NetworkConfig.Network)
 	d.Set("subnetwork", cluster.NetworkConfig.Subnetwork)
 	d.Set("enable_binary_authorization", cluster.BinaryAuthorization != nil && cluster.BinaryAuthorization.Enabled)
+	if err := d.Set("binary_authorization", flattenBinaryAuthorization(res["binaryAuthorization"])); err != nil {
+		return err
+	}
+	if err := d.Set("security_posture_config", flattenSecurityPostureConfig(res["securityPostureConfig"])); err != nil {
+		return err
+	}
+	if err := d.Set("cost_management_config", flattenCostManagementConfig(res["costManagementConfig"])); err != nil {
+		return err
+	}
+	if err := d.Set("fleet", flattenFleet(res["fleet"])); err != nil {
+		return err
+	}
+	d.Set("enable_shielded_nodes", flattenShieldedNodes(res["shieldedNodes"]))
+	if err := d.Set("confidential_nodes", flattenConfidentialNodes(res["confidentialNodes"])); err != nil {
+		return err
+	}
+	if err := d.Set("mesh_certificates", flattenMeshCertificates(res["meshCertificates"])); err != nil {
+		return err
+	}
+	if err := d.Set("identity_service_config", flattenIdentityServiceConfig(res["identityServiceConfig"])); err != nil {
+		return err
+	}
 	d.Set("enable_tpu", cluster.EnableTpu)
 	d.Set("tpu_ipv4_cidr_block", cluster.TpuIpv4CidrBlock)
 	if err := d.Set("cluster_autoscaling", flattenClusterAutoscaling(cluster.Autoscaling)); err != nil {
@@ -1178,7 +1454,9 @@ func resourceContainerClusterRead(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 	d.Set("enable_intranode_visibility", cluster.NetworkConfig.EnableIntraNodeVisibility)
-	if err := d.Set("node_config", flattenNodeConfig(cluster.NodeConfig)); err != nil {
+	nodeConfig := flattenNodeConfig(cluster.NodeConfig)
+	flattenNodeConfigRawFields(nodeConfig, res["nodeConfig"])
+	if err := d.Set("node_config", nodeConfig); err != nil {
 		return err
 	}
 	d.Set("project", project)
@@ -1189,6 +1467,12 @@ func resourceContainerClusterRead(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		return err
 	}
+	rawNodePools, _ := res["nodePools"].([]interface{})
+	for i, np := range nps {
+		if i < len(rawNodePools) {
+			flattenNodeConfigRawFields(np["node_config"].([]map[string]interface{}), rawNodePools[i].(map[string]interface{})["config"])
+		}
+	}
 	if err := d.Set("node_pool", nps); err != nil {
 		return err
 	}
@@ -1269,6 +1553,24 @@ func resourceContainerClusterUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
+	// rawUpdateFunc sends a ClusterUpdate as JSON, for fields the vendored
+	// client doesn't know about.
+	rawUpdateFunc := func(update map[string]interface{}, updateDescription string) func() error {
+		return func() error {
+			url := config.ContainerBetaBasePath + containerClusterFullName(project, location, clusterName)
+			res, err := sendRequestWithTimeout(config, "PUT", project, url, map[string]interface{}{"update": update}, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return err
+			}
+			op := &containerBeta.Operation{}
+			if err := Convert(res, op); err != nil {
+				return err
+			}
+			// Wait until it's updated
+			return containerOperationWait(config, op, project, location, updateDescription, timeoutInMinutes)
+		}
+	}
+
 	// The ClusterUpdate object that we use for most of these updates only allows updating one field at a time,
 	// so we have to make separate calls for each field that we want to update. The order here is fairly arbitrary-
 	// if the order of updating fields does matter, it is called out explicitly.
@@ -1330,6 +1632,136 @@ func resourceContainerClusterUpdate(d *schema.ResourceData, meta interface{}) er
 		d.SetPartial("enable_binary_authorization")
 	}
 
+	if d.HasChange("binary_authorization") {
+		if v, ok := d.GetOk("binary_authorization"); ok {
+			update := map[string]interface{}{
+				"desiredBinaryAuthorization": expandBinaryAuthorization(v),
+			}
+
+			updateF := rawUpdateFunc(update, "updating GKE binary authorization")
+			// Call update serially.
+			if err := lockedCall(lockKey, updateF); err != nil {
+				return err
+			}
+
+			log.Printf("[INFO] GKE cluster %s's binary authorization evaluation mode has been updated", d.Id())
+		}
+
+		d.SetPartial("binary_authorization")
+	}
+
+	if d.HasChange("security_posture_config") {
+		if v, ok := d.GetOk("security_posture_config"); ok {
+			update := map[string]interface{}{
+				"desiredSecurityPostureConfig": expandSecurityPostureConfig(v),
+			}
+
+			updateF := rawUpdateFunc(update, "updating GKE cluster security posture config")
+			// Call update serially.
+			if err := lockedCall(lockKey, updateF); err != nil {
+				return err
+			}
+
+			log.Printf("[INFO] GKE cluster %s security posture config has been updated", d.Id())
+		}
+
+		d.SetPartial("security_posture_config")
+	}
+
+	if d.HasChange("cost_management_config") {
+		if v, ok := d.GetOk("cost_management_config"); ok {
+			update := map[string]interface{}{
+				"desiredCostManagementConfig": expandCostManagementConfig(v),
+			}
+
+			updateF := rawUpdateFunc(update, "updating GKE cluster cost management config")
+			// Call update serially.
+			if err := lockedCall(lockKey, updateF); err != nil {
+				return err
+			}
+
+			log.Printf("[INFO] GKE cluster %s cost management config has been updated", d.Id())
+		}
+
+		d.SetPartial("cost_management_config")
+	}
+
+	if d.HasChange("fleet") {
+		// An empty fleet project unregisters the cluster from its fleet.
+		fleet := map[string]interface{}{"project": ""}
+		if v, ok := d.GetOk("fleet"); ok {
+			fleet = expandFleet(v)
+		}
+		update := map[string]interface{}{
+			"desiredFleet": fleet,
+		}
+
+		updateF := rawUpdateFunc(update, "updating GKE cluster fleet registration")
+		// Call update serially.
+		if err := lockedCall(lockKey, updateF); err != nil {
+			return err
+		}
+
+		log.Printf("[INFO] GKE cluster %s fleet registration has been updated", d.Id())
+
+		d.SetPartial("fleet")
+	}
+
+	if d.HasChange("enable_shielded_nodes") {
+		enabled := d.Get("enable_shielded_nodes").(bool)
+		update := map[string]interface{}{
+			"desiredShieldedNodes": map[string]interface{}{
+				"enabled": enabled,
+			},
+		}
+
+		updateF := rawUpdateFunc(update, "updating GKE cluster shielded nodes")
+		// Call update serially.
+		if err := lockedCall(lockKey, updateF); err != nil {
+			return err
+		}
+
+		log.Printf("[INFO] GKE cluster %s shielded nodes have been updated to %v", d.Id(), enabled)
+
+		d.SetPartial("enable_shielded_nodes")
+	}
+
+	if d.HasChange("mesh_certificates") {
+		if v, ok := d.GetOk("mesh_certificates"); ok {
+			update := map[string]interface{}{
+				"desiredMeshCertificates": expandMeshCertificates(v),
+			}
+
+			updateF := rawUpdateFunc(update, "updating GKE cluster mesh certificates")
+			// Call update serially.
+			if err := lockedCall(lockKey, updateF); err != nil {
+				return err
+			}
+
+			log.Printf("[INFO] GKE cluster %s mesh certificates have been updated", d.Id())
+		}
+
+		d.SetPartial("mesh_certificates")
+	}
+
+	if d.HasChange("identity_service_config") {
+		if v, ok := d.GetOk("identity_service_config"); ok {
+			update := map[string]interface{}{
+				"desiredIdentityServiceConfig": expandIdentityServiceConfig(v),
+			}
+
+			updateF := rawUpdateFunc(update, "updating GKE cluster identity service config")
+			// Call update serially.
+			if err := lockedCall(lockKey, updateF); err != nil {
+				return err
+			}
+
+			log.Printf("[INFO] GKE cluster %s identity service config has been updated", d.Id())
+		}
+
+		d.SetPartial("identity_service_config")
+	}
+
 	if d.HasChange("cluster_autoscaling") {
 		req := &containerBeta.UpdateClusterRequest{
 			Update: &containerBeta.ClusterUpdate{
@@ -2323,6 +2755,92 @@ func expandResourceUsageExportConfig(configured interface{}) *containerBeta.Reso
 	return result
 }
 
+// expandBinaryAuthorization, expandSecurityPostureConfig, expandCostManagementConfig,
+// expandFleet, expandConfidentialNodes, expandMeshCertificates and
+// expandIdentityServiceConfig build the raw JSON for fields that the vendored
+// container client doesn't support.
+func expandBinaryAuthorization(configured interface{}) map[string]interface{} {
+	l := configured.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	binAuthz := l[0].(map[string]interface{})
+	return map[string]interface{}{
+		"evaluationMode": binAuthz["evaluation_mode"],
+	}
+}
+
+func expandSecurityPostureConfig(configured interface{}) map[string]interface{} {
+	l := configured.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	securityPosture := l[0].(map[string]interface{})
+	result := map[string]interface{}{}
+	if v, ok := securityPosture["mode"]; ok && v != "" {
+		result["mode"] = v
+	}
+	if v, ok := securityPosture["vulnerability_mode"]; ok && v != "" {
+		result["vulnerabilityMode"] = v
+	}
+	return result
+}
+
+func expandCostManagementConfig(configured interface{}) map[string]interface{} {
+	l := configured.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	costManagement := l[0].(map[string]interface{})
+	return map[string]interface{}{
+		"enabled": costManagement["enabled"],
+	}
+}
+
+func expandFleet(configured interface{}) map[string]interface{} {
+	l := configured.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	fleet := l[0].(map[string]interface{})
+	return map[string]interface{}{
+		"project": fleet["project"],
+	}
+}
+
+func expandConfidentialNodes(configured interface{}) map[string]interface{} {
+	l := configured.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	confidentialNodes := l[0].(map[string]interface{})
+	return map[string]interface{}{
+		"enabled": confidentialNodes["enabled"],
+	}
+}
+
+func expandMeshCertificates(configured interface{}) map[string]interface{} {
+	l := configured.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	meshCertificates := l[0].(map[string]interface{})
+	return map[string]interface{}{
+		"enableCertificates": meshCertificates["enable_certificates"],
+	}
+}
+
+func expandIdentityServiceConfig(configured interface{}) map[string]interface{} {
+	l := configured.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	identityService := l[0].(map[string]interface{})
+	return map[string]interface{}{
+		"enabled": identityService["enabled"],
+	}
+}
+
 func flattenNetworkPolicy(c *containerBeta.NetworkPolicy) []map[string]interface{} {
 	result := []map[string]interface{}{}
 	if c != nil {
@@ -2606,6 +3124,110 @@ func flattenDatabaseEncryption(c *containerBeta.DatabaseEncryption) []map[string
 	}
 }
 
+func flattenBinaryAuthorization(c interface{}) []map[string]interface{} {
+	binAuthz, ok := c.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"evaluation_mode": binAuthz["evaluationMode"],
+		},
+	}
+}
+
+func flattenSecurityPostureConfig(c interface{}) []map[string]interface{} {
+	securityPosture, ok := c.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"mode":               securityPosture["mode"],
+			"vulnerability_mode": securityPosture["vulnerabilityMode"],
+		},
+	}
+}
+
+func flattenCostManagementConfig(c interface{}) []map[string]interface{} {
+	costManagement, ok := c.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"enabled": costManagement["enabled"],
+		},
+	}
+}
+
+func flattenFleet(c interface{}) []map[string]interface{} {
+	fleet, ok := c.(map[string]interface{})
+	if !ok || fleet["project"] == nil || fleet["project"] == "" {
+		return nil
+	}
+	membership, _ := fleet["membership"].(string)
+	membershipId := ""
+	if membership != "" {
+		membershipId = GetResourceNameFromSelfLink(membership)
+	}
+	return []map[string]interface{}{
+		{
+			"project":       fleet["project"],
+			"membership":    membership,
+			"membership_id": membershipId,
+		},
+	}
+}
+
+func flattenShieldedNodes(c interface{}) bool {
+	shieldedNodes, ok := c.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	enabled, _ := shieldedNodes["enabled"].(bool)
+	return enabled
+}
+
+func flattenConfidentialNodes(c interface{}) []map[string]interface{} {
+	confidentialNodes, ok := c.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	enabled, _ := confidentialNodes["enabled"].(bool)
+	return []map[string]interface{}{
+		{
+			"enabled": enabled,
+		},
+	}
+}
+
+func flattenMeshCertificates(c interface{}) []map[string]interface{} {
+	meshCertificates, ok := c.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	enabled, _ := meshCertificates["enableCertificates"].(bool)
+	return []map[string]interface{}{
+		{
+			"enable_certificates": enabled,
+		},
+	}
+}
+
+func flattenIdentityServiceConfig(c interface{}) []map[string]interface{} {
+	identityService, ok := c.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	enabled, _ := identityService["enabled"].(bool)
+	return []map[string]interface{}{
+		{
+			"enabled": enabled,
+		},
+	}
+}
+
 func resourceContainerClusterStateImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	config := meta.(*Config)
 
@@ -75,6 +75,143 @@ func TestContainerClusterIpAllocationCustomizeDiff(t *testing.T) {
 	}
 }
 
+func TestContainerClusterConfidentialNodesCustomizeDiff(t *testing.T) {
+	t.Parallel()
+
+	enabled := []interface{}{
+		map[string]interface{}{
+			"enabled": true,
+		},
+	}
+	nodeConfig := func(machineType string) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"machine_type": machineType,
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		ConfidentialNodes []interface{}
+		NodeConfig        []interface{}
+		NodePool          []interface{}
+		ExpectError       bool
+	}{
+		"disabled with unsupported machine type": {
+			ConfidentialNodes: []interface{}{
+				map[string]interface{}{
+					"enabled": false,
+				},
+			},
+			NodeConfig: nodeConfig("e2-medium"),
+		},
+		"unset with unsupported machine type": {
+			NodeConfig: nodeConfig("e2-medium"),
+		},
+		"enabled with n2d": {
+			ConfidentialNodes: enabled,
+			NodeConfig:        nodeConfig("n2d-standard-2"),
+		},
+		"enabled with c2d": {
+			ConfidentialNodes: enabled,
+			NodeConfig:        nodeConfig("c2d-standard-4"),
+		},
+		"enabled with unset machine type": {
+			ConfidentialNodes: enabled,
+			NodeConfig:        nodeConfig(""),
+		},
+		"enabled with unsupported machine type": {
+			ConfidentialNodes: enabled,
+			NodeConfig:        nodeConfig("e2-medium"),
+			ExpectError:       true,
+		},
+		"enabled with unsupported machine type in node pool": {
+			ConfidentialNodes: enabled,
+			NodePool: []interface{}{
+				map[string]interface{}{
+					"node_config": nodeConfig("n2d-standard-2"),
+				},
+				map[string]interface{}{
+					"node_config": nodeConfig("n1-standard-1"),
+				},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &ResourceDiffMock{
+			After: map[string]interface{}{
+				"confidential_nodes": tc.ConfidentialNodes,
+				"node_config":        tc.NodeConfig,
+				"node_pool":          tc.NodePool,
+			},
+		}
+		err := containerClusterConfidentialNodesCustomizeDiffFunc(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s failed, expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s failed, unexpected error: %s", tn, err)
+		}
+	}
+}
+
+func TestContainerClusterMeshCertificatesCustomizeDiff(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		MeshCertificates       []interface{}
+		WorkloadIdentityConfig []interface{}
+		ExpectError            bool
+	}{
+		"unset": {},
+		"disabled without workload identity": {
+			MeshCertificates: []interface{}{
+				map[string]interface{}{
+					"enable_certificates": false,
+				},
+			},
+		},
+		"enabled with workload identity": {
+			MeshCertificates: []interface{}{
+				map[string]interface{}{
+					"enable_certificates": true,
+				},
+			},
+			WorkloadIdentityConfig: []interface{}{
+				map[string]interface{}{
+					"identity_namespace": "my-project.svc.id.goog",
+				},
+			},
+		},
+		"enabled without workload identity": {
+			MeshCertificates: []interface{}{
+				map[string]interface{}{
+					"enable_certificates": true,
+				},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &ResourceDiffMock{
+			After: map[string]interface{}{
+				"mesh_certificates":        tc.MeshCertificates,
+				"workload_identity_config": tc.WorkloadIdentityConfig,
+			},
+		}
+		err := containerClusterMeshCertificatesCustomizeDiffFunc(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s failed, expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s failed, unexpected error: %s", tn, err)
+		}
+	}
+}
+
 func TestAccContainerCluster_basic(t *testing.T) {
 	t.Parallel()
 
@@ -1353,6 +1490,185 @@ func TestAccContainerCluster_withBinaryAuthorization(t *testing.T) {
 	})
 }
 
+func TestAccContainerCluster_withSecurityPostureAndBinaryAuthorization(t *testing.T) {
+	t.Parallel()
+
+	clusterName := fmt.Sprintf("cluster-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerCluster_withSecurityPostureAndBinaryAuthorization(clusterName, "BASIC", "PROJECT_SINGLETON_POLICY_ENFORCE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_container_cluster.with_security_posture", "security_posture_config.0.mode", "BASIC"),
+					resource.TestCheckResourceAttr("google_container_cluster.with_security_posture", "binary_authorization.0.evaluation_mode", "PROJECT_SINGLETON_POLICY_ENFORCE"),
+				),
+			},
+			{
+				ResourceName:        "google_container_cluster.with_security_posture",
+				ImportStateIdPrefix: "us-central1-a/",
+				ImportState:         true,
+				ImportStateVerify:   true,
+			},
+			{
+				Config: testAccContainerCluster_withSecurityPostureAndBinaryAuthorization(clusterName, "DISABLED", "DISABLED"),
+			},
+			{
+				ResourceName:        "google_container_cluster.with_security_posture",
+				ImportStateIdPrefix: "us-central1-a/",
+				ImportState:         true,
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func TestAccContainerCluster_withCostManagementAndFleet(t *testing.T) {
+	t.Parallel()
+
+	clusterName := fmt.Sprintf("cluster-test-%s", acctest.RandString(10))
+	project := getTestProjectFromEnv()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerCluster_withCostManagementAndFleet(clusterName, project),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_container_cluster.with_fleet", "cost_management_config.0.enabled", "true"),
+					resource.TestCheckResourceAttrSet("google_container_cluster.with_fleet", "fleet.0.membership"),
+					resource.TestCheckResourceAttr("google_container_cluster.with_fleet", "fleet.0.membership_id", clusterName),
+				),
+			},
+			{
+				ResourceName:        "google_container_cluster.with_fleet",
+				ImportStateIdPrefix: "us-central1-a/",
+				ImportState:         true,
+				ImportStateVerify:   true,
+			},
+			{
+				Config: testAccContainerCluster_withoutFleet(clusterName),
+			},
+			{
+				ResourceName:        "google_container_cluster.with_fleet",
+				ImportStateIdPrefix: "us-central1-a/",
+				ImportState:         true,
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func TestAccContainerCluster_withShieldedNodes(t *testing.T) {
+	t.Parallel()
+
+	clusterName := fmt.Sprintf("cluster-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerCluster_withShieldedNodes(clusterName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_container_cluster.with_shielded_nodes", "enable_shielded_nodes", "false"),
+				),
+			},
+			{
+				ResourceName:        "google_container_cluster.with_shielded_nodes",
+				ImportStateIdPrefix: "us-central1-a/",
+				ImportState:         true,
+				ImportStateVerify:   true,
+			},
+			{
+				Config: testAccContainerCluster_withShieldedNodes(clusterName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_container_cluster.with_shielded_nodes", "enable_shielded_nodes", "true"),
+				),
+			},
+			{
+				ResourceName:        "google_container_cluster.with_shielded_nodes",
+				ImportStateIdPrefix: "us-central1-a/",
+				ImportState:         true,
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func TestAccContainerCluster_withConfidentialNodes(t *testing.T) {
+	t.Parallel()
+
+	clusterName := fmt.Sprintf("cluster-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerCluster_withConfidentialNodes(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_container_cluster.with_confidential_nodes", "confidential_nodes.0.enabled", "true"),
+				),
+			},
+			{
+				ResourceName:        "google_container_cluster.with_confidential_nodes",
+				ImportStateIdPrefix: "us-central1-a/",
+				ImportState:         true,
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func TestAccContainerCluster_withMeshCertificatesAndIdentityService(t *testing.T) {
+	t.Parallel()
+
+	clusterName := fmt.Sprintf("cluster-test-%s", acctest.RandString(10))
+	project := getTestProjectFromEnv()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerCluster_withMeshCertificatesAndIdentityService(clusterName, project, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_container_cluster.with_mesh_certificates", "mesh_certificates.0.enable_certificates", "true"),
+					resource.TestCheckResourceAttr("google_container_cluster.with_mesh_certificates", "identity_service_config.0.enabled", "true"),
+				),
+			},
+			{
+				ResourceName:        "google_container_cluster.with_mesh_certificates",
+				ImportStateIdPrefix: "us-central1-a/",
+				ImportState:         true,
+				ImportStateVerify:   true,
+			},
+			{
+				Config: testAccContainerCluster_withMeshCertificatesAndIdentityService(clusterName, project, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_container_cluster.with_mesh_certificates", "mesh_certificates.0.enable_certificates", "false"),
+					resource.TestCheckResourceAttr("google_container_cluster.with_mesh_certificates", "identity_service_config.0.enabled", "false"),
+				),
+			},
+			{
+				ResourceName:        "google_container_cluster.with_mesh_certificates",
+				ImportStateIdPrefix: "us-central1-a/",
+				ImportState:         true,
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
 func TestAccContainerCluster_withFlexiblePodCIDR(t *testing.T) {
 	t.Parallel()
 
@@ -2946,6 +3262,109 @@ resource "google_container_cluster" "with_binary_authorization" {
 `, clusterName, enabled)
 }
 
+func testAccContainerCluster_withSecurityPostureAndBinaryAuthorization(clusterName, postureMode, evaluationMode string) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "with_security_posture" {
+	name = "%s"
+	zone = "us-central1-a"
+	initial_node_count = 1
+
+	security_posture_config {
+		mode               = "%s"
+		vulnerability_mode = "VULNERABILITY_DISABLED"
+	}
+
+	binary_authorization {
+		evaluation_mode = "%s"
+	}
+}
+`, clusterName, postureMode, evaluationMode)
+}
+
+func testAccContainerCluster_withCostManagementAndFleet(clusterName, project string) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "with_fleet" {
+	name = "%s"
+	zone = "us-central1-a"
+	initial_node_count = 1
+
+	cost_management_config {
+		enabled = true
+	}
+
+	fleet {
+		project = "%s"
+	}
+}
+`, clusterName, project)
+}
+
+func testAccContainerCluster_withShieldedNodes(clusterName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "with_shielded_nodes" {
+	name = "%s"
+	zone = "us-central1-a"
+	initial_node_count = 1
+
+	enable_shielded_nodes = %t
+}
+`, clusterName, enabled)
+}
+
+func testAccContainerCluster_withConfidentialNodes(clusterName string) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "with_confidential_nodes" {
+	name = "%s"
+	zone = "us-central1-a"
+	initial_node_count = 1
+
+	node_config {
+		machine_type = "n2d-standard-2"
+	}
+
+	confidential_nodes {
+		enabled = true
+	}
+}
+`, clusterName)
+}
+
+func testAccContainerCluster_withMeshCertificatesAndIdentityService(clusterName, project string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "with_mesh_certificates" {
+	name = "%s"
+	zone = "us-central1-a"
+	initial_node_count = 1
+
+	workload_identity_config {
+		identity_namespace = "%s.svc.id.goog"
+	}
+
+	mesh_certificates {
+		enable_certificates = %t
+	}
+
+	identity_service_config {
+		enabled = %t
+	}
+}
+`, clusterName, project, enabled, enabled)
+}
+
+func testAccContainerCluster_withoutFleet(clusterName string) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "with_fleet" {
+	name = "%s"
+	zone = "us-central1-a"
+	initial_node_count = 1
+
+	cost_management_config {
+		enabled = false
+	}
+}
+`, clusterName)
+}
+
 func testAccContainerCluster_withFlexiblePodCIDR(cluster string) string {
 	return fmt.Sprintf(`
 resource "google_compute_network" "container_network" {
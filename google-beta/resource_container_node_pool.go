@@ -36,6 +36,8 @@ func resourceContainerNodePool() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			resourceNodeConfigEmptyGuestAccelerator,
+			resourceContainerNodePoolPlacementPolicyCustomizeDiff,
+			resourceNodeConfigLocalSsdCountCustomizeDiff,
 		),
 
 		Schema: mergeSchemas(
@@ -72,10 +74,60 @@ func resourceContainerNodePool() *schema.Resource {
 					Computed: true,
 					ForceNew: true,
 				},
+				"placement_policy": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"type": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice([]string{"COMPACT"}, false),
+							},
+							"tpu_topology": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+							"policy_name": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
 			}),
 	}
 }
 
+// Compact placement is only available on these machine families, see
+// https://cloud.google.com/kubernetes-engine/docs/how-to/compact-placement
+var compactPlacementMachineFamilies = []string{"a2", "a3", "c2", "c2d", "c3", "c3d", "g2", "h3", "n2", "n2d"}
+
+func resourceContainerNodePoolPlacementPolicyCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Get("placement_policy.0.type").(string) != "COMPACT" {
+		return nil
+	}
+
+	machineType := diff.Get("node_config.0.machine_type").(string)
+	if machineType == "" {
+		// The API defaults to n1-standard-1, which doesn't support compact placement.
+		machineType = "n1-standard-1"
+	}
+	family := strings.Split(machineType, "-")[0]
+	for _, f := range compactPlacementMachineFamilies {
+		if f == family {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("placement_policy type COMPACT isn't supported on machine type %q, it requires one of the machine families %s", machineType, strings.Join(compactPlacementMachineFamilies, ", "))
+}
+
 var schemaNodePool = map[string]*schema.Schema{
 	"autoscaling": {
 		Type:     schema.TypeList,
@@ -237,13 +289,29 @@ func resourceContainerNodePoolCreate(d *schema.ResourceData, meta interface{}) e
 		NodePool: nodePool,
 	}
 
+	// The vendored client doesn't know about placement policies and some node
+	// config fields, so the request is sent as JSON with those added to it.
+	rawReq, err := ConvertToMap(req)
+	if err != nil {
+		return err
+	}
+	rawNodePool := rawReq["nodePool"].(map[string]interface{})
+	if v, ok := d.GetOk("placement_policy"); ok {
+		rawNodePool["placementPolicy"] = expandNodePoolPlacementPolicy(v)
+	}
+	expandNodeConfigRawFields(d.Get("node_config"), rawNodePool["config"].(map[string]interface{}))
+
 	timeout := d.Timeout(schema.TimeoutCreate)
 	startTime := time.Now()
 
+	url := config.ContainerBetaBasePath + nodePoolInfo.parent() + "/nodePools"
 	var operation *containerBeta.Operation
 	err = resource.Retry(timeout, func() *resource.RetryError {
-		operation, err = config.clientContainerBeta.
-			Projects.Locations.Clusters.NodePools.Create(nodePoolInfo.parent(), req).Do()
+		res, err := sendRequestWithTimeout(config, "POST", "", url, rawReq, timeout)
+		if err == nil {
+			operation = &containerBeta.Operation{}
+			err = Convert(res, operation)
+		}
 
 		if err != nil {
 			if isFailedPreconditionError(err) {
@@ -287,14 +355,18 @@ func resourceContainerNodePoolRead(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
+	// The node pool is read as JSON so the fields the vendored client doesn't
+	// know about can be read from it as well.
+	var res map[string]interface{}
 	var nodePool = &containerBeta.NodePool{}
 	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
-		nodePool, err = config.clientContainerBeta.
-			Projects.Locations.Clusters.NodePools.Get(nodePoolInfo.fullyQualifiedName(name)).Do()
-
+		res, err = sendRequest(config, "GET", "", config.ContainerBetaBasePath+nodePoolInfo.fullyQualifiedName(name), nil)
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
+		if err := Convert(res, nodePool); err != nil {
+			return resource.NonRetryableError(err)
+		}
 		if nodePool.Status != "RUNNING" {
 			return resource.RetryableError(fmt.Errorf("Nodepool %q has status %q with message %q", d.Get("name"), nodePool.Status, nodePool.StatusMessage))
 		}
@@ -309,6 +381,7 @@ func resourceContainerNodePoolRead(d *schema.ResourceData, meta interface{}) err
 	if err != nil {
 		return err
 	}
+	flattenNodeConfigRawFields(npMap["node_config"].([]map[string]interface{}), res["config"])
 
 	for k, v := range npMap {
 		d.Set(k, v)
@@ -323,6 +396,10 @@ func resourceContainerNodePoolRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("location", nodePoolInfo.location)
 	d.Set("project", nodePoolInfo.project)
 
+	if err := d.Set("placement_policy", flattenNodePoolPlacementPolicy(res["placementPolicy"])); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -761,6 +838,38 @@ func nodePoolUpdate(d *schema.ResourceData, meta interface{}, nodePoolInfo *Node
 	return nil
 }
 
+func expandNodePoolPlacementPolicy(configured interface{}) map[string]interface{} {
+	l := configured.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	placementPolicy := l[0].(map[string]interface{})
+	result := map[string]interface{}{
+		"type": placementPolicy["type"],
+	}
+	if v, ok := placementPolicy["tpu_topology"]; ok && v != "" {
+		result["tpuTopology"] = v
+	}
+	if v, ok := placementPolicy["policy_name"]; ok && v != "" {
+		result["policyName"] = v
+	}
+	return result
+}
+
+func flattenNodePoolPlacementPolicy(c interface{}) []map[string]interface{} {
+	placementPolicy, ok := c.(map[string]interface{})
+	if !ok || placementPolicy["type"] == nil || placementPolicy["type"] == "TYPE_UNSPECIFIED" {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"type":         placementPolicy["type"],
+			"tpu_topology": placementPolicy["tpuTopology"],
+			"policy_name":  placementPolicy["policyName"],
+		},
+	}
+}
+
 func getNodePoolName(id string) string {
 	// name can be specified with name, name_prefix, or neither, so read it from the id.
 	return strings.Split(id, "/")[2]
@@ -2,6 +2,7 @@ package google
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -57,6 +58,93 @@ func TestAccContainerNodePool_maxPodsPerNode(t *testing.T) {
 	})
 }
 
+func TestAccContainerNodePool_compactPlacement(t *testing.T) {
+	t.Parallel()
+
+	cluster := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+	np := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerNodePool_compactPlacement(cluster, np, "c2-standard-4"),
+			},
+			{
+				ResourceName:            "google_container_node_pool.np",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"max_pods_per_node"},
+			},
+		},
+	})
+}
+
+func TestAccContainerNodePool_compactPlacementUnsupportedMachineType(t *testing.T) {
+	t.Parallel()
+
+	cluster := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+	np := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccContainerNodePool_compactPlacement(cluster, np, "e2-standard-4"),
+				ExpectError: regexp.MustCompile("placement_policy type COMPACT isn't supported"),
+			},
+		},
+	})
+}
+
+func TestAccContainerNodePool_localNvmeSsdBlockConfig(t *testing.T) {
+	t.Parallel()
+
+	cluster := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+	np := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerNodePool_localNvmeSsdBlockConfig(cluster, np, "n2-standard-8", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_container_node_pool.np", "node_config.0.local_nvme_ssd_block_config.0.local_ssd_count", "1"),
+				),
+			},
+			{
+				ResourceName:            "google_container_node_pool.np",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"max_pods_per_node"},
+			},
+		},
+	})
+}
+
+func TestAccContainerNodePool_localSsdCountUnsupportedMachineType(t *testing.T) {
+	t.Parallel()
+
+	cluster := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+	np := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccContainerNodePool_localNvmeSsdBlockConfig(cluster, np, "n1-standard-4", 9),
+				ExpectError: regexp.MustCompile("isn't supported on machine type \"n1-standard-4\""),
+			},
+		},
+	})
+}
+
 func TestAccContainerNodePool_namePrefix(t *testing.T) {
 	t.Parallel()
 
@@ -691,6 +779,55 @@ resource "google_container_node_pool" "np" {
 }`, cluster, np)
 }
 
+func testAccContainerNodePool_compactPlacement(cluster, np, machineType string) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "cluster" {
+	name               = "%s"
+	location           = "us-central1-a"
+	initial_node_count = 1
+}
+
+resource "google_container_node_pool" "np" {
+	name               = "%s"
+	location           = "us-central1-a"
+	cluster            = "${google_container_cluster.cluster.name}"
+	initial_node_count = 2
+
+	node_config {
+		machine_type = "%s"
+	}
+
+	placement_policy {
+		type = "COMPACT"
+	}
+}`, cluster, np, machineType)
+}
+
+func testAccContainerNodePool_localNvmeSsdBlockConfig(cluster, np, machineType string, count int) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "cluster" {
+	name               = "%s"
+	location           = "us-central1-a"
+	initial_node_count = 1
+	min_master_version = "latest"
+}
+
+resource "google_container_node_pool" "np" {
+	name               = "%s"
+	location           = "us-central1-a"
+	cluster            = "${google_container_cluster.cluster.name}"
+	initial_node_count = 1
+
+	node_config {
+		machine_type = "%s"
+
+		local_nvme_ssd_block_config {
+			local_ssd_count = %d
+		}
+	}
+}`, cluster, np, machineType, count)
+}
+
 func testAccContainerNodePool_maxPodsPerNode(cluster, np string) string {
 	return fmt.Sprintf(`
 resource "google_compute_network" "container_network" {
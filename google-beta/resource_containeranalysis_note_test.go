@@ -8,6 +8,48 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
+func TestContainerAnalysisNoteKindCustomizeDiff(t *testing.T) {
+	cases := map[string]struct {
+		AttestationAuthority, Vulnerability, Build int
+		ExpectError                                bool
+	}{
+		"attestation authority": {
+			AttestationAuthority: 1,
+		},
+		"vulnerability": {
+			Vulnerability: 1,
+		},
+		"build": {
+			Build: 1,
+		},
+		"no kind": {
+			ExpectError: true,
+		},
+		"two kinds": {
+			AttestationAuthority: 1,
+			Build:                1,
+			ExpectError:          true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &ResourceDiffMock{
+			After: map[string]interface{}{
+				"attestation_authority.#": tc.AttestationAuthority,
+				"vulnerability.#":         tc.Vulnerability,
+				"build.#":                 tc.Build,
+			},
+		}
+		err := containerAnalysisNoteKindCustomizeDiffFunc(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
+
 func TestAccContainerAnalysisNote_basic(t *testing.T) {
 	t.Parallel()
 
@@ -73,3 +115,61 @@ resource "google_container_analysis_note" "note" {
 }
 `, name, readableName)
 }
+
+func TestAccContainerAnalysisNote_vulnerability(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandString(10)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerAnalysisNoteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerAnalysisNoteVulnerability(name, "HIGH"),
+			},
+			{
+				ResourceName:      "google_container_analysis_note.note",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContainerAnalysisNoteVulnerability(name, "CRITICAL"),
+			},
+			{
+				ResourceName:      "google_container_analysis_note.note",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccContainerAnalysisNoteVulnerability(name, severity string) string {
+	return fmt.Sprintf(`
+resource "google_container_analysis_note" "note" {
+  name = "tf-test-%s"
+  vulnerability {
+    cvss_score = 7.5
+    severity   = "%s"
+    details {
+      cpe_uri       = "cpe:/o:debian:debian_linux:9"
+      package       = "openssl"
+      package_type  = "OS"
+      severity_name = "%s"
+      description   = "Test vulnerability"
+
+      min_affected_version {
+        kind = "MINIMUM"
+      }
+
+      max_affected_version {
+        kind     = "NORMAL"
+        name     = "1.1.0"
+        revision = "1"
+      }
+    }
+  }
+}
+`, name, severity, severity)
+}
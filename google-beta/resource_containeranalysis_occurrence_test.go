@@ -0,0 +1,113 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccContainerAnalysisOccurrence_attestation(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+		"remediation":   "",
+	}
+	updated := map[string]interface{}{
+		"random_suffix": context["random_suffix"],
+		"remediation":   "Rebuild the image from a patched base image",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerAnalysisOccurrenceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerAnalysisOccurrence_attestation(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_container_analysis_occurrence.occurrence", "kind", "ATTESTATION"),
+				),
+			},
+			{
+				ResourceName:      "google_container_analysis_occurrence.occurrence",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContainerAnalysisOccurrence_attestation(updated),
+			},
+			{
+				ResourceName:      "google_container_analysis_occurrence.occurrence",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccContainerAnalysisOccurrence_attestation(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_container_analysis_note" "note" {
+  name = "tf-test-attestor-note-%{random_suffix}"
+  attestation_authority {
+    hint {
+      human_readable_name = "Attestor Note"
+    }
+  }
+}
+
+locals {
+  image_uri = "gcr.io/cloud-builders/gcloud@sha256:c1b2f9a2e3c8e2e3e7e4ddb3e0e1c5bd40c2e0b66b22e0ca1d1b8e2ff5fc4e3b"
+}
+
+resource "google_container_analysis_occurrence" "occurrence" {
+  resource_uri = "https://${local.image_uri}"
+  note_name    = "projects/${google_container_analysis_note.note.project}/notes/${google_container_analysis_note.note.name}"
+  remediation  = "%{remediation}"
+
+  attestation {
+    serialized_payload = base64encode(jsonencode({
+      critical = {
+        identity = {
+          docker-reference = "gcr.io/cloud-builders/gcloud"
+        }
+        image = {
+          docker-manifest-digest = "sha256:c1b2f9a2e3c8e2e3e7e4ddb3e0e1c5bd40c2e0b66b22e0ca1d1b8e2ff5fc4e3b"
+        }
+        type = "Google cloud binauthz container signature"
+      }
+    }))
+    signatures {
+      public_key_id = "//cloudkms.googleapis.com/v1/projects/my-project/locations/global/keyRings/my-ring/cryptoKeys/my-key/cryptoKeyVersions/1"
+      signature     = base64encode("test-signature-%{random_suffix}")
+    }
+  }
+}
+`, context)
+}
+
+func testAccCheckContainerAnalysisOccurrenceDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_container_analysis_occurrence" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url := fmt.Sprintf("%s%s", config.ContainerAnalysisBasePath, rs.Primary.ID)
+		_, err := sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("ContainerAnalysisOccurrence still exists at %s", url)
+		}
+	}
+
+	return nil
+}
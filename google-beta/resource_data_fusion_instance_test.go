@@ -0,0 +1,59 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataFusionInstance_update(t *testing.T) {
+	t.Parallel()
+
+	instanceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDataFusionInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataFusionInstance_developer(instanceName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("google_data_fusion_instance.foobar", "service_endpoint"),
+					resource.TestCheckResourceAttrSet("google_data_fusion_instance.foobar", "api_endpoint"),
+				),
+			},
+			{
+				ResourceName:      "google_data_fusion_instance.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDataFusionInstance_developer(instanceName, true),
+			},
+			{
+				ResourceName:      "google_data_fusion_instance.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDataFusionInstance_developer(instanceName string, stackdriver bool) string {
+	return fmt.Sprintf(`
+resource "google_data_fusion_instance" "foobar" {
+  name                          = "%s"
+  region                        = "us-central1"
+  type                          = "DEVELOPER"
+  enable_stackdriver_logging    = %t
+  enable_stackdriver_monitoring = %t
+
+  labels = {
+    stackdriver = "%t"
+  }
+}
+`, instanceName, stackdriver, stackdriver, stackdriver)
+}
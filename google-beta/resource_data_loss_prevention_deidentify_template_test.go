@@ -0,0 +1,176 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestDataLossPreventionDeidentifyTemplateCustomizeDiff(t *testing.T) {
+	infoTypes := "deidentify_config.0.info_type_transformations.0.transformations"
+	records := "deidentify_config.0.record_transformations.0.field_transformations"
+	cases := map[string]struct {
+		After       map[string]interface{}
+		ExpectError bool
+	}{
+		"info type replace": {
+			After: map[string]interface{}{
+				"deidentify_config.#":                             1,
+				"deidentify_config.0.info_type_transformations.#": 1,
+				infoTypes + ".#":                                  1,
+				infoTypes + ".0.primitive_transformation.0.replace_with_info_type_config": true,
+			},
+		},
+		"record mask": {
+			After: map[string]interface{}{
+				"deidentify_config.#":                          1,
+				"deidentify_config.0.record_transformations.#": 1,
+				records + ".#":                                 2,
+				records + ".0.primitive_transformation.0.character_mask_config.#": 1,
+				records + ".1.primitive_transformation.0.crypto_hash_config.#":    1,
+			},
+		},
+		"no transformations": {
+			After: map[string]interface{}{
+				"deidentify_config.#": 1,
+			},
+			ExpectError: true,
+		},
+		"both transformations": {
+			After: map[string]interface{}{
+				"deidentify_config.#":                             1,
+				"deidentify_config.0.info_type_transformations.#": 1,
+				"deidentify_config.0.record_transformations.#":    1,
+			},
+			ExpectError: true,
+		},
+		"empty primitive": {
+			After: map[string]interface{}{
+				"deidentify_config.#":                          1,
+				"deidentify_config.0.record_transformations.#": 1,
+				records + ".#":                                 2,
+				records + ".0.primitive_transformation.0.character_mask_config.#": 1,
+			},
+			ExpectError: true,
+		},
+		"two primitives": {
+			After: map[string]interface{}{
+				"deidentify_config.#":                             1,
+				"deidentify_config.0.info_type_transformations.#": 1,
+				infoTypes + ".#":                                  1,
+				infoTypes + ".0.primitive_transformation.0.replace_with_info_type_config": true,
+				infoTypes + ".0.primitive_transformation.0.replace_config.#":              1,
+			},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &ResourceDiffMock{
+			After: tc.After,
+		}
+		err := dataLossPreventionDeidentifyTemplateCustomizeDiffFunc(d)
+		if tc.ExpectError != (err != nil) {
+			t.Errorf("%s: expected error %t, got %v", tn, tc.ExpectError, err)
+		}
+	}
+}
+
+func TestAccDataLossPreventionDeidentifyTemplate_infoTypeTransformations(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"project":           getTestProjectFromEnv(),
+		"random_suffix":     acctest.RandString(10),
+		"masking_character": "#",
+	}
+	updated := map[string]interface{}{
+		"project":           context["project"],
+		"random_suffix":     context["random_suffix"],
+		"masking_character": "*",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDataLossPreventionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataLossPreventionDeidentifyTemplate_infoTypeTransformations(context),
+			},
+			{
+				ResourceName:            "google_data_loss_prevention_deidentify_template.template",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_id"},
+			},
+			{
+				Config: testAccDataLossPreventionDeidentifyTemplate_infoTypeTransformations(updated),
+			},
+			{
+				ResourceName:            "google_data_loss_prevention_deidentify_template.template",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_id"},
+			},
+		},
+	})
+}
+
+func testAccDataLossPreventionDeidentifyTemplate_infoTypeTransformations(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_data_loss_prevention_deidentify_template" "template" {
+  parent       = "projects/%{project}"
+  template_id  = "tf-test-template-%{random_suffix}"
+  description  = "Masks phone numbers and replaces email addresses"
+  display_name = "tf-test-template-%{random_suffix}"
+
+  deidentify_config {
+    info_type_transformations {
+      transformations {
+        info_types {
+          name = "PHONE_NUMBER"
+        }
+
+        primitive_transformation {
+          character_mask_config {
+            masking_character = "%{masking_character}"
+            number_to_mask    = 4
+            reverse_order     = true
+
+            characters_to_ignore {
+              common_characters_to_ignore = "PUNCTUATION"
+            }
+          }
+        }
+      }
+
+      transformations {
+        info_types {
+          name = "EMAIL_ADDRESS"
+        }
+
+        primitive_transformation {
+          replace_with_info_type_config = true
+        }
+      }
+
+      transformations {
+        info_types {
+          name = "PERSON_NAME"
+        }
+
+        primitive_transformation {
+          replace_config {
+            new_value {
+              string_value = "[redacted]"
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, context)
+}
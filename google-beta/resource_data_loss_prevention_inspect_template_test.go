@@ -0,0 +1,154 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDataLossPreventionInfoTypeName(t *testing.T) {
+	cases := map[string]struct {
+		Value      string
+		ExpectFail bool
+	}{
+		"built-in":        {Value: "EMAIL_ADDRESS"},
+		"custom":          {Value: "my-custom_TYPE$1"},
+		"empty":           {Value: "", ExpectFail: true},
+		"space":           {Value: "EMAIL ADDRESS", ExpectFail: true},
+		"dot":             {Value: "email.address", ExpectFail: true},
+		"too long":        {Value: strings.Repeat("A", 65), ExpectFail: true},
+		"max length name": {Value: strings.Repeat("A", 64)},
+	}
+
+	for tn, tc := range cases {
+		_, errs := validateDataLossPreventionInfoTypeName(tc.Value, "name")
+		if tc.ExpectFail != (len(errs) > 0) {
+			t.Errorf("%s: expected failure %t for %q, got errors: %v", tn, tc.ExpectFail, tc.Value, errs)
+		}
+	}
+}
+
+func TestAccDataLossPreventionInspectTemplate_emailAndCustomRegex(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"project":        getTestProjectFromEnv(),
+		"random_suffix":  acctest.RandString(10),
+		"min_likelihood": "POSSIBLE",
+		"max_findings":   100,
+	}
+	updated := map[string]interface{}{
+		"project":        context["project"],
+		"random_suffix":  context["random_suffix"],
+		"min_likelihood": "LIKELY",
+		"max_findings":   200,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDataLossPreventionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataLossPreventionInspectTemplate_emailAndCustomRegex(context),
+			},
+			{
+				ResourceName:            "google_data_loss_prevention_inspect_template.template",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_id"},
+			},
+			{
+				Config: testAccDataLossPreventionInspectTemplate_emailAndCustomRegex(updated),
+			},
+			{
+				ResourceName:            "google_data_loss_prevention_inspect_template.template",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_id"},
+			},
+		},
+	})
+}
+
+func testAccDataLossPreventionInspectTemplate_emailAndCustomRegex(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_data_loss_prevention_inspect_template" "template" {
+  parent       = "projects/%{project}"
+  template_id  = "tf-test-template-%{random_suffix}"
+  description  = "Detects email addresses and employee IDs"
+  display_name = "tf-test-template-%{random_suffix}"
+
+  inspect_config {
+    min_likelihood = "%{min_likelihood}"
+    include_quote  = true
+
+    info_types {
+      name = "EMAIL_ADDRESS"
+    }
+
+    custom_info_types {
+      info_type {
+        name = "EMPLOYEE_ID"
+      }
+      likelihood = "LIKELY"
+
+      regex {
+        pattern = "E-[0-9]{6}"
+      }
+    }
+
+    rule_set {
+      info_types {
+        name = "EMAIL_ADDRESS"
+      }
+      rules {
+        exclusion_rule {
+          matching_type = "MATCHING_TYPE_FULL_MATCH"
+          regex {
+            pattern = ".+@example.com"
+          }
+        }
+      }
+    }
+
+    limits {
+      max_findings_per_item    = 10
+      max_findings_per_request = %{max_findings}
+
+      max_findings_per_info_type {
+        max_findings = 5
+        info_type {
+          name = "EMAIL_ADDRESS"
+        }
+      }
+    }
+  }
+}
+`, context)
+}
+
+func testAccCheckDataLossPreventionDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if !strings.HasPrefix(rs.Type, "google_data_loss_prevention_") {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url := fmt.Sprintf("%s%s", config.DataLossPreventionBasePath, rs.Primary.ID)
+		_, err := sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("%s still exists at %s", rs.Type, url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestDataLossPreventionJobTriggerStorageCustomizeDiff(t *testing.T) {
+	cases := map[string]struct {
+		After       map[string]interface{}
+		ExpectError bool
+	}{
+		"cloud storage": {
+			After: map[string]interface{}{
+				"inspect_job.#": 1,
+				"inspect_job.0.storage_config.0.cloud_storage_options.#": 1,
+			},
+		},
+		"big query": {
+			After: map[string]interface{}{
+				"inspect_job.#": 1,
+				"inspect_job.0.storage_config.0.big_query_options.#": 1,
+			},
+		},
+		"no storage": {
+			After: map[string]interface{}{
+				"inspect_job.#": 1,
+			},
+			ExpectError: true,
+		},
+		"two storages": {
+			After: map[string]interface{}{
+				"inspect_job.#": 1,
+				"inspect_job.0.storage_config.0.cloud_storage_options.#": 1,
+				"inspect_job.0.storage_config.0.datastore_options.#":     1,
+			},
+			ExpectError: true,
+		},
+		"no inspect job": {
+			After: map[string]interface{}{
+				"inspect_job.#": 0,
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &ResourceDiffMock{
+			After: tc.After,
+		}
+		err := dataLossPreventionJobTriggerStorageCustomizeDiffFunc(d)
+		if tc.ExpectError != (err != nil) {
+			t.Errorf("%s: expected error %t, got %v", tn, tc.ExpectError, err)
+		}
+	}
+}
+
+func TestAccDataLossPreventionJobTrigger_dailyCloudStorage(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"project":       getTestProjectFromEnv(),
+		"random_suffix": acctest.RandString(10),
+		"status":        "HEALTHY",
+		"description":   "Scans the bucket daily",
+	}
+	updated := map[string]interface{}{
+		"project":       context["project"],
+		"random_suffix": context["random_suffix"],
+		"status":        "PAUSED",
+		"description":   "Paused daily bucket scan",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDataLossPreventionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataLossPreventionJobTrigger_dailyCloudStorage(context),
+			},
+			{
+				ResourceName:            "google_data_loss_prevention_job_trigger.trigger",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"trigger_id"},
+			},
+			{
+				Config: testAccDataLossPreventionJobTrigger_dailyCloudStorage(updated),
+			},
+			{
+				ResourceName:            "google_data_loss_prevention_job_trigger.trigger",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"trigger_id"},
+			},
+		},
+	})
+}
+
+func testAccDataLossPreventionJobTrigger_dailyCloudStorage(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_storage_bucket" "bucket" {
+  name = "tf-test-dlp-%{random_suffix}"
+}
+
+resource "google_pubsub_topic" "topic" {
+  name = "tf-test-dlp-%{random_suffix}"
+}
+
+resource "google_data_loss_prevention_inspect_template" "template" {
+  parent = "projects/%{project}"
+
+  inspect_config {
+    info_types {
+      name = "EMAIL_ADDRESS"
+    }
+  }
+}
+
+resource "google_data_loss_prevention_job_trigger" "trigger" {
+  parent       = "projects/%{project}"
+  trigger_id   = "tf-test-trigger-%{random_suffix}"
+  description  = "%{description}"
+  display_name = "tf-test-trigger-%{random_suffix}"
+  status       = "%{status}"
+
+  triggers {
+    schedule {
+      recurrence_period_duration = "86400s"
+    }
+  }
+
+  inspect_job {
+    inspect_template_name = "${google_data_loss_prevention_inspect_template.template.id}"
+
+    storage_config {
+      cloud_storage_options {
+        file_set {
+          url = "gs://${google_storage_bucket.bucket.name}/*"
+        }
+        files_limit_percent = 50
+        sample_method       = "RANDOM_START"
+      }
+    }
+
+    actions {
+      pub_sub {
+        topic = "${google_pubsub_topic.topic.id}"
+      }
+    }
+  }
+}
+`, context)
+}
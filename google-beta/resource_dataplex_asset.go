@@ -0,0 +1,647 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/customdiff"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// dataplexAssetResourceNamePatterns are the formats of the resources an asset
+// can reference, keyed by resource type.
+var dataplexAssetResourceNamePatterns = map[string]*regexp.Regexp{
+	"STORAGE_BUCKET":   regexp.MustCompile(`^projects/[^/]+/buckets/[^/]+$`),
+	"BIGQUERY_DATASET": regexp.MustCompile(`^projects/[^/]+/datasets/[^/]+$`),
+}
+
+func resourceDataplexAssetResourceSpecCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	resourceType := diff.Get("resource_spec.0.type").(string)
+	name := diff.Get("resource_spec.0.name").(string)
+	re, ok := dataplexAssetResourceNamePatterns[resourceType]
+	if !ok || name == "" {
+		// Either unknown yet or rejected by the schema validation.
+		return nil
+	}
+	if !re.MatchString(name) {
+		return fmt.Errorf("resource_spec.0.name %q doesn't match the format of a %s, expected %s", name, resourceType, re)
+	}
+	return nil
+}
+
+func resourceDataplexAsset() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDataplexAssetCreate,
+		Read:   resourceDataplexAssetRead,
+		Update: resourceDataplexAssetUpdate,
+		Delete: resourceDataplexAssetDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceDataplexAssetImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.All(
+			resourceDataplexAssetResourceSpecCustomizeDiff,
+			setLabelsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"dataplex_zone": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"discovery_spec": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"exclude_patterns": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"include_patterns": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"schedule": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"lake": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_spec": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"STORAGE_BUCKET", "BIGQUERY_DATASET"}, false),
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"effective_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"uid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceDataplexAssetCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	displayNameProp, err := expandDataplexAssetDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(displayNameProp)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+	descriptionProp, err := expandDataplexAssetDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	labelsProp, err := expandDataplexAssetLabels(d.Get("terraform_labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("terraform_labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+	resourceSpecProp, err := expandDataplexAssetResourceSpec(d.Get("resource_spec"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("resource_spec"); !isEmptyValue(reflect.ValueOf(resourceSpecProp)) && (ok || !reflect.DeepEqual(v, resourceSpecProp)) {
+		obj["resourceSpec"] = resourceSpecProp
+	}
+	discoverySpecProp, err := expandDataplexAssetDiscoverySpec(d.Get("discovery_spec"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("discovery_spec"); !isEmptyValue(reflect.ValueOf(discoverySpecProp)) && (ok || !reflect.DeepEqual(v, discoverySpecProp)) {
+		obj["discoverySpec"] = discoverySpecProp
+	}
+
+	url, err := replaceVars(d, config, "{{DataplexBasePath}}projects/{{project}}/locations/{{location}}/lakes/{{lake}}/zones/{{dataplex_zone}}/assets?assetId={{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Asset: %#v", obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Asset: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/lakes/{{lake}}/zones/{{dataplex_zone}}/assets/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	waitErr := dataplexOperationWaitTime(
+		config, res, project, "Creating Asset",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create Asset: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating Asset %q: %#v", d.Id(), res)
+
+	return resourceDataplexAssetRead(d, meta)
+}
+
+func resourceDataplexAssetRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{DataplexBasePath}}projects/{{project}}/locations/{{location}}/lakes/{{lake}}/zones/{{dataplex_zone}}/assets/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("DataplexAsset %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Asset: %s", err)
+	}
+
+	if err := d.Set("display_name", flattenDataplexAssetDisplayName(res["displayName"], d)); err != nil {
+		return fmt.Errorf("Error reading Asset: %s", err)
+	}
+	if err := d.Set("description", flattenDataplexAssetDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading Asset: %s", err)
+	}
+	if err := setLabelsFields(d, config, res["labels"]); err != nil {
+		return fmt.Errorf("Error reading Asset: %s", err)
+	}
+	if err := d.Set("resource_spec", flattenDataplexAssetResourceSpec(res["resourceSpec"], d)); err != nil {
+		return fmt.Errorf("Error reading Asset: %s", err)
+	}
+	if err := d.Set("discovery_spec", flattenDataplexAssetDiscoverySpec(res["discoverySpec"], d)); err != nil {
+		return fmt.Errorf("Error reading Asset: %s", err)
+	}
+	if err := d.Set("uid", flattenDataplexAssetUid(res["uid"], d)); err != nil {
+		return fmt.Errorf("Error reading Asset: %s", err)
+	}
+	if err := d.Set("state", flattenDataplexAssetState(res["state"], d)); err != nil {
+		return fmt.Errorf("Error reading Asset: %s", err)
+	}
+	if err := d.Set("create_time", flattenDataplexAssetCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Asset: %s", err)
+	}
+	if err := d.Set("update_time", flattenDataplexAssetUpdateTime(res["updateTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Asset: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDataplexAssetUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	displayNameProp, err := expandDataplexAssetDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+	descriptionProp, err := expandDataplexAssetDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	labelsProp, err := expandDataplexAssetLabels(d.Get("terraform_labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("terraform_labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+	discoverySpecProp, err := expandDataplexAssetDiscoverySpec(d.Get("discovery_spec"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("discovery_spec"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, discoverySpecProp)) {
+		obj["discoverySpec"] = discoverySpecProp
+	}
+
+	url, err := replaceVars(d, config, "{{DataplexBasePath}}projects/{{project}}/locations/{{location}}/lakes/{{lake}}/zones/{{dataplex_zone}}/assets/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Asset %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("display_name") {
+		updateMask = append(updateMask, "displayName")
+	}
+
+	if d.HasChange("description") {
+		updateMask = append(updateMask, "description")
+	}
+
+	if d.HasChange("labels") || d.HasChange("terraform_labels") {
+		updateMask = append(updateMask, "labels")
+	}
+
+	if d.HasChange("discovery_spec") {
+		updateMask = append(updateMask, "discoverySpec")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Asset %q: %s", d.Id(), err)
+	}
+
+	err = dataplexOperationWaitTime(
+		config, res, project, "Updating Asset",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceDataplexAssetRead(d, meta)
+}
+
+func resourceDataplexAssetDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{DataplexBasePath}}projects/{{project}}/locations/{{location}}/lakes/{{lake}}/zones/{{dataplex_zone}}/assets/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Asset %q", d.Id())
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Asset")
+	}
+
+	err = dataplexOperationWaitTime(
+		config, res, project, "Deleting Asset",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting Asset %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceDataplexAssetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/lakes/(?P<lake>[^/]+)/zones/(?P<dataplex_zone>[^/]+)/assets/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<lake>[^/]+)/(?P<dataplex_zone>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<lake>[^/]+)/(?P<dataplex_zone>[^/]+)/(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/lakes/{{lake}}/zones/{{dataplex_zone}}/assets/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenDataplexAssetDisplayName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexAssetDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexAssetResourceSpec(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["name"] =
+		flattenDataplexAssetResourceSpecName(original["name"], d)
+	transformed["type"] =
+		flattenDataplexAssetResourceSpecType(original["type"], d)
+	return []interface{}{transformed}
+}
+func flattenDataplexAssetResourceSpecName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexAssetResourceSpecType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexAssetDiscoverySpec(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["enabled"] =
+		flattenDataplexAssetDiscoverySpecEnabled(original["enabled"], d)
+	transformed["include_patterns"] =
+		flattenDataplexAssetDiscoverySpecIncludePatterns(original["includePatterns"], d)
+	transformed["exclude_patterns"] =
+		flattenDataplexAssetDiscoverySpecExcludePatterns(original["excludePatterns"], d)
+	transformed["schedule"] =
+		flattenDataplexAssetDiscoverySpecSchedule(original["schedule"], d)
+	return []interface{}{transformed}
+}
+func flattenDataplexAssetDiscoverySpecEnabled(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexAssetDiscoverySpecIncludePatterns(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexAssetDiscoverySpecExcludePatterns(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexAssetDiscoverySpecSchedule(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexAssetUid(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexAssetState(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexAssetCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexAssetUpdateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandDataplexAssetDisplayName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexAssetDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexAssetLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandDataplexAssetResourceSpec(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedName, err := expandDataplexAssetResourceSpecName(original["name"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedName); val.IsValid() && !isEmptyValue(val) {
+		transformed["name"] = transformedName
+	}
+
+	transformedType, err := expandDataplexAssetResourceSpecType(original["type"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedType); val.IsValid() && !isEmptyValue(val) {
+		transformed["type"] = transformedType
+	}
+
+	return transformed, nil
+}
+
+func expandDataplexAssetResourceSpecName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexAssetResourceSpecType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexAssetDiscoverySpec(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedEnabled, err := expandDataplexAssetDiscoverySpecEnabled(original["enabled"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedEnabled); val.IsValid() && !isEmptyValue(val) {
+		transformed["enabled"] = transformedEnabled
+	}
+
+	transformedIncludePatterns, err := expandDataplexAssetDiscoverySpecIncludePatterns(original["include_patterns"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedIncludePatterns); val.IsValid() && !isEmptyValue(val) {
+		transformed["includePatterns"] = transformedIncludePatterns
+	}
+
+	transformedExcludePatterns, err := expandDataplexAssetDiscoverySpecExcludePatterns(original["exclude_patterns"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedExcludePatterns); val.IsValid() && !isEmptyValue(val) {
+		transformed["excludePatterns"] = transformedExcludePatterns
+	}
+
+	transformedSchedule, err := expandDataplexAssetDiscoverySpecSchedule(original["schedule"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSchedule); val.IsValid() && !isEmptyValue(val) {
+		transformed["schedule"] = transformedSchedule
+	}
+
+	return transformed, nil
+}
+
+func expandDataplexAssetDiscoverySpecEnabled(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexAssetDiscoverySpecIncludePatterns(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexAssetDiscoverySpecExcludePatterns(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexAssetDiscoverySpecSchedule(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,120 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDataplexAsset_dataplexAssetBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDataplexAssetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataplexAsset_dataplexAssetBasicExample(context),
+			},
+			{
+				ResourceName:      "google_dataplex_asset.primary",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDataplexAsset_dataplexAssetBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_storage_bucket" "basic_bucket" {
+  name          = "tf-test-bucket-%{random_suffix}"
+  location      = "us-west1"
+  force_destroy = true
+}
+
+resource "google_dataplex_lake" "basic_lake" {
+  name     = "tf-test-lake-%{random_suffix}"
+  location = "us-west1"
+}
+
+resource "google_dataplex_zone" "basic_zone" {
+  name     = "tf-test-zone-%{random_suffix}"
+  location = "us-west1"
+  lake     = "${google_dataplex_lake.basic_lake.name}"
+  type     = "RAW"
+
+  discovery_spec {
+    enabled = false
+  }
+
+  resource_spec {
+    location_type = "SINGLE_REGION"
+  }
+}
+
+resource "google_dataplex_asset" "primary" {
+  name          = "tf-test-asset-%{random_suffix}"
+  location      = "us-west1"
+  lake          = "${google_dataplex_lake.basic_lake.name}"
+  dataplex_zone = "${google_dataplex_zone.basic_zone.name}"
+
+  discovery_spec {
+    enabled = false
+  }
+
+  resource_spec {
+    name = "projects/${google_storage_bucket.basic_bucket.project}/buckets/${google_storage_bucket.basic_bucket.name}"
+    type = "STORAGE_BUCKET"
+  }
+}
+`, context)
+}
+
+func testAccCheckDataplexAssetDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_dataplex_asset" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(config, rs, "{{DataplexBasePath}}projects/{{project}}/locations/{{location}}/lakes/{{lake}}/zones/{{dataplex_zone}}/assets/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("DataplexAsset still exists at %s", url)
+		}
+	}
+
+	return nil
+}
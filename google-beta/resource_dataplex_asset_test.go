@@ -0,0 +1,51 @@
+package google
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataplexAsset_resourceSpecMismatch(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataplexAsset_resourceSpec(suffix, "projects/my-project/buckets/my-bucket", "BIGQUERY_DATASET"),
+				ExpectError: regexp.MustCompile("doesn't match the format of a BIGQUERY_DATASET"),
+			},
+			{
+				Config:      testAccDataplexAsset_resourceSpec(suffix, "projects/my-project/buckets/my-bucket", "GCS_BUCKET"),
+				ExpectError: regexp.MustCompile(`expected resource_spec.0.type to be one of \[STORAGE_BUCKET BIGQUERY_DATASET\]`),
+			},
+		},
+	})
+}
+
+func testAccDataplexAsset_resourceSpec(suffix, name, resourceType string) string {
+	return fmt.Sprintf(`
+resource "google_dataplex_asset" "primary" {
+  name          = "tf-test-asset-%s"
+  location      = "us-west1"
+  lake          = "tf-test-lake-%s"
+  dataplex_zone = "tf-test-zone-%s"
+
+  discovery_spec {
+    enabled = false
+  }
+
+  resource_spec {
+    name = "%s"
+    type = "%s"
+  }
+}
+`, suffix, suffix, suffix, name, resourceType)
+}
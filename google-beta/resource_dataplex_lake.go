@@ -0,0 +1,460 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceDataplexLake() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDataplexLakeCreate,
+		Read:   resourceDataplexLakeRead,
+		Update: resourceDataplexLakeUpdate,
+		Delete: resourceDataplexLakeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceDataplexLakeImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: setLabelsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"effective_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"metastore": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_account": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"uid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceDataplexLakeCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	displayNameProp, err := expandDataplexLakeDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(displayNameProp)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+	descriptionProp, err := expandDataplexLakeDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	labelsProp, err := expandDataplexLakeLabels(d.Get("terraform_labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("terraform_labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+	metastoreProp, err := expandDataplexLakeMetastore(d.Get("metastore"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("metastore"); !isEmptyValue(reflect.ValueOf(metastoreProp)) && (ok || !reflect.DeepEqual(v, metastoreProp)) {
+		obj["metastore"] = metastoreProp
+	}
+
+	url, err := replaceVars(d, config, "{{DataplexBasePath}}projects/{{project}}/locations/{{location}}/lakes?lakeId={{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Lake: %#v", obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Lake: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/lakes/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	waitErr := dataplexOperationWaitTime(
+		config, res, project, "Creating Lake",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create Lake: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating Lake %q: %#v", d.Id(), res)
+
+	return resourceDataplexLakeRead(d, meta)
+}
+
+func resourceDataplexLakeRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{DataplexBasePath}}projects/{{project}}/locations/{{location}}/lakes/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("DataplexLake %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Lake: %s", err)
+	}
+
+	if err := d.Set("display_name", flattenDataplexLakeDisplayName(res["displayName"], d)); err != nil {
+		return fmt.Errorf("Error reading Lake: %s", err)
+	}
+	if err := d.Set("description", flattenDataplexLakeDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading Lake: %s", err)
+	}
+	if err := setLabelsFields(d, config, res["labels"]); err != nil {
+		return fmt.Errorf("Error reading Lake: %s", err)
+	}
+	if err := d.Set("metastore", flattenDataplexLakeMetastore(res["metastore"], d)); err != nil {
+		return fmt.Errorf("Error reading Lake: %s", err)
+	}
+	if err := d.Set("service_account", flattenDataplexLakeServiceAccount(res["serviceAccount"], d)); err != nil {
+		return fmt.Errorf("Error reading Lake: %s", err)
+	}
+	if err := d.Set("uid", flattenDataplexLakeUid(res["uid"], d)); err != nil {
+		return fmt.Errorf("Error reading Lake: %s", err)
+	}
+	if err := d.Set("state", flattenDataplexLakeState(res["state"], d)); err != nil {
+		return fmt.Errorf("Error reading Lake: %s", err)
+	}
+	if err := d.Set("create_time", flattenDataplexLakeCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Lake: %s", err)
+	}
+	if err := d.Set("update_time", flattenDataplexLakeUpdateTime(res["updateTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Lake: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDataplexLakeUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	displayNameProp, err := expandDataplexLakeDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+	descriptionProp, err := expandDataplexLakeDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	labelsProp, err := expandDataplexLakeLabels(d.Get("terraform_labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("terraform_labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+	metastoreProp, err := expandDataplexLakeMetastore(d.Get("metastore"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("metastore"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, metastoreProp)) {
+		obj["metastore"] = metastoreProp
+	}
+
+	url, err := replaceVars(d, config, "{{DataplexBasePath}}projects/{{project}}/locations/{{location}}/lakes/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Lake %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("display_name") {
+		updateMask = append(updateMask, "displayName")
+	}
+
+	if d.HasChange("description") {
+		updateMask = append(updateMask, "description")
+	}
+
+	if d.HasChange("labels") || d.HasChange("terraform_labels") {
+		updateMask = append(updateMask, "labels")
+	}
+
+	if d.HasChange("metastore") {
+		updateMask = append(updateMask, "metastore")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Lake %q: %s", d.Id(), err)
+	}
+
+	err = dataplexOperationWaitTime(
+		config, res, project, "Updating Lake",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceDataplexLakeRead(d, meta)
+}
+
+func resourceDataplexLakeDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{DataplexBasePath}}projects/{{project}}/locations/{{location}}/lakes/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Lake %q", d.Id())
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Lake")
+	}
+
+	err = dataplexOperationWaitTime(
+		config, res, project, "Deleting Lake",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting Lake %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceDataplexLakeImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/lakes/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/lakes/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenDataplexLakeDisplayName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexLakeDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexLakeMetastore(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["service"] =
+		flattenDataplexLakeMetastoreService(original["service"], d)
+	return []interface{}{transformed}
+}
+func flattenDataplexLakeMetastoreService(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexLakeServiceAccount(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexLakeUid(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexLakeState(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexLakeCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexLakeUpdateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandDataplexLakeDisplayName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexLakeDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexLakeLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandDataplexLakeMetastore(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedService, err := expandDataplexLakeMetastoreService(original["service"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedService); val.IsValid() && !isEmptyValue(val) {
+		transformed["service"] = transformedService
+	}
+
+	return transformed, nil
+}
+
+func expandDataplexLakeMetastoreService(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,89 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDataplexLake_dataplexLakeBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDataplexLakeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataplexLake_dataplexLakeBasicExample(context),
+			},
+			{
+				ResourceName:      "google_dataplex_lake.primary",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDataplexLake_dataplexLakeBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_dataplex_lake" "primary" {
+  location     = "us-west1"
+  name         = "tf-test-lake-%{random_suffix}"
+  description  = "Lake for DCL"
+  display_name = "Lake for DCL"
+
+  labels = {
+    my-lake = "exists"
+  }
+}
+`, context)
+}
+
+func testAccCheckDataplexLakeDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_dataplex_lake" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(config, rs, "{{DataplexBasePath}}projects/{{project}}/locations/{{location}}/lakes/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("DataplexLake still exists at %s", url)
+		}
+	}
+
+	return nil
+}
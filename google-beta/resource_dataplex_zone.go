@@ -0,0 +1,616 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceDataplexZone() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDataplexZoneCreate,
+		Read:   resourceDataplexZoneRead,
+		Update: resourceDataplexZoneUpdate,
+		Delete: resourceDataplexZoneDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceDataplexZoneImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: setLabelsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"discovery_spec": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"exclude_patterns": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"include_patterns": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"schedule": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"lake": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_spec": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"location_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"SINGLE_REGION", "MULTI_REGION"}, false),
+						},
+					},
+				},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"RAW", "CURATED"}, false),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"effective_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"uid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceDataplexZoneCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	displayNameProp, err := expandDataplexZoneDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(displayNameProp)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+	descriptionProp, err := expandDataplexZoneDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	labelsProp, err := expandDataplexZoneLabels(d.Get("terraform_labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("terraform_labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+	typeProp, err := expandDataplexZoneType(d.Get("type"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("type"); !isEmptyValue(reflect.ValueOf(typeProp)) && (ok || !reflect.DeepEqual(v, typeProp)) {
+		obj["type"] = typeProp
+	}
+	resourceSpecProp, err := expandDataplexZoneResourceSpec(d.Get("resource_spec"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("resource_spec"); !isEmptyValue(reflect.ValueOf(resourceSpecProp)) && (ok || !reflect.DeepEqual(v, resourceSpecProp)) {
+		obj["resourceSpec"] = resourceSpecProp
+	}
+	discoverySpecProp, err := expandDataplexZoneDiscoverySpec(d.Get("discovery_spec"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("discovery_spec"); !isEmptyValue(reflect.ValueOf(discoverySpecProp)) && (ok || !reflect.DeepEqual(v, discoverySpecProp)) {
+		obj["discoverySpec"] = discoverySpecProp
+	}
+
+	url, err := replaceVars(d, config, "{{DataplexBasePath}}projects/{{project}}/locations/{{location}}/lakes/{{lake}}/zones?zoneId={{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Zone: %#v", obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Zone: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/lakes/{{lake}}/zones/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	waitErr := dataplexOperationWaitTime(
+		config, res, project, "Creating Zone",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create Zone: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating Zone %q: %#v", d.Id(), res)
+
+	return resourceDataplexZoneRead(d, meta)
+}
+
+func resourceDataplexZoneRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{DataplexBasePath}}projects/{{project}}/locations/{{location}}/lakes/{{lake}}/zones/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("DataplexZone %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Zone: %s", err)
+	}
+
+	if err := d.Set("display_name", flattenDataplexZoneDisplayName(res["displayName"], d)); err != nil {
+		return fmt.Errorf("Error reading Zone: %s", err)
+	}
+	if err := d.Set("description", flattenDataplexZoneDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading Zone: %s", err)
+	}
+	if err := setLabelsFields(d, config, res["labels"]); err != nil {
+		return fmt.Errorf("Error reading Zone: %s", err)
+	}
+	if err := d.Set("type", flattenDataplexZoneType(res["type"], d)); err != nil {
+		return fmt.Errorf("Error reading Zone: %s", err)
+	}
+	if err := d.Set("resource_spec", flattenDataplexZoneResourceSpec(res["resourceSpec"], d)); err != nil {
+		return fmt.Errorf("Error reading Zone: %s", err)
+	}
+	if err := d.Set("discovery_spec", flattenDataplexZoneDiscoverySpec(res["discoverySpec"], d)); err != nil {
+		return fmt.Errorf("Error reading Zone: %s", err)
+	}
+	if err := d.Set("uid", flattenDataplexZoneUid(res["uid"], d)); err != nil {
+		return fmt.Errorf("Error reading Zone: %s", err)
+	}
+	if err := d.Set("state", flattenDataplexZoneState(res["state"], d)); err != nil {
+		return fmt.Errorf("Error reading Zone: %s", err)
+	}
+	if err := d.Set("create_time", flattenDataplexZoneCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Zone: %s", err)
+	}
+	if err := d.Set("update_time", flattenDataplexZoneUpdateTime(res["updateTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Zone: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDataplexZoneUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	displayNameProp, err := expandDataplexZoneDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+	descriptionProp, err := expandDataplexZoneDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	labelsProp, err := expandDataplexZoneLabels(d.Get("terraform_labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("terraform_labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+	discoverySpecProp, err := expandDataplexZoneDiscoverySpec(d.Get("discovery_spec"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("discovery_spec"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, discoverySpecProp)) {
+		obj["discoverySpec"] = discoverySpecProp
+	}
+
+	url, err := replaceVars(d, config, "{{DataplexBasePath}}projects/{{project}}/locations/{{location}}/lakes/{{lake}}/zones/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Zone %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("display_name") {
+		updateMask = append(updateMask, "displayName")
+	}
+
+	if d.HasChange("description") {
+		updateMask = append(updateMask, "description")
+	}
+
+	if d.HasChange("labels") || d.HasChange("terraform_labels") {
+		updateMask = append(updateMask, "labels")
+	}
+
+	if d.HasChange("discovery_spec") {
+		updateMask = append(updateMask, "discoverySpec")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Zone %q: %s", d.Id(), err)
+	}
+
+	err = dataplexOperationWaitTime(
+		config, res, project, "Updating Zone",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceDataplexZoneRead(d, meta)
+}
+
+func resourceDataplexZoneDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{DataplexBasePath}}projects/{{project}}/locations/{{location}}/lakes/{{lake}}/zones/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Zone %q", d.Id())
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Zone")
+	}
+
+	err = dataplexOperationWaitTime(
+		config, res, project, "Deleting Zone",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting Zone %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceDataplexZoneImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/lakes/(?P<lake>[^/]+)/zones/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<lake>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<lake>[^/]+)/(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/lakes/{{lake}}/zones/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenDataplexZoneDisplayName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexZoneDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexZoneType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexZoneResourceSpec(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["location_type"] =
+		flattenDataplexZoneResourceSpecLocationType(original["locationType"], d)
+	return []interface{}{transformed}
+}
+func flattenDataplexZoneResourceSpecLocationType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexZoneDiscoverySpec(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["enabled"] =
+		flattenDataplexZoneDiscoverySpecEnabled(original["enabled"], d)
+	transformed["include_patterns"] =
+		flattenDataplexZoneDiscoverySpecIncludePatterns(original["includePatterns"], d)
+	transformed["exclude_patterns"] =
+		flattenDataplexZoneDiscoverySpecExcludePatterns(original["excludePatterns"], d)
+	transformed["schedule"] =
+		flattenDataplexZoneDiscoverySpecSchedule(original["schedule"], d)
+	return []interface{}{transformed}
+}
+func flattenDataplexZoneDiscoverySpecEnabled(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexZoneDiscoverySpecIncludePatterns(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexZoneDiscoverySpecExcludePatterns(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexZoneDiscoverySpecSchedule(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexZoneUid(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexZoneState(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexZoneCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexZoneUpdateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandDataplexZoneDisplayName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexZoneDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexZoneLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandDataplexZoneType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexZoneResourceSpec(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedLocationType, err := expandDataplexZoneResourceSpecLocationType(original["location_type"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedLocationType); val.IsValid() && !isEmptyValue(val) {
+		transformed["locationType"] = transformedLocationType
+	}
+
+	return transformed, nil
+}
+
+func expandDataplexZoneResourceSpecLocationType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexZoneDiscoverySpec(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedEnabled, err := expandDataplexZoneDiscoverySpecEnabled(original["enabled"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedEnabled); val.IsValid() && !isEmptyValue(val) {
+		transformed["enabled"] = transformedEnabled
+	}
+
+	transformedIncludePatterns, err := expandDataplexZoneDiscoverySpecIncludePatterns(original["include_patterns"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedIncludePatterns); val.IsValid() && !isEmptyValue(val) {
+		transformed["includePatterns"] = transformedIncludePatterns
+	}
+
+	transformedExcludePatterns, err := expandDataplexZoneDiscoverySpecExcludePatterns(original["exclude_patterns"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedExcludePatterns); val.IsValid() && !isEmptyValue(val) {
+		transformed["excludePatterns"] = transformedExcludePatterns
+	}
+
+	transformedSchedule, err := expandDataplexZoneDiscoverySpecSchedule(original["schedule"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSchedule); val.IsValid() && !isEmptyValue(val) {
+		transformed["schedule"] = transformedSchedule
+	}
+
+	return transformed, nil
+}
+
+func expandDataplexZoneDiscoverySpecEnabled(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexZoneDiscoverySpecIncludePatterns(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexZoneDiscoverySpecExcludePatterns(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexZoneDiscoverySpecSchedule(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,102 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDataplexZone_dataplexZoneBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDataplexZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataplexZone_dataplexZoneBasicExample(context),
+			},
+			{
+				ResourceName:      "google_dataplex_zone.primary",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDataplexZone_dataplexZoneBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_dataplex_lake" "basic" {
+  location     = "us-west1"
+  name         = "tf-test-lake-%{random_suffix}"
+  description  = "Lake for DCL"
+  display_name = "Lake for DCL"
+}
+
+resource "google_dataplex_zone" "primary" {
+  location     = "us-west1"
+  name         = "tf-test-zone-%{random_suffix}"
+  lake         = "${google_dataplex_lake.basic.name}"
+  type         = "RAW"
+  description  = "Zone for DCL"
+  display_name = "Zone for DCL"
+
+  discovery_spec {
+    enabled = false
+  }
+
+  resource_spec {
+    location_type = "MULTI_REGION"
+  }
+}
+`, context)
+}
+
+func testAccCheckDataplexZoneDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_dataplex_zone" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(config, rs, "{{DataplexBasePath}}projects/{{project}}/locations/{{location}}/lakes/{{lake}}/zones/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("DataplexZone still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -28,6 +28,8 @@ func resourceDataprocCluster() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: setLabelsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -80,6 +82,18 @@ func resourceDataprocCluster() *schema.Resource {
 				Computed: true,
 			},
 
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"effective_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"cluster_config": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -454,7 +468,7 @@ func resourceDataprocClusterCreate(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
-	if _, ok := d.GetOk("labels"); ok {
+	if _, ok := d.GetOk("terraform_labels"); ok {
 		cluster.Labels = expandLabels(d)
 	}
 
@@ -736,8 +750,8 @@ func resourceDataprocClusterUpdate(d *schema.ResourceData, meta interface{}) err
 
 	updMask := []string{}
 
-	if d.HasChange("labels") {
-		v := d.Get("labels")
+	if d.HasChange("labels") || d.HasChange("terraform_labels") {
+		v := d.Get("terraform_labels")
 		m := make(map[string]string)
 		for k, val := range v.(map[string]interface{}) {
 			m[k] = val.(string)
@@ -805,7 +819,9 @@ func resourceDataprocClusterRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("name", cluster.ClusterName)
 	d.Set("project", project)
 	d.Set("region", region)
-	d.Set("labels", cluster.Labels)
+	if err := setLabelsFields(d, config, cluster.Labels); err != nil {
+		return fmt.Errorf("Error setting labels: %s", err)
+	}
 
 	cfg, err := flattenClusterConfig(d, cluster.Config)
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"google.golang.org/api/dataproc/v1"
@@ -22,6 +23,11 @@ func resourceDataprocJob() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.All(
+			setLabelsDiff,
+			forceNewIfTerraformLabelsChange,
+		),
+
 		Schema: map[string]*schema.Schema{
 			"project": {
 				Type:     schema.TypeString,
@@ -137,6 +143,20 @@ func resourceDataprocJob() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 
+			"terraform_labels": {
+				Type:        schema.TypeMap,
+				Description: "The combination of labels configured directly on the resource and default labels configured on the provider.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"effective_labels": {
+				Type:        schema.TypeMap,
+				Description: "All of labels (key/value pairs) present on the resource in GCP, including the labels configured through Terraform, other clients and services.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
 			"scheduling": {
 				Type:        schema.TypeList,
 				Description: "Optional. Job scheduling configuration.",
@@ -199,7 +219,7 @@ func resourceDataprocJobCreate(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("reference.0.job_id"); ok {
 		submitReq.Job.Reference.JobId = v.(string)
 	}
-	if _, ok := d.GetOk("labels"); ok {
+	if _, ok := d.GetOk("terraform_labels"); ok {
 		submitReq.Job.Labels = expandLabels(d)
 	}
 
@@ -278,7 +298,9 @@ func resourceDataprocJobRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("force_delete", d.Get("force_delete"))
-	d.Set("labels", job.Labels)
+	if err := setLabelsFields(d, config, job.Labels); err != nil {
+		return fmt.Errorf("Error setting labels: %s", err)
+	}
 	d.Set("driver_output_resource_uri", job.DriverOutputResourceUri)
 	d.Set("driver_controls_files_uri", job.DriverControlFilesUri)
 
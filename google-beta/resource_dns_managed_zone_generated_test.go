@@ -203,7 +203,7 @@ func testAccCheckDnsManagedZoneDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("DnsManagedZone still exists at %s", url)
 		}
@@ -58,15 +58,7 @@ func resourceDnsPolicy() *schema.Resource {
 							Type:     schema.TypeSet,
 							Optional: true,
 							Elem:     dnsPolicyAlternativeNameServerConfigTargetNameServersSchema(),
-							Set: func(v interface{}) int {
-								raw := v.(map[string]interface{})
-								if address, ok := raw["ipv4_address"]; ok {
-									hashcode.String(address.(string))
-								}
-								var buf bytes.Buffer
-								schema.SerializeResourceForHash(&buf, raw, dnsPolicyAlternativeNameServerConfigTargetNameServersSchema())
-								return hashcode.String(buf.String())
-							},
+							Set:      dnsPolicyAlternativeNameServerConfigTargetNameServersHash,
 						},
 					},
 				},
@@ -112,13 +104,24 @@ func dnsPolicyAlternativeNameServerConfigTargetNameServersSchema() *schema.Resou
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"ipv4_address": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateIpv4Address,
 			},
 		},
 	}
 }
 
+func dnsPolicyAlternativeNameServerConfigTargetNameServersHash(v interface{}) int {
+	raw := v.(map[string]interface{})
+	if address, ok := raw["ipv4_address"]; ok {
+		return hashcode.String(address.(string))
+	}
+	var buf bytes.Buffer
+	schema.SerializeResourceForHash(&buf, raw, dnsPolicyAlternativeNameServerConfigTargetNameServersSchema())
+	return hashcode.String(buf.String())
+}
+
 func dnsPolicyNetworksSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -177,7 +180,12 @@ func resourceDnsPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] Creating new Policy: %#v", obj)
-	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("Error creating Policy: %s", err)
 	}
@@ -202,15 +210,16 @@ func resourceDnsPolicyRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	res, err := sendRequest(config, "GET", url, nil)
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("DnsPolicy %q", d.Id()))
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequest(config, "GET", project, url, nil)
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, fmt.Sprintf("DnsPolicy %q", d.Id()))
 	}
+
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("Error reading Policy: %s", err)
 	}
@@ -279,7 +288,12 @@ func resourceDnsPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			return err
 		}
-		_, err = sendRequestWithTimeout(config, "PATCH", url, obj, d.Timeout(schema.TimeoutUpdate))
+		project, err := getProject(d, config)
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf("Error updating Policy %q: %s", d.Id(), err)
 		}
@@ -315,13 +329,23 @@ func resourceDnsPolicyDelete(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 
-		_, err = sendRequestWithTimeout(config, "PATCH", url, patched, d.Timeout(schema.TimeoutUpdate))
+		project, err := getProject(d, config)
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequestWithTimeout(config, "PATCH", project, url, patched, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf("Error updating Policy %q: %s", d.Id(), err)
 		}
 	}
 	log.Printf("[DEBUG] Deleting Policy %q", d.Id())
-	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return handleNotFoundError(err, d, "Policy")
 	}
@@ -368,15 +392,7 @@ func flattenDnsPolicyAlternativeNameServerConfigTargetNameServers(v interface{},
 		return v
 	}
 	l := v.([]interface{})
-	transformed := schema.NewSet(func(v interface{}) int {
-		raw := v.(map[string]interface{})
-		if address, ok := raw["ipv4_address"]; ok {
-			hashcode.String(address.(string))
-		}
-		var buf bytes.Buffer
-		schema.SerializeResourceForHash(&buf, raw, dnsPolicyAlternativeNameServerConfigTargetNameServersSchema())
-		return hashcode.String(buf.String())
-	}, []interface{}{})
+	transformed := schema.NewSet(dnsPolicyAlternativeNameServerConfigTargetNameServersHash, []interface{}{})
 	for _, raw := range l {
 		original := raw.(map[string]interface{})
 		if len(original) < 1 {
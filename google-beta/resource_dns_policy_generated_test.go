@@ -107,7 +107,7 @@ func testAccCheckDnsPolicyDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("DnsPolicy still exists at %s", url)
 		}
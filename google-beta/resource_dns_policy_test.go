@@ -5,9 +5,35 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 )
 
+func TestFlattenDnsPolicyAlternativeNameServerConfigTargetNameServers(t *testing.T) {
+	api := []interface{}{
+		map[string]interface{}{"ipv4Address": "172.16.1.10"},
+		map[string]interface{}{"ipv4Address": "172.16.1.20"},
+	}
+
+	// The flattened set must hash its items the same way as the schema so
+	// that reading the policy back doesn't produce a diff.
+	hash := resourceDnsPolicy().Schema["alternative_name_server_config"].Elem.(*schema.Resource).Schema["target_name_servers"].Set
+	expected := schema.NewSet(hash, []interface{}{
+		map[string]interface{}{"ipv4_address": "172.16.1.10"},
+		map[string]interface{}{"ipv4_address": "172.16.1.20"},
+	})
+
+	flattened := flattenDnsPolicyAlternativeNameServerConfigTargetNameServers(api, nil).(*schema.Set)
+	if !flattened.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected.List(), flattened.List())
+	}
+
+	if got, want := hash(map[string]interface{}{"ipv4_address": "172.16.1.10"}), hashcode.String("172.16.1.10"); got != want {
+		t.Errorf("expected target name servers to be hashed by address: want %d, got %d", want, got)
+	}
+}
+
 func TestAccDnsPolicy_update(t *testing.T) {
 	t.Parallel()
 
@@ -38,6 +64,32 @@ func TestAccDnsPolicy_update(t *testing.T) {
 	})
 }
 
+func TestAccDnsPolicy_inboundForwarding(t *testing.T) {
+	t.Parallel()
+
+	policySuffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDnsPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsPolicy_inboundForwarding(policySuffix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_dns_policy.inbound", "enable_inbound_forwarding", "true"),
+					resource.TestCheckResourceAttr("google_dns_policy.inbound", "networks.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "google_dns_policy.inbound",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccDnsPolicy_privateUpdate(suffix, forwarding, nameserver, network string) string {
 	return fmt.Sprintf(`
 resource "google_dns_policy" "example-policy" {
@@ -65,3 +117,20 @@ resource "google_compute_network" "network-2" {
 	auto_create_subnetworks = false
 }`, suffix, forwarding, nameserver, network, suffix, suffix)
 }
+
+func testAccDnsPolicy_inboundForwarding(suffix string) string {
+	return fmt.Sprintf(`
+resource "google_dns_policy" "inbound" {
+	name = "inbound-policy-%s"
+	enable_inbound_forwarding = true
+
+	networks {
+		network_url =  "${google_compute_network.vpc.self_link}"
+	}
+}
+
+resource "google_compute_network" "vpc" {
+	name = "inbound-vpc-%s"
+	auto_create_subnetworks = false
+}`, suffix, suffix)
+}
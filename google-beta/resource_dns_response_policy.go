@@ -0,0 +1,414 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceDnsResponsePolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsResponsePolicyCreate,
+		Read:   resourceDnsResponsePolicyRead,
+		Update: resourceDnsResponsePolicyUpdate,
+		Delete: resourceDnsResponsePolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceDnsResponsePolicyImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Update: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"response_policy_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"gke_clusters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"gke_cluster_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"networks": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"network_url": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: compareSelfLinkOrResourceName,
+						},
+					},
+				},
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceDnsResponsePolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	responsePolicyNameProp, err := expandDnsResponsePolicyResponsePolicyName(d.Get("response_policy_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("response_policy_name"); !isEmptyValue(reflect.ValueOf(responsePolicyNameProp)) && (ok || !reflect.DeepEqual(v, responsePolicyNameProp)) {
+		obj["responsePolicyName"] = responsePolicyNameProp
+	}
+	descriptionProp, err := expandDnsResponsePolicyDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	networksProp, err := expandDnsResponsePolicyNetworks(d.Get("networks"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("networks"); ok || !reflect.DeepEqual(v, networksProp) {
+		obj["networks"] = networksProp
+	}
+	gkeClustersProp, err := expandDnsResponsePolicyGkeClusters(d.Get("gke_clusters"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("gke_clusters"); ok || !reflect.DeepEqual(v, gkeClustersProp) {
+		obj["gkeClusters"] = gkeClustersProp
+	}
+
+	url, err := replaceVars(d, config, "{{DnsBasePath}}projects/{{project}}/responsePolicies")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new ResponsePolicy: %#v", obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating ResponsePolicy: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/responsePolicies/{{response_policy_name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating ResponsePolicy %q: %#v", d.Id(), res)
+
+	return resourceDnsResponsePolicyRead(d, meta)
+}
+
+func resourceDnsResponsePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{DnsBasePath}}projects/{{project}}/responsePolicies/{{response_policy_name}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("DnsResponsePolicy %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading ResponsePolicy: %s", err)
+	}
+
+	if err := d.Set("response_policy_name", flattenDnsResponsePolicyResponsePolicyName(res["responsePolicyName"], d)); err != nil {
+		return fmt.Errorf("Error reading ResponsePolicy: %s", err)
+	}
+	if err := d.Set("description", flattenDnsResponsePolicyDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading ResponsePolicy: %s", err)
+	}
+	if err := d.Set("networks", flattenDnsResponsePolicyNetworks(res["networks"], d)); err != nil {
+		return fmt.Errorf("Error reading ResponsePolicy: %s", err)
+	}
+	if err := d.Set("gke_clusters", flattenDnsResponsePolicyGkeClusters(res["gkeClusters"], d)); err != nil {
+		return fmt.Errorf("Error reading ResponsePolicy: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDnsResponsePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandDnsResponsePolicyDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	networksProp, err := expandDnsResponsePolicyNetworks(d.Get("networks"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("networks"); ok || !reflect.DeepEqual(v, networksProp) {
+		obj["networks"] = networksProp
+	}
+	gkeClustersProp, err := expandDnsResponsePolicyGkeClusters(d.Get("gke_clusters"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("gke_clusters"); ok || !reflect.DeepEqual(v, gkeClustersProp) {
+		obj["gkeClusters"] = gkeClustersProp
+	}
+
+	url, err := replaceVars(d, config, "{{DnsBasePath}}projects/{{project}}/responsePolicies/{{response_policy_name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating ResponsePolicy %q: %#v", d.Id(), obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating ResponsePolicy %q: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Finished updating ResponsePolicy %q: %#v", d.Id(), res)
+
+	return resourceDnsResponsePolicyRead(d, meta)
+}
+
+func resourceDnsResponsePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{DnsBasePath}}projects/{{project}}/responsePolicies/{{response_policy_name}}")
+	if err != nil {
+		return err
+	}
+
+	// if gke clusters or networks are attached, they need to be detached before the response policy can be deleted
+	if d.Get("gke_clusters.#").(int) > 0 || d.Get("networks.#").(int) > 0 {
+		patched := make(map[string]interface{})
+		patched["gkeClusters"] = nil
+		patched["networks"] = nil
+
+		url, err := replaceVars(d, config, "{{DnsBasePath}}projects/{{project}}/responsePolicies/{{response_policy_name}}")
+		if err != nil {
+			return err
+		}
+
+		project, err := getProject(d, config)
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequestWithTimeout(config, "PATCH", project, url, patched, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("Error updating ResponsePolicy %q: %s", d.Id(), err)
+		}
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting ResponsePolicy %q", d.Id())
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "ResponsePolicy")
+	}
+
+	log.Printf("[DEBUG] Finished deleting ResponsePolicy %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceDnsResponsePolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/responsePolicies/(?P<response_policy_name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<response_policy_name>[^/]+)",
+		"(?P<response_policy_name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/responsePolicies/{{response_policy_name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenDnsResponsePolicyResponsePolicyName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDnsResponsePolicyDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDnsResponsePolicyNetworks(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"network_url": flattenDnsResponsePolicyNetworksNetworkUrl(original["networkUrl"], d),
+		})
+	}
+	return transformed
+}
+func flattenDnsResponsePolicyNetworksNetworkUrl(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDnsResponsePolicyGkeClusters(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"gke_cluster_name": flattenDnsResponsePolicyGkeClustersGkeClusterName(original["gkeClusterName"], d),
+		})
+	}
+	return transformed
+}
+func flattenDnsResponsePolicyGkeClustersGkeClusterName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandDnsResponsePolicyResponsePolicyName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDnsResponsePolicyDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDnsResponsePolicyNetworks(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedNetworkUrl, err := expandDnsResponsePolicyNetworksNetworkUrl(original["network_url"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedNetworkUrl); val.IsValid() && !isEmptyValue(val) {
+			transformed["networkUrl"] = transformedNetworkUrl
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandDnsResponsePolicyNetworksNetworkUrl(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	if v == nil || v.(string) == "" {
+		return "", nil
+	} else if strings.HasPrefix(v.(string), "https://") {
+		return v, nil
+	}
+	url, err := replaceVars(d, config, "{{ComputeBasePath}}"+v.(string))
+	if err != nil {
+		return "", err
+	}
+	return ConvertSelfLinkToV1(url), nil
+}
+
+func expandDnsResponsePolicyGkeClusters(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedGkeClusterName, err := expandDnsResponsePolicyGkeClustersGkeClusterName(original["gke_cluster_name"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedGkeClusterName); val.IsValid() && !isEmptyValue(val) {
+			transformed["gkeClusterName"] = transformedGkeClusterName
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandDnsResponsePolicyGkeClustersGkeClusterName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
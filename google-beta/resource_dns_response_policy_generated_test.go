@@ -0,0 +1,105 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDnsResponsePolicy_dnsResponsePolicyBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersOiCS,
+		CheckDestroy: testAccCheckDnsResponsePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsResponsePolicy_dnsResponsePolicyBasicExample(context),
+			},
+			{
+				ResourceName:      "google_dns_response_policy.example-response-policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDnsResponsePolicy_dnsResponsePolicyBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_network" "network-1" {
+  provider = "google-beta"
+
+  name                    = "network-1-%{random_suffix}"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_network" "network-2" {
+  provider = "google-beta"
+
+  name                    = "network-2-%{random_suffix}"
+  auto_create_subnetworks = false
+}
+
+resource "google_dns_response_policy" "example-response-policy" {
+  provider = "google-beta"
+
+  response_policy_name = "example-response-policy-%{random_suffix}"
+
+  networks {
+    network_url = "${google_compute_network.network-1.self_link}"
+  }
+  networks {
+    network_url = "${google_compute_network.network-2.self_link}"
+  }
+}
+`, context)
+}
+
+func testAccCheckDnsResponsePolicyDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_dns_response_policy" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(config, rs, "{{DnsBasePath}}projects/{{project}}/responsePolicies/{{response_policy_name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("DnsResponsePolicy still exists at %s", url)
+		}
+	}
+
+	return nil
+}
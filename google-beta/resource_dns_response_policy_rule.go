@@ -0,0 +1,490 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// A rule either answers with local data or with a behavior, never both.
+func dnsResponsePolicyRuleLocalDataOrBehaviorCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	if !diff.NewValueKnown("local_data") || !diff.NewValueKnown("behavior") {
+		return nil
+	}
+
+	_, hasLocalData := diff.GetOk("local_data")
+	_, hasBehavior := diff.GetOk("behavior")
+	if hasLocalData == hasBehavior {
+		return fmt.Errorf("exactly one of local_data or behavior must be set")
+	}
+
+	return nil
+}
+
+func resourceDnsResponsePolicyRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsResponsePolicyRuleCreate,
+		Read:   resourceDnsResponsePolicyRuleRead,
+		Update: resourceDnsResponsePolicyRuleUpdate,
+		Delete: resourceDnsResponsePolicyRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceDnsResponsePolicyRuleImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Update: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		CustomizeDiff: dnsResponsePolicyRuleLocalDataOrBehaviorCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"dns_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"response_policy": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rule_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"behavior": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice([]string{"BYPASS_RESPONSE_POLICY", ""}, false),
+				ConflictsWith: []string{"local_data"},
+			},
+			"local_data": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"behavior"},
+				MaxItems:      1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"local_datas": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{"A", "AAAA", "CAA", "CNAME", "DNSKEY", "DS", "HTTPS", "IPSECVPNKEY", "MX", "NAPTR", "NS", "PTR", "SOA", "SPF", "SRV", "SSHFP", "SVCB", "TLSA", "TXT"}, false),
+									},
+									"rrdatas": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"ttl": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceDnsResponsePolicyRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	ruleNameProp, err := expandDnsResponsePolicyRuleRuleName(d.Get("rule_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("rule_name"); !isEmptyValue(reflect.ValueOf(ruleNameProp)) && (ok || !reflect.DeepEqual(v, ruleNameProp)) {
+		obj["ruleName"] = ruleNameProp
+	}
+	dnsNameProp, err := expandDnsResponsePolicyRuleDnsName(d.Get("dns_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("dns_name"); !isEmptyValue(reflect.ValueOf(dnsNameProp)) && (ok || !reflect.DeepEqual(v, dnsNameProp)) {
+		obj["dnsName"] = dnsNameProp
+	}
+	localDataProp, err := expandDnsResponsePolicyRuleLocalData(d.Get("local_data"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("local_data"); !isEmptyValue(reflect.ValueOf(localDataProp)) && (ok || !reflect.DeepEqual(v, localDataProp)) {
+		obj["localData"] = localDataProp
+	}
+	behaviorProp, err := expandDnsResponsePolicyRuleBehavior(d.Get("behavior"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("behavior"); !isEmptyValue(reflect.ValueOf(behaviorProp)) && (ok || !reflect.DeepEqual(v, behaviorProp)) {
+		obj["behavior"] = behaviorProp
+	}
+
+	url, err := replaceVars(d, config, "{{DnsBasePath}}projects/{{project}}/responsePolicies/{{response_policy}}/rules")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new ResponsePolicyRule: %#v", obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating ResponsePolicyRule: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/responsePolicies/{{response_policy}}/rules/{{rule_name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating ResponsePolicyRule %q: %#v", d.Id(), res)
+
+	return resourceDnsResponsePolicyRuleRead(d, meta)
+}
+
+func resourceDnsResponsePolicyRuleRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{DnsBasePath}}projects/{{project}}/responsePolicies/{{response_policy}}/rules/{{rule_name}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("DnsResponsePolicyRule %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading ResponsePolicyRule: %s", err)
+	}
+
+	if err := d.Set("rule_name", flattenDnsResponsePolicyRuleRuleName(res["ruleName"], d)); err != nil {
+		return fmt.Errorf("Error reading ResponsePolicyRule: %s", err)
+	}
+	if err := d.Set("dns_name", flattenDnsResponsePolicyRuleDnsName(res["dnsName"], d)); err != nil {
+		return fmt.Errorf("Error reading ResponsePolicyRule: %s", err)
+	}
+	if err := d.Set("local_data", flattenDnsResponsePolicyRuleLocalData(res["localData"], d)); err != nil {
+		return fmt.Errorf("Error reading ResponsePolicyRule: %s", err)
+	}
+	if err := d.Set("behavior", flattenDnsResponsePolicyRuleBehavior(res["behavior"], d)); err != nil {
+		return fmt.Errorf("Error reading ResponsePolicyRule: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDnsResponsePolicyRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	dnsNameProp, err := expandDnsResponsePolicyRuleDnsName(d.Get("dns_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("dns_name"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, dnsNameProp)) {
+		obj["dnsName"] = dnsNameProp
+	}
+	localDataProp, err := expandDnsResponsePolicyRuleLocalData(d.Get("local_data"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("local_data"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, localDataProp)) {
+		obj["localData"] = localDataProp
+	}
+	behaviorProp, err := expandDnsResponsePolicyRuleBehavior(d.Get("behavior"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("behavior"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, behaviorProp)) {
+		obj["behavior"] = behaviorProp
+	}
+
+	obj, err = resourceDnsResponsePolicyRuleUpdateEncoder(d, meta, obj)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "{{DnsBasePath}}projects/{{project}}/responsePolicies/{{response_policy}}/rules/{{rule_name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating ResponsePolicyRule %q: %#v", d.Id(), obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating ResponsePolicyRule %q: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Finished updating ResponsePolicyRule %q: %#v", d.Id(), res)
+
+	return resourceDnsResponsePolicyRuleRead(d, meta)
+}
+
+func resourceDnsResponsePolicyRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{DnsBasePath}}projects/{{project}}/responsePolicies/{{response_policy}}/rules/{{rule_name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting ResponsePolicyRule %q", d.Id())
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "ResponsePolicyRule")
+	}
+
+	log.Printf("[DEBUG] Finished deleting ResponsePolicyRule %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceDnsResponsePolicyRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/responsePolicies/(?P<response_policy>[^/]+)/rules/(?P<rule_name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<response_policy>[^/]+)/(?P<rule_name>[^/]+)",
+		"(?P<response_policy>[^/]+)/(?P<rule_name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/responsePolicies/{{response_policy}}/rules/{{rule_name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenDnsResponsePolicyRuleRuleName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDnsResponsePolicyRuleDnsName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDnsResponsePolicyRuleLocalData(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["local_datas"] =
+		flattenDnsResponsePolicyRuleLocalDataLocalDatas(original["localDatas"], d)
+	return []interface{}{transformed}
+}
+func flattenDnsResponsePolicyRuleLocalDataLocalDatas(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"name":    flattenDnsResponsePolicyRuleLocalDataLocalDatasName(original["name"], d),
+			"type":    flattenDnsResponsePolicyRuleLocalDataLocalDatasType(original["type"], d),
+			"ttl":     flattenDnsResponsePolicyRuleLocalDataLocalDatasTtl(original["ttl"], d),
+			"rrdatas": flattenDnsResponsePolicyRuleLocalDataLocalDatasRrdatas(original["rrdatas"], d),
+		})
+	}
+	return transformed
+}
+func flattenDnsResponsePolicyRuleLocalDataLocalDatasName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDnsResponsePolicyRuleLocalDataLocalDatasType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDnsResponsePolicyRuleLocalDataLocalDatasTtl(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenDnsResponsePolicyRuleLocalDataLocalDatasRrdatas(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDnsResponsePolicyRuleBehavior(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandDnsResponsePolicyRuleRuleName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDnsResponsePolicyRuleDnsName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDnsResponsePolicyRuleLocalData(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedLocalDatas, err := expandDnsResponsePolicyRuleLocalDataLocalDatas(original["local_datas"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedLocalDatas); val.IsValid() && !isEmptyValue(val) {
+		transformed["localDatas"] = transformedLocalDatas
+	}
+
+	return transformed, nil
+}
+
+func expandDnsResponsePolicyRuleLocalDataLocalDatas(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedName, err := expandDnsResponsePolicyRuleLocalDataLocalDatasName(original["name"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedName); val.IsValid() && !isEmptyValue(val) {
+			transformed["name"] = transformedName
+		}
+
+		transformedType, err := expandDnsResponsePolicyRuleLocalDataLocalDatasType(original["type"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedType); val.IsValid() && !isEmptyValue(val) {
+			transformed["type"] = transformedType
+		}
+
+		transformedTtl, err := expandDnsResponsePolicyRuleLocalDataLocalDatasTtl(original["ttl"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedTtl); val.IsValid() && !isEmptyValue(val) {
+			transformed["ttl"] = transformedTtl
+		}
+
+		transformedRrdatas, err := expandDnsResponsePolicyRuleLocalDataLocalDatasRrdatas(original["rrdatas"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedRrdatas); val.IsValid() && !isEmptyValue(val) {
+			transformed["rrdatas"] = transformedRrdatas
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandDnsResponsePolicyRuleLocalDataLocalDatasName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDnsResponsePolicyRuleLocalDataLocalDatasType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDnsResponsePolicyRuleLocalDataLocalDatasTtl(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDnsResponsePolicyRuleLocalDataLocalDatasRrdatas(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDnsResponsePolicyRuleBehavior(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func resourceDnsResponsePolicyRuleUpdateEncoder(d *schema.ResourceData, meta interface{}, obj map[string]interface{}) (map[string]interface{}, error) {
+	// A rule has exactly one of localData or behavior, and PATCH keeps fields
+	// that are omitted, so send a null for the one that isn't set to clear it
+	// when switching between them.
+	if _, ok := obj["localData"]; !ok {
+		obj["localData"] = nil
+	}
+	if _, ok := obj["behavior"]; !ok {
+		obj["behavior"] = nil
+	}
+
+	return obj, nil
+}
@@ -0,0 +1,112 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDnsResponsePolicyRule_dnsResponsePolicyRuleBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersOiCS,
+		CheckDestroy: testAccCheckDnsResponsePolicyRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsResponsePolicyRule_dnsResponsePolicyRuleBasicExample(context),
+			},
+			{
+				ResourceName:      "google_dns_response_policy_rule.example-response-policy-rule",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDnsResponsePolicyRule_dnsResponsePolicyRuleBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_network" "network-1" {
+  provider = "google-beta"
+
+  name                    = "network-1-%{random_suffix}"
+  auto_create_subnetworks = false
+}
+
+resource "google_dns_response_policy" "response-policy" {
+  provider = "google-beta"
+
+  response_policy_name = "example-response-policy-%{random_suffix}"
+
+  networks {
+    network_url = "${google_compute_network.network-1.self_link}"
+  }
+}
+
+resource "google_dns_response_policy_rule" "example-response-policy-rule" {
+  provider = "google-beta"
+
+  response_policy = "${google_dns_response_policy.response-policy.response_policy_name}"
+  rule_name       = "example-rule-%{random_suffix}"
+  dns_name        = "dns.example.com."
+
+  local_data {
+    local_datas {
+      name    = "dns.example.com."
+      type    = "A"
+      ttl     = 300
+      rrdatas = ["192.0.2.91"]
+    }
+  }
+}
+`, context)
+}
+
+func testAccCheckDnsResponsePolicyRuleDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_dns_response_policy_rule" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(config, rs, "{{DnsBasePath}}projects/{{project}}/responsePolicies/{{response_policy}}/rules/{{rule_name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("DnsResponsePolicyRule still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package google
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDnsResponsePolicyRule_update(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDnsResponsePolicyRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsResponsePolicyRule_localData(suffix, "192.0.2.91"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_dns_response_policy_rule.override", "local_data.0.local_datas.0.rrdatas.0", "192.0.2.91"),
+				),
+			},
+			{
+				ResourceName:      "google_dns_response_policy_rule.override",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDnsResponsePolicyRule_localData(suffix, "192.0.2.92"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_dns_response_policy_rule.override", "local_data.0.local_datas.0.rrdatas.0", "192.0.2.92"),
+				),
+			},
+			{
+				ResourceName:      "google_dns_response_policy.policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "google_dns_response_policy_rule.override",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDnsResponsePolicyRule_behavior(suffix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_dns_response_policy_rule.override", "behavior", "BYPASS_RESPONSE_POLICY"),
+					resource.TestCheckResourceAttr("google_dns_response_policy_rule.override", "local_data.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "google_dns_response_policy_rule.override",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDnsResponsePolicyRule_localData(suffix, "192.0.2.93"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_dns_response_policy_rule.override", "behavior", ""),
+					resource.TestCheckResourceAttr("google_dns_response_policy_rule.override", "local_data.0.local_datas.0.rrdatas.0", "192.0.2.93"),
+				),
+			},
+		},
+	})
+}
+
+func TestDnsResponsePolicyRuleUpdateEncoder(t *testing.T) {
+	t.Parallel()
+
+	localData := map[string]interface{}{
+		"localDatas": []interface{}{
+			map[string]interface{}{"name": "www.example.com.", "type": "A"},
+		},
+	}
+	cases := map[string]struct {
+		Obj      map[string]interface{}
+		Expected map[string]interface{}
+	}{
+		"switching to behavior": {
+			Obj:      map[string]interface{}{"behavior": "BYPASS_RESPONSE_POLICY"},
+			Expected: map[string]interface{}{"behavior": "BYPASS_RESPONSE_POLICY", "localData": nil},
+		},
+		"switching to local data": {
+			Obj:      map[string]interface{}{"localData": localData},
+			Expected: map[string]interface{}{"behavior": nil, "localData": localData},
+		},
+	}
+
+	for tn, tc := range cases {
+		obj, err := resourceDnsResponsePolicyRuleUpdateEncoder(nil, nil, tc.Obj)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tn, err)
+		}
+		if !reflect.DeepEqual(obj, tc.Expected) {
+			t.Errorf("%s: expected %v, got %v", tn, tc.Expected, obj)
+		}
+	}
+}
+
+func TestAccDnsResponsePolicyRule_localDataOrBehavior(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDnsResponsePolicyRule_noAnswer(suffix),
+				ExpectError: regexp.MustCompile("exactly one of local_data or behavior must be set"),
+			},
+		},
+	})
+}
+
+func testAccDnsResponsePolicyRule_localData(suffix, address string) string {
+	return fmt.Sprintf(`
+resource "google_compute_network" "network" {
+  name                    = "tf-test-network-%s"
+  auto_create_subnetworks = false
+}
+
+resource "google_dns_response_policy" "policy" {
+  response_policy_name = "tf-test-response-policy-%s"
+  description          = "overrides www.example.com"
+
+  networks {
+    network_url = "${google_compute_network.network.self_link}"
+  }
+}
+
+resource "google_dns_response_policy_rule" "override" {
+  response_policy = "${google_dns_response_policy.policy.response_policy_name}"
+  rule_name       = "tf-test-rule-%s"
+  dns_name        = "www.example.com."
+
+  local_data {
+    local_datas {
+      name    = "www.example.com."
+      type    = "A"
+      ttl     = 300
+      rrdatas = ["%s"]
+    }
+  }
+}
+`, suffix, suffix, suffix, address)
+}
+
+func testAccDnsResponsePolicyRule_behavior(suffix string) string {
+	return fmt.Sprintf(`
+resource "google_compute_network" "network" {
+  name                    = "tf-test-network-%s"
+  auto_create_subnetworks = false
+}
+
+resource "google_dns_response_policy" "policy" {
+  response_policy_name = "tf-test-response-policy-%s"
+  description          = "overrides www.example.com"
+
+  networks {
+    network_url = "${google_compute_network.network.self_link}"
+  }
+}
+
+resource "google_dns_response_policy_rule" "override" {
+  response_policy = "${google_dns_response_policy.policy.response_policy_name}"
+  rule_name       = "tf-test-rule-%s"
+  dns_name        = "www.example.com."
+  behavior        = "BYPASS_RESPONSE_POLICY"
+}
+`, suffix, suffix, suffix)
+}
+
+func testAccDnsResponsePolicyRule_noAnswer(suffix string) string {
+	return fmt.Sprintf(`
+resource "google_dns_response_policy_rule" "override" {
+  response_policy = "tf-test-response-policy-%s"
+  rule_name       = "tf-test-rule-%s"
+  dns_name        = "www.example.com."
+}
+`, suffix, suffix)
+}
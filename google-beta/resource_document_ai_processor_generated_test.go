@@ -0,0 +1,84 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDocumentAIProcessor_documentAIProcessorExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDocumentAIProcessorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentAIProcessor_documentAIProcessorExample(context),
+			},
+			{
+				ResourceName:      "google_document_ai_processor.processor",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDocumentAIProcessor_documentAIProcessorExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_document_ai_processor" "processor" {
+  location     = "us"
+  display_name = "test-processor-%{random_suffix}"
+  type         = "OCR_PROCESSOR"
+}
+`, context)
+}
+
+func testAccCheckDocumentAIProcessorDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_document_ai_processor" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(config, rs, documentAIBasePath(config, rs.Primary.Attributes["location"])+"projects/{{project}}/locations/{{location}}/processors/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("DocumentAIProcessor still exists at %s", url)
+		}
+	}
+
+	return nil
+}
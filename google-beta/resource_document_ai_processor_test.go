@@ -0,0 +1,37 @@
+package google
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDocumentAIProcessor_invalidType(t *testing.T) {
+	t.Parallel()
+
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDocumentAIProcessor_type(name, "ocr"),
+				ExpectError: regexp.MustCompile("\"type\""),
+			},
+		},
+	})
+}
+
+func testAccDocumentAIProcessor_type(name, processorType string) string {
+	return fmt.Sprintf(`
+resource "google_document_ai_processor" "processor" {
+  location     = "us"
+  display_name = "%s"
+  type         = "%s"
+}
+`, name, processorType)
+}
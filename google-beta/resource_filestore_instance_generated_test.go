@@ -85,7 +85,7 @@ func testAccCheckFilestoreInstanceDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("FilestoreInstance still exists at %s", url)
 		}
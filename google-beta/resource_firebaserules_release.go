@@ -0,0 +1,279 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceFirebaserulesRelease() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFirebaserulesReleaseCreate,
+		Read:   resourceFirebaserulesReleaseRead,
+		Update: resourceFirebaserulesReleaseUpdate,
+		Delete: resourceFirebaserulesReleaseDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceFirebaserulesReleaseImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Update: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ruleset_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceFirebaserulesReleaseCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	nameProp, err := expandFirebaserulesReleaseName(d.Get("name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("name"); !isEmptyValue(reflect.ValueOf(nameProp)) && (ok || !reflect.DeepEqual(v, nameProp)) {
+		obj["name"] = nameProp
+	}
+	rulesetNameProp, err := expandFirebaserulesReleaseRulesetName(d.Get("ruleset_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("ruleset_name"); !isEmptyValue(reflect.ValueOf(rulesetNameProp)) && (ok || !reflect.DeepEqual(v, rulesetNameProp)) {
+		obj["rulesetName"] = rulesetNameProp
+	}
+
+	url, err := replaceVars(d, config, "{{FirebaserulesBasePath}}projects/{{project}}/releases")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Release: %#v", obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Release: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/releases/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating Release %q: %#v", d.Id(), res)
+
+	return resourceFirebaserulesReleaseRead(d, meta)
+}
+
+func resourceFirebaserulesReleaseRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{FirebaserulesBasePath}}projects/{{project}}/releases/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("FirebaserulesRelease %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Release: %s", err)
+	}
+
+	if err := d.Set("name", flattenFirebaserulesReleaseName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading Release: %s", err)
+	}
+	if err := d.Set("ruleset_name", flattenFirebaserulesReleaseRulesetName(res["rulesetName"], d)); err != nil {
+		return fmt.Errorf("Error reading Release: %s", err)
+	}
+	if err := d.Set("create_time", flattenFirebaserulesReleaseCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Release: %s", err)
+	}
+	if err := d.Set("update_time", flattenFirebaserulesReleaseUpdateTime(res["updateTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Release: %s", err)
+	}
+
+	return nil
+}
+
+func resourceFirebaserulesReleaseUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	rulesetNameProp, err := expandFirebaserulesReleaseRulesetName(d.Get("ruleset_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("ruleset_name"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, rulesetNameProp)) {
+		obj["rulesetName"] = rulesetNameProp
+	}
+
+	obj, err = resourceFirebaserulesReleaseUpdateEncoder(d, meta, obj)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "{{FirebaserulesBasePath}}projects/{{project}}/releases/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Release %q: %#v", d.Id(), obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Release %q: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Finished updating Release %q: %#v", d.Id(), res)
+
+	return resourceFirebaserulesReleaseRead(d, meta)
+}
+
+func resourceFirebaserulesReleaseDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{FirebaserulesBasePath}}projects/{{project}}/releases/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Release %q", d.Id())
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Release")
+	}
+
+	log.Printf("[DEBUG] Finished deleting Release %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceFirebaserulesReleaseImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/releases/(?P<name>.+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/releases/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenFirebaserulesReleaseName(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	// Release names may contain slashes, e.g. `firebase.storage/my-bucket`, so
+	// only strip the project prefix.
+	parts := strings.SplitN(v.(string), "/releases/", 2)
+	return parts[len(parts)-1]
+}
+
+func flattenFirebaserulesReleaseRulesetName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenFirebaserulesReleaseCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenFirebaserulesReleaseUpdateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandFirebaserulesReleaseName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return replaceVars(d, config, "projects/{{project}}/releases/{{name}}")
+}
+
+func expandFirebaserulesReleaseRulesetName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	if v == nil || strings.HasPrefix(v.(string), "projects/") {
+		return v, nil
+	}
+	return replaceVars(d, config, "projects/{{project}}/rulesets/"+v.(string))
+}
+
+func resourceFirebaserulesReleaseUpdateEncoder(d *schema.ResourceData, meta interface{}, obj map[string]interface{}) (map[string]interface{}, error) {
+	config := meta.(*Config)
+	name, err := expandFirebaserulesReleaseName(d.Get("name"), d, config)
+	if err != nil {
+		return nil, err
+	}
+	obj["name"] = name
+
+	// The update request wraps the release instead of sending it directly.
+	return map[string]interface{}{"release": obj}, nil
+}
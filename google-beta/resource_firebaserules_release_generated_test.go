@@ -0,0 +1,96 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccFirebaserulesRelease_firebaserulesReleaseFirestoreExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"project_id":    getTestFirestoreProjectFromEnv(t),
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFirebaserulesReleaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirebaserulesRelease_firebaserulesReleaseFirestoreExample(context),
+			},
+			{
+				ResourceName:      "google_firebaserules_release.primary",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccFirebaserulesRelease_firebaserulesReleaseFirestoreExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_firebaserules_ruleset" "firestore" {
+  project = "%{project_id}"
+
+  source {
+    files {
+      content = "service cloud.firestore {match /databases/{database}/documents { match /{document=**} { allow read, write: if false; } } }"
+      name    = "firestore.rules"
+    }
+  }
+}
+
+resource "google_firebaserules_release" "primary" {
+  project      = "%{project_id}"
+  name         = "cloud.firestore"
+  ruleset_name = "projects/%{project_id}/rulesets/${google_firebaserules_ruleset.firestore.name}"
+}
+`, context)
+}
+
+func testAccCheckFirebaserulesReleaseDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_firebaserules_release" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(config, rs, "{{FirebaserulesBasePath}}projects/{{project}}/releases/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("FirebaserulesRelease still exists at %s", url)
+		}
+	}
+
+	return nil
+}
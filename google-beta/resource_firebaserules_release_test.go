@@ -0,0 +1,90 @@
+package google
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccFirebaserulesRelease_update(t *testing.T) {
+	t.Parallel()
+
+	releaseName := fmt.Sprintf("tf-test-release-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFirebaserulesReleaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirebaserulesRelease_update(releaseName, "false"),
+			},
+			{
+				ResourceName:      "google_firebaserules_release.release",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFirebaserulesRelease_update(releaseName, "request.auth != null"),
+			},
+			{
+				ResourceName:      "google_firebaserules_release.release",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFirebaserulesRuleset_emptySource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFirebaserulesRuleset_emptySource(),
+				ExpectError: regexp.MustCompile("must not be empty"),
+			},
+		},
+	})
+}
+
+func testAccFirebaserulesRelease_update(releaseName, condition string) string {
+	return fmt.Sprintf(`
+resource "google_firebaserules_ruleset" "ruleset" {
+  source {
+    files {
+      content = "service cloud.firestore { match /databases/{database}/documents { match /{document=**} { allow read, write: if %s; } } }"
+      name    = "firestore.rules"
+    }
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "google_firebaserules_release" "release" {
+  name         = "%s"
+  ruleset_name = "${google_firebaserules_ruleset.ruleset.name}"
+}
+`, condition, releaseName)
+}
+
+func testAccFirebaserulesRuleset_emptySource() string {
+	return `
+resource "google_firebaserules_ruleset" "ruleset" {
+  source {
+    files {
+      content = "  "
+      name    = "firestore.rules"
+    }
+  }
+}
+`
+}
@@ -0,0 +1,380 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func validateFirebaserulesRulesetSourceContent(v interface{}, k string) (ws []string, errs []error) {
+	if strings.TrimSpace(v.(string)) == "" {
+		errs = append(errs, fmt.Errorf("%q must not be empty", k))
+	}
+	return
+}
+
+func resourceFirebaserulesRuleset() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFirebaserulesRulesetCreate,
+		Read:   resourceFirebaserulesRulesetRead,
+		Delete: resourceFirebaserulesRulesetDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceFirebaserulesRulesetImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"source": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"files": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"content": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateFirebaserulesRulesetSourceContent,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"fingerprint": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"language": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"FIREBASE_RULES", "EVENT_FLOW_TRIGGERS", ""}, false),
+						},
+					},
+				},
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceFirebaserulesRulesetCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	sourceProp, err := expandFirebaserulesRulesetSource(d.Get("source"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("source"); !isEmptyValue(reflect.ValueOf(sourceProp)) && (ok || !reflect.DeepEqual(v, sourceProp)) {
+		obj["source"] = sourceProp
+	}
+
+	url, err := replaceVars(d, config, "{{FirebaserulesBasePath}}projects/{{project}}/rulesets")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Ruleset: %#v", obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Ruleset: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/rulesets/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating Ruleset %q: %#v", d.Id(), res)
+
+	// `name` is autogenerated from the api so needs to be set post-create
+	name, ok := res["name"]
+	if !ok {
+		return fmt.Errorf("Create response didn't contain critical fields. Create may not have succeeded.")
+	}
+	d.Set("name", GetResourceNameFromSelfLink(name.(string)))
+	// Store the ID now that we have the name
+	id, err = replaceVars(d, config, "projects/{{project}}/rulesets/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return resourceFirebaserulesRulesetRead(d, meta)
+}
+
+func resourceFirebaserulesRulesetRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{FirebaserulesBasePath}}projects/{{project}}/rulesets/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("FirebaserulesRuleset %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Ruleset: %s", err)
+	}
+
+	if err := d.Set("name", flattenFirebaserulesRulesetName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading Ruleset: %s", err)
+	}
+	if err := d.Set("source", flattenFirebaserulesRulesetSource(res["source"], d)); err != nil {
+		return fmt.Errorf("Error reading Ruleset: %s", err)
+	}
+	if err := d.Set("create_time", flattenFirebaserulesRulesetCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Ruleset: %s", err)
+	}
+
+	return nil
+}
+
+func resourceFirebaserulesRulesetDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{FirebaserulesBasePath}}projects/{{project}}/rulesets/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Ruleset %q", d.Id())
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Ruleset")
+	}
+
+	log.Printf("[DEBUG] Finished deleting Ruleset %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceFirebaserulesRulesetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/rulesets/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<name>[^/]+)",
+		"(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/rulesets/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenFirebaserulesRulesetName(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	return NameFromSelfLinkStateFunc(v)
+}
+
+func flattenFirebaserulesRulesetSource(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["files"] =
+		flattenFirebaserulesRulesetSourceFiles(original["files"], d)
+	transformed["language"] =
+		flattenFirebaserulesRulesetSourceLanguage(original["language"], d)
+	return []interface{}{transformed}
+}
+func flattenFirebaserulesRulesetSourceFiles(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"content":     flattenFirebaserulesRulesetSourceFilesContent(original["content"], d),
+			"name":        flattenFirebaserulesRulesetSourceFilesName(original["name"], d),
+			"fingerprint": flattenFirebaserulesRulesetSourceFilesFingerprint(original["fingerprint"], d),
+		})
+	}
+	return transformed
+}
+func flattenFirebaserulesRulesetSourceFilesContent(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenFirebaserulesRulesetSourceFilesName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenFirebaserulesRulesetSourceFilesFingerprint(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenFirebaserulesRulesetSourceLanguage(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenFirebaserulesRulesetCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandFirebaserulesRulesetSource(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedFiles, err := expandFirebaserulesRulesetSourceFiles(original["files"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedFiles); val.IsValid() && !isEmptyValue(val) {
+		transformed["files"] = transformedFiles
+	}
+
+	transformedLanguage, err := expandFirebaserulesRulesetSourceLanguage(original["language"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedLanguage); val.IsValid() && !isEmptyValue(val) {
+		transformed["language"] = transformedLanguage
+	}
+
+	return transformed, nil
+}
+
+func expandFirebaserulesRulesetSourceFiles(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedContent, err := expandFirebaserulesRulesetSourceFilesContent(original["content"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedContent); val.IsValid() && !isEmptyValue(val) {
+			transformed["content"] = transformedContent
+		}
+
+		transformedName, err := expandFirebaserulesRulesetSourceFilesName(original["name"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedName); val.IsValid() && !isEmptyValue(val) {
+			transformed["name"] = transformedName
+		}
+
+		transformedFingerprint, err := expandFirebaserulesRulesetSourceFilesFingerprint(original["fingerprint"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedFingerprint); val.IsValid() && !isEmptyValue(val) {
+			transformed["fingerprint"] = transformedFingerprint
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandFirebaserulesRulesetSourceFilesContent(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandFirebaserulesRulesetSourceFilesName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandFirebaserulesRulesetSourceFilesFingerprint(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandFirebaserulesRulesetSourceLanguage(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,87 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccFirebaserulesRuleset_firebaserulesRulesetBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFirebaserulesRulesetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirebaserulesRuleset_firebaserulesRulesetBasicExample(context),
+			},
+			{
+				ResourceName:      "google_firebaserules_ruleset.primary",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccFirebaserulesRuleset_firebaserulesRulesetBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_firebaserules_ruleset" "primary" {
+  source {
+    files {
+      content     = "service cloud.firestore {match /databases/{database}/documents { match /{document=**} { allow read, write: if false; } } }"
+      name        = "firestore.rules"
+    }
+  }
+}
+`, context)
+}
+
+func testAccCheckFirebaserulesRulesetDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_firebaserules_ruleset" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(config, rs, "{{FirebaserulesBasePath}}projects/{{project}}/rulesets/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("FirebaserulesRuleset still exists at %s", url)
+		}
+	}
+
+	return nil
+}
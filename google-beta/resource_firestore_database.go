@@ -0,0 +1,483 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceFirestoreDatabase() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFirestoreDatabaseCreate,
+		Read:   resourceFirestoreDatabaseRead,
+		Update: resourceFirestoreDatabaseUpdate,
+		Delete: resourceFirestoreDatabaseDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceFirestoreDatabaseImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"location_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"FIRESTORE_NATIVE", "DATASTORE_MODE"}, false),
+			},
+			"app_engine_integration_mode": {
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ENABLED", "DISABLED", ""}, false),
+			},
+			"concurrency_mode": {
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"OPTIMISTIC", "PESSIMISTIC", "OPTIMISTIC_WITH_ENTITY_GROUPS", ""}, false),
+			},
+			"delete_protection_state": {
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"DELETE_PROTECTION_ENABLED", "DELETE_PROTECTION_DISABLED", ""}, false),
+			},
+			"point_in_time_recovery_enablement": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"POINT_IN_TIME_RECOVERY_ENABLED", "POINT_IN_TIME_RECOVERY_DISABLED", ""}, false),
+				Default:      "POINT_IN_TIME_RECOVERY_DISABLED",
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"earliest_version_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"uid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version_retention_period": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceFirestoreDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	locationIdProp, err := expandFirestoreDatabaseLocationId(d.Get("location_id"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("location_id"); !isEmptyValue(reflect.ValueOf(locationIdProp)) && (ok || !reflect.DeepEqual(v, locationIdProp)) {
+		obj["locationId"] = locationIdProp
+	}
+	typeProp, err := expandFirestoreDatabaseType(d.Get("type"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("type"); !isEmptyValue(reflect.ValueOf(typeProp)) && (ok || !reflect.DeepEqual(v, typeProp)) {
+		obj["type"] = typeProp
+	}
+	concurrencyModeProp, err := expandFirestoreDatabaseConcurrencyMode(d.Get("concurrency_mode"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("concurrency_mode"); !isEmptyValue(reflect.ValueOf(concurrencyModeProp)) && (ok || !reflect.DeepEqual(v, concurrencyModeProp)) {
+		obj["concurrencyMode"] = concurrencyModeProp
+	}
+	appEngineIntegrationModeProp, err := expandFirestoreDatabaseAppEngineIntegrationMode(d.Get("app_engine_integration_mode"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("app_engine_integration_mode"); !isEmptyValue(reflect.ValueOf(appEngineIntegrationModeProp)) && (ok || !reflect.DeepEqual(v, appEngineIntegrationModeProp)) {
+		obj["appEngineIntegrationMode"] = appEngineIntegrationModeProp
+	}
+	pointInTimeRecoveryEnablementProp, err := expandFirestoreDatabasePointInTimeRecoveryEnablement(d.Get("point_in_time_recovery_enablement"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("point_in_time_recovery_enablement"); !isEmptyValue(reflect.ValueOf(pointInTimeRecoveryEnablementProp)) && (ok || !reflect.DeepEqual(v, pointInTimeRecoveryEnablementProp)) {
+		obj["pointInTimeRecoveryEnablement"] = pointInTimeRecoveryEnablementProp
+	}
+	deleteProtectionStateProp, err := expandFirestoreDatabaseDeleteProtectionState(d.Get("delete_protection_state"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("delete_protection_state"); !isEmptyValue(reflect.ValueOf(deleteProtectionStateProp)) && (ok || !reflect.DeepEqual(v, deleteProtectionStateProp)) {
+		obj["deleteProtectionState"] = deleteProtectionStateProp
+	}
+
+	url, err := replaceVars(d, config, "{{FirestoreBasePath}}projects/{{project}}/databases?databaseId={{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Database: %#v", obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Database: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/databases/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	waitErr := firestoreOperationWaitTime(
+		config, res, project, "Creating Database",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create Database: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating Database %q: %#v", d.Id(), res)
+
+	return resourceFirestoreDatabaseRead(d, meta)
+}
+
+func resourceFirestoreDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{FirestoreBasePath}}projects/{{project}}/databases/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("FirestoreDatabase %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Database: %s", err)
+	}
+
+	if err := d.Set("location_id", flattenFirestoreDatabaseLocationId(res["locationId"], d)); err != nil {
+		return fmt.Errorf("Error reading Database: %s", err)
+	}
+	if err := d.Set("type", flattenFirestoreDatabaseType(res["type"], d)); err != nil {
+		return fmt.Errorf("Error reading Database: %s", err)
+	}
+	if err := d.Set("concurrency_mode", flattenFirestoreDatabaseConcurrencyMode(res["concurrencyMode"], d)); err != nil {
+		return fmt.Errorf("Error reading Database: %s", err)
+	}
+	if err := d.Set("app_engine_integration_mode", flattenFirestoreDatabaseAppEngineIntegrationMode(res["appEngineIntegrationMode"], d)); err != nil {
+		return fmt.Errorf("Error reading Database: %s", err)
+	}
+	if err := d.Set("point_in_time_recovery_enablement", flattenFirestoreDatabasePointInTimeRecoveryEnablement(res["pointInTimeRecoveryEnablement"], d)); err != nil {
+		return fmt.Errorf("Error reading Database: %s", err)
+	}
+	if err := d.Set("delete_protection_state", flattenFirestoreDatabaseDeleteProtectionState(res["deleteProtectionState"], d)); err != nil {
+		return fmt.Errorf("Error reading Database: %s", err)
+	}
+	if err := d.Set("key_prefix", flattenFirestoreDatabaseKeyPrefix(res["keyPrefix"], d)); err != nil {
+		return fmt.Errorf("Error reading Database: %s", err)
+	}
+	if err := d.Set("etag", flattenFirestoreDatabaseEtag(res["etag"], d)); err != nil {
+		return fmt.Errorf("Error reading Database: %s", err)
+	}
+	if err := d.Set("create_time", flattenFirestoreDatabaseCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Database: %s", err)
+	}
+	if err := d.Set("update_time", flattenFirestoreDatabaseUpdateTime(res["updateTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Database: %s", err)
+	}
+	if err := d.Set("uid", flattenFirestoreDatabaseUid(res["uid"], d)); err != nil {
+		return fmt.Errorf("Error reading Database: %s", err)
+	}
+	if err := d.Set("version_retention_period", flattenFirestoreDatabaseVersionRetentionPeriod(res["versionRetentionPeriod"], d)); err != nil {
+		return fmt.Errorf("Error reading Database: %s", err)
+	}
+	if err := d.Set("earliest_version_time", flattenFirestoreDatabaseEarliestVersionTime(res["earliestVersionTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Database: %s", err)
+	}
+
+	return nil
+}
+
+func resourceFirestoreDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	typeProp, err := expandFirestoreDatabaseType(d.Get("type"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("type"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, typeProp)) {
+		obj["type"] = typeProp
+	}
+	concurrencyModeProp, err := expandFirestoreDatabaseConcurrencyMode(d.Get("concurrency_mode"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("concurrency_mode"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, concurrencyModeProp)) {
+		obj["concurrencyMode"] = concurrencyModeProp
+	}
+	appEngineIntegrationModeProp, err := expandFirestoreDatabaseAppEngineIntegrationMode(d.Get("app_engine_integration_mode"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("app_engine_integration_mode"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, appEngineIntegrationModeProp)) {
+		obj["appEngineIntegrationMode"] = appEngineIntegrationModeProp
+	}
+	pointInTimeRecoveryEnablementProp, err := expandFirestoreDatabasePointInTimeRecoveryEnablement(d.Get("point_in_time_recovery_enablement"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("point_in_time_recovery_enablement"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, pointInTimeRecoveryEnablementProp)) {
+		obj["pointInTimeRecoveryEnablement"] = pointInTimeRecoveryEnablementProp
+	}
+	deleteProtectionStateProp, err := expandFirestoreDatabaseDeleteProtectionState(d.Get("delete_protection_state"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("delete_protection_state"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, deleteProtectionStateProp)) {
+		obj["deleteProtectionState"] = deleteProtectionStateProp
+	}
+
+	url, err := replaceVars(d, config, "{{FirestoreBasePath}}projects/{{project}}/databases/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Database %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("type") {
+		updateMask = append(updateMask, "type")
+	}
+
+	if d.HasChange("concurrency_mode") {
+		updateMask = append(updateMask, "concurrencyMode")
+	}
+
+	if d.HasChange("app_engine_integration_mode") {
+		updateMask = append(updateMask, "appEngineIntegrationMode")
+	}
+
+	if d.HasChange("point_in_time_recovery_enablement") {
+		updateMask = append(updateMask, "pointInTimeRecoveryEnablement")
+	}
+
+	if d.HasChange("delete_protection_state") {
+		updateMask = append(updateMask, "deleteProtectionState")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Database %q: %s", d.Id(), err)
+	}
+
+	err = firestoreOperationWaitTime(
+		config, res, project, "Updating Database",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceFirestoreDatabaseRead(d, meta)
+}
+
+func resourceFirestoreDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{FirestoreBasePath}}projects/{{project}}/databases/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Database %q", d.Id())
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Database")
+	}
+
+	err = firestoreOperationWaitTime(
+		config, res, project, "Deleting Database",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting Database %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceFirestoreDatabaseImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/databases/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<name>[^/]+)",
+		"(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/databases/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenFirestoreDatabaseLocationId(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenFirestoreDatabaseType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenFirestoreDatabaseConcurrencyMode(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenFirestoreDatabaseAppEngineIntegrationMode(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenFirestoreDatabasePointInTimeRecoveryEnablement(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenFirestoreDatabaseDeleteProtectionState(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenFirestoreDatabaseKeyPrefix(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenFirestoreDatabaseEtag(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenFirestoreDatabaseCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenFirestoreDatabaseUpdateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenFirestoreDatabaseUid(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenFirestoreDatabaseVersionRetentionPeriod(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenFirestoreDatabaseEarliestVersionTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandFirestoreDatabaseLocationId(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandFirestoreDatabaseType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandFirestoreDatabaseConcurrencyMode(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandFirestoreDatabaseAppEngineIntegrationMode(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandFirestoreDatabasePointInTimeRecoveryEnablement(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandFirestoreDatabaseDeleteProtectionState(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
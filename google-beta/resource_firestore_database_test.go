@@ -0,0 +1,60 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccFirestoreDatabase_updateInPlace(t *testing.T) {
+	t.Parallel()
+
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFirestoreDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirestoreDatabase_named(name, "POINT_IN_TIME_RECOVERY_DISABLED", "DELETE_PROTECTION_DISABLED"),
+			},
+			{
+				ResourceName:            "google_firestore_database.database",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"etag"},
+			},
+			{
+				Config: testAccFirestoreDatabase_named(name, "POINT_IN_TIME_RECOVERY_ENABLED", "DELETE_PROTECTION_ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_firestore_database.database", "version_retention_period", "604800s"),
+				),
+			},
+			{
+				ResourceName:            "google_firestore_database.database",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"etag"},
+			},
+			{
+				// Delete protection needs to be turned off again so the database can be destroyed.
+				Config: testAccFirestoreDatabase_named(name, "POINT_IN_TIME_RECOVERY_ENABLED", "DELETE_PROTECTION_DISABLED"),
+			},
+		},
+	})
+}
+
+func testAccFirestoreDatabase_named(name, pitr, deleteProtection string) string {
+	return fmt.Sprintf(`
+resource "google_firestore_database" "database" {
+  name                              = "%s"
+  location_id                       = "nam5"
+  type                              = "FIRESTORE_NATIVE"
+  point_in_time_recovery_enablement = "%s"
+  delete_protection_state           = "%s"
+}
+`, name, pitr, deleteProtection)
+}
@@ -0,0 +1,412 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// TTL policies expire documents based on a timestamp field, which neither the
+// document name nor the wildcard default field can be.
+func firestoreFieldCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if _, ok := diff.GetOk("ttl_config"); ok {
+		if diff.Get("field").(string) == "__name__" || diff.Get("collection").(string) == "__default__" {
+			return fmt.Errorf("ttl_config requires a timestamp field and can't be set on %s/%s", diff.Get("collection"), diff.Get("field"))
+		}
+	}
+
+	for _, raw := range diff.Get("index_config.0.indexes").(*schema.Set).List() {
+		index := raw.(map[string]interface{})
+		if (index["order"].(string) == "") == (index["array_config"].(string) == "") {
+			return fmt.Errorf("exactly one of order or array_config must be set for each index in index_config")
+		}
+	}
+
+	return nil
+}
+
+func resourceFirestoreField() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFirestoreFieldCreate,
+		Read:   resourceFirestoreFieldRead,
+		Update: resourceFirestoreFieldUpdate,
+		Delete: resourceFirestoreFieldDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceFirestoreFieldImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: firestoreFieldCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"collection": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"field": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"database": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "(default)",
+			},
+			"index_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"indexes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"array_config": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"CONTAINS", ""}, false),
+									},
+									"order": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"ASCENDING", "DESCENDING", ""}, false),
+									},
+									"query_scope": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"COLLECTION", "COLLECTION_GROUP", ""}, false),
+										Default:      "COLLECTION",
+									},
+								},
+							},
+							// Default schema.HashSchema is used.
+						},
+					},
+				},
+			},
+			"ttl_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceFirestoreFieldCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	indexConfigProp, err := expandFirestoreFieldIndexConfig(d.Get("index_config"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("index_config"); ok || !reflect.DeepEqual(v, indexConfigProp) {
+		obj["indexConfig"] = indexConfigProp
+	}
+	ttlConfigProp, err := expandFirestoreFieldTtlConfig(d.Get("ttl_config"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("ttl_config"); ok || !reflect.DeepEqual(v, ttlConfigProp) {
+		obj["ttlConfig"] = ttlConfigProp
+	}
+
+	url, err := replaceVars(d, config, "{{FirestoreBasePath}}projects/{{project}}/databases/{{database}}/collectionGroups/{{collection}}/fields/{{field}}?updateMask=indexConfig,ttlConfig")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Field: %#v", obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Field: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/databases/{{database}}/collectionGroups/{{collection}}/fields/{{field}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	waitErr := firestoreOperationWaitTime(
+		config, res, project, "Creating Field",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create Field: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating Field %q: %#v", d.Id(), res)
+
+	return resourceFirestoreFieldRead(d, meta)
+}
+
+func resourceFirestoreFieldRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{FirestoreBasePath}}projects/{{project}}/databases/{{database}}/collectionGroups/{{collection}}/fields/{{field}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("FirestoreField %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Field: %s", err)
+	}
+
+	if err := d.Set("name", flattenFirestoreFieldName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading Field: %s", err)
+	}
+	if err := d.Set("index_config", flattenFirestoreFieldIndexConfig(res["indexConfig"], d)); err != nil {
+		return fmt.Errorf("Error reading Field: %s", err)
+	}
+	if err := d.Set("ttl_config", flattenFirestoreFieldTtlConfig(res["ttlConfig"], d)); err != nil {
+		return fmt.Errorf("Error reading Field: %s", err)
+	}
+
+	return nil
+}
+
+func resourceFirestoreFieldUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	indexConfigProp, err := expandFirestoreFieldIndexConfig(d.Get("index_config"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("index_config"); ok || !reflect.DeepEqual(v, indexConfigProp) {
+		obj["indexConfig"] = indexConfigProp
+	}
+	ttlConfigProp, err := expandFirestoreFieldTtlConfig(d.Get("ttl_config"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("ttl_config"); ok || !reflect.DeepEqual(v, ttlConfigProp) {
+		obj["ttlConfig"] = ttlConfigProp
+	}
+
+	url, err := replaceVars(d, config, "{{FirestoreBasePath}}projects/{{project}}/databases/{{database}}/collectionGroups/{{collection}}/fields/{{field}}?updateMask=indexConfig,ttlConfig")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Field %q: %#v", d.Id(), obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Field %q: %s", d.Id(), err)
+	}
+
+	err = firestoreOperationWaitTime(
+		config, res, project, "Updating Field",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceFirestoreFieldRead(d, meta)
+}
+
+func resourceFirestoreFieldDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{FirestoreBasePath}}projects/{{project}}/databases/{{database}}/collectionGroups/{{collection}}/fields/{{field}}?updateMask=indexConfig,ttlConfig")
+	if err != nil {
+		return err
+	}
+
+	// A field can't be deleted, clearing both overrides returns it to the
+	// collection group's defaults.
+	obj := make(map[string]interface{})
+	log.Printf("[DEBUG] Deleting Field %q", d.Id())
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Field")
+	}
+
+	err = firestoreOperationWaitTime(
+		config, res, project, "Deleting Field",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting Field %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceFirestoreFieldImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/databases/(?P<database>[^/]+)/collectionGroups/(?P<collection>[^/]+)/fields/(?P<field>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/databases/{{database}}/collectionGroups/{{collection}}/fields/{{field}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenFirestoreFieldName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenFirestoreFieldIndexConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if usesAncestorConfig, ok := original["usesAncestorConfig"].(bool); ok && usesAncestorConfig {
+		// The field doesn't override the collection group's index settings.
+		return nil
+	}
+
+	indexes := []interface{}{}
+	if l, ok := original["indexes"].([]interface{}); ok {
+		for _, raw := range l {
+			index := raw.(map[string]interface{})
+			transformed := map[string]interface{}{
+				"query_scope": index["queryScope"],
+			}
+			if fields, ok := index["fields"].([]interface{}); ok && len(fields) > 0 {
+				field := fields[0].(map[string]interface{})
+				transformed["order"] = field["order"]
+				transformed["array_config"] = field["arrayConfig"]
+			}
+			indexes = append(indexes, transformed)
+		}
+	}
+
+	return []interface{}{map[string]interface{}{"indexes": indexes}}
+}
+
+func flattenFirestoreFieldTtlConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	return []interface{}{map[string]interface{}{
+		"state": original["state"],
+	}}
+}
+
+func expandFirestoreFieldIndexConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 {
+		// Sending no index config clears the override, so the field inherits
+		// the collection group's index settings again.
+		return nil, nil
+	}
+
+	indexes := []interface{}{}
+	if l[0] != nil {
+		for _, raw := range l[0].(map[string]interface{})["indexes"].(*schema.Set).List() {
+			index := raw.(map[string]interface{})
+			field := map[string]interface{}{
+				"fieldPath": d.Get("field"),
+			}
+			if order := index["order"].(string); order != "" {
+				field["order"] = order
+			}
+			if arrayConfig := index["array_config"].(string); arrayConfig != "" {
+				field["arrayConfig"] = arrayConfig
+			}
+			indexes = append(indexes, map[string]interface{}{
+				"queryScope": index["query_scope"],
+				"fields":     []interface{}{field},
+			})
+		}
+	}
+
+	return map[string]interface{}{"indexes": indexes}, nil
+}
+
+func expandFirestoreFieldTtlConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 {
+		// Sending no TTL config removes the TTL policy.
+		return nil, nil
+	}
+	// The ttl_config block has no arguments, its presence enables TTL.
+	return map[string]interface{}{}, nil
+}
@@ -0,0 +1,139 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccFirestoreField_firestoreFieldBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"project_id":    getTestFirestoreProjectFromEnv(t),
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFirestoreFieldDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirestoreField_firestoreFieldBasicExample(context),
+			},
+			{
+				ResourceName:      "google_firestore_field.basic",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccFirestoreField_firestoreFieldBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_firestore_field" "basic" {
+  project    = "%{project_id}"
+  collection = "chatrooms_%{random_suffix}"
+  field      = "basic"
+
+  index_config {
+    indexes {
+      order       = "ASCENDING"
+      query_scope = "COLLECTION_GROUP"
+    }
+    indexes {
+      array_config = "CONTAINS"
+    }
+  }
+}
+`, context)
+}
+
+func TestAccFirestoreField_firestoreFieldTtlExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"project_id":    getTestFirestoreProjectFromEnv(t),
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFirestoreFieldDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirestoreField_firestoreFieldTtlExample(context),
+			},
+			{
+				ResourceName:      "google_firestore_field.ttl",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccFirestoreField_firestoreFieldTtlExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_firestore_field" "ttl" {
+  project    = "%{project_id}"
+  collection = "chatrooms_%{random_suffix}"
+  field      = "expireAt"
+
+  ttl_config {}
+}
+`, context)
+}
+
+func testAccCheckFirestoreFieldDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_firestore_field" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(config, rs, "{{FirestoreBasePath}}projects/{{project}}/databases/{{database}}/collectionGroups/{{collection}}/fields/{{field}}")
+		if err != nil {
+			return err
+		}
+
+		// Fields always exist, destroying one clears its overrides.
+		res, err := sendRequest(config, "GET", "", url, nil)
+		if err != nil {
+			return err
+		}
+		if _, ok := res["ttlConfig"]; ok {
+			return fmt.Errorf("FirestoreField still has a TTL config at %s", url)
+		}
+		if indexConfig, ok := res["indexConfig"].(map[string]interface{}); ok && indexConfig["usesAncestorConfig"] != true {
+			return fmt.Errorf("FirestoreField still has an index config override at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package google
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccFirestoreField_ttlUpdate(t *testing.T) {
+	t.Parallel()
+
+	project := getTestFirestoreProjectFromEnv(t)
+	collection := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFirestoreFieldDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirestoreField_ttl(project, collection),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_firestore_field.expire_at", "ttl_config.0.state", "ACTIVE"),
+				),
+			},
+			{
+				ResourceName:      "google_firestore_field.expire_at",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFirestoreField_ttlIndexesDisabled(project, collection),
+			},
+			{
+				ResourceName:      "google_firestore_field.expire_at",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFirestoreField_ttlOnDocumentName(t *testing.T) {
+	t.Parallel()
+
+	collection := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFirestoreField_ttlOnDocumentName(collection),
+				ExpectError: regexp.MustCompile("ttl_config requires a timestamp field"),
+			},
+		},
+	})
+}
+
+func testAccFirestoreField_ttl(project, collection string) string {
+	return fmt.Sprintf(`
+resource "google_firestore_field" "expire_at" {
+  project    = "%s"
+  collection = "%s"
+  field      = "expireAt"
+
+  ttl_config {}
+}
+`, project, collection)
+}
+
+func testAccFirestoreField_ttlIndexesDisabled(project, collection string) string {
+	return fmt.Sprintf(`
+resource "google_firestore_field" "expire_at" {
+  project    = "%s"
+  collection = "%s"
+  field      = "expireAt"
+
+  index_config {}
+
+  ttl_config {}
+}
+`, project, collection)
+}
+
+func testAccFirestoreField_ttlOnDocumentName(collection string) string {
+	return fmt.Sprintf(`
+resource "google_firestore_field" "expire_at" {
+  collection = "%s"
+  field      = "__name__"
+
+  ttl_config {}
+}
+`, collection)
+}
@@ -25,6 +25,18 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 )
 
+// Each index field is either ordered or an array field, but never both.
+func firestoreIndexFieldsCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	for i := 0; i < diff.Get("fields.#").(int); i++ {
+		order := diff.Get(fmt.Sprintf("fields.%d.order", i)).(string)
+		arrayConfig := diff.Get(fmt.Sprintf("fields.%d.array_config", i)).(string)
+		if (order == "") == (arrayConfig == "") {
+			return fmt.Errorf("exactly one of order or array_config must be set for fields.%d", i)
+		}
+	}
+	return nil
+}
+
 func resourceFirestoreIndex() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceFirestoreIndexCreate,
@@ -40,6 +52,8 @@ func resourceFirestoreIndex() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: firestoreIndexFieldsCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"collection": {
 				Type:     schema.TypeString,
@@ -90,6 +104,10 @@ func resourceFirestoreIndex() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"project": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -140,7 +158,12 @@ func resourceFirestoreIndexCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	log.Printf("[DEBUG] Creating new Index: %#v", obj)
-	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("Error creating Index: %s", err)
 	}
@@ -152,10 +175,6 @@ func resourceFirestoreIndexCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 	d.SetId(id)
 
-	project, err := getProject(d, config)
-	if err != nil {
-		return err
-	}
 	waitErr := firestoreOperationWaitTime(
 		config, res, project, "Creating Index",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
@@ -187,15 +206,16 @@ func resourceFirestoreIndexRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	res, err := sendRequest(config, "GET", url, nil)
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("FirestoreIndex %q", d.Id()))
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequest(config, "GET", project, url, nil)
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, fmt.Sprintf("FirestoreIndex %q", d.Id()))
 	}
+
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("Error reading Index: %s", err)
 	}
@@ -209,6 +229,9 @@ func resourceFirestoreIndexRead(d *schema.ResourceData, meta interface{}) error
 	if err := d.Set("fields", flattenFirestoreIndexFields(res["fields"], d)); err != nil {
 		return fmt.Errorf("Error reading Index: %s", err)
 	}
+	if err := d.Set("state", flattenFirestoreIndexState(res["state"], d)); err != nil {
+		return fmt.Errorf("Error reading Index: %s", err)
+	}
 
 	return nil
 }
@@ -223,14 +246,14 @@ func resourceFirestoreIndexDelete(d *schema.ResourceData, meta interface{}) erro
 
 	var obj map[string]interface{}
 	log.Printf("[DEBUG] Deleting Index %q", d.Id())
-	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, "Index")
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, "Index")
 	}
 
 	err = firestoreOperationWaitTime(
@@ -264,6 +287,8 @@ func resourceFirestoreIndexImport(d *schema.ResourceData, meta interface{}) ([]*
 	}
 
 	d.Set("project", fmt.Sprintf("%s", stringParts[1]))
+	d.Set("database", fmt.Sprintf("%s", stringParts[3]))
+	d.Set("collection", fmt.Sprintf("%s", stringParts[5]))
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -307,6 +332,10 @@ func flattenFirestoreIndexFieldsArrayConfig(v interface{}, d *schema.ResourceDat
 	return v
 }
 
+func flattenFirestoreIndexState(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
 func expandFirestoreIndexDatabase(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
 	return v, nil
 }
@@ -41,10 +41,9 @@ func TestAccFirestoreIndex_firestoreIndexBasicExample(t *testing.T) {
 				Config: testAccFirestoreIndex_firestoreIndexBasicExample(context),
 			},
 			{
-				ResourceName:            "google_firestore_index.my-index",
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"database", "collection"},
+				ResourceName:      "google_firestore_index.my-index",
+				ImportState:       true,
+				ImportStateVerify: true,
 			},
 		},
 	})
@@ -91,7 +90,7 @@ func testAccCheckFirestoreIndexDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("FirestoreIndex still exists at %s", url)
 		}
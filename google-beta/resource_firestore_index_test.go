@@ -0,0 +1,92 @@
+package google
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccFirestoreIndex_compositeReady(t *testing.T) {
+	t.Parallel()
+
+	project := getTestFirestoreProjectFromEnv(t)
+	collection := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFirestoreIndexDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirestoreIndex_composite(project, collection),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_firestore_index.composite", "state", "READY"),
+				),
+			},
+			{
+				ResourceName:      "google_firestore_index.composite",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFirestoreIndex_fieldOrderOrArrayConfig(t *testing.T) {
+	t.Parallel()
+
+	collection := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFirestoreIndex_orderAndArrayConfig(collection),
+				ExpectError: regexp.MustCompile("exactly one of order or array_config must be set for fields.1"),
+			},
+		},
+	})
+}
+
+func testAccFirestoreIndex_composite(project, collection string) string {
+	return fmt.Sprintf(`
+resource "google_firestore_index" "composite" {
+  project     = "%s"
+  collection  = "%s"
+  query_scope = "COLLECTION_GROUP"
+
+  fields {
+    field_path = "name"
+    order      = "ASCENDING"
+  }
+
+  fields {
+    field_path   = "tags"
+    array_config = "CONTAINS"
+  }
+}
+`, project, collection)
+}
+
+func testAccFirestoreIndex_orderAndArrayConfig(collection string) string {
+	return fmt.Sprintf(`
+resource "google_firestore_index" "composite" {
+  collection = "%s"
+
+  fields {
+    field_path = "name"
+    order      = "ASCENDING"
+  }
+
+  fields {
+    field_path   = "tags"
+    order        = "DESCENDING"
+    array_config = "CONTAINS"
+  }
+}
+`, collection)
+}
@@ -0,0 +1,165 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccGKEBackupBackupPlan_gKEBackupBackupPlanBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGKEBackupBackupPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGKEBackupBackupPlan_gKEBackupBackupPlanBasicExample(context),
+			},
+			{
+				ResourceName:      "google_gke_backup_backup_plan.basic",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGKEBackupBackupPlan_gKEBackupBackupPlanBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_container_cluster" "primary" {
+  name               = "tf-test-cluster-%{random_suffix}"
+  location           = "us-central1"
+  initial_node_count = 1
+
+  workload_identity_config {
+    identity_namespace = "${data.google_project.project.project_id}.svc.id.goog"
+  }
+}
+
+data "google_project" "project" {}
+
+resource "google_gke_backup_backup_plan" "basic" {
+  name     = "tf-test-basic-plan-%{random_suffix}"
+  cluster  = "projects/${google_container_cluster.primary.project}/locations/${google_container_cluster.primary.location}/clusters/${google_container_cluster.primary.name}"
+  location = "us-central1"
+
+  backup_config {
+    include_volume_data = true
+    include_secrets     = true
+    all_namespaces      = true
+  }
+}
+`, context)
+}
+
+func TestAccGKEBackupBackupPlan_gKEBackupBackupPlanDailyExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGKEBackupBackupPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGKEBackupBackupPlan_gKEBackupBackupPlanDailyExample(context),
+			},
+			{
+				ResourceName:      "google_gke_backup_backup_plan.daily",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGKEBackupBackupPlan_gKEBackupBackupPlanDailyExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_container_cluster" "primary" {
+  name               = "tf-test-cluster-%{random_suffix}"
+  location           = "us-central1"
+  initial_node_count = 1
+
+  workload_identity_config {
+    identity_namespace = "${data.google_project.project.project_id}.svc.id.goog"
+  }
+}
+
+data "google_project" "project" {}
+
+resource "google_gke_backup_backup_plan" "daily" {
+  name     = "tf-test-daily-plan-%{random_suffix}"
+  cluster  = "projects/${google_container_cluster.primary.project}/locations/${google_container_cluster.primary.location}/clusters/${google_container_cluster.primary.name}"
+  location = "us-central1"
+
+  retention_policy {
+    backup_delete_lock_days = 30
+    backup_retain_days      = 180
+  }
+
+  backup_schedule {
+    cron_schedule = "0 9 * * *"
+  }
+
+  backup_config {
+    include_volume_data = true
+    include_secrets     = true
+    selected_namespaces {
+      namespaces = ["app1", "app2"]
+    }
+  }
+}
+`, context)
+}
+
+func testAccCheckGKEBackupBackupPlanDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_gke_backup_backup_plan" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(config, rs, "{{GKEBackupBasePath}}projects/{{project}}/locations/{{location}}/backupPlans/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("GKEBackupBackupPlan still exists at %s", url)
+		}
+	}
+
+	return nil
+}
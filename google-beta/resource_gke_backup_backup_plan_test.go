@@ -0,0 +1,99 @@
+package google
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccGKEBackupBackupPlan_update(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGKEBackupBackupPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGKEBackupBackupPlan_daily(suffix, "0 9 * * *", 30),
+			},
+			{
+				ResourceName:      "google_gke_backup_backup_plan.daily",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGKEBackupBackupPlan_daily(suffix, "0 21 * * *", 60),
+			},
+			{
+				ResourceName:      "google_gke_backup_backup_plan.daily",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGKEBackupBackupPlan_missingCluster(t *testing.T) {
+	t.Parallel()
+
+	project := getTestProjectFromEnv()
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGKEBackupBackupPlan_missingCluster(project, suffix),
+				ExpectError: regexp.MustCompile("doesn't exist"),
+			},
+		},
+	})
+}
+
+func testAccGKEBackupBackupPlan_daily(suffix, cronSchedule string, retainDays int) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "primary" {
+  name               = "tf-test-cluster-%s"
+  location           = "us-central1"
+  initial_node_count = 1
+}
+
+resource "google_gke_backup_backup_plan" "daily" {
+  name     = "tf-test-daily-plan-%s"
+  cluster  = "projects/${google_container_cluster.primary.project}/locations/${google_container_cluster.primary.location}/clusters/${google_container_cluster.primary.name}"
+  location = "us-central1"
+
+  retention_policy {
+    backup_delete_lock_days = 7
+    backup_retain_days      = %d
+  }
+
+  backup_schedule {
+    cron_schedule = "%s"
+  }
+
+  backup_config {
+    include_volume_data = true
+    include_secrets     = false
+    all_namespaces      = true
+  }
+}
+`, suffix, suffix, retainDays, cronSchedule)
+}
+
+func testAccGKEBackupBackupPlan_missingCluster(project, suffix string) string {
+	return fmt.Sprintf(`
+resource "google_gke_backup_backup_plan" "missing" {
+  name     = "tf-test-missing-plan-%s"
+  cluster  = "projects/%s/locations/us-central1/clusters/tf-test-missing-%s"
+  location = "us-central1"
+}
+`, suffix, project, suffix)
+}
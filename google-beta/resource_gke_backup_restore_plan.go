@@ -0,0 +1,828 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceGKEBackupRestorePlan() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGKEBackupRestorePlanCreate,
+		Read:   resourceGKEBackupRestorePlanRead,
+		Update: resourceGKEBackupRestorePlanUpdate,
+		Delete: resourceGKEBackupRestorePlanDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceGKEBackupRestorePlanImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: setLabelsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"backup_plan": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkRelativePaths,
+			},
+			"cluster": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegexp(`^projects/[^/]+/locations/[^/]+/clusters/[^/]+$`),
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"restore_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"all_namespaces": {
+							Type:          schema.TypeBool,
+							Optional:      true,
+							ConflictsWith: []string{"restore_config.0.selected_namespaces", "restore_config.0.excluded_namespaces", "restore_config.0.no_namespaces"},
+						},
+						"cluster_resource_conflict_policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"USE_EXISTING_VERSION", "USE_BACKUP_VERSION", ""}, false),
+						},
+						"cluster_resource_restore_scope": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"all_group_kinds": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"selected_group_kinds": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"resource_group": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"resource_kind": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"excluded_namespaces": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							ConflictsWith: []string{"restore_config.0.all_namespaces", "restore_config.0.selected_namespaces", "restore_config.0.no_namespaces"},
+							MaxItems:      1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"namespaces": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+						"namespaced_resource_restore_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"DELETE_AND_RESTORE", "FAIL_ON_CONFLICT", ""}, false),
+						},
+						"no_namespaces": {
+							Type:          schema.TypeBool,
+							Optional:      true,
+							ConflictsWith: []string{"restore_config.0.all_namespaces", "restore_config.0.selected_namespaces", "restore_config.0.excluded_namespaces"},
+						},
+						"selected_namespaces": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							ConflictsWith: []string{"restore_config.0.all_namespaces", "restore_config.0.excluded_namespaces", "restore_config.0.no_namespaces"},
+							MaxItems:      1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"namespaces": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+						"volume_data_restore_policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"RESTORE_VOLUME_DATA_FROM_BACKUP", "REUSE_VOLUME_HANDLE_FROM_BACKUP", "NO_VOLUME_DATA_RESTORATION", ""}, false),
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"effective_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"uid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceGKEBackupRestorePlanCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandGKEBackupRestorePlanDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	labelsProp, err := expandGKEBackupRestorePlanLabels(d.Get("terraform_labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("terraform_labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+	backupPlanProp, err := expandGKEBackupRestorePlanBackupPlan(d.Get("backup_plan"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("backup_plan"); !isEmptyValue(reflect.ValueOf(backupPlanProp)) && (ok || !reflect.DeepEqual(v, backupPlanProp)) {
+		obj["backupPlan"] = backupPlanProp
+	}
+	clusterProp, err := expandGKEBackupRestorePlanCluster(d.Get("cluster"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("cluster"); !isEmptyValue(reflect.ValueOf(clusterProp)) && (ok || !reflect.DeepEqual(v, clusterProp)) {
+		obj["cluster"] = clusterProp
+	}
+	restoreConfigProp, err := expandGKEBackupRestorePlanRestoreConfig(d.Get("restore_config"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("restore_config"); !isEmptyValue(reflect.ValueOf(restoreConfigProp)) && (ok || !reflect.DeepEqual(v, restoreConfigProp)) {
+		obj["restoreConfig"] = restoreConfigProp
+	}
+
+	if err := gkeBackupValidateCluster(config, d.Get("cluster").(string)); err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "{{GKEBackupBasePath}}projects/{{project}}/locations/{{location}}/restorePlans?restorePlanId={{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new RestorePlan: %#v", obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating RestorePlan: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/restorePlans/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	waitErr := gkeBackupOperationWaitTime(
+		config, res, project, "Creating RestorePlan",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create RestorePlan: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating RestorePlan %q: %#v", d.Id(), res)
+
+	return resourceGKEBackupRestorePlanRead(d, meta)
+}
+
+func resourceGKEBackupRestorePlanRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{GKEBackupBasePath}}projects/{{project}}/locations/{{location}}/restorePlans/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("GKEBackupRestorePlan %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading RestorePlan: %s", err)
+	}
+
+	if err := d.Set("description", flattenGKEBackupRestorePlanDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading RestorePlan: %s", err)
+	}
+	if err := setLabelsFields(d, config, res["labels"]); err != nil {
+		return fmt.Errorf("Error reading RestorePlan: %s", err)
+	}
+	if err := d.Set("backup_plan", flattenGKEBackupRestorePlanBackupPlan(res["backupPlan"], d)); err != nil {
+		return fmt.Errorf("Error reading RestorePlan: %s", err)
+	}
+	if err := d.Set("cluster", flattenGKEBackupRestorePlanCluster(res["cluster"], d)); err != nil {
+		return fmt.Errorf("Error reading RestorePlan: %s", err)
+	}
+	if err := d.Set("restore_config", flattenGKEBackupRestorePlanRestoreConfig(res["restoreConfig"], d)); err != nil {
+		return fmt.Errorf("Error reading RestorePlan: %s", err)
+	}
+	if err := d.Set("uid", flattenGKEBackupRestorePlanUid(res["uid"], d)); err != nil {
+		return fmt.Errorf("Error reading RestorePlan: %s", err)
+	}
+	if err := d.Set("etag", flattenGKEBackupRestorePlanEtag(res["etag"], d)); err != nil {
+		return fmt.Errorf("Error reading RestorePlan: %s", err)
+	}
+	if err := d.Set("state", flattenGKEBackupRestorePlanState(res["state"], d)); err != nil {
+		return fmt.Errorf("Error reading RestorePlan: %s", err)
+	}
+
+	return nil
+}
+
+func resourceGKEBackupRestorePlanUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandGKEBackupRestorePlanDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	labelsProp, err := expandGKEBackupRestorePlanLabels(d.Get("terraform_labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("terraform_labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+	restoreConfigProp, err := expandGKEBackupRestorePlanRestoreConfig(d.Get("restore_config"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("restore_config"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, restoreConfigProp)) {
+		obj["restoreConfig"] = restoreConfigProp
+	}
+
+	url, err := replaceVars(d, config, "{{GKEBackupBasePath}}projects/{{project}}/locations/{{location}}/restorePlans/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating RestorePlan %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("description") {
+		updateMask = append(updateMask, "description")
+	}
+
+	if d.HasChange("labels") || d.HasChange("terraform_labels") {
+		updateMask = append(updateMask, "labels")
+	}
+
+	if d.HasChange("restore_config") {
+		updateMask = append(updateMask, "restoreConfig")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating RestorePlan %q: %s", d.Id(), err)
+	}
+
+	err = gkeBackupOperationWaitTime(
+		config, res, project, "Updating RestorePlan",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceGKEBackupRestorePlanRead(d, meta)
+}
+
+func resourceGKEBackupRestorePlanDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{GKEBackupBasePath}}projects/{{project}}/locations/{{location}}/restorePlans/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting RestorePlan %q", d.Id())
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "RestorePlan")
+	}
+
+	err = gkeBackupOperationWaitTime(
+		config, res, project, "Deleting RestorePlan",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting RestorePlan %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceGKEBackupRestorePlanImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/restorePlans/(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/restorePlans/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenGKEBackupRestorePlanDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEBackupRestorePlanBackupPlan(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEBackupRestorePlanCluster(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEBackupRestorePlanRestoreConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["all_namespaces"] =
+		flattenGKEBackupRestorePlanRestoreConfigAllNamespaces(original["allNamespaces"], d)
+	transformed["excluded_namespaces"] =
+		flattenGKEBackupRestorePlanRestoreConfigExcludedNamespaces(original["excludedNamespaces"], d)
+	transformed["selected_namespaces"] =
+		flattenGKEBackupRestorePlanRestoreConfigSelectedNamespaces(original["selectedNamespaces"], d)
+	transformed["no_namespaces"] =
+		flattenGKEBackupRestorePlanRestoreConfigNoNamespaces(original["noNamespaces"], d)
+	transformed["namespaced_resource_restore_mode"] =
+		flattenGKEBackupRestorePlanRestoreConfigNamespacedResourceRestoreMode(original["namespacedResourceRestoreMode"], d)
+	transformed["volume_data_restore_policy"] =
+		flattenGKEBackupRestorePlanRestoreConfigVolumeDataRestorePolicy(original["volumeDataRestorePolicy"], d)
+	transformed["cluster_resource_conflict_policy"] =
+		flattenGKEBackupRestorePlanRestoreConfigClusterResourceConflictPolicy(original["clusterResourceConflictPolicy"], d)
+	transformed["cluster_resource_restore_scope"] =
+		flattenGKEBackupRestorePlanRestoreConfigClusterResourceRestoreScope(original["clusterResourceRestoreScope"], d)
+	return []interface{}{transformed}
+}
+func flattenGKEBackupRestorePlanRestoreConfigAllNamespaces(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEBackupRestorePlanRestoreConfigExcludedNamespaces(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["namespaces"] =
+		flattenGKEBackupRestorePlanRestoreConfigExcludedNamespacesNamespaces(original["namespaces"], d)
+	return []interface{}{transformed}
+}
+func flattenGKEBackupRestorePlanRestoreConfigExcludedNamespacesNamespaces(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEBackupRestorePlanRestoreConfigSelectedNamespaces(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["namespaces"] =
+		flattenGKEBackupRestorePlanRestoreConfigSelectedNamespacesNamespaces(original["namespaces"], d)
+	return []interface{}{transformed}
+}
+func flattenGKEBackupRestorePlanRestoreConfigSelectedNamespacesNamespaces(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEBackupRestorePlanRestoreConfigNoNamespaces(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEBackupRestorePlanRestoreConfigNamespacedResourceRestoreMode(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEBackupRestorePlanRestoreConfigVolumeDataRestorePolicy(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEBackupRestorePlanRestoreConfigClusterResourceConflictPolicy(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEBackupRestorePlanRestoreConfigClusterResourceRestoreScope(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["all_group_kinds"] =
+		flattenGKEBackupRestorePlanRestoreConfigClusterResourceRestoreScopeAllGroupKinds(original["allGroupKinds"], d)
+	transformed["selected_group_kinds"] =
+		flattenGKEBackupRestorePlanRestoreConfigClusterResourceRestoreScopeSelectedGroupKinds(original["selectedGroupKinds"], d)
+	return []interface{}{transformed}
+}
+func flattenGKEBackupRestorePlanRestoreConfigClusterResourceRestoreScopeAllGroupKinds(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEBackupRestorePlanRestoreConfigClusterResourceRestoreScopeSelectedGroupKinds(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"resource_group": flattenGKEBackupRestorePlanRestoreConfigClusterResourceRestoreScopeSelectedGroupKindsResourceGroup(original["resourceGroup"], d),
+			"resource_kind":  flattenGKEBackupRestorePlanRestoreConfigClusterResourceRestoreScopeSelectedGroupKindsResourceKind(original["resourceKind"], d),
+		})
+	}
+	return transformed
+}
+func flattenGKEBackupRestorePlanRestoreConfigClusterResourceRestoreScopeSelectedGroupKindsResourceGroup(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEBackupRestorePlanRestoreConfigClusterResourceRestoreScopeSelectedGroupKindsResourceKind(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEBackupRestorePlanUid(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEBackupRestorePlanEtag(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEBackupRestorePlanState(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandGKEBackupRestorePlanDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandGKEBackupRestorePlanLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandGKEBackupRestorePlanBackupPlan(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandGKEBackupRestorePlanCluster(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandGKEBackupRestorePlanRestoreConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedAllNamespaces, err := expandGKEBackupRestorePlanRestoreConfigAllNamespaces(original["all_namespaces"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedAllNamespaces); val.IsValid() && !isEmptyValue(val) {
+		transformed["allNamespaces"] = transformedAllNamespaces
+	}
+
+	transformedExcludedNamespaces, err := expandGKEBackupRestorePlanRestoreConfigExcludedNamespaces(original["excluded_namespaces"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedExcludedNamespaces); val.IsValid() && !isEmptyValue(val) {
+		transformed["excludedNamespaces"] = transformedExcludedNamespaces
+	}
+
+	transformedSelectedNamespaces, err := expandGKEBackupRestorePlanRestoreConfigSelectedNamespaces(original["selected_namespaces"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSelectedNamespaces); val.IsValid() && !isEmptyValue(val) {
+		transformed["selectedNamespaces"] = transformedSelectedNamespaces
+	}
+
+	transformedNoNamespaces, err := expandGKEBackupRestorePlanRestoreConfigNoNamespaces(original["no_namespaces"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedNoNamespaces); val.IsValid() && !isEmptyValue(val) {
+		transformed["noNamespaces"] = transformedNoNamespaces
+	}
+
+	transformedNamespacedResourceRestoreMode, err := expandGKEBackupRestorePlanRestoreConfigNamespacedResourceRestoreMode(original["namespaced_resource_restore_mode"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedNamespacedResourceRestoreMode); val.IsValid() && !isEmptyValue(val) {
+		transformed["namespacedResourceRestoreMode"] = transformedNamespacedResourceRestoreMode
+	}
+
+	transformedVolumeDataRestorePolicy, err := expandGKEBackupRestorePlanRestoreConfigVolumeDataRestorePolicy(original["volume_data_restore_policy"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedVolumeDataRestorePolicy); val.IsValid() && !isEmptyValue(val) {
+		transformed["volumeDataRestorePolicy"] = transformedVolumeDataRestorePolicy
+	}
+
+	transformedClusterResourceConflictPolicy, err := expandGKEBackupRestorePlanRestoreConfigClusterResourceConflictPolicy(original["cluster_resource_conflict_policy"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedClusterResourceConflictPolicy); val.IsValid() && !isEmptyValue(val) {
+		transformed["clusterResourceConflictPolicy"] = transformedClusterResourceConflictPolicy
+	}
+
+	transformedClusterResourceRestoreScope, err := expandGKEBackupRestorePlanRestoreConfigClusterResourceRestoreScope(original["cluster_resource_restore_scope"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedClusterResourceRestoreScope); val.IsValid() && !isEmptyValue(val) {
+		transformed["clusterResourceRestoreScope"] = transformedClusterResourceRestoreScope
+	}
+
+	return transformed, nil
+}
+
+func expandGKEBackupRestorePlanRestoreConfigAllNamespaces(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandGKEBackupRestorePlanRestoreConfigExcludedNamespaces(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedNamespaces, err := expandGKEBackupRestorePlanRestoreConfigExcludedNamespacesNamespaces(original["namespaces"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedNamespaces); val.IsValid() && !isEmptyValue(val) {
+		transformed["namespaces"] = transformedNamespaces
+	}
+
+	return transformed, nil
+}
+
+func expandGKEBackupRestorePlanRestoreConfigExcludedNamespacesNamespaces(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandGKEBackupRestorePlanRestoreConfigSelectedNamespaces(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedNamespaces, err := expandGKEBackupRestorePlanRestoreConfigSelectedNamespacesNamespaces(original["namespaces"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedNamespaces); val.IsValid() && !isEmptyValue(val) {
+		transformed["namespaces"] = transformedNamespaces
+	}
+
+	return transformed, nil
+}
+
+func expandGKEBackupRestorePlanRestoreConfigSelectedNamespacesNamespaces(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandGKEBackupRestorePlanRestoreConfigNoNamespaces(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandGKEBackupRestorePlanRestoreConfigNamespacedResourceRestoreMode(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandGKEBackupRestorePlanRestoreConfigVolumeDataRestorePolicy(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandGKEBackupRestorePlanRestoreConfigClusterResourceConflictPolicy(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandGKEBackupRestorePlanRestoreConfigClusterResourceRestoreScope(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedAllGroupKinds, err := expandGKEBackupRestorePlanRestoreConfigClusterResourceRestoreScopeAllGroupKinds(original["all_group_kinds"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedAllGroupKinds); val.IsValid() && !isEmptyValue(val) {
+		transformed["allGroupKinds"] = transformedAllGroupKinds
+	}
+
+	transformedSelectedGroupKinds, err := expandGKEBackupRestorePlanRestoreConfigClusterResourceRestoreScopeSelectedGroupKinds(original["selected_group_kinds"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSelectedGroupKinds); val.IsValid() && !isEmptyValue(val) {
+		transformed["selectedGroupKinds"] = transformedSelectedGroupKinds
+	}
+
+	return transformed, nil
+}
+
+func expandGKEBackupRestorePlanRestoreConfigClusterResourceRestoreScopeAllGroupKinds(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandGKEBackupRestorePlanRestoreConfigClusterResourceRestoreScopeSelectedGroupKinds(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedResourceGroup, err := expandGKEBackupRestorePlanRestoreConfigClusterResourceRestoreScopeSelectedGroupKindsResourceGroup(original["resource_group"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedResourceGroup); val.IsValid() && !isEmptyValue(val) {
+			transformed["resourceGroup"] = transformedResourceGroup
+		}
+
+		transformedResourceKind, err := expandGKEBackupRestorePlanRestoreConfigClusterResourceRestoreScopeSelectedGroupKindsResourceKind(original["resource_kind"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedResourceKind); val.IsValid() && !isEmptyValue(val) {
+			transformed["resourceKind"] = transformedResourceKind
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandGKEBackupRestorePlanRestoreConfigClusterResourceRestoreScopeSelectedGroupKindsResourceGroup(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandGKEBackupRestorePlanRestoreConfigClusterResourceRestoreScopeSelectedGroupKindsResourceKind(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,120 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccGKEBackupRestorePlan_gKEBackupRestorePlanAllNamespacesExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGKEBackupRestorePlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGKEBackupRestorePlan_gKEBackupRestorePlanAllNamespacesExample(context),
+			},
+			{
+				ResourceName:      "google_gke_backup_restore_plan.all_ns",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGKEBackupRestorePlan_gKEBackupRestorePlanAllNamespacesExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_container_cluster" "primary" {
+  name               = "tf-test-cluster-%{random_suffix}"
+  location           = "us-central1"
+  initial_node_count = 1
+
+  workload_identity_config {
+    identity_namespace = "${data.google_project.project.project_id}.svc.id.goog"
+  }
+}
+
+data "google_project" "project" {}
+
+resource "google_gke_backup_backup_plan" "basic" {
+  name     = "tf-test-restore-all-ns-%{random_suffix}"
+  cluster  = "projects/${google_container_cluster.primary.project}/locations/${google_container_cluster.primary.location}/clusters/${google_container_cluster.primary.name}"
+  location = "us-central1"
+
+  backup_config {
+    include_volume_data = true
+    include_secrets     = true
+    all_namespaces      = true
+  }
+}
+
+resource "google_gke_backup_restore_plan" "all_ns" {
+  name        = "tf-test-restore-all-ns-%{random_suffix}"
+  location    = "us-central1"
+  backup_plan = "${google_gke_backup_backup_plan.basic.id}"
+  cluster     = "projects/${google_container_cluster.primary.project}/locations/${google_container_cluster.primary.location}/clusters/${google_container_cluster.primary.name}"
+
+  restore_config {
+    all_namespaces                   = true
+    namespaced_resource_restore_mode = "FAIL_ON_CONFLICT"
+    volume_data_restore_policy       = "RESTORE_VOLUME_DATA_FROM_BACKUP"
+    cluster_resource_conflict_policy = "USE_EXISTING_VERSION"
+
+    cluster_resource_restore_scope {
+      all_group_kinds = true
+    }
+  }
+}
+`, context)
+}
+
+func testAccCheckGKEBackupRestorePlanDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_gke_backup_restore_plan" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(config, rs, "{{GKEBackupBasePath}}projects/{{project}}/locations/{{location}}/restorePlans/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("GKEBackupRestorePlan still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,593 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// The features a block of google_gke_hub_feature_membership configures.
+var gkeHubFeatureMembershipFeatures = map[string]string{
+	"configmanagement": "configmanagement",
+	"mesh":             "servicemesh",
+}
+
+// The fields of each block, mapped to their names in the API. Nested blocks
+// are expanded and flattened separately.
+var (
+	gkeHubConfigSyncFields = map[string]string{
+		"source_format": "sourceFormat",
+		"prevent_drift": "preventDrift",
+	}
+	gkeHubConfigSyncGitFields = map[string]string{
+		"sync_repo":                 "syncRepo",
+		"sync_branch":               "syncBranch",
+		"policy_dir":                "policyDir",
+		"sync_wait_secs":            "syncWaitSecs",
+		"sync_rev":                  "syncRev",
+		"secret_type":               "secretType",
+		"https_proxy":               "httpsProxy",
+		"gcp_service_account_email": "gcpServiceAccountEmail",
+	}
+	gkeHubConfigSyncOciFields = map[string]string{
+		"sync_repo":                 "syncRepo",
+		"policy_dir":                "policyDir",
+		"sync_wait_secs":            "syncWaitSecs",
+		"secret_type":               "secretType",
+		"gcp_service_account_email": "gcpServiceAccountEmail",
+	}
+	gkeHubPolicyControllerFields = map[string]string{
+		"enabled":                    "enabled",
+		"exemptable_namespaces":      "exemptableNamespaces",
+		"referential_rules_enabled":  "referentialRulesEnabled",
+		"log_denies_enabled":         "logDeniesEnabled",
+		"mutation_enabled":           "mutationEnabled",
+		"template_library_installed": "templateLibraryInstalled",
+		"audit_interval_seconds":     "auditIntervalSeconds",
+	}
+	gkeHubHierarchyControllerFields = map[string]string{
+		"enabled":                            "enabled",
+		"enable_pod_tree_labels":             "enablePodTreeLabels",
+		"enable_hierarchical_resource_quota": "enableHierarchicalResourceQuota",
+	}
+	gkeHubMeshFields = map[string]string{
+		"management": "management",
+	}
+)
+
+func resourceGKEHubFeatureMembership() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGKEHubFeatureMembershipCreate,
+		Read:   resourceGKEHubFeatureMembershipRead,
+		Update: resourceGKEHubFeatureMembershipUpdate,
+		Delete: resourceGKEHubFeatureMembershipDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceGKEHubFeatureMembershipImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: gkeHubFeatureMembershipFeatureCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The location of the feature, usually global.`,
+			},
+			"feature": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"configmanagement", "servicemesh"}, false),
+				Description:  `The name of the feature to configure for the membership, configmanagement or servicemesh.`,
+			},
+			"membership": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegexp(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`),
+				Description:  `The id of the global membership to configure the feature for.`,
+			},
+			"configmanagement": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"mesh"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"config_sync": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"source_format": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"hierarchy", "unstructured", ""}, false),
+									},
+									"prevent_drift": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+									},
+									"git": {
+										Type:          schema.TypeList,
+										Optional:      true,
+										MaxItems:      1,
+										ConflictsWith: []string{"configmanagement.0.config_sync.0.oci"},
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"sync_repo": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"sync_branch": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"policy_dir": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"sync_wait_secs": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"sync_rev": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"secret_type": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"https_proxy": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"gcp_service_account_email": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"oci": {
+										Type:          schema.TypeList,
+										Optional:      true,
+										MaxItems:      1,
+										ConflictsWith: []string{"configmanagement.0.config_sync.0.git"},
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"sync_repo": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"policy_dir": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"sync_wait_secs": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"secret_type": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"gcp_service_account_email": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"policy_controller": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"exemptable_namespaces": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"referential_rules_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"log_denies_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"mutation_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"template_library_installed": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+									},
+									"audit_interval_seconds": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"hierarchy_controller": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"enable_pod_tree_labels": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"enable_hierarchical_resource_quota": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"mesh": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"configmanagement"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"management": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"MANAGEMENT_AUTOMATIC", "MANAGEMENT_MANUAL", ""}, false),
+						},
+					},
+				},
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func gkeHubFeatureMembershipFeatureCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	// separate func to allow unit testing
+	return gkeHubFeatureMembershipFeatureCustomizeDiffFunc(diff)
+}
+
+// Each block configures a single feature, so it can only be set for that one.
+func gkeHubFeatureMembershipFeatureCustomizeDiffFunc(diff TerraformResourceDiff) error {
+	_, feature := diff.GetChange("feature")
+	for block, f := range gkeHubFeatureMembershipFeatures {
+		_, v := diff.GetChange(block)
+		if l, ok := v.([]interface{}); ok && len(l) > 0 && feature != f {
+			return fmt.Errorf("%s can only be set for the %s feature, got feature %q", block, f, feature)
+		}
+	}
+	return nil
+}
+
+func resourceGKEHubFeatureMembershipCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	// Configuration for a feature that isn't enabled, or a membership that
+	// doesn't exist, would otherwise only surface as a failed operation.
+	featureName := gkeHubFeatureName(project, d.Get("location").(string), d.Get("feature").(string))
+	if _, err := sendRequest(config, "GET", project, config.GKEHubBasePath+featureName, nil); err != nil {
+		if isGoogleApiErrorWithCode(err, 404) {
+			return fmt.Errorf("Feature %q must be enabled in project %q before it can be configured for a membership", d.Get("feature").(string), project)
+		}
+		return fmt.Errorf("Error reading feature %q: %s", featureName, err)
+	}
+	membershipName := gkeHubMembershipName(project, d.Get("membership").(string))
+	if _, err := sendRequest(config, "GET", project, config.GKEHubBasePath+membershipName, nil); err != nil {
+		if isGoogleApiErrorWithCode(err, 404) {
+			return fmt.Errorf("Membership %q doesn't exist in project %q", d.Get("membership").(string), project)
+		}
+		return fmt.Errorf("Error reading membership %q: %s", membershipName, err)
+	}
+
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/features/{{feature}}/membershipId/{{membership}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Creating new FeatureMembership %q", d.Id())
+	if err := gkeHubPatchMembershipSpec(d, config, project, expandGKEHubFeatureMembershipSpec(d), "Creating FeatureMembership", d.Timeout(schema.TimeoutCreate)); err != nil {
+		d.SetId("")
+		return fmt.Errorf("Error creating FeatureMembership: %s", err)
+	}
+
+	log.Printf("[DEBUG] Finished creating FeatureMembership %q", d.Id())
+
+	return resourceGKEHubFeatureMembershipRead(d, meta)
+}
+
+func resourceGKEHubFeatureMembershipRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	featureName := gkeHubFeatureName(project, d.Get("location").(string), d.Get("feature").(string))
+	res, err := sendRequest(config, "GET", project, config.GKEHubBasePath+featureName, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("GKEHubFeatureMembership %q", d.Id()))
+	}
+
+	specs, _ := res["membershipSpecs"].(map[string]interface{})
+	spec, _ := specs[gkeHubMembershipSpecKey(specs, project, d.Get("membership").(string))].(map[string]interface{})
+	if len(spec) == 0 {
+		log.Printf("[DEBUG] Removing GKEHubFeatureMembership %q because it no longer exists.", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading FeatureMembership: %s", err)
+	}
+	if err := d.Set("configmanagement", flattenGKEHubFeatureMembershipConfigmanagement(spec["configmanagement"])); err != nil {
+		return fmt.Errorf("Error reading FeatureMembership: %s", err)
+	}
+	if err := d.Set("mesh", flattenGKEHubFeatureMembershipBlock(spec["mesh"], gkeHubMeshFields)); err != nil {
+		return fmt.Errorf("Error reading FeatureMembership: %s", err)
+	}
+
+	return nil
+}
+
+func resourceGKEHubFeatureMembershipUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating FeatureMembership %q", d.Id())
+	if err := gkeHubPatchMembershipSpec(d, config, project, expandGKEHubFeatureMembershipSpec(d), "Updating FeatureMembership", d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("Error updating FeatureMembership %q: %s", d.Id(), err)
+	}
+
+	return resourceGKEHubFeatureMembershipRead(d, meta)
+}
+
+func resourceGKEHubFeatureMembershipDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	// An empty spec removes the membership's configuration from the feature.
+	log.Printf("[DEBUG] Deleting FeatureMembership %q", d.Id())
+	if err := gkeHubPatchMembershipSpec(d, config, project, map[string]interface{}{}, "Deleting FeatureMembership", d.Timeout(schema.TimeoutDelete)); err != nil {
+		return handleNotFoundError(err, d, "FeatureMembership")
+	}
+
+	log.Printf("[DEBUG] Finished deleting FeatureMembership %q", d.Id())
+	return nil
+}
+
+func resourceGKEHubFeatureMembershipImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/features/(?P<feature>[^/]+)/membershipId/(?P<membership>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<feature>[^/]+)/(?P<membership>[^/]+)",
+		"(?P<location>[^/]+)/(?P<feature>[^/]+)/(?P<membership>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/features/{{feature}}/membershipId/{{membership}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// gkeHubPatchMembershipSpec sets the spec of the membership on the feature.
+// Specs of other memberships are left as they are, as the API merges the
+// membership specs of a patch into those of the feature.
+func gkeHubPatchMembershipSpec(d *schema.ResourceData, config *Config, project string, spec map[string]interface{}, activity string, timeout time.Duration) error {
+	featureName := gkeHubFeatureName(project, d.Get("location").(string), d.Get("feature").(string))
+	mutexKV.Lock(featureName)
+	defer mutexKV.Unlock(featureName)
+
+	obj := map[string]interface{}{
+		"membershipSpecs": map[string]interface{}{
+			gkeHubMembershipName(project, d.Get("membership").(string)): spec,
+		},
+	}
+
+	url, err := addQueryParams(config.GKEHubBasePath+featureName, map[string]string{"updateMask": "membershipSpecs"})
+	if err != nil {
+		return err
+	}
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, timeout)
+	if err != nil {
+		return err
+	}
+
+	return gkeHubOperationWaitTime(config, res, activity, int(timeout.Minutes()))
+}
+
+func gkeHubFeatureName(project, location, feature string) string {
+	return fmt.Sprintf("projects/%s/locations/%s/features/%s", project, location, feature)
+}
+
+func gkeHubMembershipName(project, membership string) string {
+	return fmt.Sprintf("projects/%s/locations/global/memberships/%s", project, membership)
+}
+
+// gkeHubMembershipSpecKey returns the key of a membership in the membership
+// specs of a feature. The API keys memberships by project number, so the key
+// is matched on the membership's location and id.
+func gkeHubMembershipSpecKey(specs map[string]interface{}, project, membership string) string {
+	suffix := fmt.Sprintf("/locations/global/memberships/%s", membership)
+	for k := range specs {
+		if strings.HasSuffix(k, suffix) {
+			return k
+		}
+	}
+	return gkeHubMembershipName(project, membership)
+}
+
+func expandGKEHubFeatureMembershipSpec(d *schema.ResourceData) map[string]interface{} {
+	spec := make(map[string]interface{})
+	if v := expandGKEHubFeatureMembershipConfigmanagement(d.Get("configmanagement")); v != nil {
+		spec["configmanagement"] = v
+	}
+	if v := expandGKEHubFeatureMembershipBlock(d.Get("mesh"), gkeHubMeshFields); v != nil {
+		spec["mesh"] = v
+	}
+	return spec
+}
+
+func expandGKEHubFeatureMembershipConfigmanagement(v interface{}) map[string]interface{} {
+	l, ok := v.([]interface{})
+	if !ok || len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	raw := l[0].(map[string]interface{})
+
+	transformed := make(map[string]interface{})
+	if version, ok := raw["version"].(string); ok && version != "" {
+		transformed["version"] = version
+	}
+	if configSync := expandGKEHubFeatureMembershipBlock(raw["config_sync"], gkeHubConfigSyncFields); configSync != nil {
+		rawConfigSync := raw["config_sync"].([]interface{})[0].(map[string]interface{})
+		if git := expandGKEHubFeatureMembershipBlock(rawConfigSync["git"], gkeHubConfigSyncGitFields); git != nil {
+			configSync["git"] = git
+		}
+		if oci := expandGKEHubFeatureMembershipBlock(rawConfigSync["oci"], gkeHubConfigSyncOciFields); oci != nil {
+			configSync["oci"] = oci
+		}
+		transformed["configSync"] = configSync
+	}
+	if policyController := expandGKEHubFeatureMembershipBlock(raw["policy_controller"], gkeHubPolicyControllerFields); policyController != nil {
+		transformed["policyController"] = policyController
+	}
+	if hierarchyController := expandGKEHubFeatureMembershipBlock(raw["hierarchy_controller"], gkeHubHierarchyControllerFields); hierarchyController != nil {
+		transformed["hierarchyController"] = hierarchyController
+	}
+	return transformed
+}
+
+// expandGKEHubFeatureMembershipBlock expands the scalar and list fields of a
+// block into their API names, leaving out unset strings and lists.
+func expandGKEHubFeatureMembershipBlock(v interface{}, fields map[string]string) map[string]interface{} {
+	l, ok := v.([]interface{})
+	if !ok || len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	raw := l[0].(map[string]interface{})
+
+	transformed := make(map[string]interface{})
+	for tf, api := range fields {
+		switch v := raw[tf].(type) {
+		case string:
+			if v != "" {
+				transformed[api] = v
+			}
+		case bool:
+			transformed[api] = v
+		case []interface{}:
+			if len(v) > 0 {
+				transformed[api] = v
+			}
+		}
+	}
+	return transformed
+}
+
+func flattenGKEHubFeatureMembershipConfigmanagement(v interface{}) []map[string]interface{} {
+	raw, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	configSync := flattenGKEHubFeatureMembershipBlock(raw["configSync"], gkeHubConfigSyncFields)
+	if len(configSync) > 0 {
+		rawConfigSync := raw["configSync"].(map[string]interface{})
+		configSync[0]["git"] = flattenGKEHubFeatureMembershipBlock(rawConfigSync["git"], gkeHubConfigSyncGitFields)
+		configSync[0]["oci"] = flattenGKEHubFeatureMembershipBlock(rawConfigSync["oci"], gkeHubConfigSyncOciFields)
+	}
+	return []map[string]interface{}{
+		{
+			"version":              raw["version"],
+			"config_sync":          configSync,
+			"policy_controller":    flattenGKEHubFeatureMembershipBlock(raw["policyController"], gkeHubPolicyControllerFields),
+			"hierarchy_controller": flattenGKEHubFeatureMembershipBlock(raw["hierarchyController"], gkeHubHierarchyControllerFields),
+		},
+	}
+}
+
+// flattenGKEHubFeatureMembershipBlock flattens the scalar and list fields of
+// a block from their API names.
+func flattenGKEHubFeatureMembershipBlock(v interface{}, fields map[string]string) []map[string]interface{} {
+	raw, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	flattened := make(map[string]interface{})
+	for tf, api := range fields {
+		flattened[tf] = raw[api]
+	}
+	return []map[string]interface{}{flattened}
+}
@@ -0,0 +1,207 @@
+package google
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestGKEHubFeatureMembershipFeatureCustomizeDiff(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		After       map[string]interface{}
+		ExpectError bool
+	}{
+		"configmanagement for configmanagement": {
+			After: map[string]interface{}{
+				"feature":          "configmanagement",
+				"configmanagement": []interface{}{map[string]interface{}{"version": "1.12.0"}},
+			},
+		},
+		"mesh for servicemesh": {
+			After: map[string]interface{}{
+				"feature": "servicemesh",
+				"mesh":    []interface{}{map[string]interface{}{"management": "MANAGEMENT_AUTOMATIC"}},
+			},
+		},
+		"no blocks": {
+			After: map[string]interface{}{
+				"feature": "servicemesh",
+			},
+		},
+		"configmanagement for servicemesh": {
+			After: map[string]interface{}{
+				"feature":          "servicemesh",
+				"configmanagement": []interface{}{map[string]interface{}{"version": "1.12.0"}},
+			},
+			ExpectError: true,
+		},
+		"mesh for configmanagement": {
+			After: map[string]interface{}{
+				"feature": "configmanagement",
+				"mesh":    []interface{}{map[string]interface{}{"management": "MANAGEMENT_AUTOMATIC"}},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &ResourceDiffMock{
+			After: tc.After,
+		}
+		err := gkeHubFeatureMembershipFeatureCustomizeDiffFunc(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
+
+func TestGKEHubFeatureMembershipConfigmanagement_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	api := map[string]interface{}{
+		"version": "1.12.0",
+		"configSync": map[string]interface{}{
+			"sourceFormat": "hierarchy",
+			"preventDrift": true,
+			"git": map[string]interface{}{
+				"syncRepo":   "https://github.com/GoogleCloudPlatform/magic-modules",
+				"syncBranch": "main",
+				"policyDir":  "config",
+				"secretType": "none",
+			},
+		},
+		"policyController": map[string]interface{}{
+			"enabled":              true,
+			"exemptableNamespaces": []interface{}{"kube-system"},
+		},
+	}
+
+	flattened := flattenGKEHubFeatureMembershipConfigmanagement(api)
+	raw := []interface{}{map[string]interface{}(flattened[0])}
+	// Terraform hands blocks to expanders as lists of maps.
+	for _, block := range []string{"config_sync", "policy_controller", "hierarchy_controller"} {
+		raw[0].(map[string]interface{})[block] = gkeHubTestBlockList(raw[0].(map[string]interface{})[block])
+	}
+	configSync := raw[0].(map[string]interface{})["config_sync"].([]interface{})[0].(map[string]interface{})
+	for _, block := range []string{"git", "oci"} {
+		configSync[block] = gkeHubTestBlockList(configSync[block])
+	}
+
+	expanded := expandGKEHubFeatureMembershipConfigmanagement(raw)
+	if !reflect.DeepEqual(expanded, api) {
+		t.Errorf("expected %#v to round trip, got %#v", api, expanded)
+	}
+}
+
+func gkeHubTestBlockList(v interface{}) []interface{} {
+	l, _ := v.([]map[string]interface{})
+	out := make([]interface{}, 0, len(l))
+	for _, m := range l {
+		out = append(out, m)
+	}
+	return out
+}
+
+// Enabling a feature is project-wide, so the configmanagement feature must
+// already be enabled in the test project.
+func TestAccGKEHubFeatureMembership_configSyncGit(t *testing.T) {
+	t.Parallel()
+
+	project := getTestProjectFromEnv()
+	clusterName := fmt.Sprintf("tf-test-cluster-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGKEHubFeatureMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGKEHubFeatureMembership_configSyncGit(project, clusterName, "main"),
+			},
+			{
+				ResourceName:      "google_gke_hub_feature_membership.membership",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGKEHubFeatureMembership_configSyncGit(project, clusterName, "master"),
+			},
+			{
+				ResourceName:      "google_gke_hub_feature_membership.membership",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGKEHubFeatureMembership_configSyncGit(project, clusterName, branch string) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "primary" {
+  name               = "%s"
+  location           = "us-central1-a"
+  initial_node_count = 1
+
+  fleet {
+    project = "%s"
+  }
+}
+
+resource "google_gke_hub_feature_membership" "membership" {
+  location   = "global"
+  feature    = "configmanagement"
+  membership = google_container_cluster.primary.name
+
+  configmanagement {
+    config_sync {
+      source_format = "hierarchy"
+
+      git {
+        sync_repo   = "https://github.com/GoogleCloudPlatform/magic-modules"
+        sync_branch = "%s"
+        policy_dir  = "mmv1/third_party/terraform/utils"
+        secret_type = "none"
+      }
+    }
+  }
+}
+`, clusterName, project, branch)
+}
+
+func testAccCheckGKEHubFeatureMembershipDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_gke_hub_feature_membership" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		attrs := rs.Primary.Attributes
+		url := config.GKEHubBasePath + gkeHubFeatureName(attrs["project"], attrs["location"], attrs["feature"])
+		res, err := sendRequest(config, "GET", "", url, nil)
+		if err != nil {
+			// The feature is gone, and its membership specs with it.
+			continue
+		}
+
+		specs, _ := res["membershipSpecs"].(map[string]interface{})
+		if spec, _ := specs[gkeHubMembershipSpecKey(specs, attrs["project"], attrs["membership"])].(map[string]interface{}); len(spec) > 0 {
+			return fmt.Errorf("GKEHubFeatureMembership still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/schema"
 	"google.golang.org/api/cloudbilling/v1"
 	"google.golang.org/api/cloudresourcemanager/v1"
@@ -31,6 +32,14 @@ func resourceGoogleProject() *schema.Resource {
 		},
 		MigrateState: resourceGoogleProjectMigrateState,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: setLabelsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:         schema.TypeString,
@@ -88,6 +97,18 @@ func resourceGoogleProject() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"effective_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"app_engine": {
 				Type:     schema.TypeList,
 				Elem:     appEngineResource(),
@@ -212,11 +233,15 @@ func resourceGoogleProjectCreate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	if _, ok := d.GetOk("labels"); ok {
+	if _, ok := d.GetOk("terraform_labels"); ok {
 		project.Labels = expandLabels(d)
 	}
 
-	op, err := config.clientResourceManager.Projects.Create(project).Do()
+	var op *cloudresourcemanager.Operation
+	err = retryTimeDuration(func() (reqErr error) {
+		op, reqErr = config.clientResourceManager.Projects.Create(project).Do()
+		return reqErr
+	}, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("error creating project %s (%s): %s. "+
 			"If you received a 403 error, make sure you have the"+
@@ -257,12 +282,23 @@ func resourceGoogleProjectCreate(d *schema.ResourceData, meta interface{}) error
 	// people if we don't have to.  The GCP Console is doing the same thing - creating
 	// a network and deleting it in the background.
 	if !d.Get("auto_create_network").(bool) {
-		// The compute API has to be enabled before we can delete a network.
-		if err = enableServiceUsageProjectServices([]string{"compute.googleapis.com"}, project.ProjectId, config, d.Timeout(schema.TimeoutCreate)); err != nil {
+		// The compute API has to be enabled before we can delete a network, which
+		// requires the billing account linked above to have propagated.
+		retryable := isRetryableError
+		if _, ok := d.GetOk("billing_account"); ok {
+			retryable = isProjectBillingPropagationError
+		}
+		err = enableServiceUsageProjectServicesWithRetryPredicate([]string{"compute.googleapis.com"}, project.ProjectId, config, d.Timeout(schema.TimeoutCreate), retryable)
+		if err != nil {
 			return fmt.Errorf("Error enabling the Compute Engine API required to delete the default network: %s", err)
 		}
 
 		if err = forceDeleteComputeNetwork(project.ProjectId, "default", config); err != nil {
+			if isGoogleApiErrorWithCode(err, 404) {
+				// The default network isn't created when an org policy skips it.
+				log.Printf("[DEBUG] Default network not found in project %q, no need to delete it", project.ProjectId)
+				return nil
+			}
 			return fmt.Errorf("Error deleting default network in project %s: %s", project.ProjectId, err)
 		}
 	}
@@ -289,7 +325,9 @@ func resourceGoogleProjectRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("project_id", pid)
 	d.Set("number", strconv.FormatInt(p.ProjectNumber, 10))
 	d.Set("name", p.Name)
-	d.Set("labels", p.Labels)
+	if err := setLabelsFields(d, config, p.Labels); err != nil {
+		return fmt.Errorf("Error setting labels: %s", err)
+	}
 
 	// We get app_engine.#: "" => "<computed>" without this set
 	// Remove when app_engine field is removed from schema completely
@@ -419,7 +457,7 @@ func resourceGoogleProjectUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	// Project Labels have changed
-	if ok := d.HasChange("labels"); ok {
+	if d.HasChange("labels") || d.HasChange("terraform_labels") {
 		p.Labels = expandLabels(d)
 
 		// Do Update on project
@@ -439,7 +477,10 @@ func resourceGoogleProjectDelete(d *schema.ResourceData, meta interface{}) error
 	// Only delete projects if skip_delete isn't set
 	if !d.Get("skip_delete").(bool) {
 		pid := d.Id()
-		_, err := config.clientResourceManager.Projects.Delete(pid).Do()
+		err := retryTimeDuration(func() error {
+			_, reqErr := config.clientResourceManager.Projects.Delete(pid).Do()
+			return reqErr
+		}, d.Timeout(schema.TimeoutDelete))
 		if err != nil {
 			return fmt.Errorf("Error deleting project %q: %s", pid, err)
 		}
@@ -485,7 +526,7 @@ func forceDeleteComputeNetwork(projectId, networkName string, config *Config) er
 			if err != nil {
 				return fmt.Errorf("Error deleting firewall: %s", err)
 			}
-			err = computeSharedOperationWait(config.clientCompute, op, projectId, "Deleting Firewall")
+			err = computeSharedOperationWait(config, op, projectId, "Deleting Firewall")
 			if err != nil {
 				return err
 			}
@@ -529,14 +570,28 @@ func updateProjectBillingAccount(d *schema.ResourceData, config *Config) error {
 		name, strings.TrimPrefix(ba.BillingAccountName, "billingAccounts/"))
 }
 
+// isProjectBillingPropagationError returns whether an error is the one
+// returned when enabling services before a newly linked billing account has
+// propagated, in addition to the errors that are always retried.
+func isProjectBillingPropagationError(err error) bool {
+	if isRetryableError(err) {
+		return true
+	}
+	if gerr, ok := err.(*googleapi.Error); ok && gerr.Code == 400 && strings.Contains(gerr.Message, "Billing must be enabled for activation of service") {
+		log.Printf("[DEBUG] Dismissed an error as retryable while the project's billing account propagates: %s", err)
+		return true
+	}
+	return false
+}
+
 func deleteComputeNetwork(project, network string, config *Config) error {
 	op, err := config.clientCompute.Networks.Delete(
 		project, network).Do()
 	if err != nil {
-		return fmt.Errorf("Error deleting network: %s", err)
+		return errwrap.Wrapf("Error deleting network: {{err}}", err)
 	}
 
-	err = computeOperationWaitTime(config.clientCompute, op, project, "Deleting Network", 10)
+	err = computeOperationWaitTime(config, op, project, "Deleting Network", 10)
 	if err != nil {
 		return err
 	}
@@ -225,6 +225,12 @@ func listCurrentlyEnabledServices(project string, config *Config, timeout time.D
 
 // Enables services. WARNING: Use globalBatchEnableServices for better batching if possible.
 func enableServiceUsageProjectServices(services []string, project string, config *Config, timeout time.Duration) error {
+	return enableServiceUsageProjectServicesWithRetryPredicate(services, project, config, timeout, isRetryableError)
+}
+
+// Enables services, retrying the enable requests on the errors accepted by
+// retryable within the same timeout.
+func enableServiceUsageProjectServicesWithRetryPredicate(services []string, project string, config *Config, timeout time.Duration, retryable func(error) bool) error {
 	// ServiceUsage does not allow more than 20 services to be enabled per
 	// batchEnable API call. See
 	// https://cloud.google.com/service-usage/docs/reference/rest/v1/services/batchEnable
@@ -239,7 +245,7 @@ func enableServiceUsageProjectServices(services []string, project string, config
 			return nil
 		}
 
-		if err := doEnableServicesRequest(nextBatch, project, config, timeout); err != nil {
+		if err := doEnableServicesRequest(nextBatch, project, config, timeout, retryable); err != nil {
 			return err
 		}
 		log.Printf("[DEBUG] Finished enabling next batch of %d project services: %+v", len(nextBatch), nextBatch)
@@ -289,10 +295,10 @@ func waitForServiceUsageEnabledServices(services []string, project string, confi
 	return nil
 }
 
-func doEnableServicesRequest(services []string, project string, config *Config, timeout time.Duration) error {
+func doEnableServicesRequest(services []string, project string, config *Config, timeout time.Duration, retryable func(error) bool) error {
 	var op *serviceusage.Operation
 
-	err := retryTimeDuration(func() error {
+	err := retryTimeDurationWithPredicate(func() error {
 		var rerr error
 		if len(services) == 1 {
 			// BatchEnable returns an error for a single item, so just enable
@@ -307,7 +313,7 @@ func doEnableServicesRequest(services []string, project string, config *Config,
 			op, rerr = config.clientServiceUsage.Services.BatchEnable(name, req).Do()
 		}
 		return handleServiceUsageRetryableError(rerr)
-	}, timeout)
+	}, timeout, retryable)
 	if err != nil {
 		return errwrap.Wrapf("failed to send enable services request: {{err}}", err)
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/googleapi"
 )
 
 var (
@@ -167,6 +168,10 @@ func TestAccProject_deleteDefaultNetwork(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: testAccProject_deleteDefaultNetwork(pid, pname, org, billingId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleProjectExists("google_project.acceptance", pid),
+					testAccCheckGoogleProjectHasNoDefaultNetwork(pid),
+				),
 			},
 		},
 	})
@@ -208,6 +213,21 @@ func testAccCheckGoogleProjectExists(r, pid string) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckGoogleProjectHasNoDefaultNetwork(pid string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		_, err := config.clientCompute.Networks.Get(pid, "default").Do()
+		if err == nil {
+			return fmt.Errorf("Expected the default network of project %q to be deleted", pid)
+		}
+		if !isGoogleApiErrorWithCode(err, 404) {
+			return fmt.Errorf("Error reading the default network of project %q: %s", pid, err)
+		}
+		return nil
+	}
+}
+
 func testAccCheckGoogleProjectHasBillingAccount(r, pid, billingId string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[r]
@@ -340,6 +360,44 @@ resource "google_project" "acceptance" {
 	return r + l
 }
 
+func TestIsProjectBillingPropagationError(t *testing.T) {
+	cases := map[string]struct {
+		err       error
+		retryable bool
+	}{
+		"transient": {
+			err:       &googleapi.Error{Code: 503, Message: "The service is currently unavailable."},
+			retryable: true,
+		},
+		"billing not yet linked": {
+			err:       &googleapi.Error{Code: 400, Message: "Billing must be enabled for activation of service(s) 'compute.googleapis.com' to proceed."},
+			retryable: true,
+		},
+		"billing account not found": {
+			err:       &googleapi.Error{Code: 403, Message: "Billing account for project '1234' is not found."},
+			retryable: false,
+		},
+		"org policy violated": {
+			err:       &googleapi.Error{Code: 412, Message: "Request violates constraint 'constraints/compute.skipDefaultNetworkCreation' of the org policy."},
+			retryable: false,
+		},
+		"permission denied": {
+			err:       &googleapi.Error{Code: 403, Message: "The caller does not have permission"},
+			retryable: false,
+		},
+		"already exists": {
+			err:       &googleapi.Error{Code: 409, Message: "Requested entity already exists"},
+			retryable: false,
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := isProjectBillingPropagationError(tc.err); got != tc.retryable {
+			t.Errorf("%s: expected retryable to be %t, got %t", tn, tc.retryable, got)
+		}
+	}
+}
+
 func testAccProject_deleteDefaultNetwork(pid, name, org, billing string) string {
 	return fmt.Sprintf(`
 resource "google_project" "acceptance" {
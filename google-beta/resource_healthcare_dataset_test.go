@@ -122,7 +122,7 @@ func testAccCheckHealthcareDatasetDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("HealthcareDataset still exists at %s", url)
 		}
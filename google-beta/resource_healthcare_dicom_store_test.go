@@ -164,7 +164,7 @@ func testAccCheckHealthcareDicomStoreDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("HealthcareDicomStore still exists at %s", url)
 		}
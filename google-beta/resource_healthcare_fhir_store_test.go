@@ -171,7 +171,7 @@ func testAccCheckHealthcareFhirStoreDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("HealthcareFhirStore still exists at %s", url)
 		}
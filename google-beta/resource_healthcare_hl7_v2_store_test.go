@@ -169,7 +169,7 @@ func testAccCheckHealthcareHl7V2StoreDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("HealthcareHl7V2Store still exists at %s", url)
 		}
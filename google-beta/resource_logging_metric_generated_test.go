@@ -91,7 +91,7 @@ func testAccCheckLoggingMetricDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("LoggingMetric still exists at %s", url)
 		}
@@ -113,7 +113,7 @@ func testAccCheckMonitoringGroupDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("MonitoringGroup still exists at %s", url)
 		}
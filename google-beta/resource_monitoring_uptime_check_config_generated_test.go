@@ -138,7 +138,7 @@ func testAccCheckMonitoringUptimeCheckConfigDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("MonitoringUptimeCheckConfig still exists at %s", url)
 		}
@@ -0,0 +1,377 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceNetappStoragePool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetappStoragePoolCreate,
+		Read:   resourceNetappStoragePoolRead,
+		Update: resourceNetappStoragePoolUpdate,
+		Delete: resourceNetappStoragePoolDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceNetappStoragePoolImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"capacity_gib": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"network": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"service_level": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"PREMIUM", "EXTREME", "STANDARD", "FLEX"}, false),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"volume_capacity_gib": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"volume_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceNetappStoragePoolCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	serviceLevelProp, err := expandNetappStoragePoolServiceLevel(d.Get("service_level"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("service_level"); !isEmptyValue(reflect.ValueOf(serviceLevelProp)) && (ok || !reflect.DeepEqual(v, serviceLevelProp)) {
+		obj["serviceLevel"] = serviceLevelProp
+	}
+	capacityGibProp, err := expandNetappStoragePoolCapacityGib(d.Get("capacity_gib"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("capacity_gib"); !isEmptyValue(reflect.ValueOf(capacityGibProp)) && (ok || !reflect.DeepEqual(v, capacityGibProp)) {
+		obj["capacityGib"] = capacityGibProp
+	}
+	networkProp, err := expandNetappStoragePoolNetwork(d.Get("network"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("network"); !isEmptyValue(reflect.ValueOf(networkProp)) && (ok || !reflect.DeepEqual(v, networkProp)) {
+		obj["network"] = networkProp
+	}
+	descriptionProp, err := expandNetappStoragePoolDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+
+	url, err := replaceVars(d, config, "{{NetappBasePath}}projects/{{project}}/locations/{{location}}/storagePools?storagePoolId={{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new StoragePool: %#v", obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating StoragePool: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/storagePools/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	waitErr := netappOperationWaitTime(
+		config, res, project, "Creating StoragePool",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create StoragePool: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating StoragePool %q: %#v", d.Id(), res)
+
+	return resourceNetappStoragePoolRead(d, meta)
+}
+
+func resourceNetappStoragePoolRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{NetappBasePath}}projects/{{project}}/locations/{{location}}/storagePools/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("NetappStoragePool %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading StoragePool: %s", err)
+	}
+
+	if err := d.Set("service_level", flattenNetappStoragePoolServiceLevel(res["serviceLevel"], d)); err != nil {
+		return fmt.Errorf("Error reading StoragePool: %s", err)
+	}
+	if err := d.Set("capacity_gib", flattenNetappStoragePoolCapacityGib(res["capacityGib"], d)); err != nil {
+		return fmt.Errorf("Error reading StoragePool: %s", err)
+	}
+	if err := d.Set("volume_capacity_gib", flattenNetappStoragePoolVolumeCapacityGib(res["volumeCapacityGib"], d)); err != nil {
+		return fmt.Errorf("Error reading StoragePool: %s", err)
+	}
+	if err := d.Set("volume_count", flattenNetappStoragePoolVolumeCount(res["volumeCount"], d)); err != nil {
+		return fmt.Errorf("Error reading StoragePool: %s", err)
+	}
+	if err := d.Set("network", flattenNetappStoragePoolNetwork(res["network"], d)); err != nil {
+		return fmt.Errorf("Error reading StoragePool: %s", err)
+	}
+	if err := d.Set("description", flattenNetappStoragePoolDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading StoragePool: %s", err)
+	}
+	if err := d.Set("state", flattenNetappStoragePoolState(res["state"], d)); err != nil {
+		return fmt.Errorf("Error reading StoragePool: %s", err)
+	}
+
+	return nil
+}
+
+func resourceNetappStoragePoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	capacityGibProp, err := expandNetappStoragePoolCapacityGib(d.Get("capacity_gib"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("capacity_gib"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, capacityGibProp)) {
+		obj["capacityGib"] = capacityGibProp
+	}
+	descriptionProp, err := expandNetappStoragePoolDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+
+	url, err := replaceVars(d, config, "{{NetappBasePath}}projects/{{project}}/locations/{{location}}/storagePools/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating StoragePool %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("capacity_gib") {
+		updateMask = append(updateMask, "capacityGib")
+	}
+
+	if d.HasChange("description") {
+		updateMask = append(updateMask, "description")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating StoragePool %q: %s", d.Id(), err)
+	}
+
+	err = netappOperationWaitTime(
+		config, res, project, "Updating StoragePool",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceNetappStoragePoolRead(d, meta)
+}
+
+func resourceNetappStoragePoolDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{NetappBasePath}}projects/{{project}}/locations/{{location}}/storagePools/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting StoragePool %q", d.Id())
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "StoragePool")
+	}
+
+	err = netappOperationWaitTime(
+		config, res, project, "Deleting StoragePool",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting StoragePool %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceNetappStoragePoolImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/storagePools/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/storagePools/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenNetappStoragePoolServiceLevel(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetappStoragePoolCapacityGib(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetappStoragePoolVolumeCapacityGib(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetappStoragePoolVolumeCount(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenNetappStoragePoolNetwork(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetappStoragePoolDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetappStoragePoolState(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandNetappStoragePoolServiceLevel(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNetappStoragePoolCapacityGib(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNetappStoragePoolNetwork(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	f, err := parseGlobalFieldValue("networks", v.(string), "project", d, config, true)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid value for network: %s", err)
+	}
+	return f.RelativeLink(), nil
+}
+
+func expandNetappStoragePoolDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,807 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceNetappVolume() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetappVolumeCreate,
+		Read:   resourceNetappVolumeRead,
+		Update: resourceNetappVolumeUpdate,
+		Delete: resourceNetappVolumeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceNetappVolumeImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"capacity_gib": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"protocols": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"NFSV3", "NFSV4", "SMB"}, false),
+				},
+			},
+			"share_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"storage_pool": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"export_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rules": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"access_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"READ_ONLY", "READ_WRITE", "READ_NONE", ""}, false),
+									},
+									"allowed_clients": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"has_root_access": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"nfsv3": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"nfsv4": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"snapshot_policy": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"daily_schedule": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"snapshots_to_keep": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+									"hour": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"minute": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"hourly_schedule": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"snapshots_to_keep": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+									"minute": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"network": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_level": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"used_gib": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceNetappVolumeCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	shareNameProp, err := expandNetappVolumeShareName(d.Get("share_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("share_name"); !isEmptyValue(reflect.ValueOf(shareNameProp)) && (ok || !reflect.DeepEqual(v, shareNameProp)) {
+		obj["shareName"] = shareNameProp
+	}
+	storagePoolProp, err := expandNetappVolumeStoragePool(d.Get("storage_pool"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("storage_pool"); !isEmptyValue(reflect.ValueOf(storagePoolProp)) && (ok || !reflect.DeepEqual(v, storagePoolProp)) {
+		obj["storagePool"] = storagePoolProp
+	}
+	capacityGibProp, err := expandNetappVolumeCapacityGib(d.Get("capacity_gib"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("capacity_gib"); !isEmptyValue(reflect.ValueOf(capacityGibProp)) && (ok || !reflect.DeepEqual(v, capacityGibProp)) {
+		obj["capacityGib"] = capacityGibProp
+	}
+	protocolsProp, err := expandNetappVolumeProtocols(d.Get("protocols"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("protocols"); !isEmptyValue(reflect.ValueOf(protocolsProp)) && (ok || !reflect.DeepEqual(v, protocolsProp)) {
+		obj["protocols"] = protocolsProp
+	}
+	exportPolicyProp, err := expandNetappVolumeExportPolicy(d.Get("export_policy"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("export_policy"); !isEmptyValue(reflect.ValueOf(exportPolicyProp)) && (ok || !reflect.DeepEqual(v, exportPolicyProp)) {
+		obj["exportPolicy"] = exportPolicyProp
+	}
+	snapshotPolicyProp, err := expandNetappVolumeSnapshotPolicy(d.Get("snapshot_policy"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("snapshot_policy"); !isEmptyValue(reflect.ValueOf(snapshotPolicyProp)) && (ok || !reflect.DeepEqual(v, snapshotPolicyProp)) {
+		obj["snapshotPolicy"] = snapshotPolicyProp
+	}
+	descriptionProp, err := expandNetappVolumeDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+
+	url, err := replaceVars(d, config, "{{NetappBasePath}}projects/{{project}}/locations/{{location}}/volumes?volumeId={{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Volume: %#v", obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Volume: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/volumes/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	waitErr := netappOperationWaitTime(
+		config, res, project, "Creating Volume",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create Volume: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating Volume %q: %#v", d.Id(), res)
+
+	return resourceNetappVolumeRead(d, meta)
+}
+
+func resourceNetappVolumeRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{NetappBasePath}}projects/{{project}}/locations/{{location}}/volumes/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("NetappVolume %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Volume: %s", err)
+	}
+
+	if err := d.Set("state", flattenNetappVolumeState(res["state"], d)); err != nil {
+		return fmt.Errorf("Error reading Volume: %s", err)
+	}
+	if err := d.Set("share_name", flattenNetappVolumeShareName(res["shareName"], d)); err != nil {
+		return fmt.Errorf("Error reading Volume: %s", err)
+	}
+	if err := d.Set("storage_pool", flattenNetappVolumeStoragePool(res["storagePool"], d)); err != nil {
+		return fmt.Errorf("Error reading Volume: %s", err)
+	}
+	if err := d.Set("network", flattenNetappVolumeNetwork(res["network"], d)); err != nil {
+		return fmt.Errorf("Error reading Volume: %s", err)
+	}
+	if err := d.Set("service_level", flattenNetappVolumeServiceLevel(res["serviceLevel"], d)); err != nil {
+		return fmt.Errorf("Error reading Volume: %s", err)
+	}
+	if err := d.Set("capacity_gib", flattenNetappVolumeCapacityGib(res["capacityGib"], d)); err != nil {
+		return fmt.Errorf("Error reading Volume: %s", err)
+	}
+	if err := d.Set("used_gib", flattenNetappVolumeUsedGib(res["usedGib"], d)); err != nil {
+		return fmt.Errorf("Error reading Volume: %s", err)
+	}
+	if err := d.Set("protocols", flattenNetappVolumeProtocols(res["protocols"], d)); err != nil {
+		return fmt.Errorf("Error reading Volume: %s", err)
+	}
+	if err := d.Set("export_policy", flattenNetappVolumeExportPolicy(res["exportPolicy"], d)); err != nil {
+		return fmt.Errorf("Error reading Volume: %s", err)
+	}
+	if err := d.Set("snapshot_policy", flattenNetappVolumeSnapshotPolicy(res["snapshotPolicy"], d)); err != nil {
+		return fmt.Errorf("Error reading Volume: %s", err)
+	}
+	if err := d.Set("description", flattenNetappVolumeDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading Volume: %s", err)
+	}
+
+	return nil
+}
+
+func resourceNetappVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	capacityGibProp, err := expandNetappVolumeCapacityGib(d.Get("capacity_gib"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("capacity_gib"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, capacityGibProp)) {
+		obj["capacityGib"] = capacityGibProp
+	}
+	exportPolicyProp, err := expandNetappVolumeExportPolicy(d.Get("export_policy"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("export_policy"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, exportPolicyProp)) {
+		obj["exportPolicy"] = exportPolicyProp
+	}
+	snapshotPolicyProp, err := expandNetappVolumeSnapshotPolicy(d.Get("snapshot_policy"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("snapshot_policy"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, snapshotPolicyProp)) {
+		obj["snapshotPolicy"] = snapshotPolicyProp
+	}
+	descriptionProp, err := expandNetappVolumeDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+
+	url, err := replaceVars(d, config, "{{NetappBasePath}}projects/{{project}}/locations/{{location}}/volumes/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Volume %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("capacity_gib") {
+		updateMask = append(updateMask, "capacityGib")
+	}
+
+	if d.HasChange("export_policy") {
+		updateMask = append(updateMask, "exportPolicy")
+	}
+
+	if d.HasChange("snapshot_policy") {
+		updateMask = append(updateMask, "snapshotPolicy")
+	}
+
+	if d.HasChange("description") {
+		updateMask = append(updateMask, "description")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Volume %q: %s", d.Id(), err)
+	}
+
+	err = netappOperationWaitTime(
+		config, res, project, "Updating Volume",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceNetappVolumeRead(d, meta)
+}
+
+func resourceNetappVolumeDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{NetappBasePath}}projects/{{project}}/locations/{{location}}/volumes/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Volume %q", d.Id())
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Volume")
+	}
+
+	err = netappOperationWaitTime(
+		config, res, project, "Deleting Volume",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting Volume %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceNetappVolumeImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/volumes/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/volumes/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenNetappVolumeState(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetappVolumeShareName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetappVolumeStoragePool(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetappVolumeNetwork(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetappVolumeServiceLevel(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetappVolumeCapacityGib(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetappVolumeUsedGib(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetappVolumeProtocols(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetappVolumeExportPolicy(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["rules"] =
+		flattenNetappVolumeExportPolicyRules(original["rules"], d)
+	return []interface{}{transformed}
+}
+func flattenNetappVolumeExportPolicyRules(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"allowed_clients": flattenNetappVolumeExportPolicyRulesAllowedClients(original["allowedClients"], d),
+			"has_root_access": flattenNetappVolumeExportPolicyRulesHasRootAccess(original["hasRootAccess"], d),
+			"access_type":     flattenNetappVolumeExportPolicyRulesAccessType(original["accessType"], d),
+			"nfsv3":           flattenNetappVolumeExportPolicyRulesNfsv3(original["nfsv3"], d),
+			"nfsv4":           flattenNetappVolumeExportPolicyRulesNfsv4(original["nfsv4"], d),
+		})
+	}
+	return transformed
+}
+func flattenNetappVolumeExportPolicyRulesAllowedClients(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetappVolumeExportPolicyRulesHasRootAccess(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetappVolumeExportPolicyRulesAccessType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetappVolumeExportPolicyRulesNfsv3(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetappVolumeExportPolicyRulesNfsv4(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetappVolumeSnapshotPolicy(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["enabled"] =
+		flattenNetappVolumeSnapshotPolicyEnabled(original["enabled"], d)
+	transformed["hourly_schedule"] =
+		flattenNetappVolumeSnapshotPolicyHourlySchedule(original["hourlySchedule"], d)
+	transformed["daily_schedule"] =
+		flattenNetappVolumeSnapshotPolicyDailySchedule(original["dailySchedule"], d)
+	return []interface{}{transformed}
+}
+func flattenNetappVolumeSnapshotPolicyEnabled(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetappVolumeSnapshotPolicyHourlySchedule(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["snapshots_to_keep"] =
+		flattenNetappVolumeSnapshotPolicyScheduleInt(original["snapshotsToKeep"], d)
+	transformed["minute"] =
+		flattenNetappVolumeSnapshotPolicyScheduleInt(original["minute"], d)
+	return []interface{}{transformed}
+}
+
+func flattenNetappVolumeSnapshotPolicyDailySchedule(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["snapshots_to_keep"] =
+		flattenNetappVolumeSnapshotPolicyScheduleInt(original["snapshotsToKeep"], d)
+	transformed["minute"] =
+		flattenNetappVolumeSnapshotPolicyScheduleInt(original["minute"], d)
+	transformed["hour"] =
+		flattenNetappVolumeSnapshotPolicyScheduleInt(original["hour"], d)
+	return []interface{}{transformed}
+}
+
+func flattenNetappVolumeSnapshotPolicyScheduleInt(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+
+	// number values are represented as float64
+	if floatVal, ok := v.(float64); ok {
+		intVal := int(floatVal)
+		return intVal
+	}
+
+	return v // let terraform core handle it otherwise
+}
+
+func flattenNetappVolumeDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandNetappVolumeShareName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNetappVolumeStoragePool(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNetappVolumeCapacityGib(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNetappVolumeProtocols(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNetappVolumeExportPolicy(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedRules, err := expandNetappVolumeExportPolicyRules(original["rules"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRules); val.IsValid() && !isEmptyValue(val) {
+		transformed["rules"] = transformedRules
+	}
+
+	return transformed, nil
+}
+
+func expandNetappVolumeExportPolicyRules(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedAllowedClients, err := expandNetappVolumeExportPolicyRulesAllowedClients(original["allowed_clients"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedAllowedClients); val.IsValid() && !isEmptyValue(val) {
+			transformed["allowedClients"] = transformedAllowedClients
+		}
+
+		transformedHasRootAccess, err := expandNetappVolumeExportPolicyRulesHasRootAccess(original["has_root_access"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedHasRootAccess); val.IsValid() && !isEmptyValue(val) {
+			transformed["hasRootAccess"] = transformedHasRootAccess
+		}
+
+		transformedAccessType, err := expandNetappVolumeExportPolicyRulesAccessType(original["access_type"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedAccessType); val.IsValid() && !isEmptyValue(val) {
+			transformed["accessType"] = transformedAccessType
+		}
+
+		transformedNfsv3, err := expandNetappVolumeExportPolicyRulesNfsv3(original["nfsv3"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedNfsv3); val.IsValid() && !isEmptyValue(val) {
+			transformed["nfsv3"] = transformedNfsv3
+		}
+
+		transformedNfsv4, err := expandNetappVolumeExportPolicyRulesNfsv4(original["nfsv4"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedNfsv4); val.IsValid() && !isEmptyValue(val) {
+			transformed["nfsv4"] = transformedNfsv4
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandNetappVolumeExportPolicyRulesAllowedClients(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNetappVolumeExportPolicyRulesHasRootAccess(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNetappVolumeExportPolicyRulesAccessType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNetappVolumeExportPolicyRulesNfsv3(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNetappVolumeExportPolicyRulesNfsv4(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNetappVolumeSnapshotPolicy(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedEnabled, err := expandNetappVolumeSnapshotPolicyEnabled(original["enabled"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedEnabled); val.IsValid() && !isEmptyValue(val) {
+		transformed["enabled"] = transformedEnabled
+	}
+
+	transformedHourlySchedule, err := expandNetappVolumeSnapshotPolicySchedule(original["hourly_schedule"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedHourlySchedule); val.IsValid() && !isEmptyValue(val) {
+		transformed["hourlySchedule"] = transformedHourlySchedule
+	}
+
+	transformedDailySchedule, err := expandNetappVolumeSnapshotPolicySchedule(original["daily_schedule"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDailySchedule); val.IsValid() && !isEmptyValue(val) {
+		transformed["dailySchedule"] = transformedDailySchedule
+	}
+
+	return transformed, nil
+}
+
+func expandNetappVolumeSnapshotPolicyEnabled(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNetappVolumeSnapshotPolicySchedule(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	original := l[0].(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	if val := reflect.ValueOf(original["snapshots_to_keep"]); val.IsValid() && !isEmptyValue(val) {
+		transformed["snapshotsToKeep"] = original["snapshots_to_keep"]
+	}
+
+	if val := reflect.ValueOf(original["minute"]); val.IsValid() && !isEmptyValue(val) {
+		transformed["minute"] = original["minute"]
+	}
+
+	// Only daily schedules have an hour.
+	if val := reflect.ValueOf(original["hour"]); val.IsValid() && !isEmptyValue(val) {
+		transformed["hour"] = original["hour"]
+	}
+
+	return transformed, nil
+}
+
+func expandNetappVolumeDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,143 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNetappVolume_nfsv3(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix":   acctest.RandString(10),
+		"pool_capacity":   "2048",
+		"volume_capacity": "100",
+	}
+	updated := map[string]interface{}{
+		"random_suffix":   context["random_suffix"],
+		"pool_capacity":   "3072",
+		"volume_capacity": "200",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetappVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetappVolume_nfsv3(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_netapp_volume.volume", "protocols.0", "NFSV3"),
+					resource.TestCheckResourceAttr("google_netapp_volume.volume", "service_level", "PREMIUM"),
+				),
+			},
+			{
+				ResourceName:      "google_netapp_storage_pool.pool",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "google_netapp_volume.volume",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccNetappVolume_nfsv3(updated),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_netapp_storage_pool.pool", "capacity_gib", "3072"),
+					resource.TestCheckResourceAttr("google_netapp_volume.volume", "capacity_gib", "200"),
+				),
+			},
+			{
+				ResourceName:      "google_netapp_volume.volume",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNetappVolume_nfsv3(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_network" "network" {
+  name                    = "tf-test-netapp-%{random_suffix}"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_global_address" "private_ip_alloc" {
+  name          = "tf-test-netapp-%{random_suffix}"
+  purpose       = "VPC_PEERING"
+  address_type  = "INTERNAL"
+  prefix_length = 24
+  network       = google_compute_network.network.self_link
+}
+
+resource "google_service_networking_connection" "default" {
+  network                 = google_compute_network.network.self_link
+  service                 = "netapp.servicenetworking.goog"
+  reserved_peering_ranges = [google_compute_global_address.private_ip_alloc.name]
+}
+
+resource "google_netapp_storage_pool" "pool" {
+  name          = "tf-test-pool-%{random_suffix}"
+  location      = "us-central1"
+  service_level = "PREMIUM"
+  capacity_gib  = "%{pool_capacity}"
+  network       = google_compute_network.network.name
+
+  depends_on = [google_service_networking_connection.default]
+}
+
+resource "google_netapp_volume" "volume" {
+  name         = "tf-test-volume-%{random_suffix}"
+  location     = google_netapp_storage_pool.pool.location
+  storage_pool = google_netapp_storage_pool.pool.name
+  share_name   = "tf-test-volume-%{random_suffix}"
+  capacity_gib = "%{volume_capacity}"
+  protocols    = ["NFSV3"]
+
+  export_policy {
+    rules {
+      allowed_clients = "10.0.0.0/8"
+      access_type     = "READ_WRITE"
+      has_root_access = "true"
+      nfsv3           = true
+    }
+  }
+
+  snapshot_policy {
+    enabled = true
+    daily_schedule {
+      snapshots_to_keep = 2
+      hour              = 3
+    }
+  }
+}
+`, context)
+}
+
+func testAccCheckNetappVolumeDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_netapp_volume" && rs.Type != "google_netapp_storage_pool" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url := fmt.Sprintf("%s%s", config.NetappBasePath, rs.Primary.ID)
+		_, err := sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("%s still exists at %s", rs.Type, url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNetworkServicesEdgeCacheService_networkServicesEdgeCacheServiceBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkServicesEdgeCacheServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkServicesEdgeCacheService_networkServicesEdgeCacheServiceBasicExample(context),
+			},
+			{
+				ResourceName:      "google_network_services_edge_cache_service.instance",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNetworkServicesEdgeCacheService_networkServicesEdgeCacheServiceBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_storage_bucket" "dest" {
+  name          = "tf-test-my-bucket%{random_suffix}"
+  location      = "US"
+  force_destroy = true
+}
+
+resource "google_network_services_edge_cache_origin" "instance" {
+  name           = "tf-test-my-origin%{random_suffix}"
+  origin_address = "${google_storage_bucket.dest.url}"
+  description    = "The default bucket for media edge test"
+  max_attempts   = 2
+
+  timeout {
+    connect_timeout = "10s"
+  }
+}
+
+resource "google_network_services_edge_cache_service" "instance" {
+  name        = "tf-test-my-service%{random_suffix}"
+  description = "some description"
+
+  routing {
+    host_rule {
+      description  = "host rule description"
+      hosts        = ["sslcert.tf-test.club"]
+      path_matcher = "routes"
+    }
+
+    path_matcher {
+      name = "routes"
+
+      route_rule {
+        description = "a route rule to match against"
+        priority    = 1
+
+        match_rule {
+          prefix_match = "/"
+        }
+
+        origin = "${google_network_services_edge_cache_origin.instance.name}"
+
+        route_action {
+          cdn_policy {
+            cache_mode  = "CACHE_ALL_STATIC"
+            default_ttl = "3600s"
+          }
+        }
+      }
+    }
+  }
+}
+`, context)
+}
+
+func testAccCheckNetworkServicesEdgeCacheServiceDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_network_services_edge_cache_service" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(config, rs, "{{NetworkServicesBasePath}}projects/{{project}}/locations/global/edgeCacheServices/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("NetworkServicesEdgeCacheService still exists at %s", url)
+		}
+	}
+
+	return nil
+}
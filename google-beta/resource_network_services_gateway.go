@@ -0,0 +1,554 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceNetworkServicesGateway() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkServicesGatewayCreate,
+		Read:   resourceNetworkServicesGatewayRead,
+		Update: resourceNetworkServicesGatewayUpdate,
+		Delete: resourceNetworkServicesGatewayDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceNetworkServicesGatewayImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: setLabelsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ports": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"OPEN_MESH", "SECURE_WEB_GATEWAY"}, false),
+			},
+			"addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"certificate_urls": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"gateway_security_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"effective_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"network": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"server_tls_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"subnetwork": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"self_link": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkServicesGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	labelsProp, err := expandNetworkServicesGatewayLabels(d.Get("terraform_labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("terraform_labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+	descriptionProp, err := expandNetworkServicesGatewayDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	typeProp, err := expandNetworkServicesGatewayType(d.Get("type"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("type"); !isEmptyValue(reflect.ValueOf(typeProp)) && (ok || !reflect.DeepEqual(v, typeProp)) {
+		obj["type"] = typeProp
+	}
+	portsProp, err := expandNetworkServicesGatewayPorts(d.Get("ports"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("ports"); !isEmptyValue(reflect.ValueOf(portsProp)) && (ok || !reflect.DeepEqual(v, portsProp)) {
+		obj["ports"] = portsProp
+	}
+	scopeProp, err := expandNetworkServicesGatewayScope(d.Get("scope"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("scope"); !isEmptyValue(reflect.ValueOf(scopeProp)) && (ok || !reflect.DeepEqual(v, scopeProp)) {
+		obj["scope"] = scopeProp
+	}
+	serverTlsPolicyProp, err := expandNetworkServicesGatewayServerTlsPolicy(d.Get("server_tls_policy"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("server_tls_policy"); !isEmptyValue(reflect.ValueOf(serverTlsPolicyProp)) && (ok || !reflect.DeepEqual(v, serverTlsPolicyProp)) {
+		obj["serverTlsPolicy"] = serverTlsPolicyProp
+	}
+	addressesProp, err := expandNetworkServicesGatewayAddresses(d.Get("addresses"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("addresses"); !isEmptyValue(reflect.ValueOf(addressesProp)) && (ok || !reflect.DeepEqual(v, addressesProp)) {
+		obj["addresses"] = addressesProp
+	}
+	certificateUrlsProp, err := expandNetworkServicesGatewayCertificateUrls(d.Get("certificate_urls"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("certificate_urls"); !isEmptyValue(reflect.ValueOf(certificateUrlsProp)) && (ok || !reflect.DeepEqual(v, certificateUrlsProp)) {
+		obj["certificateUrls"] = certificateUrlsProp
+	}
+	gatewaySecurityPolicyProp, err := expandNetworkServicesGatewayGatewaySecurityPolicy(d.Get("gateway_security_policy"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("gateway_security_policy"); !isEmptyValue(reflect.ValueOf(gatewaySecurityPolicyProp)) && (ok || !reflect.DeepEqual(v, gatewaySecurityPolicyProp)) {
+		obj["gatewaySecurityPolicy"] = gatewaySecurityPolicyProp
+	}
+	networkProp, err := expandNetworkServicesGatewayNetwork(d.Get("network"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("network"); !isEmptyValue(reflect.ValueOf(networkProp)) && (ok || !reflect.DeepEqual(v, networkProp)) {
+		obj["network"] = networkProp
+	}
+	subnetworkProp, err := expandNetworkServicesGatewaySubnetwork(d.Get("subnetwork"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("subnetwork"); !isEmptyValue(reflect.ValueOf(subnetworkProp)) && (ok || !reflect.DeepEqual(v, subnetworkProp)) {
+		obj["subnetwork"] = subnetworkProp
+	}
+
+	url, err := replaceVars(d, config, "{{NetworkServicesBasePath}}projects/{{project}}/locations/{{location}}/gateways?gatewayId={{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Gateway: %#v", obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Gateway: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/gateways/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	waitErr := networkServicesOperationWaitTime(
+		config, res, project, "Creating Gateway",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create Gateway: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating Gateway %q: %#v", d.Id(), res)
+
+	return resourceNetworkServicesGatewayRead(d, meta)
+}
+
+func resourceNetworkServicesGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{NetworkServicesBasePath}}projects/{{project}}/locations/{{location}}/gateways/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("NetworkServicesGateway %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Gateway: %s", err)
+	}
+
+	if err := setLabelsFields(d, config, res["labels"]); err != nil {
+		return fmt.Errorf("Error reading Gateway: %s", err)
+	}
+	if err := d.Set("description", flattenNetworkServicesGatewayDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading Gateway: %s", err)
+	}
+	if err := d.Set("type", flattenNetworkServicesGatewayType(res["type"], d)); err != nil {
+		return fmt.Errorf("Error reading Gateway: %s", err)
+	}
+	if err := d.Set("ports", flattenNetworkServicesGatewayPorts(res["ports"], d)); err != nil {
+		return fmt.Errorf("Error reading Gateway: %s", err)
+	}
+	if err := d.Set("scope", flattenNetworkServicesGatewayScope(res["scope"], d)); err != nil {
+		return fmt.Errorf("Error reading Gateway: %s", err)
+	}
+	if err := d.Set("server_tls_policy", flattenNetworkServicesGatewayServerTlsPolicy(res["serverTlsPolicy"], d)); err != nil {
+		return fmt.Errorf("Error reading Gateway: %s", err)
+	}
+	if err := d.Set("addresses", flattenNetworkServicesGatewayAddresses(res["addresses"], d)); err != nil {
+		return fmt.Errorf("Error reading Gateway: %s", err)
+	}
+	if err := d.Set("certificate_urls", flattenNetworkServicesGatewayCertificateUrls(res["certificateUrls"], d)); err != nil {
+		return fmt.Errorf("Error reading Gateway: %s", err)
+	}
+	if err := d.Set("gateway_security_policy", flattenNetworkServicesGatewayGatewaySecurityPolicy(res["gatewaySecurityPolicy"], d)); err != nil {
+		return fmt.Errorf("Error reading Gateway: %s", err)
+	}
+	if err := d.Set("network", flattenNetworkServicesGatewayNetwork(res["network"], d)); err != nil {
+		return fmt.Errorf("Error reading Gateway: %s", err)
+	}
+	if err := d.Set("subnetwork", flattenNetworkServicesGatewaySubnetwork(res["subnetwork"], d)); err != nil {
+		return fmt.Errorf("Error reading Gateway: %s", err)
+	}
+	if err := d.Set("self_link", flattenNetworkServicesGatewaySelfLink(res["selfLink"], d)); err != nil {
+		return fmt.Errorf("Error reading Gateway: %s", err)
+	}
+	if err := d.Set("create_time", flattenNetworkServicesGatewayCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Gateway: %s", err)
+	}
+	if err := d.Set("update_time", flattenNetworkServicesGatewayUpdateTime(res["updateTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Gateway: %s", err)
+	}
+
+	return nil
+}
+
+func resourceNetworkServicesGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	labelsProp, err := expandNetworkServicesGatewayLabels(d.Get("terraform_labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("terraform_labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+	descriptionProp, err := expandNetworkServicesGatewayDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	serverTlsPolicyProp, err := expandNetworkServicesGatewayServerTlsPolicy(d.Get("server_tls_policy"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("server_tls_policy"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, serverTlsPolicyProp)) {
+		obj["serverTlsPolicy"] = serverTlsPolicyProp
+	}
+
+	url, err := replaceVars(d, config, "{{NetworkServicesBasePath}}projects/{{project}}/locations/{{location}}/gateways/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Gateway %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("labels") || d.HasChange("terraform_labels") {
+		updateMask = append(updateMask, "labels")
+	}
+
+	if d.HasChange("description") {
+		updateMask = append(updateMask, "description")
+	}
+
+	if d.HasChange("server_tls_policy") {
+		updateMask = append(updateMask, "serverTlsPolicy")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Gateway %q: %s", d.Id(), err)
+	}
+
+	err = networkServicesOperationWaitTime(
+		config, res, project, "Updating Gateway",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceNetworkServicesGatewayRead(d, meta)
+}
+
+func resourceNetworkServicesGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{NetworkServicesBasePath}}projects/{{project}}/locations/{{location}}/gateways/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Gateway %q", d.Id())
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Gateway")
+	}
+
+	err = networkServicesOperationWaitTime(
+		config, res, project, "Deleting Gateway",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting Gateway %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceNetworkServicesGatewayImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/gateways/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/gateways/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenNetworkServicesGatewayDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetworkServicesGatewayType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetworkServicesGatewayPorts(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetworkServicesGatewayScope(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetworkServicesGatewayServerTlsPolicy(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetworkServicesGatewayAddresses(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetworkServicesGatewayCertificateUrls(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetworkServicesGatewayGatewaySecurityPolicy(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetworkServicesGatewayNetwork(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetworkServicesGatewaySubnetwork(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetworkServicesGatewaySelfLink(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetworkServicesGatewayCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetworkServicesGatewayUpdateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandNetworkServicesGatewayLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandNetworkServicesGatewayDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNetworkServicesGatewayType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNetworkServicesGatewayPorts(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNetworkServicesGatewayScope(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNetworkServicesGatewayServerTlsPolicy(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNetworkServicesGatewayAddresses(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNetworkServicesGatewayCertificateUrls(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNetworkServicesGatewayGatewaySecurityPolicy(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNetworkServicesGatewayNetwork(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNetworkServicesGatewaySubnetwork(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,149 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccPrivatecaCertificateAuthority_privatecaCertificateAuthorityBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPrivatecaCertificateAuthorityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrivatecaCertificateAuthority_privatecaCertificateAuthorityBasicExample(context),
+			},
+			{
+				ResourceName:            "google_privateca_certificate_authority.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection", "skip_grace_period", "ignore_active_certificates_on_deletion"},
+			},
+		},
+	})
+}
+
+func testAccPrivatecaCertificateAuthority_privatecaCertificateAuthorityBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_privateca_ca_pool" "default" {
+  name     = "tf-test-my-pool%{random_suffix}"
+  location = "us-central1"
+  tier     = "ENTERPRISE"
+
+  publishing_options {
+    publish_ca_cert = true
+    publish_crl     = true
+  }
+}
+
+resource "google_privateca_certificate_authority" "default" {
+  pool                     = "${google_privateca_ca_pool.default.name}"
+  certificate_authority_id = "tf-test-my-certificate-authority%{random_suffix}"
+  location                 = "us-central1"
+
+  deletion_protection                    = false
+  skip_grace_period                      = true
+  ignore_active_certificates_on_deletion = true
+
+  config {
+    subject_config {
+      subject {
+        organization = "HashiCorp"
+        common_name  = "my-certificate-authority"
+      }
+
+      subject_alt_name {
+        dns_names = ["hashicorp.com"]
+      }
+    }
+
+    x509_config {
+      ca_options {
+        is_ca                  = true
+        max_issuer_path_length = 10
+      }
+
+      key_usage {
+        base_key_usage {
+          digital_signature  = true
+          content_commitment = true
+          key_encipherment   = false
+          data_encipherment  = true
+          key_agreement      = true
+          cert_sign          = true
+          crl_sign           = true
+          decipher_only      = true
+        }
+
+        extended_key_usage {
+          server_auth      = true
+          client_auth      = false
+          email_protection = true
+          code_signing     = true
+          time_stamping    = true
+        }
+      }
+    }
+  }
+
+  lifetime = "86400s"
+
+  key_spec {
+    algorithm = "RSA_PKCS1_4096_SHA256"
+  }
+}
+`, context)
+}
+
+func testAccCheckPrivatecaCertificateAuthorityDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_privateca_certificate_authority" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(config, rs, "{{PrivatecaBasePath}}projects/{{project}}/locations/{{location}}/caPools/{{pool}}/certificateAuthorities/{{certificate_authority_id}}")
+		if err != nil {
+			return err
+		}
+
+		res, err := sendRequest(config, "GET", "", url, nil)
+		// Deleted CertificateAuthorities can still be returned by the API in
+		// the DELETED state.
+		if err == nil && res["state"] != "DELETED" {
+			return fmt.Errorf("PrivatecaCertificateAuthority still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccPrivatecaCertificateAuthority_desiredState(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPrivatecaCertificateAuthorityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrivatecaCertificateAuthority_desiredState(suffix, "ENABLED", "foo"),
+				Check:  resource.TestCheckResourceAttr("google_privateca_certificate_authority.default", "state", "ENABLED"),
+			},
+			{
+				ResourceName:            "google_privateca_certificate_authority.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection", "desired_state", "skip_grace_period", "ignore_active_certificates_on_deletion"},
+			},
+			{
+				Config: testAccPrivatecaCertificateAuthority_desiredState(suffix, "DISABLED", "bar"),
+				Check:  resource.TestCheckResourceAttr("google_privateca_certificate_authority.default", "state", "DISABLED"),
+			},
+			{
+				Config: testAccPrivatecaCertificateAuthority_desiredState(suffix, "ENABLED", "bar"),
+				Check:  resource.TestCheckResourceAttr("google_privateca_certificate_authority.default", "state", "ENABLED"),
+			},
+		},
+	})
+}
+
+func testAccPrivatecaCertificateAuthority_desiredState(suffix, desiredState, label string) string {
+	return fmt.Sprintf(`
+resource "google_privateca_ca_pool" "default" {
+  name     = "tf-test-pool-%s"
+  location = "us-central1"
+  tier     = "DEVOPS"
+}
+
+resource "google_privateca_certificate_authority" "default" {
+  pool                     = "${google_privateca_ca_pool.default.name}"
+  certificate_authority_id = "tf-test-ca-%s"
+  location                 = "us-central1"
+  desired_state            = "%s"
+
+  deletion_protection                    = false
+  skip_grace_period                      = true
+  ignore_active_certificates_on_deletion = true
+
+  config {
+    subject_config {
+      subject {
+        organization = "HashiCorp"
+        common_name  = "my-certificate-authority"
+      }
+    }
+
+    x509_config {
+      ca_options {
+        is_ca = true
+      }
+
+      key_usage {
+        base_key_usage {
+          cert_sign = true
+          crl_sign  = true
+        }
+
+        extended_key_usage {
+          server_auth = true
+        }
+      }
+    }
+  }
+
+  key_spec {
+    algorithm = "EC_P256_SHA256"
+  }
+
+  labels = {
+    foo = "%s"
+  }
+}
+`, suffix, suffix, desiredState, label)
+}
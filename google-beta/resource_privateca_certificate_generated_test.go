@@ -0,0 +1,147 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccPrivatecaCertificate_privatecaCertificateCsrExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPrivatecaCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrivatecaCertificate_privatecaCertificateCsrExample(context),
+			},
+			{
+				ResourceName:            "google_privateca_certificate.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"pem_csr", "certificate_authority"},
+			},
+		},
+	})
+}
+
+func testAccPrivatecaCertificate_privatecaCertificateCsrExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_privateca_ca_pool" "default" {
+  name     = "tf-test-my-pool%{random_suffix}"
+  location = "us-central1"
+  tier     = "ENTERPRISE"
+
+  publishing_options {
+    publish_ca_cert = false
+    publish_crl     = true
+  }
+
+  issuance_policy {
+    allowed_issuance_modes {
+      allow_csr_based_issuance    = true
+      allow_config_based_issuance = false
+    }
+  }
+}
+
+resource "google_privateca_certificate_authority" "default" {
+  pool                     = "${google_privateca_ca_pool.default.name}"
+  certificate_authority_id = "tf-test-my-certificate-authority%{random_suffix}"
+  location                 = "us-central1"
+
+  deletion_protection                    = false
+  skip_grace_period                      = true
+  ignore_active_certificates_on_deletion = true
+
+  config {
+    subject_config {
+      subject {
+        organization = "HashiCorp"
+        common_name  = "my-certificate-authority"
+      }
+    }
+
+    x509_config {
+      ca_options {
+        is_ca = true
+      }
+
+      key_usage {
+        base_key_usage {
+          cert_sign = true
+          crl_sign  = true
+        }
+
+        extended_key_usage {
+          server_auth = true
+        }
+      }
+    }
+  }
+
+  key_spec {
+    algorithm = "RSA_PKCS1_4096_SHA256"
+  }
+}
+
+resource "google_privateca_certificate" "default" {
+  pool                  = "${google_privateca_ca_pool.default.name}"
+  name                  = "tf-test-my-certificate%{random_suffix}"
+  location              = "us-central1"
+  certificate_authority = "${google_privateca_certificate_authority.default.certificate_authority_id}"
+  lifetime              = "860s"
+  pem_csr               = "${file("test-fixtures/ssl_cert/test.csr")}"
+}
+`, context)
+}
+
+func testAccCheckPrivatecaCertificateDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_privateca_certificate" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(config, rs, "{{PrivatecaBasePath}}projects/{{project}}/locations/{{location}}/caPools/{{pool}}/certificates/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		res, err := sendRequest(config, "GET", "", url, nil)
+		// Certificates are revoked rather than deleted.
+		if err == nil && res["revocationDetails"] == nil {
+			return fmt.Errorf("PrivatecaCertificate still exists at %s", url)
+		}
+	}
+
+	return nil
+}
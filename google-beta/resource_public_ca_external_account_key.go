@@ -0,0 +1,107 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourcePublicCAExternalAccountKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePublicCAExternalAccountKeyCreate,
+		Read:   resourcePublicCAExternalAccountKeyRead,
+		Delete: resourcePublicCAExternalAccountKeyDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "global",
+			},
+
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"key_id": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"b64_mac_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourcePublicCAExternalAccountKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	d.Set("project", project)
+
+	url, err := replaceVars(d, config, "{{PublicCABasePath}}projects/{{project}}/locations/{{location}}/externalAccountKeys")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new ExternalAccountKey")
+	res, err := sendRequestWithTimeout(config, "POST", project, url, make(map[string]interface{}), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating ExternalAccountKey: %s", err)
+	}
+
+	// `name` is autogenerated from the api so needs to be set post-create
+	name, ok := res["name"]
+	if !ok {
+		return fmt.Errorf("Create response didn't contain critical fields. Create may not have succeeded.")
+	}
+	d.SetId(name.(string))
+	d.Set("name", name)
+
+	// The key can't be read back from the API, only the create response holds
+	// the secret values.
+	d.Set("key_id", res["keyId"])
+	d.Set("b64_mac_key", res["b64MacKey"])
+
+	log.Printf("[DEBUG] Finished creating ExternalAccountKey %q", d.Id())
+
+	return resourcePublicCAExternalAccountKeyRead(d, meta)
+}
+
+func resourcePublicCAExternalAccountKeyRead(d *schema.ResourceData, meta interface{}) error {
+	// There's no get method for external account keys, the state set on create
+	// is kept as is.
+	return nil
+}
+
+func resourcePublicCAExternalAccountKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARNING] PublicCA ExternalAccountKey resources"+
+		" cannot be deleted from GCP. The resource %s will be removed from Terraform"+
+		" state, but will still be present on the server.", d.Id())
+	d.SetId("")
+
+	return nil
+}
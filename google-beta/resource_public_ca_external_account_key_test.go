@@ -0,0 +1,34 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccPublicCAExternalAccountKey_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPublicCAExternalAccountKey_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("google_public_ca_external_account_key.key", "name"),
+					resource.TestCheckResourceAttrSet("google_public_ca_external_account_key.key", "key_id"),
+					resource.TestCheckResourceAttrSet("google_public_ca_external_account_key.key", "b64_mac_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPublicCAExternalAccountKey_basic() string {
+	return `
+resource "google_public_ca_external_account_key" "key" {
+  location = "global"
+}
+`
+}
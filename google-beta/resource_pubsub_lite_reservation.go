@@ -0,0 +1,199 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourcePubsubLiteReservation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePubsubLiteReservationCreate,
+		Read:   resourcePubsubLiteReservationRead,
+		Update: resourcePubsubLiteReservationUpdate,
+		Delete: resourcePubsubLiteReservationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourcePubsubLiteReservationImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Update: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+				Description:      `Name of the reservation.`,
+			},
+			"throughput_capacity": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  `The reserved throughput capacity. Every unit of throughput capacity is equivalent to 1 MiB/s of published messages or 2 MiB/s of subscribed messages.`,
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The region of the reservation.`,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourcePubsubLiteReservationCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	obj := map[string]interface{}{
+		"throughputCapacity": d.Get("throughput_capacity").(int),
+	}
+
+	url, err := replaceVars(d, config, pubsubLiteBasePath(config, region)+"projects/{{project}}/locations/{{region}}/reservations?reservationId={{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Reservation: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", "", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Reservation: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{region}}/reservations/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating Reservation %q: %#v", d.Id(), res)
+
+	return resourcePubsubLiteReservationRead(d, meta)
+}
+
+func resourcePubsubLiteReservationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, pubsubLiteBasePath(config, region)+"projects/{{project}}/locations/{{region}}/reservations/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("PubsubLiteReservation %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Reservation: %s", err)
+	}
+	if err := d.Set("region", region); err != nil {
+		return fmt.Errorf("Error reading Reservation: %s", err)
+	}
+	if err := d.Set("throughput_capacity", flattenPubsubLiteInt(res["throughputCapacity"])); err != nil {
+		return fmt.Errorf("Error reading Reservation: %s", err)
+	}
+
+	return nil
+}
+
+func resourcePubsubLiteReservationUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	obj := map[string]interface{}{
+		"throughputCapacity": d.Get("throughput_capacity").(int),
+	}
+
+	url, err := replaceVars(d, config, pubsubLiteBasePath(config, region)+"projects/{{project}}/locations/{{region}}/reservations/{{name}}?updateMask=throughputCapacity")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Reservation %q: %#v", d.Id(), obj)
+	_, err = sendRequestWithTimeout(config, "PATCH", "", url, obj, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("Error updating Reservation %q: %s", d.Id(), err)
+	}
+
+	return resourcePubsubLiteReservationRead(d, meta)
+}
+
+func resourcePubsubLiteReservationDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, pubsubLiteBasePath(config, region)+"projects/{{project}}/locations/{{region}}/reservations/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Reservation %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", "", url, nil, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Reservation")
+	}
+
+	log.Printf("[DEBUG] Finished deleting Reservation %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourcePubsubLiteReservationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/locations/(?P<region>[^/]+)/reservations/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<name>[^/]+)",
+		"(?P<region>[^/]+)/(?P<name>[^/]+)",
+		"(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{region}}/reservations/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
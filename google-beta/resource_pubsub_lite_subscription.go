@@ -0,0 +1,227 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourcePubsubLiteSubscription() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePubsubLiteSubscriptionCreate,
+		Read:   resourcePubsubLiteSubscriptionRead,
+		Update: resourcePubsubLiteSubscriptionUpdate,
+		Delete: resourcePubsubLiteSubscriptionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourcePubsubLiteSubscriptionImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Update: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+				Description:      `Name of the subscription.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The location of the subscription, which must be the location of its topic.`,
+			},
+			"topic": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+				Description:      `A reference to a Topic resource.`,
+			},
+			"delivery_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: `The settings for this subscription's message delivery.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delivery_requirement": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"DELIVER_IMMEDIATELY", "DELIVER_AFTER_STORED"}, false),
+							Description:  `When this subscription should send messages to subscribers relative to messages persistence in storage. Possible values are "DELIVER_IMMEDIATELY" and "DELIVER_AFTER_STORED".`,
+						},
+					},
+				},
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourcePubsubLiteSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	location := d.Get("location").(string)
+	obj := map[string]interface{}{
+		"topic": pubsubLiteResourcePath(project, location, "topics", d.Get("topic").(string)),
+	}
+	if deliveryConfig := expandPubsubLiteSubscriptionDeliveryConfig(d.Get("delivery_config")); deliveryConfig != nil {
+		obj["deliveryConfig"] = deliveryConfig
+	}
+
+	url, err := replaceVars(d, config, pubsubLiteBasePath(config, pubsubLiteRegion(location))+"projects/{{project}}/locations/{{location}}/subscriptions?subscriptionId={{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Subscription: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Subscription: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/subscriptions/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating Subscription %q: %#v", d.Id(), res)
+
+	return resourcePubsubLiteSubscriptionRead(d, meta)
+}
+
+func resourcePubsubLiteSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, pubsubLiteBasePath(config, pubsubLiteRegion(d.Get("location").(string)))+"projects/{{project}}/locations/{{location}}/subscriptions/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("PubsubLiteSubscription %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Subscription: %s", err)
+	}
+	if topic, ok := res["topic"].(string); ok {
+		if err := d.Set("topic", GetResourceNameFromSelfLink(topic)); err != nil {
+			return fmt.Errorf("Error reading Subscription: %s", err)
+		}
+	}
+	if err := d.Set("delivery_config", flattenPubsubLiteSubscriptionDeliveryConfig(res["deliveryConfig"])); err != nil {
+		return fmt.Errorf("Error reading Subscription: %s", err)
+	}
+
+	return nil
+}
+
+func resourcePubsubLiteSubscriptionUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	if deliveryConfig := expandPubsubLiteSubscriptionDeliveryConfig(d.Get("delivery_config")); deliveryConfig != nil {
+		obj["deliveryConfig"] = deliveryConfig
+	}
+
+	url, err := replaceVars(d, config, pubsubLiteBasePath(config, pubsubLiteRegion(d.Get("location").(string)))+"projects/{{project}}/locations/{{location}}/subscriptions/{{name}}?updateMask=deliveryConfig.deliveryRequirement")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Subscription %q: %#v", d.Id(), obj)
+	_, err = sendRequestWithTimeout(config, "PATCH", "", url, obj, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("Error updating Subscription %q: %s", d.Id(), err)
+	}
+
+	return resourcePubsubLiteSubscriptionRead(d, meta)
+}
+
+func resourcePubsubLiteSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, pubsubLiteBasePath(config, pubsubLiteRegion(d.Get("location").(string)))+"projects/{{project}}/locations/{{location}}/subscriptions/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Subscription %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", "", url, nil, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Subscription")
+	}
+
+	log.Printf("[DEBUG] Finished deleting Subscription %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourcePubsubLiteSubscriptionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/subscriptions/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/subscriptions/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandPubsubLiteSubscriptionDeliveryConfig(v interface{}) map[string]interface{} {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	original := l[0].(map[string]interface{})
+	return map[string]interface{}{
+		"deliveryRequirement": original["delivery_requirement"],
+	}
+}
+
+func flattenPubsubLiteSubscriptionDeliveryConfig(v interface{}) interface{} {
+	original, ok := v.(map[string]interface{})
+	if !ok || len(original) == 0 {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"delivery_requirement": original["deliveryRequirement"],
+	}}
+}
@@ -0,0 +1,386 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourcePubsubLiteTopic() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePubsubLiteTopicCreate,
+		Read:   resourcePubsubLiteTopicRead,
+		Update: resourcePubsubLiteTopicUpdate,
+		Delete: resourcePubsubLiteTopicDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourcePubsubLiteTopicImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Update: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		CustomizeDiff: pubsubLiteTopicPartitionCountCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+				Description:      `Name of the topic.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The zone of a zonal topic, e.g. us-central1-a, or the region of a regional topic, e.g. us-central1.`,
+			},
+			"partition_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: `The settings for this topic's partitions.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  `The number of partitions in the topic. Must be at least 1, and can be increased but not decreased.`,
+						},
+						"capacity": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Computed:    true,
+							MaxItems:    1,
+							Description: `The capacity configuration of each partition.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"publish_mib_per_sec": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(4, 16),
+										Description:  `Publish throughput capacity per partition in MiB/s. Must be between 4 and 16.`,
+									},
+									"subscribe_mib_per_sec": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(4, 32),
+										Description:  `Subscribe throughput capacity per partition in MiB/s. Must be between 4 and 32.`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"retention_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: `The settings for a topic's message retention.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"per_partition_bytes": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validatePubsubLitePerPartitionBytes,
+							Description:  `The provisioned storage, in bytes, per partition. If the number of bytes stored in any of the topic's partitions grows beyond this value, older messages will be dropped to make room for newer ones, regardless of the value of period. Must be between 30 GiB and 10 TiB.`,
+						},
+						"period": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `How long a published message is retained. If unset, messages will be retained as long as the bytes retained for each partition is below perPartitionBytes. A duration in seconds with up to nine fractional digits, terminated by 's'. Example: "3.5s".`,
+						},
+					},
+				},
+			},
+			"reservation_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: `The settings for this topic's Reservation usage.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"throughput_reservation": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: compareSelfLinkOrResourceName,
+							Description:      `The Reservation to use for this topic's throughput capacity. It must be in the region of the topic.`,
+						},
+					},
+				},
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func pubsubLiteTopicPartitionCountCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	// separate func to allow unit testing
+	return pubsubLiteTopicPartitionCountCustomizeDiffFunc(diff)
+}
+
+// The partitions of a topic can't be removed once they hold messages.
+func pubsubLiteTopicPartitionCountCustomizeDiffFunc(diff TerraformResourceDiff) error {
+	o, n := diff.GetChange("partition_config.0.count")
+	oldCount, _ := o.(int)
+	newCount, _ := n.(int)
+	if oldCount > 0 && newCount > 0 && newCount < oldCount {
+		return fmt.Errorf("partition_config.0.count can't be decreased from %d to %d, the number of partitions of a topic can only be increased", oldCount, newCount)
+	}
+	return nil
+}
+
+func resourcePubsubLiteTopicCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	location := d.Get("location").(string)
+	obj := expandPubsubLiteTopic(d, project)
+
+	url, err := replaceVars(d, config, pubsubLiteBasePath(config, pubsubLiteRegion(location))+"projects/{{project}}/locations/{{location}}/topics?topicId={{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Topic: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Topic: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/topics/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating Topic %q: %#v", d.Id(), res)
+
+	return resourcePubsubLiteTopicRead(d, meta)
+}
+
+func resourcePubsubLiteTopicRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, pubsubLiteBasePath(config, pubsubLiteRegion(d.Get("location").(string)))+"projects/{{project}}/locations/{{location}}/topics/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", project, url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("PubsubLiteTopic %q", d.Id()))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Topic: %s", err)
+	}
+	if err := d.Set("partition_config", flattenPubsubLiteTopicPartitionConfig(res["partitionConfig"])); err != nil {
+		return fmt.Errorf("Error reading Topic: %s", err)
+	}
+	if err := d.Set("retention_config", flattenPubsubLiteTopicRetentionConfig(res["retentionConfig"])); err != nil {
+		return fmt.Errorf("Error reading Topic: %s", err)
+	}
+	if err := d.Set("reservation_config", flattenPubsubLiteTopicReservationConfig(res["reservationConfig"])); err != nil {
+		return fmt.Errorf("Error reading Topic: %s", err)
+	}
+
+	return nil
+}
+
+func resourcePubsubLiteTopicUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	obj := expandPubsubLiteTopic(d, project)
+
+	updateMask := []string{}
+	if d.HasChange("partition_config.0.count") {
+		updateMask = append(updateMask, "partitionConfig.count")
+	}
+	if d.HasChange("partition_config.0.capacity") {
+		updateMask = append(updateMask, "partitionConfig.capacity")
+	}
+	if d.HasChange("retention_config.0.per_partition_bytes") {
+		updateMask = append(updateMask, "retentionConfig.perPartitionBytes")
+	}
+	if d.HasChange("retention_config.0.period") {
+		updateMask = append(updateMask, "retentionConfig.period")
+	}
+	if d.HasChange("reservation_config") {
+		updateMask = append(updateMask, "reservationConfig.throughputReservation")
+	}
+
+	url, err := replaceVars(d, config, pubsubLiteBasePath(config, pubsubLiteRegion(d.Get("location").(string)))+"projects/{{project}}/locations/{{location}}/topics/{{name}}")
+	if err != nil {
+		return err
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Topic %q: %#v", d.Id(), obj)
+	_, err = sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("Error updating Topic %q: %s", d.Id(), err)
+	}
+
+	return resourcePubsubLiteTopicRead(d, meta)
+}
+
+func resourcePubsubLiteTopicDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, pubsubLiteBasePath(config, pubsubLiteRegion(d.Get("location").(string)))+"projects/{{project}}/locations/{{location}}/topics/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Topic %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", "", url, nil, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Topic")
+	}
+
+	log.Printf("[DEBUG] Finished deleting Topic %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourcePubsubLiteTopicImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/topics/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/topics/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandPubsubLiteTopic(d *schema.ResourceData, project string) map[string]interface{} {
+	obj := make(map[string]interface{})
+
+	if v, ok := d.GetOk("partition_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		original := v.([]interface{})[0].(map[string]interface{})
+		partitionConfig := map[string]interface{}{
+			"count": strconv.Itoa(original["count"].(int)),
+		}
+		if l := original["capacity"].([]interface{}); len(l) > 0 && l[0] != nil {
+			capacity := l[0].(map[string]interface{})
+			partitionConfig["capacity"] = map[string]interface{}{
+				"publishMibPerSec":   capacity["publish_mib_per_sec"],
+				"subscribeMibPerSec": capacity["subscribe_mib_per_sec"],
+			}
+		}
+		obj["partitionConfig"] = partitionConfig
+	}
+
+	if v, ok := d.GetOk("retention_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		original := v.([]interface{})[0].(map[string]interface{})
+		retentionConfig := map[string]interface{}{
+			"perPartitionBytes": original["per_partition_bytes"],
+		}
+		if period := original["period"].(string); period != "" {
+			retentionConfig["period"] = period
+		}
+		obj["retentionConfig"] = retentionConfig
+	}
+
+	reservationConfig := map[string]interface{}{}
+	if v, ok := d.GetOk("reservation_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		original := v.([]interface{})[0].(map[string]interface{})
+		region := pubsubLiteRegion(d.Get("location").(string))
+		if reservation := pubsubLiteResourcePath(project, region, "reservations", original["throughput_reservation"].(string)); reservation != "" {
+			reservationConfig["throughputReservation"] = reservation
+		}
+	}
+	obj["reservationConfig"] = reservationConfig
+
+	return obj
+}
+
+func flattenPubsubLiteTopicPartitionConfig(v interface{}) interface{} {
+	original, ok := v.(map[string]interface{})
+	if !ok || len(original) == 0 {
+		return nil
+	}
+	transformed := map[string]interface{}{
+		"count": flattenPubsubLiteInt(original["count"]),
+	}
+	if capacity, ok := original["capacity"].(map[string]interface{}); ok && len(capacity) > 0 {
+		transformed["capacity"] = []interface{}{map[string]interface{}{
+			"publish_mib_per_sec":   flattenPubsubLiteInt(capacity["publishMibPerSec"]),
+			"subscribe_mib_per_sec": flattenPubsubLiteInt(capacity["subscribeMibPerSec"]),
+		}}
+	}
+	return []interface{}{transformed}
+}
+
+func flattenPubsubLiteTopicRetentionConfig(v interface{}) interface{} {
+	original, ok := v.(map[string]interface{})
+	if !ok || len(original) == 0 {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"per_partition_bytes": original["perPartitionBytes"],
+		"period":              original["period"],
+	}}
+}
+
+func flattenPubsubLiteTopicReservationConfig(v interface{}) interface{} {
+	original, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	reservation, _ := original["throughputReservation"].(string)
+	if reservation == "" {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"throughput_reservation": GetResourceNameFromSelfLink(reservation),
+	}}
+}
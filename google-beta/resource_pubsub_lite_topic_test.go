@@ -0,0 +1,198 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestPubsubLiteTopicPartitionCountCustomizeDiff(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Before, After int
+		ExpectError   bool
+	}{
+		"create": {
+			After: 1,
+		},
+		"unchanged": {
+			Before: 2,
+			After:  2,
+		},
+		"increase": {
+			Before: 1,
+			After:  3,
+		},
+		"decrease": {
+			Before:      3,
+			After:       1,
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &ResourceDiffMock{
+			Before: map[string]interface{}{"partition_config.0.count": tc.Before},
+			After:  map[string]interface{}{"partition_config.0.count": tc.After},
+		}
+		err := pubsubLiteTopicPartitionCountCustomizeDiffFunc(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
+
+func TestValidatePubsubLitePerPartitionBytes(t *testing.T) {
+	x := []StringValidationTestCase{
+		// No errors
+		{TestName: "min", Value: "32212254720"},
+		{TestName: "max", Value: "10995116277760"},
+
+		// With errors
+		{TestName: "too small", Value: "1073741824", ExpectError: true},
+		{TestName: "too large", Value: "10995116277761", ExpectError: true},
+		{TestName: "not a number", Value: "30GiB", ExpectError: true},
+	}
+
+	es := testStringValidationCases(x, validatePubsubLitePerPartitionBytes)
+	if len(es) > 0 {
+		t.Errorf("Failed to validate per partition bytes: %v", es)
+	}
+}
+
+func TestPubsubLiteRegion(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"us-central1-a":   "us-central1",
+		"us-central1":     "us-central1",
+		"europe-west1-b":  "europe-west1",
+		"asia-southeast1": "asia-southeast1",
+	}
+
+	for location, expected := range cases {
+		if region := pubsubLiteRegion(location); region != expected {
+			t.Errorf("expected the region of %q to be %q, got %q", location, expected, region)
+		}
+	}
+}
+
+func TestAccPubsubLiteTopic_withReservationAndSubscription(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPubsubLiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPubsubLiteTopic_withReservationAndSubscription(suffix, 1, "DELIVER_IMMEDIATELY"),
+			},
+			{
+				ResourceName:      "google_pubsub_lite_reservation.reservation",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "google_pubsub_lite_topic.topic",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "google_pubsub_lite_subscription.subscription",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPubsubLiteTopic_withReservationAndSubscription(suffix, 2, "DELIVER_AFTER_STORED"),
+			},
+			{
+				ResourceName:      "google_pubsub_lite_topic.topic",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "google_pubsub_lite_subscription.subscription",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPubsubLiteTopic_withReservationAndSubscription(suffix string, partitions int, deliveryRequirement string) string {
+	return fmt.Sprintf(`
+resource "google_pubsub_lite_reservation" "reservation" {
+  name                = "tf-test-reservation-%s"
+  region              = "us-central1"
+  throughput_capacity = 2
+}
+
+resource "google_pubsub_lite_topic" "topic" {
+  name     = "tf-test-topic-%s"
+  location = "us-central1-a"
+
+  partition_config {
+    count = %d
+
+    capacity {
+      publish_mib_per_sec   = 4
+      subscribe_mib_per_sec = 8
+    }
+  }
+
+  retention_config {
+    per_partition_bytes = "32212254720"
+  }
+
+  reservation_config {
+    throughput_reservation = google_pubsub_lite_reservation.reservation.name
+  }
+}
+
+resource "google_pubsub_lite_subscription" "subscription" {
+  name     = "tf-test-sub-%s"
+  location = google_pubsub_lite_topic.topic.location
+  topic    = google_pubsub_lite_topic.topic.name
+
+  delivery_config {
+    delivery_requirement = "%s"
+  }
+}
+`, suffix, suffix, partitions, suffix, deliveryRequirement)
+}
+
+func testAccCheckPubsubLiteDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if !strings.HasPrefix(rs.Type, "google_pubsub_lite_") {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		location := rs.Primary.Attributes["location"]
+		if rs.Type == "google_pubsub_lite_reservation" {
+			location = rs.Primary.Attributes["region"]
+		}
+		url := pubsubLiteBasePath(config, pubsubLiteRegion(location)) + rs.Primary.ID
+		_, err := sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("%s still exists at %s", rs.Type, url)
+		}
+	}
+
+	return nil
+}
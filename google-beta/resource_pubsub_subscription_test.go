@@ -153,6 +153,83 @@ resource "google_pubsub_subscription" "foo" {
 `, topic, subscription, label, deadline)
 }
 
+func TestAccPubsubSubscription_bigqueryConfig(t *testing.T) {
+	t.Parallel()
+
+	dataset := fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+	topic := fmt.Sprintf("tf-test-topic-%s", acctest.RandString(10))
+	subscription := fmt.Sprintf("tf-test-sub-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPubsubSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPubsubSubscription_bigqueryConfig(dataset, topic, subscription),
+			},
+			{
+				ResourceName:      "google_pubsub_subscription.foo",
+				ImportStateId:     fmt.Sprintf("projects/%s/subscriptions/%s", getTestProjectFromEnv(), subscription),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPubsubSubscription_bigqueryConfig(dataset, topic, subscription string) string {
+	return fmt.Sprintf(`
+data "google_project" "project" {}
+
+resource "google_project_iam_member" "editor" {
+	role   = "roles/bigquery.dataEditor"
+	member = "serviceAccount:service-${data.google_project.project.number}@gcp-sa-pubsub.iam.gserviceaccount.com"
+}
+
+resource "google_project_iam_member" "viewer" {
+	role   = "roles/bigquery.metadataViewer"
+	member = "serviceAccount:service-${data.google_project.project.number}@gcp-sa-pubsub.iam.gserviceaccount.com"
+}
+
+resource "google_bigquery_dataset" "test" {
+	dataset_id = "%s"
+}
+
+resource "google_bigquery_table" "test" {
+	dataset_id = "${google_bigquery_dataset.test.dataset_id}"
+	table_id   = "test"
+
+	schema = <<EOS
+[
+	{
+		"name": "data",
+		"type": "STRING",
+		"mode": "NULLABLE",
+		"description": "The data"
+	}
+]
+EOS
+}
+
+resource "google_pubsub_topic" "foo" {
+	name = "%s"
+}
+
+resource "google_pubsub_subscription" "foo" {
+	name  = "%s"
+	topic = "${google_pubsub_topic.foo.id}"
+
+	bigquery_config {
+		table          = "${google_bigquery_table.test.project}.${google_bigquery_table.test.dataset_id}.${google_bigquery_table.test.table_id}"
+		write_metadata = true
+	}
+
+	depends_on = ["google_project_iam_member.editor", "google_project_iam_member.viewer"]
+}
+`, dataset, topic, subscription)
+}
+
 func TestGetComputedTopicName(t *testing.T) {
 	type testData struct {
 		project  string
@@ -76,7 +76,7 @@ func testAccCheckPubsubTopicDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("PubsubTopic still exists at %s", url)
 		}
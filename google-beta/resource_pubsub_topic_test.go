@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestAccPubsubTopic_update(t *testing.T) {
@@ -63,6 +64,192 @@ func TestAccPubsubTopic_cmek(t *testing.T) {
 	})
 }
 
+func TestAccPubsubTopic_defaultLabels(t *testing.T) {
+	t.Parallel()
+
+	topic := fmt.Sprintf("tf-test-topic-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPubsubTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPubsubTopic_defaultLabels(topic),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_pubsub_topic.foo", "labels.%", "1"),
+					resource.TestCheckResourceAttr("google_pubsub_topic.foo", "labels.team", "data"),
+					resource.TestCheckResourceAttr("google_pubsub_topic.foo", "terraform_labels.%", "2"),
+					resource.TestCheckResourceAttr("google_pubsub_topic.foo", "terraform_labels.cost-center", "cc-1234"),
+					resource.TestCheckResourceAttr("google_pubsub_topic.foo", "terraform_labels.team", "data"),
+					resource.TestCheckResourceAttr("google_pubsub_topic.foo", "effective_labels.%", "2"),
+				),
+			},
+			{
+				ResourceName:      "google_pubsub_topic.foo",
+				ImportStateId:     topic,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Remove the default label out of band, which the next
+				// refresh reports as drift in terraform_labels.
+				Config:             testAccPubsubTopic_defaultLabels(topic),
+				Check:              testAccCheckPubsubTopicSetLabelsOutOfBand(topic, map[string]string{"team": "data"}),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccPubsubTopic_defaultLabels(topic),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_pubsub_topic.foo", "labels.%", "1"),
+					resource.TestCheckResourceAttr("google_pubsub_topic.foo", "terraform_labels.cost-center", "cc-1234"),
+					resource.TestCheckResourceAttr("google_pubsub_topic.foo", "effective_labels.cost-center", "cc-1234"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPubsubTopic_terraformAttributionLabel(t *testing.T) {
+	t.Parallel()
+
+	topic := fmt.Sprintf("tf-test-topic-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPubsubTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPubsubTopic_terraformAttributionLabel(topic),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_pubsub_topic.foo", "labels.%", "1"),
+					resource.TestCheckResourceAttr("google_pubsub_topic.foo", "terraform_labels.goog-terraform-provisioned", "true"),
+					resource.TestCheckResourceAttr("google_pubsub_topic.foo", "effective_labels.goog-terraform-provisioned", "true"),
+				),
+			},
+			{
+				ResourceName:      "google_pubsub_topic.foo",
+				ImportStateId:     topic,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPubsubTopic_userProjectOverride(t *testing.T) {
+	t.Parallel()
+
+	topic := fmt.Sprintf("tf-test-topic-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPubsubTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPubsubTopic_userProjectOverride(getTestProjectFromEnv(), topic),
+			},
+			{
+				ResourceName:      "google_pubsub_topic.foo",
+				ImportStateId:     topic,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPubsubTopic_messageStoragePolicy(t *testing.T) {
+	t.Parallel()
+
+	topic := fmt.Sprintf("tf-test-topic-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPubsubTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPubsubTopic_messageStoragePolicy(topic, `"europe-west1"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_pubsub_topic.foo", "message_storage_policy.0.allowed_persistence_regions.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "google_pubsub_topic.foo",
+				ImportStateId:     topic,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPubsubTopic_messageStoragePolicy(topic, `"europe-west1", "europe-west3"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_pubsub_topic.foo", "message_storage_policy.0.allowed_persistence_regions.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "google_pubsub_topic.foo",
+				ImportStateId:     topic,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// The Kinesis stream doesn't need to exist: Pub/Sub accepts the settings and
+// reports the failure to ingest through the topic's state.
+func TestAccPubsubTopic_ingestionAwsKinesis(t *testing.T) {
+	t.Parallel()
+
+	topic := fmt.Sprintf("tf-test-topic-%s", acctest.RandString(10))
+	account := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPubsubTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPubsubTopic_ingestionAwsKinesis(topic, account, "fake-role-name"),
+			},
+			{
+				ResourceName:      "google_pubsub_topic.foo",
+				ImportStateId:     topic,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPubsubTopic_ingestionAwsKinesis(topic, account, "another-fake-role-name"),
+			},
+			{
+				ResourceName:      "google_pubsub_topic.foo",
+				ImportStateId:     topic,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPubsubTopicSetLabelsOutOfBand(topic string, labels map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		url := fmt.Sprintf("%sprojects/%s/topics/%s", config.PubsubBasePath, getTestProjectFromEnv(), topic)
+		obj := map[string]interface{}{
+			"topic": map[string]interface{}{
+				"labels": labels,
+			},
+			"updateMask": "labels",
+		}
+		_, err := sendRequest(config, "PATCH", "", url, obj)
+		return err
+	}
+}
+
 func testAccPubsubTopic_update(topic, key, value string) string {
 	return fmt.Sprintf(`
 resource "google_pubsub_topic" "foo" {
@@ -93,3 +280,82 @@ resource "google_pubsub_topic" "topic" {
 }
 `, pid, topicName, kmsKey)
 }
+
+func testAccPubsubTopic_defaultLabels(topic string) string {
+	return fmt.Sprintf(`
+provider "google" {
+	default_labels = {
+		cost-center = "cc-1234"
+		team        = "platform"
+	}
+}
+
+resource "google_pubsub_topic" "foo" {
+	name = "%s"
+	labels = {
+		team = "data"
+	}
+}
+`, topic)
+}
+
+func testAccPubsubTopic_terraformAttributionLabel(topic string) string {
+	return fmt.Sprintf(`
+provider "google" {
+	add_terraform_attribution_label = true
+}
+
+resource "google_pubsub_topic" "foo" {
+	name = "%s"
+	labels = {
+		team = "data"
+	}
+}
+`, topic)
+}
+
+func testAccPubsubTopic_userProjectOverride(billingProject, topic string) string {
+	return fmt.Sprintf(`
+provider "google" {
+	user_project_override = true
+	billing_project       = "%s"
+}
+
+resource "google_pubsub_topic" "foo" {
+	name = "%s"
+}
+`, billingProject, topic)
+}
+
+func testAccPubsubTopic_messageStoragePolicy(topic, regions string) string {
+	return fmt.Sprintf(`
+resource "google_pubsub_topic" "foo" {
+	name = "%s"
+
+	message_storage_policy {
+		allowed_persistence_regions = [%s]
+	}
+}
+`, topic, regions)
+}
+
+func testAccPubsubTopic_ingestionAwsKinesis(topic, account, role string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "ingestion" {
+	account_id = "%s"
+}
+
+resource "google_pubsub_topic" "foo" {
+	name = "%s"
+
+	ingestion_data_source_settings {
+		aws_kinesis {
+			stream_arn          = "arn:aws:kinesis:us-west-2:111111111111:stream/fake-stream-name"
+			consumer_arn        = "arn:aws:kinesis:us-west-2:111111111111:stream/fake-stream-name/consumer/consumer-1:1111111111"
+			aws_role_arn        = "arn:aws:iam::111111111111:role/%s"
+			gcp_service_account = "${google_service_account.ingestion.email}"
+		}
+	}
+}
+`, account, topic, role)
+}
@@ -0,0 +1,136 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccRecaptchaEnterpriseKey_recaptchaEnterpriseKeyWebScoreExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRecaptchaEnterpriseKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecaptchaEnterpriseKey_recaptchaEnterpriseKeyWebScoreExample(context),
+			},
+			{
+				ResourceName:      "google_recaptcha_enterprise_key.primary",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRecaptchaEnterpriseKey_recaptchaEnterpriseKeyWebScoreExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_recaptcha_enterprise_key" "primary" {
+  display_name = "display-name-one"
+
+  labels = {
+    label-one = "value-one"
+  }
+
+  testing_options {
+    testing_score = 0.5
+  }
+
+  web_settings {
+    integration_type  = "SCORE"
+    allow_all_domains = true
+    allow_amp_traffic = false
+  }
+}
+`, context)
+}
+
+func TestAccRecaptchaEnterpriseKey_recaptchaEnterpriseKeyAndroidExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRecaptchaEnterpriseKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecaptchaEnterpriseKey_recaptchaEnterpriseKeyAndroidExample(context),
+			},
+			{
+				ResourceName:      "google_recaptcha_enterprise_key.primary",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRecaptchaEnterpriseKey_recaptchaEnterpriseKeyAndroidExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_recaptcha_enterprise_key" "primary" {
+  display_name = "display-name-one"
+
+  android_settings {
+    allow_all_package_names = true
+  }
+
+  labels = {
+    label-one = "value-one"
+  }
+}
+`, context)
+}
+
+func testAccCheckRecaptchaEnterpriseKeyDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_recaptcha_enterprise_key" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(config, rs, "{{RecaptchaEnterpriseBasePath}}projects/{{project}}/keys/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("RecaptchaEnterpriseKey still exists at %s", url)
+		}
+	}
+
+	return nil
+}
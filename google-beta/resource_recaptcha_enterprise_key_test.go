@@ -0,0 +1,96 @@
+package google
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccRecaptchaEnterpriseKey_webUpdate(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRecaptchaEnterpriseKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecaptchaEnterpriseKey_web("display-name-one", "example.com"),
+				Check:  resource.TestCheckResourceAttrSet("google_recaptcha_enterprise_key.primary", "name"),
+			},
+			{
+				ResourceName:      "google_recaptcha_enterprise_key.primary",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRecaptchaEnterpriseKey_web("display-name-two", "subdomain.example.com"),
+			},
+			{
+				ResourceName:      "google_recaptcha_enterprise_key.primary",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRecaptchaEnterpriseKey_invalidPlatformSettings(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRecaptchaEnterpriseKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRecaptchaEnterpriseKey_noPlatform(),
+				ExpectError: regexp.MustCompile("exactly one of web_settings, android_settings or ios_settings must be set"),
+			},
+			{
+				Config:      testAccRecaptchaEnterpriseKey_iosNoBundleIds(),
+				ExpectError: regexp.MustCompile("one of ios_settings.0.allow_all_bundle_ids or ios_settings.0.allowed_bundle_ids must be set"),
+			},
+		},
+	})
+}
+
+func testAccRecaptchaEnterpriseKey_web(displayName, domain string) string {
+	return fmt.Sprintf(`
+resource "google_recaptcha_enterprise_key" "primary" {
+  display_name = "%s"
+
+  labels = {
+    display-name = "%s"
+  }
+
+  web_settings {
+    integration_type              = "CHECKBOX"
+    allowed_domains               = ["%s"]
+    challenge_security_preference = "USABILITY"
+  }
+}
+`, displayName, displayName, domain)
+}
+
+func testAccRecaptchaEnterpriseKey_noPlatform() string {
+	return `
+resource "google_recaptcha_enterprise_key" "primary" {
+  display_name = "display-name-one"
+}
+`
+}
+
+func testAccRecaptchaEnterpriseKey_iosNoBundleIds() string {
+	return `
+resource "google_recaptcha_enterprise_key" "primary" {
+  display_name = "display-name-one"
+
+  ios_settings {
+    allow_all_bundle_ids = false
+  }
+}
+`
+}
@@ -127,7 +127,7 @@ func testAccCheckRedisInstanceDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("RedisInstance still exists at %s", url)
 		}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	resourceManager "google.golang.org/api/cloudresourcemanager/v1"
 )
@@ -46,6 +47,27 @@ func TestAccResourceManagerLien_basic(t *testing.T) {
 	})
 }
 
+func TestResourceManagerLien_restrictionsValidation(t *testing.T) {
+	x := []StringValidationTestCase{
+		// No errors
+		{TestName: "project delete", Value: "resourcemanager.projects.delete"},
+		{TestName: "camel case verb", Value: "resourcemanager.projects.updateLiens"},
+
+		// With errors
+		{TestName: "empty", Value: "", ExpectError: true},
+		{TestName: "missing verb", Value: "resourcemanager.projects", ExpectError: true},
+		{TestName: "role", Value: "roles/owner", ExpectError: true},
+		{TestName: "wildcard", Value: "resourcemanager.projects.*", ExpectError: true},
+		{TestName: "whitespace", Value: "resourcemanager.projects.delete ", ExpectError: true},
+	}
+
+	validate := resourceResourceManagerLien().Schema["restrictions"].Elem.(*schema.Schema).ValidateFunc
+	es := testStringValidationCases(x, validate)
+	if len(es) > 0 {
+		t.Errorf("Failed to validate lien restrictions: %v", es)
+	}
+}
+
 func testAccCheckResourceManagerLienExists(n, projectName string, lien *resourceManager.Lien) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
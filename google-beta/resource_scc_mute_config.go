@@ -0,0 +1,291 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceSecurityCenterMuteConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSecurityCenterMuteConfigCreate,
+		Read:   resourceSecurityCenterMuteConfigRead,
+		Update: resourceSecurityCenterMuteConfigUpdate,
+		Delete: resourceSecurityCenterMuteConfigDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceSecurityCenterMuteConfigImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Update: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateSecurityCenterFilter,
+			},
+			"mute_config_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"parent": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegexp(`^(organizations|folders|projects)/[^/]+$`),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"most_recent_editor": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSecurityCenterMuteConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandSecurityCenterMuteConfigDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	filterProp, err := expandSecurityCenterMuteConfigFilter(d.Get("filter"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("filter"); !isEmptyValue(reflect.ValueOf(filterProp)) && (ok || !reflect.DeepEqual(v, filterProp)) {
+		obj["filter"] = filterProp
+	}
+
+	url, err := replaceVars(d, config, "{{SecurityCenterBasePath}}{{parent}}/muteConfigs?muteConfigId={{mute_config_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new MuteConfig: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", "", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating MuteConfig: %s", err)
+	}
+
+	// The full resource name is only known once the resource is created
+	if err := d.Set("name", flattenSecurityCenterMuteConfigName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error setting name: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating MuteConfig %q: %#v", d.Id(), res)
+
+	return resourceSecurityCenterMuteConfigRead(d, meta)
+}
+
+func resourceSecurityCenterMuteConfigRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{SecurityCenterBasePath}}{{name}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", "", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("SecurityCenterMuteConfig %q", d.Id()))
+	}
+
+	if err := d.Set("name", flattenSecurityCenterMuteConfigName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading MuteConfig: %s", err)
+	}
+	if err := d.Set("description", flattenSecurityCenterMuteConfigDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading MuteConfig: %s", err)
+	}
+	if err := d.Set("filter", flattenSecurityCenterMuteConfigFilter(res["filter"], d)); err != nil {
+		return fmt.Errorf("Error reading MuteConfig: %s", err)
+	}
+	if err := d.Set("create_time", flattenSecurityCenterMuteConfigCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading MuteConfig: %s", err)
+	}
+	if err := d.Set("update_time", flattenSecurityCenterMuteConfigUpdateTime(res["updateTime"], d)); err != nil {
+		return fmt.Errorf("Error reading MuteConfig: %s", err)
+	}
+	if err := d.Set("most_recent_editor", flattenSecurityCenterMuteConfigMostRecentEditor(res["mostRecentEditor"], d)); err != nil {
+		return fmt.Errorf("Error reading MuteConfig: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSecurityCenterMuteConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandSecurityCenterMuteConfigDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	filterProp, err := expandSecurityCenterMuteConfigFilter(d.Get("filter"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("filter"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, filterProp)) {
+		obj["filter"] = filterProp
+	}
+
+	url, err := replaceVars(d, config, "{{SecurityCenterBasePath}}{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating MuteConfig %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("description") {
+		updateMask = append(updateMask, "description")
+	}
+
+	if d.HasChange("filter") {
+		updateMask = append(updateMask, "filter")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	res, err := sendRequestWithTimeout(config, "PATCH", "", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating MuteConfig %q: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Finished updating MuteConfig %q: %#v", d.Id(), res)
+
+	return resourceSecurityCenterMuteConfigRead(d, meta)
+}
+
+func resourceSecurityCenterMuteConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{SecurityCenterBasePath}}{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting MuteConfig %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", "", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "MuteConfig")
+	}
+
+	log.Printf("[DEBUG] Finished deleting MuteConfig %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceSecurityCenterMuteConfigImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+
+	// current import_formats can't import fields with forward slashes in their value
+	if err := parseImportId([]string{"(?P<name>.+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	stringParts := strings.Split(d.Get("name").(string), "/")
+	if len(stringParts) != 4 || stringParts[2] != "muteConfigs" {
+		return nil, fmt.Errorf(
+			"Saw %s when the name is expected to have shape %s",
+			d.Get("name"),
+			"{{parent_type}}/{{parent}}/muteConfigs/{{mute_config_id}}",
+		)
+	}
+
+	if err := d.Set("parent", stringParts[0]+"/"+stringParts[1]); err != nil {
+		return nil, fmt.Errorf("Error setting parent: %s", err)
+	}
+	if err := d.Set("mute_config_id", stringParts[3]); err != nil {
+		return nil, fmt.Errorf("Error setting mute_config_id: %s", err)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenSecurityCenterMuteConfigName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSecurityCenterMuteConfigDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSecurityCenterMuteConfigFilter(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSecurityCenterMuteConfigCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSecurityCenterMuteConfigUpdateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSecurityCenterMuteConfigMostRecentEditor(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandSecurityCenterMuteConfigDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandSecurityCenterMuteConfigFilter(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,58 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccSecurityCenterMuteConfig_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"org_id":        getTestOrgFromEnv(t),
+		"random_suffix": acctest.RandString(10),
+		"filter":        `category = \"OS_VULNERABILITY\" AND severity = \"LOW\"`,
+	}
+	updated := map[string]interface{}{
+		"org_id":        context["org_id"],
+		"random_suffix": context["random_suffix"],
+		"filter":        `category = \"OS_VULNERABILITY\" AND (severity = \"LOW\" OR severity = \"MEDIUM\")`,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSecurityCenterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityCenterMuteConfig_basic(context),
+			},
+			{
+				ResourceName:      "google_scc_mute_config.mute",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSecurityCenterMuteConfig_basic(updated),
+			},
+			{
+				ResourceName:      "google_scc_mute_config.mute",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSecurityCenterMuteConfig_basic(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_scc_mute_config" "mute" {
+  mute_config_id = "tf-test-mute-%{random_suffix}"
+  parent         = "organizations/%{org_id}"
+  description    = "Mutes low severity OS vulnerabilities"
+  filter         = "%{filter}"
+}
+`, context)
+}
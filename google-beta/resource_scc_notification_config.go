@@ -0,0 +1,341 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceSecurityCenterNotificationConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSecurityCenterNotificationConfigCreate,
+		Read:   resourceSecurityCenterNotificationConfigRead,
+		Update: resourceSecurityCenterNotificationConfigUpdate,
+		Delete: resourceSecurityCenterNotificationConfigDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceSecurityCenterNotificationConfigImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Update: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"config_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"pubsub_topic": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateRegexp(`^projects/[^/]+/topics/[^/]+$`),
+			},
+			"streaming_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"filter": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateSecurityCenterFilter,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_account": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSecurityCenterNotificationConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandSecurityCenterNotificationConfigDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	pubsubTopicProp, err := expandSecurityCenterNotificationConfigPubsubTopic(d.Get("pubsub_topic"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("pubsub_topic"); !isEmptyValue(reflect.ValueOf(pubsubTopicProp)) && (ok || !reflect.DeepEqual(v, pubsubTopicProp)) {
+		obj["pubsubTopic"] = pubsubTopicProp
+	}
+	streamingConfigProp, err := expandSecurityCenterNotificationConfigStreamingConfig(d.Get("streaming_config"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("streaming_config"); !isEmptyValue(reflect.ValueOf(streamingConfigProp)) && (ok || !reflect.DeepEqual(v, streamingConfigProp)) {
+		obj["streamingConfig"] = streamingConfigProp
+	}
+
+	url, err := replaceVars(d, config, "{{SecurityCenterBasePath}}organizations/{{organization}}/notificationConfigs?configId={{config_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new NotificationConfig: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", "", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating NotificationConfig: %s", err)
+	}
+
+	// The full resource name is only known once the resource is created
+	if err := d.Set("name", flattenSecurityCenterNotificationConfigName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error setting name: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating NotificationConfig %q: %#v", d.Id(), res)
+
+	return resourceSecurityCenterNotificationConfigRead(d, meta)
+}
+
+func resourceSecurityCenterNotificationConfigRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{SecurityCenterBasePath}}{{name}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", "", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("SecurityCenterNotificationConfig %q", d.Id()))
+	}
+
+	if err := d.Set("name", flattenSecurityCenterNotificationConfigName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading NotificationConfig: %s", err)
+	}
+	if err := d.Set("description", flattenSecurityCenterNotificationConfigDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading NotificationConfig: %s", err)
+	}
+	if err := d.Set("pubsub_topic", flattenSecurityCenterNotificationConfigPubsubTopic(res["pubsubTopic"], d)); err != nil {
+		return fmt.Errorf("Error reading NotificationConfig: %s", err)
+	}
+	if err := d.Set("service_account", flattenSecurityCenterNotificationConfigServiceAccount(res["serviceAccount"], d)); err != nil {
+		return fmt.Errorf("Error reading NotificationConfig: %s", err)
+	}
+	if err := d.Set("streaming_config", flattenSecurityCenterNotificationConfigStreamingConfig(res["streamingConfig"], d)); err != nil {
+		return fmt.Errorf("Error reading NotificationConfig: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSecurityCenterNotificationConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandSecurityCenterNotificationConfigDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	pubsubTopicProp, err := expandSecurityCenterNotificationConfigPubsubTopic(d.Get("pubsub_topic"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("pubsub_topic"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, pubsubTopicProp)) {
+		obj["pubsubTopic"] = pubsubTopicProp
+	}
+	streamingConfigProp, err := expandSecurityCenterNotificationConfigStreamingConfig(d.Get("streaming_config"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("streaming_config"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, streamingConfigProp)) {
+		obj["streamingConfig"] = streamingConfigProp
+	}
+
+	url, err := replaceVars(d, config, "{{SecurityCenterBasePath}}{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating NotificationConfig %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("description") {
+		updateMask = append(updateMask, "description")
+	}
+
+	if d.HasChange("pubsub_topic") {
+		updateMask = append(updateMask, "pubsubTopic")
+	}
+
+	if d.HasChange("streaming_config") {
+		updateMask = append(updateMask, "streamingConfig.filter")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	res, err := sendRequestWithTimeout(config, "PATCH", "", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating NotificationConfig %q: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Finished updating NotificationConfig %q: %#v", d.Id(), res)
+
+	return resourceSecurityCenterNotificationConfigRead(d, meta)
+}
+
+func resourceSecurityCenterNotificationConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{SecurityCenterBasePath}}{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting NotificationConfig %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", "", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "NotificationConfig")
+	}
+
+	log.Printf("[DEBUG] Finished deleting NotificationConfig %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceSecurityCenterNotificationConfigImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+
+	// current import_formats can't import fields with forward slashes in their value
+	if err := parseImportId([]string{"(?P<name>.+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	stringParts := strings.Split(d.Get("name").(string), "/")
+	if len(stringParts) != 4 || stringParts[0] != "organizations" || stringParts[2] != "notificationConfigs" {
+		return nil, fmt.Errorf(
+			"Saw %s when the name is expected to have shape %s",
+			d.Get("name"),
+			"organizations/{{organization}}/notificationConfigs/{{config_id}}",
+		)
+	}
+
+	if err := d.Set("organization", stringParts[1]); err != nil {
+		return nil, fmt.Errorf("Error setting organization: %s", err)
+	}
+	if err := d.Set("config_id", stringParts[3]); err != nil {
+		return nil, fmt.Errorf("Error setting config_id: %s", err)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenSecurityCenterNotificationConfigName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSecurityCenterNotificationConfigDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSecurityCenterNotificationConfigPubsubTopic(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSecurityCenterNotificationConfigServiceAccount(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSecurityCenterNotificationConfigStreamingConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["filter"] =
+		flattenSecurityCenterNotificationConfigStreamingConfigFilter(original["filter"], d)
+	return []interface{}{transformed}
+}
+func flattenSecurityCenterNotificationConfigStreamingConfigFilter(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandSecurityCenterNotificationConfigDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandSecurityCenterNotificationConfigPubsubTopic(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandSecurityCenterNotificationConfigStreamingConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedFilter, err := expandSecurityCenterNotificationConfigStreamingConfigFilter(original["filter"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedFilter); val.IsValid() && !isEmptyValue(val) {
+		transformed["filter"] = transformedFilter
+	}
+
+	return transformed, nil
+}
+
+func expandSecurityCenterNotificationConfigStreamingConfigFilter(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,96 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccSecurityCenterNotificationConfig_highSeverity(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"org_id":        getTestOrgFromEnv(t),
+		"random_suffix": acctest.RandString(10),
+		"description":   "Streams HIGH severity findings",
+		"filter":        `severity = \"HIGH\" AND state = \"ACTIVE\"`,
+	}
+	updated := map[string]interface{}{
+		"org_id":        context["org_id"],
+		"random_suffix": context["random_suffix"],
+		"description":   "Streams HIGH and CRITICAL severity findings",
+		"filter":        `(severity = \"HIGH\" OR severity = \"CRITICAL\") AND state = \"ACTIVE\"`,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSecurityCenterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityCenterNotificationConfig_highSeverity(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("google_scc_notification_config.config", "service_account"),
+				),
+			},
+			{
+				ResourceName:      "google_scc_notification_config.config",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSecurityCenterNotificationConfig_highSeverity(updated),
+			},
+			{
+				ResourceName:      "google_scc_notification_config.config",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSecurityCenterNotificationConfig_highSeverity(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_pubsub_topic" "scc_notification" {
+  name = "tf-test-scc-notification-%{random_suffix}"
+}
+
+resource "google_scc_notification_config" "config" {
+  config_id    = "tf-test-config-%{random_suffix}"
+  organization = "%{org_id}"
+  description  = "%{description}"
+  pubsub_topic = google_pubsub_topic.scc_notification.id
+
+  streaming_config {
+    filter = "%{filter}"
+  }
+}
+`, context)
+}
+
+func testAccCheckSecurityCenterDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		// Sources can't be deleted, they're only removed from state.
+		if rs.Type != "google_scc_notification_config" && rs.Type != "google_scc_mute_config" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url := fmt.Sprintf("%s%s", config.SecurityCenterBasePath, rs.Primary.ID)
+		_, err := sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("%s still exists at %s", rs.Type, url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,239 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceSecurityCenterSource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSecurityCenterSourceCreate,
+		Read:   resourceSecurityCenterSourceRead,
+		Update: resourceSecurityCenterSourceUpdate,
+		Delete: resourceSecurityCenterSourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceSecurityCenterSourceImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Update: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateRegexp(`^[\p{L}\p{N}]([\p{L}\p{N}_ -]{0,30}[\p{L}\p{N}])?$`),
+			},
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSecurityCenterSourceCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandSecurityCenterSourceDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	displayNameProp, err := expandSecurityCenterSourceDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(displayNameProp)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+
+	url, err := replaceVars(d, config, "{{SecurityCenterBasePath}}organizations/{{organization}}/sources")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Source: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", "", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Source: %s", err)
+	}
+
+	// The full resource name is only known once the resource is created
+	if err := d.Set("name", flattenSecurityCenterSourceName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error setting name: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating Source %q: %#v", d.Id(), res)
+
+	return resourceSecurityCenterSourceRead(d, meta)
+}
+
+func resourceSecurityCenterSourceRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{SecurityCenterBasePath}}{{name}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", "", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("SecurityCenterSource %q", d.Id()))
+	}
+
+	if err := d.Set("name", flattenSecurityCenterSourceName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading Source: %s", err)
+	}
+	if err := d.Set("description", flattenSecurityCenterSourceDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading Source: %s", err)
+	}
+	if err := d.Set("display_name", flattenSecurityCenterSourceDisplayName(res["displayName"], d)); err != nil {
+		return fmt.Errorf("Error reading Source: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSecurityCenterSourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandSecurityCenterSourceDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	displayNameProp, err := expandSecurityCenterSourceDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+
+	url, err := replaceVars(d, config, "{{SecurityCenterBasePath}}{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Source %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("description") {
+		updateMask = append(updateMask, "description")
+	}
+
+	if d.HasChange("display_name") {
+		updateMask = append(updateMask, "displayName")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	res, err := sendRequestWithTimeout(config, "PATCH", "", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Source %q: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Finished updating Source %q: %#v", d.Id(), res)
+
+	return resourceSecurityCenterSourceRead(d, meta)
+}
+
+func resourceSecurityCenterSourceDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARNING] SecurityCenter Source resources"+
+		" cannot be deleted from GCP. The resource %s will be removed from Terraform"+
+		" state, but will still be present on the server.", d.Id())
+	d.SetId("")
+
+	return nil
+}
+
+func resourceSecurityCenterSourceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+
+	// current import_formats can't import fields with forward slashes in their value
+	if err := parseImportId([]string{"(?P<name>.+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	stringParts := strings.Split(d.Get("name").(string), "/")
+	if len(stringParts) != 4 || stringParts[0] != "organizations" || stringParts[2] != "sources" {
+		return nil, fmt.Errorf(
+			"Saw %s when the name is expected to have shape %s",
+			d.Get("name"),
+			"organizations/{{organization}}/sources/{{source}}",
+		)
+	}
+
+	if err := d.Set("organization", stringParts[1]); err != nil {
+		return nil, fmt.Errorf("Error setting organization: %s", err)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenSecurityCenterSourceName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSecurityCenterSourceDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSecurityCenterSourceDisplayName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandSecurityCenterSourceDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandSecurityCenterSourceDisplayName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,56 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccSecurityCenterSource_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"org_id":        getTestOrgFromEnv(t),
+		"random_suffix": acctest.RandString(10),
+		"description":   "My custom Cloud Security Command Center Finding Source",
+	}
+	updated := map[string]interface{}{
+		"org_id":        context["org_id"],
+		"random_suffix": context["random_suffix"],
+		"description":   "My updated Cloud Security Command Center Finding Source",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityCenterSource_basic(context),
+			},
+			{
+				ResourceName:      "google_scc_source.custom_source",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSecurityCenterSource_basic(updated),
+			},
+			{
+				ResourceName:      "google_scc_source.custom_source",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSecurityCenterSource_basic(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_scc_source" "custom_source" {
+  display_name = "TF Source %{random_suffix}"
+  organization = "%{org_id}"
+  description  = "%{description}"
+}
+`, context)
+}
@@ -0,0 +1,308 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceSecurityCenterV2OrganizationMuteConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSecurityCenterV2OrganizationMuteConfigCreate,
+		Read:   resourceSecurityCenterV2OrganizationMuteConfigRead,
+		Update: resourceSecurityCenterV2OrganizationMuteConfigUpdate,
+		Delete: resourceSecurityCenterV2OrganizationMuteConfigDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceSecurityCenterV2OrganizationMuteConfigImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateSecurityCenterFilter,
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "global",
+			},
+			"mute_config_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"STATIC", "DYNAMIC"}, false),
+				Default:      "STATIC",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"most_recent_editor": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSecurityCenterV2OrganizationMuteConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandSecurityCenterV2OrganizationMuteConfigDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	filterProp, err := expandSecurityCenterV2OrganizationMuteConfigFilter(d.Get("filter"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("filter"); !isEmptyValue(reflect.ValueOf(filterProp)) && (ok || !reflect.DeepEqual(v, filterProp)) {
+		obj["filter"] = filterProp
+	}
+	typeProp, err := expandSecurityCenterV2OrganizationMuteConfigType(d.Get("type"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("type"); !isEmptyValue(reflect.ValueOf(typeProp)) && (ok || !reflect.DeepEqual(v, typeProp)) {
+		obj["type"] = typeProp
+	}
+
+	url, err := replaceVars(d, config, "{{SecurityCenterV2BasePath}}organizations/{{organization}}/locations/{{location}}/muteConfigs?muteConfigId={{mute_config_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new OrganizationMuteConfig: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", "", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating OrganizationMuteConfig: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "organizations/{{organization}}/locations/{{location}}/muteConfigs/{{mute_config_id}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating OrganizationMuteConfig %q: %#v", d.Id(), res)
+
+	return resourceSecurityCenterV2OrganizationMuteConfigRead(d, meta)
+}
+
+func resourceSecurityCenterV2OrganizationMuteConfigRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{SecurityCenterV2BasePath}}organizations/{{organization}}/locations/{{location}}/muteConfigs/{{mute_config_id}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", "", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("SecurityCenterV2OrganizationMuteConfig %q", d.Id()))
+	}
+
+	if err := d.Set("name", flattenSecurityCenterV2OrganizationMuteConfigName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading OrganizationMuteConfig: %s", err)
+	}
+	if err := d.Set("description", flattenSecurityCenterV2OrganizationMuteConfigDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading OrganizationMuteConfig: %s", err)
+	}
+	if err := d.Set("filter", flattenSecurityCenterV2OrganizationMuteConfigFilter(res["filter"], d)); err != nil {
+		return fmt.Errorf("Error reading OrganizationMuteConfig: %s", err)
+	}
+	if err := d.Set("type", flattenSecurityCenterV2OrganizationMuteConfigType(res["type"], d)); err != nil {
+		return fmt.Errorf("Error reading OrganizationMuteConfig: %s", err)
+	}
+	if err := d.Set("create_time", flattenSecurityCenterV2OrganizationMuteConfigCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading OrganizationMuteConfig: %s", err)
+	}
+	if err := d.Set("update_time", flattenSecurityCenterV2OrganizationMuteConfigUpdateTime(res["updateTime"], d)); err != nil {
+		return fmt.Errorf("Error reading OrganizationMuteConfig: %s", err)
+	}
+	if err := d.Set("most_recent_editor", flattenSecurityCenterV2OrganizationMuteConfigMostRecentEditor(res["mostRecentEditor"], d)); err != nil {
+		return fmt.Errorf("Error reading OrganizationMuteConfig: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSecurityCenterV2OrganizationMuteConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandSecurityCenterV2OrganizationMuteConfigDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	filterProp, err := expandSecurityCenterV2OrganizationMuteConfigFilter(d.Get("filter"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("filter"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, filterProp)) {
+		obj["filter"] = filterProp
+	}
+
+	url, err := replaceVars(d, config, "{{SecurityCenterV2BasePath}}organizations/{{organization}}/locations/{{location}}/muteConfigs/{{mute_config_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating OrganizationMuteConfig %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("description") {
+		updateMask = append(updateMask, "description")
+	}
+
+	if d.HasChange("filter") {
+		updateMask = append(updateMask, "filter")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	res, err := sendRequestWithTimeout(config, "PATCH", "", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating OrganizationMuteConfig %q: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Finished updating OrganizationMuteConfig %q: %#v", d.Id(), res)
+
+	return resourceSecurityCenterV2OrganizationMuteConfigRead(d, meta)
+}
+
+func resourceSecurityCenterV2OrganizationMuteConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{SecurityCenterV2BasePath}}organizations/{{organization}}/locations/{{location}}/muteConfigs/{{mute_config_id}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting OrganizationMuteConfig %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", "", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "OrganizationMuteConfig")
+	}
+
+	log.Printf("[DEBUG] Finished deleting OrganizationMuteConfig %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceSecurityCenterV2OrganizationMuteConfigImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"organizations/(?P<organization>[^/]+)/locations/(?P<location>[^/]+)/muteConfigs/(?P<mute_config_id>[^/]+)",
+		"(?P<organization>[^/]+)/(?P<location>[^/]+)/(?P<mute_config_id>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "organizations/{{organization}}/locations/{{location}}/muteConfigs/{{mute_config_id}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenSecurityCenterV2OrganizationMuteConfigName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSecurityCenterV2OrganizationMuteConfigDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSecurityCenterV2OrganizationMuteConfigFilter(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSecurityCenterV2OrganizationMuteConfigType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSecurityCenterV2OrganizationMuteConfigCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSecurityCenterV2OrganizationMuteConfigUpdateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSecurityCenterV2OrganizationMuteConfigMostRecentEditor(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandSecurityCenterV2OrganizationMuteConfigDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandSecurityCenterV2OrganizationMuteConfigFilter(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandSecurityCenterV2OrganizationMuteConfigType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,89 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccSecurityCenterV2OrganizationMuteConfig_global(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"org_id":        getTestOrgFromEnv(t),
+		"random_suffix": acctest.RandString(10),
+		"filter":        `category = \"OS_VULNERABILITY\" AND severity = \"LOW\"`,
+	}
+	updated := map[string]interface{}{
+		"org_id":        context["org_id"],
+		"random_suffix": context["random_suffix"],
+		"filter":        `category = \"OS_VULNERABILITY\" AND (severity = \"LOW\" OR severity = \"MEDIUM\")`,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSecurityCenterV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityCenterV2OrganizationMuteConfig_global(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_scc_v2_organization_mute_config.mute", "name",
+						fmt.Sprintf("organizations/%s/locations/global/muteConfigs/tf-test-mute-%s", context["org_id"], context["random_suffix"])),
+				),
+			},
+			{
+				ResourceName:      "google_scc_v2_organization_mute_config.mute",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSecurityCenterV2OrganizationMuteConfig_global(updated),
+			},
+			{
+				ResourceName:      "google_scc_v2_organization_mute_config.mute",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSecurityCenterV2OrganizationMuteConfig_global(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_scc_v2_organization_mute_config" "mute" {
+  mute_config_id = "tf-test-mute-%{random_suffix}"
+  organization   = "%{org_id}"
+  location       = "global"
+  description    = "Mutes low severity OS vulnerabilities"
+  filter         = "%{filter}"
+  type           = "STATIC"
+}
+`, context)
+}
+
+func testAccCheckSecurityCenterV2Destroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		// Sources can't be deleted, they're only removed from state.
+		if rs.Type != "google_scc_v2_organization_notification_config" && rs.Type != "google_scc_v2_organization_mute_config" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url := fmt.Sprintf("%s%s", config.SecurityCenterV2BasePath, rs.Primary.ID)
+		_, err := sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("%s still exists at %s", rs.Type, url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,335 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceSecurityCenterV2OrganizationNotificationConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSecurityCenterV2OrganizationNotificationConfigCreate,
+		Read:   resourceSecurityCenterV2OrganizationNotificationConfigRead,
+		Update: resourceSecurityCenterV2OrganizationNotificationConfigUpdate,
+		Delete: resourceSecurityCenterV2OrganizationNotificationConfigDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceSecurityCenterV2OrganizationNotificationConfigImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"config_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "global",
+			},
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"pubsub_topic": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateRegexp(`^projects/[^/]+/topics/[^/]+$`),
+			},
+			"streaming_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"filter": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateSecurityCenterFilter,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_account": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSecurityCenterV2OrganizationNotificationConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandSecurityCenterV2OrganizationNotificationConfigDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	pubsubTopicProp, err := expandSecurityCenterV2OrganizationNotificationConfigPubsubTopic(d.Get("pubsub_topic"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("pubsub_topic"); !isEmptyValue(reflect.ValueOf(pubsubTopicProp)) && (ok || !reflect.DeepEqual(v, pubsubTopicProp)) {
+		obj["pubsubTopic"] = pubsubTopicProp
+	}
+	streamingConfigProp, err := expandSecurityCenterV2OrganizationNotificationConfigStreamingConfig(d.Get("streaming_config"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("streaming_config"); !isEmptyValue(reflect.ValueOf(streamingConfigProp)) && (ok || !reflect.DeepEqual(v, streamingConfigProp)) {
+		obj["streamingConfig"] = streamingConfigProp
+	}
+
+	url, err := replaceVars(d, config, "{{SecurityCenterV2BasePath}}organizations/{{organization}}/locations/{{location}}/notificationConfigs?configId={{config_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new OrganizationNotificationConfig: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", "", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating OrganizationNotificationConfig: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "organizations/{{organization}}/locations/{{location}}/notificationConfigs/{{config_id}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating OrganizationNotificationConfig %q: %#v", d.Id(), res)
+
+	return resourceSecurityCenterV2OrganizationNotificationConfigRead(d, meta)
+}
+
+func resourceSecurityCenterV2OrganizationNotificationConfigRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{SecurityCenterV2BasePath}}organizations/{{organization}}/locations/{{location}}/notificationConfigs/{{config_id}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", "", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("SecurityCenterV2OrganizationNotificationConfig %q", d.Id()))
+	}
+
+	if err := d.Set("name", flattenSecurityCenterV2OrganizationNotificationConfigName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading OrganizationNotificationConfig: %s", err)
+	}
+	if err := d.Set("description", flattenSecurityCenterV2OrganizationNotificationConfigDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading OrganizationNotificationConfig: %s", err)
+	}
+	if err := d.Set("pubsub_topic", flattenSecurityCenterV2OrganizationNotificationConfigPubsubTopic(res["pubsubTopic"], d)); err != nil {
+		return fmt.Errorf("Error reading OrganizationNotificationConfig: %s", err)
+	}
+	if err := d.Set("service_account", flattenSecurityCenterV2OrganizationNotificationConfigServiceAccount(res["serviceAccount"], d)); err != nil {
+		return fmt.Errorf("Error reading OrganizationNotificationConfig: %s", err)
+	}
+	if err := d.Set("streaming_config", flattenSecurityCenterV2OrganizationNotificationConfigStreamingConfig(res["streamingConfig"], d)); err != nil {
+		return fmt.Errorf("Error reading OrganizationNotificationConfig: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSecurityCenterV2OrganizationNotificationConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandSecurityCenterV2OrganizationNotificationConfigDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	pubsubTopicProp, err := expandSecurityCenterV2OrganizationNotificationConfigPubsubTopic(d.Get("pubsub_topic"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("pubsub_topic"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, pubsubTopicProp)) {
+		obj["pubsubTopic"] = pubsubTopicProp
+	}
+	streamingConfigProp, err := expandSecurityCenterV2OrganizationNotificationConfigStreamingConfig(d.Get("streaming_config"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("streaming_config"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, streamingConfigProp)) {
+		obj["streamingConfig"] = streamingConfigProp
+	}
+
+	url, err := replaceVars(d, config, "{{SecurityCenterV2BasePath}}organizations/{{organization}}/locations/{{location}}/notificationConfigs/{{config_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating OrganizationNotificationConfig %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("description") {
+		updateMask = append(updateMask, "description")
+	}
+
+	if d.HasChange("pubsub_topic") {
+		updateMask = append(updateMask, "pubsubTopic")
+	}
+
+	if d.HasChange("streaming_config") {
+		updateMask = append(updateMask, "streamingConfig.filter")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	res, err := sendRequestWithTimeout(config, "PATCH", "", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating OrganizationNotificationConfig %q: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Finished updating OrganizationNotificationConfig %q: %#v", d.Id(), res)
+
+	return resourceSecurityCenterV2OrganizationNotificationConfigRead(d, meta)
+}
+
+func resourceSecurityCenterV2OrganizationNotificationConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{SecurityCenterV2BasePath}}organizations/{{organization}}/locations/{{location}}/notificationConfigs/{{config_id}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting OrganizationNotificationConfig %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", "", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "OrganizationNotificationConfig")
+	}
+
+	log.Printf("[DEBUG] Finished deleting OrganizationNotificationConfig %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceSecurityCenterV2OrganizationNotificationConfigImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"organizations/(?P<organization>[^/]+)/locations/(?P<location>[^/]+)/notificationConfigs/(?P<config_id>[^/]+)",
+		"(?P<organization>[^/]+)/(?P<location>[^/]+)/(?P<config_id>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "organizations/{{organization}}/locations/{{location}}/notificationConfigs/{{config_id}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenSecurityCenterV2OrganizationNotificationConfigName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSecurityCenterV2OrganizationNotificationConfigDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSecurityCenterV2OrganizationNotificationConfigPubsubTopic(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSecurityCenterV2OrganizationNotificationConfigServiceAccount(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSecurityCenterV2OrganizationNotificationConfigStreamingConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["filter"] =
+		flattenSecurityCenterV2OrganizationNotificationConfigStreamingConfigFilter(original["filter"], d)
+	return []interface{}{transformed}
+}
+func flattenSecurityCenterV2OrganizationNotificationConfigStreamingConfigFilter(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandSecurityCenterV2OrganizationNotificationConfigDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandSecurityCenterV2OrganizationNotificationConfigPubsubTopic(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandSecurityCenterV2OrganizationNotificationConfigStreamingConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedFilter, err := expandSecurityCenterV2OrganizationNotificationConfigStreamingConfigFilter(original["filter"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedFilter); val.IsValid() && !isEmptyValue(val) {
+		transformed["filter"] = transformedFilter
+	}
+
+	return transformed, nil
+}
+
+func expandSecurityCenterV2OrganizationNotificationConfigStreamingConfigFilter(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,78 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccSecurityCenterV2OrganizationNotificationConfig_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"org_id":        getTestOrgFromEnv(t),
+		"random_suffix": acctest.RandString(10),
+		"filter":        `severity = \"HIGH\"`,
+	}
+	updated := map[string]interface{}{
+		"org_id":        context["org_id"],
+		"random_suffix": context["random_suffix"],
+		"filter":        `severity = \"HIGH\" OR severity = \"CRITICAL\"`,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSecurityCenterV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityCenterV2OrganizationNotificationConfig_basic(context),
+			},
+			{
+				ResourceName:      "google_scc_v2_organization_notification_config.config",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSecurityCenterV2OrganizationNotificationConfig_basic(updated),
+			},
+			{
+				ResourceName:      "google_scc_v2_organization_notification_config.config",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "google_scc_v2_organization_source.source",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSecurityCenterV2OrganizationNotificationConfig_basic(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_scc_v2_organization_source" "source" {
+  display_name = "TF Source %{random_suffix}"
+  organization = "%{org_id}"
+  description  = "Source of the findings streamed by the notification config"
+}
+
+resource "google_pubsub_topic" "scc_notification" {
+  name = "tf-test-scc-v2-%{random_suffix}"
+}
+
+resource "google_scc_v2_organization_notification_config" "config" {
+  config_id    = "tf-test-config-%{random_suffix}"
+  organization = "%{org_id}"
+  location     = "global"
+  description  = "Streams HIGH severity findings"
+  pubsub_topic = google_pubsub_topic.scc_notification.id
+
+  streaming_config {
+    filter = "%{filter} AND parent = \"${google_scc_v2_organization_source.source.name}\""
+  }
+}
+`, context)
+}
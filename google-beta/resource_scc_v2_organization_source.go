@@ -0,0 +1,239 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceSecurityCenterV2OrganizationSource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSecurityCenterV2OrganizationSourceCreate,
+		Read:   resourceSecurityCenterV2OrganizationSourceRead,
+		Update: resourceSecurityCenterV2OrganizationSourceUpdate,
+		Delete: resourceSecurityCenterV2OrganizationSourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceSecurityCenterV2OrganizationSourceImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Update: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateRegexp(`^[\p{L}\p{N}]([\p{L}\p{N}_ -]{0,30}[\p{L}\p{N}])?$`),
+			},
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSecurityCenterV2OrganizationSourceCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandSecurityCenterV2OrganizationSourceDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	displayNameProp, err := expandSecurityCenterV2OrganizationSourceDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(displayNameProp)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+
+	url, err := replaceVars(d, config, "{{SecurityCenterV2BasePath}}organizations/{{organization}}/sources")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new OrganizationSource: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", "", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating OrganizationSource: %s", err)
+	}
+
+	// The full resource name is only known once the resource is created
+	if err := d.Set("name", flattenSecurityCenterV2OrganizationSourceName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error setting name: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating OrganizationSource %q: %#v", d.Id(), res)
+
+	return resourceSecurityCenterV2OrganizationSourceRead(d, meta)
+}
+
+func resourceSecurityCenterV2OrganizationSourceRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{SecurityCenterV2BasePath}}{{name}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", "", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("SecurityCenterV2OrganizationSource %q", d.Id()))
+	}
+
+	if err := d.Set("name", flattenSecurityCenterV2OrganizationSourceName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading OrganizationSource: %s", err)
+	}
+	if err := d.Set("description", flattenSecurityCenterV2OrganizationSourceDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading OrganizationSource: %s", err)
+	}
+	if err := d.Set("display_name", flattenSecurityCenterV2OrganizationSourceDisplayName(res["displayName"], d)); err != nil {
+		return fmt.Errorf("Error reading OrganizationSource: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSecurityCenterV2OrganizationSourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandSecurityCenterV2OrganizationSourceDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	displayNameProp, err := expandSecurityCenterV2OrganizationSourceDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+
+	url, err := replaceVars(d, config, "{{SecurityCenterV2BasePath}}{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating OrganizationSource %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("description") {
+		updateMask = append(updateMask, "description")
+	}
+
+	if d.HasChange("display_name") {
+		updateMask = append(updateMask, "displayName")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	res, err := sendRequestWithTimeout(config, "PATCH", "", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating OrganizationSource %q: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Finished updating OrganizationSource %q: %#v", d.Id(), res)
+
+	return resourceSecurityCenterV2OrganizationSourceRead(d, meta)
+}
+
+func resourceSecurityCenterV2OrganizationSourceDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARNING] SecurityCenterV2 OrganizationSource resources"+
+		" cannot be deleted from GCP. The resource %s will be removed from Terraform"+
+		" state, but will still be present on the server.", d.Id())
+	d.SetId("")
+
+	return nil
+}
+
+func resourceSecurityCenterV2OrganizationSourceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+
+	// current import_formats can't import fields with forward slashes in their value
+	if err := parseImportId([]string{"(?P<name>.+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	stringParts := strings.Split(d.Get("name").(string), "/")
+	if len(stringParts) != 4 || stringParts[0] != "organizations" || stringParts[2] != "sources" {
+		return nil, fmt.Errorf(
+			"Saw %s when the name is expected to have shape %s",
+			d.Get("name"),
+			"organizations/{{organization}}/sources/{{source}}",
+		)
+	}
+
+	if err := d.Set("organization", stringParts[1]); err != nil {
+		return nil, fmt.Errorf("Error setting organization: %s", err)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenSecurityCenterV2OrganizationSourceName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSecurityCenterV2OrganizationSourceDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSecurityCenterV2OrganizationSourceDisplayName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandSecurityCenterV2OrganizationSourceDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandSecurityCenterV2OrganizationSourceDisplayName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
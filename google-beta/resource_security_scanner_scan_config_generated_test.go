@@ -80,7 +80,7 @@ func testAccCheckSecurityScannerScanConfigDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("SecurityScannerScanConfig still exists at %s", url)
 		}
@@ -23,12 +23,14 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceSourceRepoRepository() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSourceRepoRepositoryCreate,
 		Read:   resourceSourceRepoRepositoryRead,
+		Update: resourceSourceRepoRepositoryUpdate,
 		Delete: resourceSourceRepoRepositoryDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -37,6 +39,7 @@ func resourceSourceRepoRepository() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(4 * time.Minute),
+			Update: schema.DefaultTimeout(4 * time.Minute),
 			Delete: schema.DefaultTimeout(4 * time.Minute),
 		},
 
@@ -46,6 +49,29 @@ func resourceSourceRepoRepository() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"pubsub_configs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"topic": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateRegexp(`^projects/[^/]+/topics/[^/]+$`),
+						},
+						"message_format": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"PROTOBUF", "JSON"}, false),
+						},
+						"service_account_email": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"size": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -81,7 +107,12 @@ func resourceSourceRepoRepositoryCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	log.Printf("[DEBUG] Creating new Repository: %#v", obj)
-	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "POST", project, url, obj, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("Error creating Repository: %s", err)
 	}
@@ -95,6 +126,12 @@ func resourceSourceRepoRepositoryCreate(d *schema.ResourceData, meta interface{}
 
 	log.Printf("[DEBUG] Finished creating Repository %q: %#v", d.Id(), res)
 
+	// pubsubConfigs are ignored by CreateRepo, so they're set in a follow-up
+	// update once the repository exists.
+	if v, ok := d.GetOk("pubsub_configs"); ok && v.(*schema.Set).Len() > 0 {
+		return resourceSourceRepoRepositoryUpdate(d, meta)
+	}
+
 	return resourceSourceRepoRepositoryRead(d, meta)
 }
 
@@ -106,15 +143,16 @@ func resourceSourceRepoRepositoryRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	res, err := sendRequest(config, "GET", url, nil)
+	project, err := getProject(d, config)
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("SourceRepoRepository %q", d.Id()))
+		return err
 	}
 
-	project, err := getProject(d, config)
+	res, err := sendRequest(config, "GET", project, url, nil)
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, fmt.Sprintf("SourceRepoRepository %q", d.Id()))
 	}
+
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("Error reading Repository: %s", err)
 	}
@@ -128,10 +166,49 @@ func resourceSourceRepoRepositoryRead(d *schema.ResourceData, meta interface{})
 	if err := d.Set("size", flattenSourceRepoRepositorySize(res["size"], d)); err != nil {
 		return fmt.Errorf("Error reading Repository: %s", err)
 	}
+	if err := d.Set("pubsub_configs", flattenSourceRepoRepositoryPubsubConfigs(res["pubsubConfigs"], d)); err != nil {
+		return fmt.Errorf("Error reading Repository: %s", err)
+	}
 
 	return nil
 }
 
+func resourceSourceRepoRepositoryUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	pubsubConfigsProp, err := expandSourceRepoRepositoryPubsubConfigs(d.Get("pubsub_configs"), d, config)
+	if err != nil {
+		return err
+	}
+	// An empty map clears all pubsubConfigs, so it's sent as-is.
+	obj["pubsubConfigs"] = pubsubConfigsProp
+
+	obj, err = resourceSourceRepoRepositoryUpdateEncoder(d, meta, obj)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "{{SourceRepoBasePath}}projects/{{project}}/repos/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Repository %q: %#v", d.Id(), obj)
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	_, err = sendRequestWithTimeout(config, "PATCH", project, url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Repository %q: %s", d.Id(), err)
+	}
+
+	return resourceSourceRepoRepositoryRead(d, meta)
+}
+
 func resourceSourceRepoRepositoryDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -142,7 +219,12 @@ func resourceSourceRepoRepositoryDelete(d *schema.ResourceData, meta interface{}
 
 	var obj map[string]interface{}
 	log.Printf("[DEBUG] Deleting Repository %q", d.Id())
-	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "DELETE", project, url, obj, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return handleNotFoundError(err, d, "Repository")
 	}
@@ -195,6 +277,52 @@ func flattenSourceRepoRepositorySize(v interface{}, d *schema.ResourceData) inte
 	return v
 }
 
+func flattenSourceRepoRepositoryPubsubConfigs(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	// pubsubConfigs is a map keyed by topic name
+	l := v.(map[string]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for topic, raw := range l {
+		original := raw.(map[string]interface{})
+		transformed = append(transformed, map[string]interface{}{
+			"topic":                 topic,
+			"message_format":        original["messageFormat"],
+			"service_account_email": original["serviceAccountEmail"],
+		})
+	}
+	return transformed
+}
+
+func expandSourceRepoRepositoryPubsubConfigs(v interface{}, d TerraformResourceData, config *Config) (map[string]interface{}, error) {
+	m := make(map[string]interface{})
+	if v == nil {
+		return m, nil
+	}
+	for _, raw := range v.(*schema.Set).List() {
+		original := raw.(map[string]interface{})
+		topic := original["topic"].(string)
+		transformed := map[string]interface{}{
+			"topic":         topic,
+			"messageFormat": original["message_format"],
+		}
+		if email, ok := original["service_account_email"]; ok && email.(string) != "" {
+			transformed["serviceAccountEmail"] = email
+		}
+		m[topic] = transformed
+	}
+	return m, nil
+}
+
+func resourceSourceRepoRepositoryUpdateEncoder(d *schema.ResourceData, meta interface{}, obj map[string]interface{}) (map[string]interface{}, error) {
+	// Nest request body in "repo" field; pubsubConfigs is the only updatable field
+	newObj := make(map[string]interface{})
+	newObj["repo"] = obj
+	newObj["updateMask"] = "pubsubConfigs"
+	return newObj, nil
+}
+
 func expandSourceRepoRepositoryName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
 	return replaceVars(d, config, "projects/{{project}}/repos/{{name}}")
 }
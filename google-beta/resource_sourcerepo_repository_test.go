@@ -36,3 +36,71 @@ resource "google_sourcerepo_repository" "acceptance" {
 }
 	`, repositoryName)
 }
+
+func TestAccSourceRepoRepository_pubsubConfigs(t *testing.T) {
+	t.Parallel()
+
+	repositoryName := fmt.Sprintf("source-repo-repository-test-%s", acctest.RandString(10))
+	topicName := fmt.Sprintf("tf-test-topic-%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSourceRepoRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceRepoRepository_pubsubConfigs(repositoryName, topicName, "JSON"),
+			},
+			{
+				ResourceName:      "google_sourcerepo_repository.acceptance",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSourceRepoRepository_pubsubConfigs(repositoryName, topicName, "PROTOBUF"),
+			},
+			{
+				ResourceName:      "google_sourcerepo_repository.acceptance",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSourceRepoRepository_basic(repositoryName),
+			},
+			{
+				ResourceName:      "google_sourcerepo_repository.acceptance",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSourceRepoRepository_pubsubConfigs(repositoryName, topicName, messageFormat string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "publisher" {
+  account_id = "%s"
+}
+
+resource "google_pubsub_topic" "topic" {
+  name = "%s"
+}
+
+resource "google_pubsub_topic_iam_member" "publisher" {
+  topic  = google_pubsub_topic.topic.id
+  role   = "roles/pubsub.publisher"
+  member = "serviceAccount:${google_service_account.publisher.email}"
+}
+
+resource "google_sourcerepo_repository" "acceptance" {
+  name = "%s"
+
+  pubsub_configs {
+    topic                 = google_pubsub_topic.topic.id
+    message_format        = "%s"
+    service_account_email = google_service_account.publisher.email
+  }
+
+  depends_on = [google_pubsub_topic_iam_member.publisher]
+}
+`, topicName, topicName, repositoryName, messageFormat)
+}
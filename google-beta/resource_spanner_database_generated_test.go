@@ -83,7 +83,7 @@ func testAccCheckSpannerDatabaseDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("SpannerDatabase still exists at %s", url)
 		}
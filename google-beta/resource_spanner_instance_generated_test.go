@@ -77,7 +77,7 @@ func testAccCheckSpannerInstanceDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("SpannerInstance still exists at %s", url)
 		}
@@ -2,15 +2,19 @@ package google
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"runtime"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gammazero/workerpool"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -30,6 +34,11 @@ func resourceStorageBucket() *schema.Resource {
 			State: resourceStorageBucketStateImporter,
 		},
 
+		CustomizeDiff: customdiff.All(
+			setLabelsDiff,
+			storageBucketHierarchicalNamespaceCustomizeDiff,
+		),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -62,12 +71,30 @@ func resourceStorageBucket() *schema.Resource {
 				Default:  false,
 			},
 
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"labels": {
 				Type:     schema.TypeMap,
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"effective_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"location": {
 				Type:     schema.TypeString,
 				Default:  "US",
@@ -267,10 +294,153 @@ func resourceStorageBucket() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+
+			"hierarchical_namespace": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"custom_placement_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_locations": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 2,
+							MaxItems: 2,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							// Regions are returned in upper case.
+							Set: func(v interface{}) int {
+								return hashcode.String(strings.ToUpper(v.(string)))
+							},
+						},
+					},
+				},
+			},
+
+			"ip_filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"Enabled", "Disabled"}, false),
+						},
+						"public_network_source": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_ip_cidr_ranges": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validateIpCidrRange,
+										},
+									},
+								},
+							},
+						},
+						"vpc_network_sources": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"network": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"allowed_ip_cidr_ranges": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validateIpCidrRange,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"soft_delete_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"retention_duration_seconds": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validateStorageBucketSoftDeleteRetention,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+func storageBucketHierarchicalNamespaceCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	// separate func to allow unit testing
+	return storageBucketHierarchicalNamespaceCustomizeDiffFunc(diff)
+}
+
+// Buckets with a hierarchical namespace must use uniform bucket-level access.
+func storageBucketHierarchicalNamespaceCustomizeDiffFunc(diff TerraformResourceDiff) error {
+	_, n := diff.GetChange("hierarchical_namespace")
+	if l, ok := n.([]interface{}); !ok || len(l) == 0 || l[0] == nil || !l[0].(map[string]interface{})["enabled"].(bool) {
+		return nil
+	}
+
+	if _, bpo := diff.GetChange("bucket_policy_only"); bpo == true {
+		return nil
+	}
+	return fmt.Errorf("hierarchical_namespace.0.enabled requires uniform bucket-level access, set bucket_policy_only to true to enable it")
+}
+
+// Soft delete can be disabled with a retention of 0, or retain deleted
+// objects for between 7 and 90 days.
+const (
+	storageBucketSoftDeleteMinRetention = 7 * 24 * 60 * 60
+	storageBucketSoftDeleteMaxRetention = 90 * 24 * 60 * 60
+)
+
+func validateStorageBucketSoftDeleteRetention(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	if value != 0 && (value < storageBucketSoftDeleteMinRetention || value > storageBucketSoftDeleteMaxRetention) {
+		errors = append(errors, fmt.Errorf("%q must be 0 to disable soft delete, or between %d (7 days) and %d (90 days), got: %d", k, storageBucketSoftDeleteMinRetention, storageBucketSoftDeleteMaxRetention, value))
+	}
+	return
+}
+
 func resourceStorageBucketCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -327,10 +497,28 @@ func resourceStorageBucketCreate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	var res *storage.Bucket
+	// The storage client doesn't know about some of the newer bucket fields,
+	// so the bucket is sent as JSON with those fields added to it.
+	rawBucket, err := ConvertToMap(sb)
+	if err != nil {
+		return err
+	}
+	if v, ok := d.GetOk("hierarchical_namespace"); ok {
+		rawBucket["hierarchicalNamespace"] = expandStorageBucketHierarchicalNamespace(v)
+	}
+	if v, ok := d.GetOk("custom_placement_config"); ok {
+		rawBucket["customPlacementConfig"] = expandStorageBucketCustomPlacementConfig(v)
+	}
+	if v, ok := d.GetOk("soft_delete_policy"); ok {
+		rawBucket["softDeletePolicy"] = expandStorageBucketSoftDeletePolicy(v)
+	}
+	if v, ok := d.GetOk("ip_filter"); ok {
+		rawBucket["ipFilter"] = expandStorageBucketIpFilter(v)
+	}
 
+	var rawRes map[string]interface{}
 	err = retry(func() error {
-		res, err = config.clientStorage.Buckets.Insert(project, sb).Do()
+		rawRes, err = sendRequest(config, "POST", project, config.StorageBasePath+"b?project="+project, rawBucket)
 		return err
 	})
 
@@ -339,6 +527,11 @@ func resourceStorageBucketCreate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	res := &storage.Bucket{}
+	if err := Convert(rawRes, res); err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Created bucket %v at location %v\n\n", res.Name, res.SelfLink)
 
 	d.SetId(res.Id)
@@ -396,7 +589,7 @@ func resourceStorageBucketUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if d.HasChange("labels") {
+	if d.HasChange("labels") || d.HasChange("terraform_labels") {
 		sb.Labels = expandLabels(d)
 		if len(sb.Labels) == 0 {
 			sb.NullFields = append(sb.NullFields, "Labels")
@@ -404,7 +597,7 @@ func resourceStorageBucketUpdate(d *schema.ResourceData, meta interface{}) error
 
 		// To delete a label using PATCH, we have to explicitly set its value
 		// to null.
-		old, _ := d.GetChange("labels")
+		old, _ := d.GetChange("terraform_labels")
 		for k := range old.(map[string]interface{}) {
 			if _, ok := sb.Labels[k]; !ok {
 				sb.NullFields = append(sb.NullFields, fmt.Sprintf("Labels.%s", k))
@@ -430,6 +623,24 @@ func resourceStorageBucketUpdate(d *schema.ResourceData, meta interface{}) error
 
 	log.Printf("[DEBUG] Patched bucket %v at location %v\n\n", res.Name, res.SelfLink)
 
+	// Fields that aren't part of the storage client's buckets are patched
+	// separately.
+	rawPatch := make(map[string]interface{})
+	if d.HasChange("soft_delete_policy") {
+		if v := expandStorageBucketSoftDeletePolicy(d.Get("soft_delete_policy")); v != nil {
+			rawPatch["softDeletePolicy"] = v
+		}
+	}
+	if d.HasChange("ip_filter") {
+		// A removed filter expands to nil, which clears it.
+		rawPatch["ipFilter"] = expandStorageBucketIpFilter(d.Get("ip_filter"))
+	}
+	if len(rawPatch) > 0 {
+		if _, err := sendRequest(config, "PATCH", "", config.StorageBasePath+"b/"+d.Get("name").(string), rawPatch); err != nil {
+			return fmt.Errorf("Error updating bucket %s: %s", d.Get("name").(string), err)
+		}
+	}
+
 	// Assign the bucket ID as the resource ID
 	d.Set("self_link", res.SelfLink)
 	d.SetId(res.Id)
@@ -448,6 +659,31 @@ func resourceStorageBucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	log.Printf("[DEBUG] Read bucket %v at location %v\n\n", res.Name, res.SelfLink)
 
+	// Fields that aren't part of the storage client's buckets are read from
+	// the API directly.
+	rawBucket, err := sendRequest(config, "GET", "", config.StorageBasePath+"b/"+bucket+"?fields=softDeletePolicy,hierarchicalNamespace,customPlacementConfig,ipFilter", nil)
+	if err != nil {
+		return fmt.Errorf("Error reading bucket %s: %s", bucket, err)
+	}
+	if err := d.Set("soft_delete_policy", flattenStorageBucketSoftDeletePolicy(rawBucket["softDeletePolicy"])); err != nil {
+		return fmt.Errorf("Error setting soft_delete_policy: %s", err)
+	}
+	if err := d.Set("hierarchical_namespace", flattenStorageBucketHierarchicalNamespace(rawBucket["hierarchicalNamespace"])); err != nil {
+		return fmt.Errorf("Error setting hierarchical_namespace: %s", err)
+	}
+	if err := d.Set("custom_placement_config", flattenStorageBucketCustomPlacementConfig(rawBucket["customPlacementConfig"])); err != nil {
+		return fmt.Errorf("Error setting custom_placement_config: %s", err)
+	}
+	if err := d.Set("ip_filter", flattenStorageBucketIpFilter(rawBucket["ipFilter"])); err != nil {
+		return fmt.Errorf("Error setting ip_filter: %s", err)
+	}
+
+	// deletion_protection isn't part of the bucket, so it's only defaulted
+	// when it's missing from state, such as after import.
+	if _, ok := d.GetOkExists("deletion_protection"); !ok {
+		d.Set("deletion_protection", false)
+	}
+
 	// We are trying to support several different use cases for bucket. Buckets are globally
 	// unique but they are associated with projects internally, but some users want to use
 	// buckets in a project agnostic way. Thus we will check to see if the project ID has been
@@ -480,7 +716,9 @@ func resourceStorageBucketRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("logging", flattenBucketLogging(res.Logging))
 	d.Set("versioning", flattenBucketVersioning(res.Versioning))
 	d.Set("lifecycle_rule", flattenBucketLifecycle(res.Lifecycle))
-	d.Set("labels", res.Labels)
+	if err := setLabelsFields(d, config, res.Labels); err != nil {
+		return fmt.Errorf("Error setting labels: %s", err)
+	}
 	d.Set("website", flattenBucketWebsite(res.Website))
 
 	if res.IamConfiguration != nil && res.IamConfiguration.BucketPolicyOnly != nil {
@@ -505,57 +743,35 @@ func resourceStorageBucketDelete(d *schema.ResourceData, meta interface{}) error
 	// Get the bucket
 	bucket := d.Get("name").(string)
 
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("Cannot delete bucket %s: deletion_protection is enabled. Set deletion_protection to false for this resource and run \"terraform apply\" before attempting to delete it.", bucket)
+	}
+
 	for {
-		res, err := config.clientStorage.Objects.List(bucket).Versions(true).Do()
+		// GCS requires that a bucket be empty (have no objects or object
+		// versions) before it can be deleted. Objects are deleted a page of
+		// versions at a time, and the bucket is listed again afterwards to
+		// make sure nothing was left behind.
+		found := false
+		err := config.clientStorage.Objects.List(bucket).Versions(true).Pages(context.Background(), func(res *storage.Objects) error {
+			if len(res.Items) == 0 {
+				return nil
+			}
+			found = true
+
+			if !d.Get("force_destroy").(bool) {
+				return errors.New("Error trying to delete a bucket containing objects without `force_destroy` set to true")
+			}
+
+			log.Printf("[DEBUG] GCS Bucket attempting to forceDestroy\n\n")
+			return deleteStorageBucketObjects(config, bucket, res.Items)
+		})
 		if err != nil {
-			fmt.Printf("Error Objects.List failed: %v", err)
+			log.Printf("Error! %s : %s\n\n", bucket, err)
 			return err
 		}
 
-		if len(res.Items) != 0 {
-			if d.Get("force_destroy").(bool) {
-				// GCS requires that a bucket be empty (have no objects or object
-				// versions) before it can be deleted.
-				log.Printf("[DEBUG] GCS Bucket attempting to forceDestroy\n\n")
-
-				// Create a workerpool for parallel deletion of resources. In the
-				// future, it would be great to expose Terraform's global parallelism
-				// flag here, but that's currently reserved for core use. Testing
-				// shows that NumCPUs-1 is the most performant on average networks.
-				//
-				// The challenge with making this user-configurable is that the
-				// configuration would reside in the Terraform configuration file,
-				// decreasing its portability. Ideally we'd want this to connect to
-				// Terraform's top-level -parallelism flag, but that's not plumbed nor
-				// is it scheduled to be plumbed to individual providers.
-				wp := workerpool.New(runtime.NumCPU() - 1)
-
-				for _, object := range res.Items {
-					log.Printf("[DEBUG] Found %s", object.Name)
-					object := object
-
-					wp.Submit(func() {
-						log.Printf("[TRACE] Attempting to delete %s", object.Name)
-						if err := config.clientStorage.Objects.Delete(bucket, object.Name).Generation(object.Generation).Do(); err != nil {
-							// We should really return an error here, but it doesn't really
-							// matter since the following step (bucket deletion) will fail
-							// with an error indicating objects are still present, and this
-							// log line will point to that object.
-							log.Printf("[ERR] Failed to delete storage object %s: %s", object.Name, err)
-						} else {
-							log.Printf("[TRACE] Successfully deleted %s", object.Name)
-						}
-					})
-				}
-
-				// Wait for everything to finish.
-				wp.StopWait()
-			} else {
-				deleteErr := errors.New("Error trying to delete a bucket containing objects without `force_destroy` set to true")
-				log.Printf("Error! %s : %s\n\n", bucket, deleteErr)
-				return deleteErr
-			}
-		} else {
+		if !found {
 			break // 0 items, bucket empty
 		}
 	}
@@ -580,6 +796,85 @@ func resourceStorageBucketDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+// deleteStorageBucketObjects deletes the given object versions of a bucket,
+// releasing their temporary holds first. Versions under an event-based hold
+// or an unexpired retention period can't be deleted, and are reported in the
+// returned error along with any failed deletions.
+func deleteStorageBucketObjects(config *Config, bucket string, objects []*storage.Object) error {
+	var errs *multierror.Error
+	var mu sync.Mutex
+	addErr := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		errs = multierror.Append(errs, err)
+	}
+
+	// Create a workerpool for parallel deletion of resources. In the
+	// future, it would be great to expose Terraform's global parallelism
+	// flag here, but that's currently reserved for core use. Testing
+	// shows that NumCPUs-1 is the most performant on average networks.
+	//
+	// The challenge with making this user-configurable is that the
+	// configuration would reside in the Terraform configuration file,
+	// decreasing its portability. Ideally we'd want this to connect to
+	// Terraform's top-level -parallelism flag, but that's not plumbed nor
+	// is it scheduled to be plumbed to individual providers.
+	wp := workerpool.New(runtime.NumCPU() - 1)
+
+	for _, object := range objects {
+		log.Printf("[DEBUG] Found %s (generation %d)", object.Name, object.Generation)
+		if err := storageObjectDeletionBlocked(object, time.Now()); err != nil {
+			addErr(err)
+			continue
+		}
+		object := object
+
+		wp.Submit(func() {
+			if object.TemporaryHold {
+				log.Printf("[TRACE] Releasing temporary hold on %s", object.Name)
+				release := &storage.Object{
+					TemporaryHold:   false,
+					ForceSendFields: []string{"TemporaryHold"},
+				}
+				if _, err := config.clientStorage.Objects.Patch(bucket, object.Name, release).Generation(object.Generation).Do(); err != nil {
+					addErr(fmt.Errorf("Failed to release temporary hold on storage object %s (generation %d): %s", object.Name, object.Generation, err))
+					return
+				}
+			}
+
+			log.Printf("[TRACE] Attempting to delete %s", object.Name)
+			if err := config.clientStorage.Objects.Delete(bucket, object.Name).Generation(object.Generation).Do(); err != nil {
+				if isGoogleApiErrorWithCode(err, 404) {
+					return
+				}
+				addErr(fmt.Errorf("Failed to delete storage object %s (generation %d): %s", object.Name, object.Generation, err))
+				return
+			}
+			log.Printf("[TRACE] Successfully deleted %s", object.Name)
+		})
+	}
+
+	// Wait for everything to finish.
+	wp.StopWait()
+
+	return errs.ErrorOrNil()
+}
+
+// storageObjectDeletionBlocked returns an error if a hold or retention
+// period that force_destroy doesn't release prevents deleting the object.
+func storageObjectDeletionBlocked(object *storage.Object, now time.Time) error {
+	if object.EventBasedHold {
+		return fmt.Errorf("Cannot delete storage object %s (generation %d): it has an event-based hold, which must be released first", object.Name, object.Generation)
+	}
+	if object.RetentionExpirationTime != "" {
+		expiration, err := time.Parse(time.RFC3339, object.RetentionExpirationTime)
+		if err == nil && expiration.After(now) {
+			return fmt.Errorf("Cannot delete storage object %s (generation %d): it is under a retention policy until %s", object.Name, object.Generation, object.RetentionExpirationTime)
+		}
+	}
+	return nil
+}
+
 func resourceStorageBucketStateImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	// We need to support project/bucket_name and bucket_name formats. This will allow
 	// importing a bucket that is in a different project than the provider default.
@@ -597,6 +892,146 @@ func resourceStorageBucketStateImporter(d *schema.ResourceData, meta interface{}
 	return []*schema.ResourceData{d}, nil
 }
 
+func expandStorageBucketSoftDeletePolicy(v interface{}) map[string]interface{} {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	policy := l[0].(map[string]interface{})
+
+	return map[string]interface{}{
+		"retentionDurationSeconds": strconv.Itoa(policy["retention_duration_seconds"].(int)),
+	}
+}
+
+func flattenStorageBucketSoftDeletePolicy(v interface{}) []map[string]interface{} {
+	policy, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	// int64 fields are sent as strings, and left out when they're 0.
+	retention := 0
+	if raw, ok := policy["retentionDurationSeconds"].(string); ok {
+		if i, err := strconv.Atoi(raw); err == nil {
+			retention = i
+		}
+	}
+	return []map[string]interface{}{
+		{
+			"retention_duration_seconds": retention,
+		},
+	}
+}
+
+func expandStorageBucketHierarchicalNamespace(v interface{}) map[string]interface{} {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"enabled": l[0].(map[string]interface{})["enabled"].(bool),
+	}
+}
+
+// A bucket without a hierarchical namespace has none set, which is flattened
+// as a disabled one.
+func flattenStorageBucketHierarchicalNamespace(v interface{}) []map[string]interface{} {
+	enabled := false
+	if hns, ok := v.(map[string]interface{}); ok {
+		enabled, _ = hns["enabled"].(bool)
+	}
+	return []map[string]interface{}{
+		{
+			"enabled": enabled,
+		},
+	}
+}
+
+func expandStorageBucketCustomPlacementConfig(v interface{}) map[string]interface{} {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"dataLocations": convertStringSet(l[0].(map[string]interface{})["data_locations"].(*schema.Set)),
+	}
+}
+
+func flattenStorageBucketCustomPlacementConfig(v interface{}) []map[string]interface{} {
+	cpc, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"data_locations": cpc["dataLocations"],
+		},
+	}
+}
+
+func expandStorageBucketIpFilter(v interface{}) map[string]interface{} {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	raw := l[0].(map[string]interface{})
+
+	transformed := map[string]interface{}{
+		"mode": raw["mode"],
+	}
+	if l, ok := raw["public_network_source"].([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		transformed["publicNetworkSource"] = map[string]interface{}{
+			"allowedIpCidrRanges": l[0].(map[string]interface{})["allowed_ip_cidr_ranges"],
+		}
+	}
+	sources := make([]interface{}, 0)
+	for _, rawSource := range raw["vpc_network_sources"].([]interface{}) {
+		source := rawSource.(map[string]interface{})
+		sources = append(sources, map[string]interface{}{
+			"network":             source["network"],
+			"allowedIpCidrRanges": source["allowed_ip_cidr_ranges"],
+		})
+	}
+	if len(sources) > 0 {
+		transformed["vpcNetworkSources"] = sources
+	}
+	return transformed
+}
+
+func flattenStorageBucketIpFilter(v interface{}) []map[string]interface{} {
+	filter, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	var publicNetworkSource []map[string]interface{}
+	if source, ok := filter["publicNetworkSource"].(map[string]interface{}); ok {
+		publicNetworkSource = []map[string]interface{}{
+			{
+				"allowed_ip_cidr_ranges": source["allowedIpCidrRanges"],
+			},
+		}
+	}
+	var vpcNetworkSources []map[string]interface{}
+	if sources, ok := filter["vpcNetworkSources"].([]interface{}); ok {
+		for _, raw := range sources {
+			source := raw.(map[string]interface{})
+			vpcNetworkSources = append(vpcNetworkSources, map[string]interface{}{
+				"network":                source["network"],
+				"allowed_ip_cidr_ranges": source["allowedIpCidrRanges"],
+			})
+		}
+	}
+	return []map[string]interface{}{
+		{
+			"mode":                  filter["mode"],
+			"public_network_source": publicNetworkSource,
+			"vpc_network_sources":   vpcNetworkSources,
+		},
+	}
+}
+
 func expandCors(configured []interface{}) []*storage.BucketCors {
 	corsRules := make([]*storage.BucketCors, 0, len(configured))
 	for _, raw := range configured {
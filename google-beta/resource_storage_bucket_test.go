@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
@@ -50,6 +52,279 @@ func TestAccStorageBucket_basic(t *testing.T) {
 	})
 }
 
+func TestStorageObjectDeletionBlocked(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		Object      *storage.Object
+		ExpectError bool
+	}{
+		"no holds": {
+			Object: &storage.Object{Name: "a"},
+		},
+		"temporary hold": {
+			Object: &storage.Object{Name: "a", TemporaryHold: true},
+		},
+		"expired retention": {
+			Object: &storage.Object{Name: "a", RetentionExpirationTime: "2019-05-01T00:00:00Z"},
+		},
+		"event-based hold": {
+			Object:      &storage.Object{Name: "a", EventBasedHold: true},
+			ExpectError: true,
+		},
+		"unexpired retention": {
+			Object:      &storage.Object{Name: "a", RetentionExpirationTime: "2019-07-01T00:00:00Z"},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		err := storageObjectDeletionBlocked(tc.Object, now)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
+
+func TestValidateStorageBucketSoftDeleteRetention(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Value       int
+		ExpectError bool
+	}{
+		"disabled": {Value: 0},
+		"7 days":   {Value: 604800},
+		"14 days":  {Value: 1209600},
+		"90 days":  {Value: 7776000},
+		"negative": {Value: -1, ExpectError: true},
+		"1 day":    {Value: 86400, ExpectError: true},
+		"91 days":  {Value: 7862400, ExpectError: true},
+	}
+
+	for tn, tc := range cases {
+		_, errs := validateStorageBucketSoftDeleteRetention(tc.Value, "retention_duration_seconds")
+		if tc.ExpectError && len(errs) == 0 {
+			t.Errorf("%s: expected an error validating %d", tn, tc.Value)
+		}
+		if !tc.ExpectError && len(errs) > 0 {
+			t.Errorf("%s: unexpected errors validating %d: %v", tn, tc.Value, errs)
+		}
+	}
+}
+
+func TestStorageBucketHierarchicalNamespaceCustomizeDiff(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		After       map[string]interface{}
+		ExpectError bool
+	}{
+		"no hierarchical namespace": {
+			After: map[string]interface{}{
+				"bucket_policy_only": false,
+			},
+		},
+		"disabled hierarchical namespace": {
+			After: map[string]interface{}{
+				"hierarchical_namespace": []interface{}{map[string]interface{}{"enabled": false}},
+				"bucket_policy_only":     false,
+			},
+		},
+		"hierarchical namespace with uniform access": {
+			After: map[string]interface{}{
+				"hierarchical_namespace": []interface{}{map[string]interface{}{"enabled": true}},
+				"bucket_policy_only":     true,
+			},
+		},
+		"hierarchical namespace without uniform access": {
+			After: map[string]interface{}{
+				"hierarchical_namespace": []interface{}{map[string]interface{}{"enabled": true}},
+				"bucket_policy_only":     false,
+			},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &ResourceDiffMock{
+			After: tc.After,
+		}
+		err := storageBucketHierarchicalNamespaceCustomizeDiffFunc(d)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
+
+func TestAccStorageBucket_deletionProtection(t *testing.T) {
+	t.Parallel()
+
+	bucketName := testBucketName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccStorageBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucket_deletionProtection(bucketName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      testAccStorageBucket_deletionProtection(bucketName, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("deletion_protection"),
+			},
+			// Update deletion_protection to false, otherwise the test harness can't delete the bucket
+			{
+				Config: testAccStorageBucket_deletionProtection(bucketName, false),
+			},
+			{
+				ResourceName:      "google_storage_bucket.bucket",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccStorageBucket_softDeletePolicy(t *testing.T) {
+	t.Parallel()
+
+	bucketName := testBucketName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccStorageBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucket_softDeletePolicy(bucketName, 1209600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "soft_delete_policy.0.retention_duration_seconds", "1209600"),
+				),
+			},
+			{
+				ResourceName:      "google_storage_bucket.bucket",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStorageBucket_softDeletePolicy(bucketName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "soft_delete_policy.0.retention_duration_seconds", "0"),
+				),
+			},
+			{
+				ResourceName:      "google_storage_bucket.bucket",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccStorageBucket_hierarchicalNamespace(t *testing.T) {
+	t.Parallel()
+
+	bucketName := testBucketName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccStorageBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucket_hierarchicalNamespace(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "hierarchical_namespace.0.enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      "google_storage_bucket.bucket",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccStorageBucket_customPlacementConfig(t *testing.T) {
+	t.Parallel()
+
+	bucketName := testBucketName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccStorageBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucket_customPlacementConfig(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "custom_placement_config.0.data_locations.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "google_storage_bucket.bucket",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// Requests from outside the allowed ranges are rejected, so the test identity
+// must be exempt from IP filtering, e.g. as a project owner.
+func TestAccStorageBucket_ipFilter(t *testing.T) {
+	t.Parallel()
+
+	bucketName := testBucketName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccStorageBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucket_ipFilter(bucketName, "Enabled"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "ip_filter.0.public_network_source.0.allowed_ip_cidr_ranges.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "google_storage_bucket.bucket",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStorageBucket_ipFilter(bucketName, "Disabled"),
+			},
+			{
+				ResourceName:      "google_storage_bucket.bucket",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccStorageBucket_requesterPays(t *testing.T) {
 	t.Parallel()
 
@@ -630,6 +905,35 @@ func TestAccStorageBucket_forceDestroyWithVersioning(t *testing.T) {
 	})
 }
 
+func TestAccStorageBucket_forceDestroyWithGenerationsAndHolds(t *testing.T) {
+	t.Parallel()
+
+	var bucket storage.Bucket
+	bucketName := fmt.Sprintf("tf-test-acc-bucket-%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccStorageBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucket_forceDestroyWithVersioning(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageBucketExists(
+						"google_storage_bucket.bucket", bucketName, &bucket),
+					testAccCheckStorageBucketPutGenerations(bucketName, 3),
+				),
+			},
+			{
+				Config: testAccStorageBucket_forceDestroyWithVersioning(acctest.RandomWithPrefix("tf-test-acc-bucket")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageBucketMissing(bucketName),
+				),
+			},
+		},
+	})
+}
+
 func TestAccStorageBucket_versioning(t *testing.T) {
 	t.Parallel()
 
@@ -926,6 +1230,31 @@ func testAccCheckStorageBucketPutItem(bucketName string) resource.TestCheckFunc
 	}
 }
 
+// testAccCheckStorageBucketPutGenerations writes several generations of a few
+// objects, the latest of each under a temporary hold.
+func testAccCheckStorageBucketPutGenerations(bucketName string, generations int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		for _, name := range []string{"bucketDestroyTestFile", "dir/bucketDestroyTestFile"} {
+			for i := 0; i < generations; i++ {
+				object := &storage.Object{
+					Name:          name,
+					TemporaryHold: i == generations-1,
+				}
+				dataReader := bytes.NewReader([]byte(fmt.Sprintf("test %d", i)))
+				res, err := config.clientStorage.Objects.Insert(bucketName, object).Media(dataReader).Do()
+				if err != nil {
+					return fmt.Errorf("Objects.Insert failed: %v", err)
+				}
+				log.Printf("[INFO] Created object %v generation %d\n\n", res.Name, res.Generation)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckStorageBucketMissing(bucketName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		config := testAccProvider.Meta().(*Config)
@@ -987,6 +1316,71 @@ resource "google_storage_bucket" "bucket" {
 `, bucketName)
 }
 
+func testAccStorageBucket_deletionProtection(bucketName string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+	name                = "%s"
+	deletion_protection = %t
+}
+`, bucketName, deletionProtection)
+}
+
+func testAccStorageBucket_softDeletePolicy(bucketName string, retention int) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+	name = "%s"
+
+	soft_delete_policy {
+		retention_duration_seconds = %d
+	}
+}
+`, bucketName, retention)
+}
+
+func testAccStorageBucket_hierarchicalNamespace(bucketName string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+	name               = "%s"
+	location           = "US-CENTRAL1"
+	bucket_policy_only = true
+
+	hierarchical_namespace {
+		enabled = true
+	}
+}
+`, bucketName)
+}
+
+func testAccStorageBucket_customPlacementConfig(bucketName string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+	name     = "%s"
+	location = "US"
+
+	custom_placement_config {
+		data_locations = ["US-CENTRAL1", "US-EAST1"]
+	}
+}
+`, bucketName)
+}
+
+func testAccStorageBucket_ipFilter(bucketName, mode string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+	name               = "%s"
+	bucket_policy_only = true
+
+	ip_filter {
+		mode = "%s"
+
+		public_network_source {
+			allowed_ip_cidr_ranges = ["192.0.2.0/24"]
+		}
+	}
+}
+`, bucketName, mode)
+}
+
 func testAccStorageBucket_requesterPays(bucketName string, pays bool) string {
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
@@ -0,0 +1,160 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceStorageManagedFolder() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceStorageManagedFolderCreate,
+		Read:   resourceStorageManagedFolderRead,
+		Delete: resourceStorageManagedFolderDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceStorageManagedFolderImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The name of the bucket that contains the managed folder. The bucket must have a hierarchical namespace.`,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegexp(`^[^/].*/$`),
+				Description:  `The name of the managed folder, expressed as a path. Must end with a slash, e.g. "folder/" or "parent/folder/".`,
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The timestamp at which this managed folder was created.`,
+			},
+			"update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The timestamp at which this managed folder was most recently updated.`,
+			},
+			"metageneration": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The metadata generation of the managed folder.`,
+			},
+		},
+	}
+}
+
+func resourceStorageManagedFolderCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	bucket := d.Get("bucket").(string)
+
+	// Managed folders can only be created in buckets with a hierarchical
+	// namespace, which the API would otherwise report less clearly.
+	res, err := sendRequest(config, "GET", "", config.StorageBasePath+"b/"+bucket+"?fields=hierarchicalNamespace", nil)
+	if err != nil {
+		return fmt.Errorf("Error reading bucket %s: %s", bucket, err)
+	}
+	if hns, ok := res["hierarchicalNamespace"].(map[string]interface{}); !ok || hns["enabled"] != true {
+		return fmt.Errorf("Cannot create a managed folder in bucket %s: the bucket doesn't have a hierarchical namespace", bucket)
+	}
+
+	obj := map[string]interface{}{
+		"name": d.Get("name").(string),
+	}
+
+	log.Printf("[DEBUG] Creating new ManagedFolder: %#v", obj)
+	res, err = sendRequestWithTimeout(config, "POST", "", config.StorageBasePath+"b/"+bucket+"/managedFolders", obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating ManagedFolder: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "{{bucket}}/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating ManagedFolder %q: %#v", d.Id(), res)
+
+	return resourceStorageManagedFolderRead(d, meta)
+}
+
+func resourceStorageManagedFolderRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	res, err := sendRequest(config, "GET", "", storageManagedFolderUrl(config, d.Get("bucket").(string), d.Get("name").(string)), nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("StorageManagedFolder %q", d.Id()))
+	}
+
+	if err := d.Set("bucket", res["bucket"]); err != nil {
+		return fmt.Errorf("Error reading ManagedFolder: %s", err)
+	}
+	if err := d.Set("name", res["name"]); err != nil {
+		return fmt.Errorf("Error reading ManagedFolder: %s", err)
+	}
+	if err := d.Set("create_time", res["createTime"]); err != nil {
+		return fmt.Errorf("Error reading ManagedFolder: %s", err)
+	}
+	if err := d.Set("update_time", res["updateTime"]); err != nil {
+		return fmt.Errorf("Error reading ManagedFolder: %s", err)
+	}
+	if err := d.Set("metageneration", res["metageneration"]); err != nil {
+		return fmt.Errorf("Error reading ManagedFolder: %s", err)
+	}
+
+	return nil
+}
+
+func resourceStorageManagedFolderDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] Deleting ManagedFolder %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", "", storageManagedFolderUrl(config, d.Get("bucket").(string), d.Get("name").(string)), nil, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "ManagedFolder")
+	}
+
+	log.Printf("[DEBUG] Finished deleting ManagedFolder %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceStorageManagedFolderImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"b/(?P<bucket>[^/]+)/managedFolders/(?P<name>.+)",
+		"(?P<bucket>[^/]+)/(?P<name>.+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{bucket}}/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// storageManagedFolderUrl returns the URL of a managed folder. Its name is a
+// path, so it's escaped as a single path segment.
+func storageManagedFolderUrl(config *Config, bucket, name string) string {
+	return fmt.Sprintf("%sb/%s/managedFolders/%s", config.StorageBasePath, bucket, url.PathEscape(name))
+}
@@ -0,0 +1,153 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestStorageManagedFolderIdParseFunc(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Id            string
+		Bucket        string
+		ManagedFolder string
+		ExpectError   bool
+	}{
+		"bucket and folder": {
+			Id:            "my-bucket/folder/",
+			Bucket:        "my-bucket",
+			ManagedFolder: "folder/",
+		},
+		"nested folder": {
+			Id:            "my-bucket/parent/folder/",
+			Bucket:        "my-bucket",
+			ManagedFolder: "parent/folder/",
+		},
+		"relative name": {
+			Id:            "b/my-bucket/managedFolders/parent/folder/",
+			Bucket:        "my-bucket",
+			ManagedFolder: "parent/folder/",
+		},
+		"no trailing slash": {
+			Id:          "my-bucket/folder",
+			ExpectError: true,
+		},
+		"bucket only": {
+			Id:          "my-bucket",
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := schema.TestResourceDataRaw(t, IamStorageManagedFolderSchema, map[string]interface{}{})
+		d.SetId(tc.Id)
+		err := StorageManagedFolderIdParseFunc(d, nil)
+		if tc.ExpectError {
+			if err == nil {
+				t.Errorf("%s: expected an error parsing %q", tn, tc.Id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+			continue
+		}
+		if d.Get("bucket") != tc.Bucket || d.Get("managed_folder") != tc.ManagedFolder {
+			t.Errorf("%s: expected bucket %q and managed folder %q, got %q and %q", tn, tc.Bucket, tc.ManagedFolder, d.Get("bucket"), d.Get("managed_folder"))
+		}
+	}
+}
+
+func TestAccStorageManagedFolder_withIamMember(t *testing.T) {
+	t.Parallel()
+
+	bucket := acctest.RandomWithPrefix("tf-test")
+	account := acctest.RandomWithPrefix("tf-test")
+	role := "roles/storage.objectViewer"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStorageManagedFolderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageManagedFolder_withIamMember(bucket, account, role),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"google_storage_managed_folder.folder", "create_time"),
+				),
+			},
+			{
+				ResourceName:      "google_storage_managed_folder.folder",
+				ImportStateId:     fmt.Sprintf("%s/folder/", bucket),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "google_storage_managed_folder_iam_member.member",
+				ImportStateId:     fmt.Sprintf("%s/folder/ %s serviceAccount:%s@%s.iam.gserviceaccount.com", bucket, role, account, getTestProjectFromEnv()),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccStorageManagedFolder_withIamMember(bucket, account, role string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+  name               = "%s"
+  location           = "US-CENTRAL1"
+  bucket_policy_only = true
+
+  hierarchical_namespace {
+    enabled = true
+  }
+}
+
+resource "google_storage_managed_folder" "folder" {
+  bucket = google_storage_bucket.bucket.name
+  name   = "folder/"
+}
+
+resource "google_service_account" "account" {
+  account_id   = "%s"
+  display_name = "Managed folder IAM test account"
+}
+
+resource "google_storage_managed_folder_iam_member" "member" {
+  bucket         = google_storage_managed_folder.folder.bucket
+  managed_folder = google_storage_managed_folder.folder.name
+  role           = "%s"
+  member         = "serviceAccount:${google_service_account.account.email}"
+}
+`, bucket, account, role)
+}
+
+func testAccCheckStorageManagedFolderDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_storage_managed_folder" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url := storageManagedFolderUrl(config, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["name"])
+		_, err := sendRequest(config, "GET", "", url, nil)
+		if err == nil {
+			return fmt.Errorf("StorageManagedFolder still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,217 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceTagsLocationTagBinding() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTagsLocationTagBindingCreate,
+		Read:   resourceTagsLocationTagBindingRead,
+		Delete: resourceTagsLocationTagBindingDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceTagsLocationTagBindingImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The location of the resource the TagValue is bound to, which selects the regional endpoint the binding is managed through. E.g. us-central1`,
+			},
+			"parent": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegexp(`^//[a-z0-9.-]+\.googleapis\.com/.+$`),
+				Description:  `The full resource name of the resource the TagValue is bound to. E.g. //run.googleapis.com/projects/123/locations/us-central1/services/my-service`,
+			},
+			"tag_value": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegexp(`^tagValues/[0-9]+$`),
+				Description:  `The TagValue of the TagBinding. Must be of the form tagValues/456.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The generated id for the TagBinding. This is a string of the form: {full-resource-name}/{tag-value-name}, escaped.`,
+			},
+		},
+	}
+}
+
+func resourceTagsLocationTagBindingCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	location := d.Get("location").(string)
+	obj := map[string]interface{}{
+		"parent":   d.Get("parent").(string),
+		"tagValue": d.Get("tag_value").(string),
+	}
+
+	url := tagsLocationBasePath(config, location) + "tagBindings"
+
+	log.Printf("[DEBUG] Creating new LocationTagBinding: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", "", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating LocationTagBinding: %s", err)
+	}
+
+	var opRes map[string]interface{}
+	err = tagsLocationOperationWaitTimeWithResponse(
+		config, res, &opRes, location, "Creating LocationTagBinding",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+	if err != nil {
+		return fmt.Errorf("Error waiting to create LocationTagBinding: %s", err)
+	}
+
+	name, ok := opRes["name"].(string)
+	if !ok || name == "" {
+		return fmt.Errorf("Error creating LocationTagBinding: the operation response has no name")
+	}
+	d.Set("name", strings.TrimPrefix(name, "tagBindings/"))
+
+	id, err := replaceVars(d, config, "{{location}}/tagBindings/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating LocationTagBinding %q: %#v", d.Id(), res)
+
+	return resourceTagsLocationTagBindingRead(d, meta)
+}
+
+func resourceTagsLocationTagBindingRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	// Tag bindings can only be listed for the resource they're attached to, so
+	// the binding is looked up among those of its parent.
+	url, err := replaceVars(d, config, tagsLocationBasePath(config, d.Get("location").(string))+"tagBindings?parent={{%parent}}&pageSize=300")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", "", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("TagsLocationTagBinding %q", d.Id()))
+	}
+
+	binding := findTagBinding(res, "tagBindings/"+d.Get("name").(string))
+	if binding == nil {
+		log.Printf("[DEBUG] Removing TagsLocationTagBinding %q because it no longer exists.", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("parent", binding["parent"]); err != nil {
+		return fmt.Errorf("Error reading LocationTagBinding: %s", err)
+	}
+	if err := d.Set("tag_value", binding["tagValue"]); err != nil {
+		return fmt.Errorf("Error reading LocationTagBinding: %s", err)
+	}
+
+	return nil
+}
+
+func resourceTagsLocationTagBindingDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	location := d.Get("location").(string)
+	url, err := replaceVars(d, config, tagsLocationBasePath(config, location)+"tagBindings/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting LocationTagBinding %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", "", url, nil, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "LocationTagBinding")
+	}
+
+	err = tagsLocationOperationWaitTime(
+		config, res, location, "Deleting LocationTagBinding",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting LocationTagBinding %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceTagsLocationTagBindingImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"(?P<location>[^/]+)/tagBindings/(?P<name>.+)",
+		"(?P<location>[^/]+)/(?P<name>.+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{location}}/tagBindings/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	parent, err := tagBindingParentFromName(d.Get("name").(string))
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("parent", parent); err != nil {
+		return nil, fmt.Errorf("Error setting parent: %s", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// findTagBinding returns the binding with the given name from a list of tag
+// bindings, or nil if it isn't listed.
+func findTagBinding(res map[string]interface{}, name string) map[string]interface{} {
+	v, ok := res["tagBindings"]
+	if !ok || v == nil {
+		return nil
+	}
+
+	for _, raw := range v.([]interface{}) {
+		binding, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if binding["name"] == name {
+			return binding
+		}
+	}
+	return nil
+}
+
+// tagBindingParentFromName returns the full resource name of the parent of a
+// tag binding, which is escaped in the binding's name.
+func tagBindingParentFromName(name string) (string, error) {
+	parts := strings.SplitN(name, "/tagValues/", 2)
+	if len(parts) != 2 {
+		return "", fmt.Errorf("Error parsing tag binding name %q, expected {{parent}}/tagValues/{{tag_value}}", name)
+	}
+	parent, err := url.PathUnescape(parts[0])
+	if err != nil {
+		return "", fmt.Errorf("Error parsing parent of tag binding %q: %s", name, err)
+	}
+	return parent, nil
+}
@@ -0,0 +1,150 @@
+package google
+
+import (
+	"fmt"
+	neturl "net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestTagsLocationTagBinding_parentValidation(t *testing.T) {
+	x := []StringValidationTestCase{
+		// No errors
+		{TestName: "cloud run service", Value: "//run.googleapis.com/projects/123/locations/us-central1/services/my-service"},
+		{TestName: "storage bucket", Value: "//storage.googleapis.com/projects/_/buckets/my-bucket"},
+
+		// With errors
+		{TestName: "relative name", Value: "projects/123/locations/us-central1/services/my-service", ExpectError: true},
+		{TestName: "url", Value: "https://run.googleapis.com/v1/projects/123/locations/us-central1/services/my-service", ExpectError: true},
+		{TestName: "service only", Value: "//run.googleapis.com/", ExpectError: true},
+	}
+
+	es := testStringValidationCases(x, resourceTagsLocationTagBinding().Schema["parent"].ValidateFunc)
+	if len(es) > 0 {
+		t.Errorf("Failed to validate location tag binding parents: %v", es)
+	}
+}
+
+func TestTagBindingParentFromName(t *testing.T) {
+	cases := map[string]struct {
+		Name        string
+		Parent      string
+		ExpectError bool
+	}{
+		"cloud run service": {
+			Name:   "%2F%2Frun.googleapis.com%2Fprojects%2F123%2Flocations%2Fus-central1%2Fservices%2Fmy-service/tagValues/456",
+			Parent: "//run.googleapis.com/projects/123/locations/us-central1/services/my-service",
+		},
+		"project": {
+			Name:   "%2F%2Fcloudresourcemanager.googleapis.com%2Fprojects%2F123/tagValues/456",
+			Parent: "//cloudresourcemanager.googleapis.com/projects/123",
+		},
+		"missing tag value": {
+			Name:        "%2F%2Frun.googleapis.com%2Fprojects%2F123",
+			ExpectError: true,
+		},
+		"bad escape": {
+			Name:        "%2G/tagValues/456",
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		parent, err := tagBindingParentFromName(tc.Name)
+		if tc.ExpectError {
+			if err == nil {
+				t.Errorf("%s: expected an error parsing %q", tn, tc.Name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+			continue
+		}
+		if parent != tc.Parent {
+			t.Errorf("%s: expected parent %q, got %q", tn, tc.Parent, parent)
+		}
+	}
+}
+
+func TestAccTagsLocationTagBinding_bucket(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"org_id":        getTestOrgFromEnv(t),
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTagsLocationTagBindingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagsLocationTagBinding_bucket(context),
+			},
+			{
+				ResourceName:      "google_tags_location_tag_binding.binding",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTagsLocationTagBinding_bucket(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_tags_tag_key" "key" {
+  parent      = "organizations/%{org_id}"
+  short_name  = "keyname%{random_suffix}"
+  description = "For keyname resources."
+}
+
+resource "google_tags_tag_value" "value" {
+  parent      = "tagKeys/${google_tags_tag_key.key.name}"
+  short_name  = "valuename%{random_suffix}"
+  description = "For valuename resources."
+}
+
+resource "google_storage_bucket" "bucket" {
+  name     = "tf-test-bucket-%{random_suffix}"
+  location = "US-CENTRAL1"
+}
+
+resource "google_tags_location_tag_binding" "binding" {
+  parent    = "//storage.googleapis.com/projects/_/buckets/${google_storage_bucket.bucket.name}"
+  tag_value = "tagValues/${google_tags_tag_value.value.name}"
+  location  = "us-central1"
+}
+`, context)
+}
+
+func testAccCheckTagsLocationTagBindingDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_tags_location_tag_binding" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url := fmt.Sprintf("%stagBindings?parent=%s&pageSize=300", tagsLocationBasePath(config, rs.Primary.Attributes["location"]), neturl.PathEscape(rs.Primary.Attributes["parent"]))
+		res, err := sendRequest(config, "GET", "", url, nil)
+		if err != nil {
+			// The parent is gone, and its bindings with it.
+			continue
+		}
+
+		if findTagBinding(res, "tagBindings/"+rs.Primary.Attributes["name"]) != nil {
+			return fmt.Errorf("TagsLocationTagBinding still exists at %s", url)
+		}
+	}
+
+	return nil
+}
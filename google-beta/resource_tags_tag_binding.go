@@ -0,0 +1,267 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceTagsTagBinding() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTagsTagBindingCreate,
+		Read:   resourceTagsTagBindingRead,
+		Delete: resourceTagsTagBindingDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceTagsTagBindingImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"parent": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegexp(`^//[a-z0-9.-]+\.googleapis\.com/.+$`),
+			},
+			"tag_value": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegexp(`^tagValues/[0-9]+$`),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTagsTagBindingCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	parentProp, err := expandTagsTagBindingParent(d.Get("parent"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("parent"); !isEmptyValue(reflect.ValueOf(parentProp)) && (ok || !reflect.DeepEqual(v, parentProp)) {
+		obj["parent"] = parentProp
+	}
+	tagValueProp, err := expandTagsTagBindingTagValue(d.Get("tag_value"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("tag_value"); !isEmptyValue(reflect.ValueOf(tagValueProp)) && (ok || !reflect.DeepEqual(v, tagValueProp)) {
+		obj["tagValue"] = tagValueProp
+	}
+
+	url, err := replaceVars(d, config, "{{TagsBasePath}}tagBindings")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new TagBinding: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", "", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating TagBinding: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "tagBindings/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	// Use the resource in the operation response to populate
+	// identity fields and d.Id() before read
+	var opRes map[string]interface{}
+	err = tagsOperationWaitTimeWithResponse(
+		config, res, &opRes, "Creating TagBinding",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+	if err != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create TagBinding: %s", err)
+	}
+
+	if err := d.Set("name", flattenTagsTagBindingName(opRes["name"], d)); err != nil {
+		return err
+	}
+
+	// This may have caused the ID to update - update it if so.
+	id, err = replaceVars(d, config, "tagBindings/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating TagBinding %q: %#v", d.Id(), res)
+
+	return resourceTagsTagBindingRead(d, meta)
+}
+
+func resourceTagsTagBindingRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{TagsBasePath}}tagBindings/?parent={{parent}}&pageSize=300")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", "", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("TagsTagBinding %q", d.Id()))
+	}
+
+	res, err = resourceTagsTagBindingDecoder(d, meta, res)
+	if err != nil {
+		return err
+	}
+
+	if res == nil {
+		// Decoding the object has resulted in it being gone. It may be marked deleted
+		log.Printf("[DEBUG] Removing TagsTagBinding because it no longer exists.")
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("name", flattenTagsTagBindingName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading TagBinding: %s", err)
+	}
+	if err := d.Set("parent", flattenTagsTagBindingParent(res["parent"], d)); err != nil {
+		return fmt.Errorf("Error reading TagBinding: %s", err)
+	}
+	if err := d.Set("tag_value", flattenTagsTagBindingTagValue(res["tagValue"], d)); err != nil {
+		return fmt.Errorf("Error reading TagBinding: %s", err)
+	}
+
+	return nil
+}
+
+func resourceTagsTagBindingDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{TagsBasePath}}tagBindings/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting TagBinding %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", "", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "TagBinding")
+	}
+
+	err = tagsOperationWaitTime(
+		config, res, "Deleting TagBinding",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting TagBinding %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceTagsTagBindingImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"tagBindings/(?P<name>.+)",
+		"(?P<name>.+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "tagBindings/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	// The parent of the binding is part of its name, escaped, and is needed
+	// to list the bindings of the parent on read.
+	parts := strings.SplitN(d.Get("name").(string), "/tagValues/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Error parsing tag binding name %q, expected {{parent}}/tagValues/{{tag_value}}", d.Get("name").(string))
+	}
+	parent, err := url.PathUnescape(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing parent of tag binding %q: %s", d.Get("name").(string), err)
+	}
+	if err := d.Set("parent", parent); err != nil {
+		return nil, fmt.Errorf("Error setting parent: %s", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenTagsTagBindingName(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	return strings.TrimPrefix(v.(string), "tagBindings/")
+}
+
+func flattenTagsTagBindingParent(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenTagsTagBindingTagValue(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandTagsTagBindingParent(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandTagsTagBindingTagValue(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+// Tag bindings can only be listed for the resource they're attached to, so the
+// binding is looked up among those of its parent.
+func resourceTagsTagBindingDecoder(d *schema.ResourceData, meta interface{}, res map[string]interface{}) (map[string]interface{}, error) {
+	v, ok := res["tagBindings"]
+	if !ok || v == nil {
+		return nil, nil
+	}
+
+	name := "tagBindings/" + d.Get("name").(string)
+	for _, raw := range v.([]interface{}) {
+		binding, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if binding["name"] == name {
+			return binding, nil
+		}
+	}
+	return nil, nil
+}
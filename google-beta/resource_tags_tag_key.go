@@ -0,0 +1,322 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceTagsTagKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTagsTagKeyCreate,
+		Read:   resourceTagsTagKeyRead,
+		Update: resourceTagsTagKeyUpdate,
+		Delete: resourceTagsTagKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceTagsTagKeyImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Update: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"parent": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegexp(`^organizations/[0-9]+$`),
+			},
+			"short_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegexp(`^[a-zA-Z0-9](?:[a-zA-Z0-9_.-]{0,61}[a-zA-Z0-9])?$`),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"namespaced_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTagsTagKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	parentProp, err := expandTagsTagKeyParent(d.Get("parent"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("parent"); !isEmptyValue(reflect.ValueOf(parentProp)) && (ok || !reflect.DeepEqual(v, parentProp)) {
+		obj["parent"] = parentProp
+	}
+	shortNameProp, err := expandTagsTagKeyShortName(d.Get("short_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("short_name"); !isEmptyValue(reflect.ValueOf(shortNameProp)) && (ok || !reflect.DeepEqual(v, shortNameProp)) {
+		obj["shortName"] = shortNameProp
+	}
+	descriptionProp, err := expandTagsTagKeyDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+
+	url, err := replaceVars(d, config, "{{TagsBasePath}}tagKeys")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new TagKey: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", "", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating TagKey: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "tagKeys/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	// Use the resource in the operation response to populate
+	// identity fields and d.Id() before read
+	var opRes map[string]interface{}
+	err = tagsOperationWaitTimeWithResponse(
+		config, res, &opRes, "Creating TagKey",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+	if err != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create TagKey: %s", err)
+	}
+
+	if err := d.Set("name", flattenTagsTagKeyName(opRes["name"], d)); err != nil {
+		return err
+	}
+
+	// This may have caused the ID to update - update it if so.
+	id, err = replaceVars(d, config, "tagKeys/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating TagKey %q: %#v", d.Id(), res)
+
+	return resourceTagsTagKeyRead(d, meta)
+}
+
+func resourceTagsTagKeyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{TagsBasePath}}tagKeys/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", "", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("TagsTagKey %q", d.Id()))
+	}
+
+	if err := d.Set("name", flattenTagsTagKeyName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading TagKey: %s", err)
+	}
+	if err := d.Set("parent", flattenTagsTagKeyParent(res["parent"], d)); err != nil {
+		return fmt.Errorf("Error reading TagKey: %s", err)
+	}
+	if err := d.Set("short_name", flattenTagsTagKeyShortName(res["shortName"], d)); err != nil {
+		return fmt.Errorf("Error reading TagKey: %s", err)
+	}
+	if err := d.Set("namespaced_name", flattenTagsTagKeyNamespacedName(res["namespacedName"], d)); err != nil {
+		return fmt.Errorf("Error reading TagKey: %s", err)
+	}
+	if err := d.Set("description", flattenTagsTagKeyDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading TagKey: %s", err)
+	}
+	if err := d.Set("create_time", flattenTagsTagKeyCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading TagKey: %s", err)
+	}
+	if err := d.Set("update_time", flattenTagsTagKeyUpdateTime(res["updateTime"], d)); err != nil {
+		return fmt.Errorf("Error reading TagKey: %s", err)
+	}
+
+	return nil
+}
+
+func resourceTagsTagKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandTagsTagKeyDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+
+	url, err := replaceVars(d, config, "{{TagsBasePath}}tagKeys/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating TagKey %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("description") {
+		updateMask = append(updateMask, "description")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	res, err := sendRequestWithTimeout(config, "PATCH", "", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating TagKey %q: %s", d.Id(), err)
+	}
+
+	err = tagsOperationWaitTime(
+		config, res, "Updating TagKey",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceTagsTagKeyRead(d, meta)
+}
+
+func resourceTagsTagKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{TagsBasePath}}tagKeys/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting TagKey %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", "", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "TagKey")
+	}
+
+	err = tagsOperationWaitTime(
+		config, res, "Deleting TagKey",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting TagKey %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceTagsTagKeyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"tagKeys/(?P<name>[^/]+)",
+		"(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "tagKeys/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenTagsTagKeyName(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	return GetResourceNameFromSelfLink(v.(string))
+}
+
+func flattenTagsTagKeyParent(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenTagsTagKeyShortName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenTagsTagKeyNamespacedName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenTagsTagKeyDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenTagsTagKeyCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenTagsTagKeyUpdateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandTagsTagKeyParent(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandTagsTagKeyShortName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandTagsTagKeyDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
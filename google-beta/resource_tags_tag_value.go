@@ -0,0 +1,322 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceTagsTagValue() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTagsTagValueCreate,
+		Read:   resourceTagsTagValueRead,
+		Update: resourceTagsTagValueUpdate,
+		Delete: resourceTagsTagValueDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceTagsTagValueImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Update: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"parent": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegexp(`^tagKeys/[0-9]+$`),
+			},
+			"short_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegexp(`^[a-zA-Z0-9](?:[a-zA-Z0-9_.-]{0,61}[a-zA-Z0-9])?$`),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"namespaced_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTagsTagValueCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	parentProp, err := expandTagsTagValueParent(d.Get("parent"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("parent"); !isEmptyValue(reflect.ValueOf(parentProp)) && (ok || !reflect.DeepEqual(v, parentProp)) {
+		obj["parent"] = parentProp
+	}
+	shortNameProp, err := expandTagsTagValueShortName(d.Get("short_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("short_name"); !isEmptyValue(reflect.ValueOf(shortNameProp)) && (ok || !reflect.DeepEqual(v, shortNameProp)) {
+		obj["shortName"] = shortNameProp
+	}
+	descriptionProp, err := expandTagsTagValueDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+
+	url, err := replaceVars(d, config, "{{TagsBasePath}}tagValues")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new TagValue: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", "", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating TagValue: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "tagValues/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	// Use the resource in the operation response to populate
+	// identity fields and d.Id() before read
+	var opRes map[string]interface{}
+	err = tagsOperationWaitTimeWithResponse(
+		config, res, &opRes, "Creating TagValue",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+	if err != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create TagValue: %s", err)
+	}
+
+	if err := d.Set("name", flattenTagsTagValueName(opRes["name"], d)); err != nil {
+		return err
+	}
+
+	// This may have caused the ID to update - update it if so.
+	id, err = replaceVars(d, config, "tagValues/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating TagValue %q: %#v", d.Id(), res)
+
+	return resourceTagsTagValueRead(d, meta)
+}
+
+func resourceTagsTagValueRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{TagsBasePath}}tagValues/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", "", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("TagsTagValue %q", d.Id()))
+	}
+
+	if err := d.Set("name", flattenTagsTagValueName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading TagValue: %s", err)
+	}
+	if err := d.Set("parent", flattenTagsTagValueParent(res["parent"], d)); err != nil {
+		return fmt.Errorf("Error reading TagValue: %s", err)
+	}
+	if err := d.Set("short_name", flattenTagsTagValueShortName(res["shortName"], d)); err != nil {
+		return fmt.Errorf("Error reading TagValue: %s", err)
+	}
+	if err := d.Set("namespaced_name", flattenTagsTagValueNamespacedName(res["namespacedName"], d)); err != nil {
+		return fmt.Errorf("Error reading TagValue: %s", err)
+	}
+	if err := d.Set("description", flattenTagsTagValueDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading TagValue: %s", err)
+	}
+	if err := d.Set("create_time", flattenTagsTagValueCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading TagValue: %s", err)
+	}
+	if err := d.Set("update_time", flattenTagsTagValueUpdateTime(res["updateTime"], d)); err != nil {
+		return fmt.Errorf("Error reading TagValue: %s", err)
+	}
+
+	return nil
+}
+
+func resourceTagsTagValueUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandTagsTagValueDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+
+	url, err := replaceVars(d, config, "{{TagsBasePath}}tagValues/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating TagValue %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("description") {
+		updateMask = append(updateMask, "description")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	res, err := sendRequestWithTimeout(config, "PATCH", "", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating TagValue %q: %s", d.Id(), err)
+	}
+
+	err = tagsOperationWaitTime(
+		config, res, "Updating TagValue",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceTagsTagValueRead(d, meta)
+}
+
+func resourceTagsTagValueDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "{{TagsBasePath}}tagValues/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting TagValue %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", "", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "TagValue")
+	}
+
+	err = tagsOperationWaitTime(
+		config, res, "Deleting TagValue",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting TagValue %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceTagsTagValueImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"tagValues/(?P<name>[^/]+)",
+		"(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "tagValues/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenTagsTagValueName(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	return GetResourceNameFromSelfLink(v.(string))
+}
+
+func flattenTagsTagValueParent(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenTagsTagValueShortName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenTagsTagValueNamespacedName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenTagsTagValueDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenTagsTagValueCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenTagsTagValueUpdateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandTagsTagValueParent(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandTagsTagValueShortName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandTagsTagValueDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,29 @@
+package google
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTagsTagKeyAndValue_shortNameValidation(t *testing.T) {
+	x := []StringValidationTestCase{
+		// No errors
+		{TestName: "single character", Value: "a"},
+		{TestName: "mixed case", Value: "Environment"},
+		{TestName: "separators", Value: "env_prod-1.a"},
+		{TestName: "max length", Value: strings.Repeat("a", 63)},
+
+		// With errors
+		{TestName: "empty", Value: "", ExpectError: true},
+		{TestName: "leading separator", Value: "-env", ExpectError: true},
+		{TestName: "trailing separator", Value: "env.", ExpectError: true},
+		{TestName: "slash", Value: "env/prod", ExpectError: true},
+		{TestName: "too long", Value: strings.Repeat("a", 64), ExpectError: true},
+	}
+
+	es := testStringValidationCases(x, resourceTagsTagKey().Schema["short_name"].ValidateFunc)
+	es = append(es, testStringValidationCases(x, resourceTagsTagValue().Schema["short_name"].ValidateFunc)...)
+	if len(es) > 0 {
+		t.Errorf("Failed to validate tag short names: %v", es)
+	}
+}
@@ -132,7 +132,7 @@ func testAccCheckTpuNodeDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = sendRequest(config, "GET", url, nil)
+		_, err = sendRequest(config, "GET", "", url, nil)
 		if err == nil {
 			return fmt.Errorf("TpuNode still exists at %s", url)
 		}
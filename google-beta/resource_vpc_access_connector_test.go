@@ -0,0 +1,85 @@
+package google
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccVPCAccessConnector_update(t *testing.T) {
+	t.Parallel()
+
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVPCAccessConnectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCAccessConnector_scaling(name, "e2-micro", 2, 3),
+			},
+			{
+				ResourceName:      "google_vpc_access_connector.connector",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCAccessConnector_scaling(name, "e2-standard-4", 2, 5),
+			},
+			{
+				ResourceName:      "google_vpc_access_connector.connector",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCAccessConnector_invalidThroughput(t *testing.T) {
+	t.Parallel()
+
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVPCAccessConnectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCAccessConnector_throughput(name, 500, 300),
+				ExpectError: regexp.MustCompile("min_throughput \\(500\\) must be lower than max_throughput \\(300\\)"),
+			},
+		},
+	})
+}
+
+func testAccVPCAccessConnector_scaling(name, machineType string, minInstances, maxInstances int) string {
+	return fmt.Sprintf(`
+resource "google_vpc_access_connector" "connector" {
+  name          = "%s"
+  ip_cidr_range = "10.18.0.0/28"
+  network       = "default"
+  region        = "us-central1"
+  machine_type  = "%s"
+  min_instances = %d
+  max_instances = %d
+}
+`, name, machineType, minInstances, maxInstances)
+}
+
+func testAccVPCAccessConnector_throughput(name string, minThroughput, maxThroughput int) string {
+	return fmt.Sprintf(`
+resource "google_vpc_access_connector" "connector" {
+  name           = "%s"
+  ip_cidr_range  = "10.18.0.0/28"
+  network        = "default"
+  region         = "us-central1"
+  min_throughput = %d
+  max_throughput = %d
+}
+`, name, minThroughput, maxThroughput)
+}
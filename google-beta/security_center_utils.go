@@ -0,0 +1,49 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+)
+
+// validateSecurityCenterFilter does a shallow check of Security Command
+// Center filter expressions, e.g. `severity = "HIGH" AND state = "ACTIVE"`.
+// The full grammar is validated by the API; this catches empty filters and
+// unbalanced quotes or parentheses at plan time.
+func validateSecurityCenterFilter(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if strings.TrimSpace(value) == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return
+	}
+
+	depth := 0
+	inQuotes := false
+	escaped := false
+	for _, c := range value {
+		switch {
+		case escaped:
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == '"':
+			inQuotes = !inQuotes
+		case inQuotes:
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+			if depth < 0 {
+				errors = append(errors, fmt.Errorf("%q has an unmatched closing parenthesis: %q", k, value))
+				return
+			}
+		}
+	}
+
+	if inQuotes {
+		errors = append(errors, fmt.Errorf("%q has an unterminated string: %q", k, value))
+	}
+	if depth > 0 {
+		errors = append(errors, fmt.Errorf("%q has an unmatched opening parenthesis: %q", k, value))
+	}
+	return
+}
@@ -0,0 +1,26 @@
+package google
+
+import "testing"
+
+func TestValidateSecurityCenterFilter(t *testing.T) {
+	tests := []struct {
+		val         string
+		errExpected bool
+	}{
+		{`severity = "HIGH"`, false},
+		{`severity = "HIGH" AND (state = "ACTIVE" OR state = "INACTIVE")`, false},
+		{`category = "a \"quoted\" (value"`, false},
+		{``, true},
+		{`   `, true},
+		{`severity = "HIGH`, true},
+		{`(severity = "HIGH"`, true},
+		{`severity = "HIGH")`, true},
+	}
+
+	for _, test := range tests {
+		_, errs := validateSecurityCenterFilter(test.val, "filter")
+		if test.errExpected != (len(errs) > 0) {
+			t.Errorf("Got unexpected result for val %#v: errors = %#v", test.val, errs)
+		}
+	}
+}
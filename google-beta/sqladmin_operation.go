@@ -65,7 +65,7 @@ func (w *SqlAdminOperationWaiter) QueryOp() (interface{}, error) {
 	var err error
 	err = retryTimeDuration(
 		func() error {
-			op, err = w.Service.Operations.Get(w.Project, w.Op.Name).Do()
+			op, err = w.Service.Operations.Get("", w.Op.Name).Do()
 			return err
 		},
 
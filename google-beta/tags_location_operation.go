@@ -0,0 +1,65 @@
+package google
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// tagsLocationBasePath returns the base path of the regional tags endpoint
+// for a location.
+func tagsLocationBasePath(config *Config, location string) string {
+	return strings.Replace(config.TagsLocationBasePath, "{{location}}", location, 1)
+}
+
+// Operations on regional tag bindings can only be read from the regional
+// endpoint they were started on.
+type TagsLocationOperationWaiter struct {
+	Config   *Config
+	Location string
+	CommonOperationWaiter
+}
+
+func (w *TagsLocationOperationWaiter) QueryOp() (interface{}, error) {
+	if w == nil {
+		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
+	}
+	url := fmt.Sprintf("%s%s", tagsLocationBasePath(w.Config, w.Location), w.CommonOperationWaiter.Op.Name)
+	return sendRequest(w.Config, "GET", "", url, nil)
+}
+
+func createTagsLocationWaiter(config *Config, op map[string]interface{}, location, activity string) (*TagsLocationOperationWaiter, error) {
+	if val, ok := op["name"]; !ok || val == "" {
+		// This was a synchronous call - there is no operation to wait for.
+		return nil, nil
+	}
+	w := &TagsLocationOperationWaiter{
+		Config:   config,
+		Location: location,
+	}
+	if err := w.CommonOperationWaiter.SetOp(op); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func tagsLocationOperationWaitTimeWithResponse(config *Config, op map[string]interface{}, response *map[string]interface{}, location, activity string, timeoutMinutes int) error {
+	w, err := createTagsLocationWaiter(config, op, location, activity)
+	if err != nil || w == nil {
+		// If w is nil, the op was synchronous.
+		return err
+	}
+	if err := OperationWait(w, activity, timeoutMinutes); err != nil {
+		return err
+	}
+	return json.Unmarshal([]byte(w.CommonOperationWaiter.Op.Response), response)
+}
+
+func tagsLocationOperationWaitTime(config *Config, op map[string]interface{}, location, activity string, timeoutMinutes int) error {
+	w, err := createTagsLocationWaiter(config, op, location, activity)
+	if err != nil || w == nil {
+		// If w is nil, the op was synchronous.
+		return err
+	}
+	return OperationWait(w, activity, timeoutMinutes)
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/errwrap"
 	"google.golang.org/api/googleapi"
 )
 
@@ -34,21 +35,33 @@ func isEmptyValue(v reflect.Value) bool {
 	return false
 }
 
-func sendRequest(config *Config, method, rawurl string, body map[string]interface{}) (map[string]interface{}, error) {
-	return sendRequestWithTimeout(config, method, rawurl, body, DefaultRequestTimeout)
+// sendRequest sends a request to rawurl. project is the project the request's
+// resource belongs to, which is billed for quota when user_project_override is
+// set. It's empty for requests that don't support a user project.
+func sendRequest(config *Config, method, project, rawurl string, body map[string]interface{}) (map[string]interface{}, error) {
+	return sendRequestWithTimeout(config, method, project, rawurl, body, DefaultRequestTimeout)
 }
 
-func sendRequestWithTimeout(config *Config, method, rawurl string, body map[string]interface{}, timeout time.Duration) (map[string]interface{}, error) {
+func sendRequestWithTimeout(config *Config, method, project, rawurl string, body map[string]interface{}, timeout time.Duration) (map[string]interface{}, error) {
+	return sendRequestWithRetryPredicate(config, method, project, rawurl, body, timeout, isRetryableError)
+}
+
+// sendRequestWithRetryPredicate sends a request, retrying the errors for
+// which retryable returns true.
+func sendRequestWithRetryPredicate(config *Config, method, project, rawurl string, body map[string]interface{}, timeout time.Duration, retryable func(error) bool) (map[string]interface{}, error) {
 	reqHeaders := make(http.Header)
 	reqHeaders.Set("User-Agent", config.userAgent)
 	reqHeaders.Set("Content-Type", "application/json")
+	if userProject := requestUserProject(config, project); userProject != "" {
+		reqHeaders.Set("X-Goog-User-Project", userProject)
+	}
 
 	if timeout == 0 {
 		timeout = time.Duration(1) * time.Hour
 	}
 
 	var res *http.Response
-	err := retryTimeDuration(
+	err := retryTimeDurationWithPredicate(
 		func() error {
 			var buf bytes.Buffer
 			if body != nil {
@@ -81,9 +94,10 @@ func sendRequestWithTimeout(config *Config, method, rawurl string, body map[stri
 			return nil
 		},
 		timeout,
+		retryable,
 	)
 	if err != nil {
-		return nil, err
+		return nil, userProjectError(config, err)
 	}
 
 	if res == nil {
@@ -106,6 +120,43 @@ func sendRequestWithTimeout(config *Config, method, rawurl string, body map[stri
 	return result, nil
 }
 
+// requestUserProject returns the project to bill a request's quota to: the
+// provider's billing_project, or failing that the request's own project. It's
+// empty unless user_project_override is set and the request supports a user
+// project.
+func requestUserProject(config *Config, project string) string {
+	if !config.UserProjectOverride || project == "" {
+		return ""
+	}
+	if config.BillingProject != "" {
+		return config.BillingProject
+	}
+	return project
+}
+
+// userProjectError explains errors from APIs that need to know which project
+// to bill quota to, which the API error itself rarely makes clear.
+func userProjectError(config *Config, err error) error {
+	gerr, ok := errwrap.GetType(err, &googleapi.Error{}).(*googleapi.Error)
+	if !ok || gerr == nil || gerr.Code != 403 {
+		return err
+	}
+
+	if strings.Contains(gerr.Body, "USER_PROJECT_DENIED") {
+		userProject := "of the resource"
+		if config.BillingProject != "" {
+			userProject = fmt.Sprintf("%q", config.BillingProject)
+		}
+		return errwrap.Wrapf(fmt.Sprintf("The credentials used can't bill quota to the user project %s, which requires the "+
+			"serviceusage.services.use permission on it: {{err}}", userProject), err)
+	}
+	if strings.Contains(strings.ToLower(gerr.Message), "quota project") && !config.UserProjectOverride {
+		return errwrap.Wrapf("This API requires a user project to bill quota to. Set user_project_override = true, "+
+			"and optionally billing_project, in the provider configuration: {{err}}", err)
+	}
+	return err
+}
+
 func addQueryParams(rawurl string, params map[string]string) (string, error) {
 	u, err := url.Parse(rawurl)
 	if err != nil {
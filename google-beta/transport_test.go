@@ -1,6 +1,9 @@
 package google
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"regexp"
 	"strings"
@@ -162,3 +165,71 @@ func TestReplaceVars(t *testing.T) {
 		}
 	}
 }
+
+func TestSendRequest_userProjectOverride(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Config   *Config
+		Project  string
+		Expected string
+	}{
+		"override with billing project": {
+			Config:   &Config{UserProjectOverride: true, BillingProject: "billing-project"},
+			Project:  "p",
+			Expected: "billing-project",
+		},
+		"override with resource project": {
+			Config:   &Config{UserProjectOverride: true},
+			Project:  "p",
+			Expected: "p",
+		},
+		"override on a request without a user project": {
+			Config: &Config{UserProjectOverride: true, BillingProject: "billing-project"},
+		},
+		"billing project without override": {
+			Config:  &Config{BillingProject: "billing-project"},
+			Project: "p",
+		},
+	}
+
+	for tn, tc := range cases {
+		var userProject string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			userProject = r.Header.Get("X-Goog-User-Project")
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"name": "topic"}`)
+		}))
+
+		tc.Config.client = server.Client()
+		// The project in the URL is never used as the user project, as the
+		// request may read a resource owned by another project.
+		if _, err := sendRequest(tc.Config, "GET", tc.Project, server.URL+"/projects/debian-cloud/topics/topic", nil); err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+		if userProject != tc.Expected {
+			t.Errorf("%s: expected X-Goog-User-Project to be %q, got %q", tn, tc.Expected, userProject)
+		}
+		server.Close()
+	}
+}
+
+func TestSendRequest_userProjectRequired(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"error": {"code": 403, "message": "Your application is authenticating by using local Application Default Credentials. The pubsub.googleapis.com API requires a quota project, which is not set by default."}}`)
+	}))
+	defer server.Close()
+
+	config := &Config{client: server.Client()}
+	_, err := sendRequest(config, "GET", "", server.URL+"/projects/p/topics/topic", nil)
+	if err == nil || !strings.Contains(err.Error(), "Set user_project_override = true") {
+		t.Fatalf("expected an error explaining how to set a user project, got: %v", err)
+	}
+	if !isGoogleApiErrorWithCode(err, 403) {
+		t.Errorf("expected the API error to be preserved, got: %#v", err)
+	}
+}
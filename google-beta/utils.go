@@ -232,9 +232,11 @@ func rfc3339TimeDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
 	return false
 }
 
-// expandLabels pulls the value of "labels" out of a TerraformResourceData as a map[string]string.
+// expandLabels pulls the labels Terraform manages, the resource's labels merged
+// with the provider's default labels, out of a TerraformResourceData as a
+// map[string]string.
 func expandLabels(d TerraformResourceData) map[string]string {
-	return expandStringMap(d, "labels")
+	return expandStringMap(d, "terraform_labels")
 }
 
 // expandEnvironmentVariables pulls the value of "environment_variables" out of a schema.ResourceData as a map[string]string.
@@ -363,13 +365,17 @@ func retryTime(retryFunc func() error, minutes int) error {
 }
 
 func retryTimeDuration(retryFunc func() error, duration time.Duration) error {
+	return retryTimeDurationWithPredicate(retryFunc, duration, isRetryableError)
+}
+
+func retryTimeDurationWithPredicate(retryFunc func() error, duration time.Duration, retryable func(error) bool) error {
 	return resource.Retry(duration, func() *resource.RetryError {
 		err := retryFunc()
 		if err == nil {
 			return nil
 		}
 		for _, e := range errwrap.GetAllType(err, &googleapi.Error{}) {
-			if isRetryableError(e) {
+			if retryable(e) {
 				return resource.RetryableError(e)
 			}
 		}
@@ -458,7 +464,7 @@ func serviceAccountFQN(serviceAccount string, d TerraformResourceData, config *C
 }
 
 func paginatedListRequest(baseUrl string, config *Config, flattener func(map[string]interface{}) []interface{}) ([]interface{}, error) {
-	res, err := sendRequest(config, "GET", baseUrl, nil)
+	res, err := sendRequest(config, "GET", "", baseUrl, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -470,7 +476,7 @@ func paginatedListRequest(baseUrl string, config *Config, flattener func(map[str
 			break
 		}
 		url := fmt.Sprintf("%s?pageToken=%s", baseUrl, pageToken.(string))
-		res, err = sendRequest(config, "GET", url, nil)
+		res, err = sendRequest(config, "GET", "", url, nil)
 		if err != nil {
 			return nil, err
 		}
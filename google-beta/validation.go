@@ -3,6 +3,7 @@ package google
 import (
 	"fmt"
 	"net"
+	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
@@ -30,6 +31,11 @@ const (
 
 	// https://cloud.google.com/iam/docs/understanding-custom-roles#naming_the_role
 	IAMCustomRoleIDRegex = "^[a-zA-Z0-9_\\.]{3,64}$"
+
+	// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference-arns.html
+	AwsKinesisStreamArnRegex   = "^arn:aws(?:-[a-z]+)*:kinesis:[a-z0-9-]+:[0-9]{12}:stream/[a-zA-Z0-9_.-]{1,128}$"
+	AwsKinesisConsumerArnRegex = "^arn:aws(?:-[a-z]+)*:kinesis:[a-z0-9-]+:[0-9]{12}:stream/[a-zA-Z0-9_.-]{1,128}/consumer/[a-zA-Z0-9_.-]{1,128}:[0-9]+$"
+	AwsIamRoleArnRegex         = "^arn:aws(?:-[a-z]+)*:iam::[0-9]{12}:role/[\\w+=,.@/-]{1,512}$"
 )
 
 var (
@@ -137,6 +143,18 @@ func validateRFC1035Name(min, max int) schema.SchemaValidateFunc {
 	return validateRegexp(fmt.Sprintf("^"+RFC1035NameTemplate+"$", min-2, max-2))
 }
 
+// Google's ASN, which routers used by Partner Interconnect must use.
+const partnerInterconnectAsn = 16550
+
+func validateRFC6996Asn(v interface{}, k string) (ws []string, errors []error) {
+	value := int64(v.(int))
+	if !(value == partnerInterconnectAsn || value >= 64512 && value <= 65534 || value >= 4200000000 && value <= 4294967294) {
+		errors = append(errors, fmt.Errorf(
+			"expected %q to be a RFC6996-compliant Local ASN: must be either in the private ASN range: 64512 - 65534 or 4200000000 - 4294967294, or 16550 for Partner Interconnect; was %d", k, value))
+	}
+	return
+}
+
 func validateIpCidrRange(v interface{}, k string) (warnings []string, errors []error) {
 	_, _, err := net.ParseCIDR(v.(string))
 	if err != nil {
@@ -145,6 +163,14 @@ func validateIpCidrRange(v interface{}, k string) (warnings []string, errors []e
 	return
 }
 
+func validateIpv4Address(v interface{}, k string) (warnings []string, errors []error) {
+	ip := net.ParseIP(v.(string))
+	if ip == nil || ip.To4() == nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid IPv4 address, got: %s", k, v))
+	}
+	return
+}
+
 func validateCloudIoTID(v interface{}, k string) (warnings []string, errors []error) {
 	value := v.(string)
 	if strings.HasPrefix(value, "goog") {
@@ -262,3 +288,47 @@ func StringNotInSlice(invalid []string, ignoreCase bool) schema.SchemaValidateFu
 		return
 	}
 }
+
+// validateOAuthScope checks that an OAuth 2.0 scope is a well-formed URL, such
+// as https://www.googleapis.com/auth/cloud-platform.
+// validateSecondsDurationBetween checks that a proto3 Duration string such as
+// "86400s" is expressed in whole seconds and falls within [min, max].
+func validateSecondsDurationBetween(min, max time.Duration) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (s []string, es []error) {
+		v, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if !regexp.MustCompile(`^[0-9]+s$`).MatchString(v) {
+			es = append(es, fmt.Errorf("expected %s to be a duration in seconds ending in 's', such as \"86400s\", got %q", k, v))
+			return
+		}
+
+		dur, err := time.ParseDuration(v)
+		if err != nil {
+			es = append(es, fmt.Errorf("expected %s to be a duration, but parsing gave an error: %s", k, err.Error()))
+			return
+		}
+
+		if dur < min || dur > max {
+			es = append(es, fmt.Errorf("expected %s to be between %.0fs and %.0fs, got %s", k, min.Seconds(), max.Seconds(), v))
+		}
+
+		return
+	}
+}
+
+func validateOAuthScope(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	u, err := url.Parse(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q (%q) is not a valid URL: %s", k, value, err))
+		return
+	}
+	if u.Scheme != "https" || u.Host == "" || strings.Trim(u.Path, "/") == "" {
+		errors = append(errors, fmt.Errorf("%q (%q) must be an OAuth 2.0 scope URL such as \"https://www.googleapis.com/auth/cloud-platform\"", k, value))
+	}
+	return
+}
@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -33,6 +34,27 @@ func TestValidateGCPName(t *testing.T) {
 	}
 }
 
+func TestValidateOAuthScope(t *testing.T) {
+	x := []StringValidationTestCase{
+		// No errors
+		{TestName: "cloud-platform", Value: "https://www.googleapis.com/auth/cloud-platform"},
+		{TestName: "read only", Value: "https://www.googleapis.com/auth/compute.readonly"},
+		{TestName: "userinfo", Value: "https://www.googleapis.com/auth/userinfo.email"},
+
+		// With errors
+		{TestName: "empty", Value: "", ExpectError: true},
+		{TestName: "short name", Value: "cloud-platform", ExpectError: true},
+		{TestName: "not https", Value: "http://www.googleapis.com/auth/cloud-platform", ExpectError: true},
+		{TestName: "no path", Value: "https://www.googleapis.com/", ExpectError: true},
+		{TestName: "malformed", Value: "https://www.googleapis.com/auth/%zz", ExpectError: true},
+	}
+
+	es := testStringValidationCases(x, validateOAuthScope)
+	if len(es) > 0 {
+		t.Errorf("Failed to validate OAuth scopes: %v", es)
+	}
+}
+
 func TestValidateRFC1918Network(t *testing.T) {
 	x := []RFC1918NetworkTestCase{
 		// No errors
@@ -241,6 +263,109 @@ func TestValidateCloudIoTID(t *testing.T) {
 	}
 }
 
+func TestValidateAwsArns(t *testing.T) {
+	streams := []StringValidationTestCase{
+		// No errors
+		{TestName: "stream", Value: "arn:aws:kinesis:us-west-2:111111111111:stream/fake-stream-name"},
+		{TestName: "partition", Value: "arn:aws-us-gov:kinesis:us-gov-west-1:111111111111:stream/fake-stream-name"},
+
+		// With errors
+		{TestName: "consumer", Value: "arn:aws:kinesis:us-west-2:111111111111:stream/fake-stream-name/consumer/consumer-1:1111111111", ExpectError: true},
+		{TestName: "short account", Value: "arn:aws:kinesis:us-west-2:1111:stream/fake-stream-name", ExpectError: true},
+		{TestName: "other service", Value: "arn:aws:sqs:us-west-2:111111111111:stream/fake-stream-name", ExpectError: true},
+	}
+	consumers := []StringValidationTestCase{
+		// No errors
+		{TestName: "consumer", Value: "arn:aws:kinesis:us-west-2:111111111111:stream/fake-stream-name/consumer/consumer-1:1111111111"},
+
+		// With errors
+		{TestName: "stream", Value: "arn:aws:kinesis:us-west-2:111111111111:stream/fake-stream-name", ExpectError: true},
+		{TestName: "no creation timestamp", Value: "arn:aws:kinesis:us-west-2:111111111111:stream/fake-stream-name/consumer/consumer-1", ExpectError: true},
+	}
+	roles := []StringValidationTestCase{
+		// No errors
+		{TestName: "role", Value: "arn:aws:iam::111111111111:role/fake-role-name"},
+		{TestName: "role with path", Value: "arn:aws:iam::111111111111:role/service-role/fake-role-name"},
+
+		// With errors
+		{TestName: "user", Value: "arn:aws:iam::111111111111:user/fake-user-name", ExpectError: true},
+		{TestName: "with region", Value: "arn:aws:iam:us-west-2:111111111111:role/fake-role-name", ExpectError: true},
+	}
+
+	es := testStringValidationCases(streams, validateRegexp(AwsKinesisStreamArnRegex))
+	es = append(es, testStringValidationCases(consumers, validateRegexp(AwsKinesisConsumerArnRegex))...)
+	es = append(es, testStringValidationCases(roles, validateRegexp(AwsIamRoleArnRegex))...)
+	if len(es) > 0 {
+		t.Errorf("Failed to validate AWS ARNs: %v", es)
+	}
+}
+
+func TestValidateIpv4Address(t *testing.T) {
+	x := []StringValidationTestCase{
+		// No errors
+		{TestName: "private", Value: "172.16.1.10"},
+		{TestName: "public", Value: "8.8.8.8"},
+
+		// With errors
+		{TestName: "empty", Value: "", ExpectError: true},
+		{TestName: "ipv6", Value: "2001:db8::1", ExpectError: true},
+		{TestName: "cidr", Value: "10.0.0.0/8", ExpectError: true},
+		{TestName: "hostname", Value: "ns1.example.com", ExpectError: true},
+	}
+
+	es := testStringValidationCases(x, validateIpv4Address)
+	if len(es) > 0 {
+		t.Errorf("Failed to validate IPv4 addresses: %v", es)
+	}
+}
+
+func TestValidateRFC6996Asn(t *testing.T) {
+	cases := map[string]struct {
+		Value       int
+		ExpectError bool
+	}{
+		"16-bit private":           {Value: 64514},
+		"16-bit private upper":     {Value: 65534},
+		"32-bit private":           {Value: 4200000000},
+		"partner interconnect":     {Value: 16550},
+		"public":                   {Value: 15169, ExpectError: true},
+		"16-bit reserved":          {Value: 65535, ExpectError: true},
+		"32-bit private too large": {Value: 4294967295, ExpectError: true},
+	}
+
+	for tn, tc := range cases {
+		_, errs := validateRFC6996Asn(tc.Value, "asn")
+		if tc.ExpectError && len(errs) == 0 {
+			t.Errorf("%s: expected an error for %d", tn, tc.Value)
+		}
+		if !tc.ExpectError && len(errs) > 0 {
+			t.Errorf("%s: unexpected errors for %d: %v", tn, tc.Value, errs)
+		}
+	}
+}
+
+func TestValidateSecondsDurationBetween(t *testing.T) {
+	x := []StringValidationTestCase{
+		// No errors
+		{TestName: "minimum", Value: "86400s"},
+		{TestName: "within range", Value: "100000s"},
+		{TestName: "maximum", Value: "3122064000s"},
+
+		// With errors
+		{TestName: "empty", Value: "", ExpectError: true},
+		{TestName: "too short", Value: "3600s", ExpectError: true},
+		{TestName: "too long", Value: "3122064001s", ExpectError: true},
+		{TestName: "not seconds", Value: "24h", ExpectError: true},
+		{TestName: "fractional", Value: "86400.5s", ExpectError: true},
+		{TestName: "negative", Value: "-86400s", ExpectError: true},
+	}
+
+	es := testStringValidationCases(x, validateSecondsDurationBetween(24*time.Hour, 3122064000*time.Second))
+	if len(es) > 0 {
+		t.Errorf("Failed to validate durations: %v", es)
+	}
+}
+
 func TestOrEmpty(t *testing.T) {
 	cases := map[string]struct {
 		Value                  string